@@ -2,6 +2,7 @@ package app
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
@@ -12,6 +13,7 @@ type Config struct {
 	HttpServer HttpServerConfig
 	Postgres   PostgresConfig
 	NewRelic   NewRelicConfig
+	Ledger     LedgerConfig
 }
 
 func LoadConfig() (*Config, error) {
@@ -61,6 +63,39 @@ type NewRelicConfig struct {
 	LicenseKey string `envconfig:"NEW_RELIC_LICENSE_KEY"`
 }
 
+type LedgerConfig struct {
+	StrictAccounts          bool                  `envconfig:"LEDGER_STRICT_ACCOUNTS" default:"false"`
+	PendingTransactionTTL   time.Duration         `envconfig:"LEDGER_PENDING_TRANSACTION_TTL" default:"168h"`
+	AccountClasses          AccountClassesConfig  `envconfig:"LEDGER_ACCOUNT_CLASSES" default:"asset:debit:balance_sheet conciliate_credit:credit conciliate_debit:debit equity:credit:balance_sheet expense:debit:income_statement liability:credit:balance_sheet revenue:credit:income_statement"`
+	ChartOfAccounts         ChartOfAccountsConfig `envconfig:"LEDGER_CHART_OF_ACCOUNTS"`
+	RetainedEarningsAccount string                `envconfig:"LEDGER_RETAINED_EARNINGS_ACCOUNT" default:"equity.retained_earnings.accumulated"`
+	Scheduler               SchedulerConfig
+}
+
+// AccountClassesConfig has the account classes, separated by spaces, each declared along with its natural
+// side and, optionally, its financial statement as '<name>:<credit|debit>[:<balance_sheet|income_statement>]'.
+type AccountClassesConfig []string
+
+func (c *AccountClassesConfig) Decode(value string) error {
+	*c = strings.Fields(value)
+	return nil
+}
+
+// ChartOfAccountsConfig has the account patterns allowed to receive entries, separated by spaces,
+// as commas are part of the pattern syntax.
+type ChartOfAccountsConfig []string
+
+func (c *ChartOfAccountsConfig) Decode(value string) error {
+	*c = strings.Fields(value)
+	return nil
+}
+
+type SchedulerConfig struct {
+	Interval     time.Duration `envconfig:"LEDGER_SCHEDULER_INTERVAL" default:"10s"`
+	BatchSize    int           `envconfig:"LEDGER_SCHEDULER_BATCH_SIZE" default:"100"`
+	ClaimTimeout time.Duration `envconfig:"LEDGER_SCHEDULER_CLAIM_TIMEOUT" default:"5m"`
+}
+
 func (c PostgresConfig) DSN() string {
 	connectString := fmt.Sprintf("user=%s password=%s host=%s port=%s dbname=%s pool_min_conns=%s pool_max_conns=%s",
 		c.User, c.Password, c.Host, c.Port, c.DatabaseName, c.PoolMinSize, c.PoolMaxSize)
@@ -0,0 +1,65 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+// Account is an analytic account explicitly opened in the account registry, along with its lifecycle status.
+// Accounts that were never opened can still receive entries, unless the ledger runs in strict mode.
+type Account struct {
+	Account     vos.Account
+	Owner       string
+	DisplayName string
+	OpeningDate time.Time
+	Status      vos.AccountStatus
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func NewAccount(account, owner, displayName string, openingDate time.Time) (Account, error) {
+	acc, err := vos.NewAnalyticAccount(account)
+	if err != nil {
+		return Account{}, err
+	}
+
+	if owner == "" {
+		return Account{}, app.ErrInvalidAccountOwner
+	}
+
+	return Account{
+		Account:     acc,
+		Owner:       owner,
+		DisplayName: displayName,
+		OpeningDate: openingDate,
+		Status:      vos.ActiveAccountStatus,
+	}, nil
+}
+
+// Freeze blocks an active account from receiving new entries.
+func (a Account) Freeze() (Account, error) {
+	return a.transition(vos.FrozenAccountStatus, vos.ActiveAccountStatus)
+}
+
+// Unfreeze makes a frozen account active again.
+func (a Account) Unfreeze() (Account, error) {
+	return a.transition(vos.ActiveAccountStatus, vos.FrozenAccountStatus)
+}
+
+// Close permanently blocks an account, either active or frozen, from receiving new entries.
+func (a Account) Close() (Account, error) {
+	return a.transition(vos.ClosedAccountStatus, vos.ActiveAccountStatus, vos.FrozenAccountStatus)
+}
+
+func (a Account) transition(to vos.AccountStatus, from ...vos.AccountStatus) (Account, error) {
+	for _, status := range from {
+		if a.Status == status {
+			a.Status = to
+			return a, nil
+		}
+	}
+
+	return Account{}, app.ErrInvalidAccountStatusTransition
+}
@@ -0,0 +1,134 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func TestNewAccount(t *testing.T) {
+	openingDate := time.Now()
+
+	testCases := []struct {
+		name        string
+		account     string
+		owner       string
+		expectedErr error
+	}{
+		{
+			name:        "Successfully opens an analytic account",
+			account:     "liability.clients.available.111",
+			owner:       "client_111",
+			expectedErr: nil,
+		},
+		{
+			name:        "Invalid when account is synthetic",
+			account:     "liability.clients.*",
+			owner:       "client_111",
+			expectedErr: app.ErrInvalidSingleAccountComponentCharacters,
+		},
+		{
+			name:        "Invalid when owner is empty",
+			account:     "liability.clients.available.111",
+			owner:       "",
+			expectedErr: app.ErrInvalidAccountOwner,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAccount(tt.account, tt.owner, "Client 111", openingDate)
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				assert.Empty(t, got)
+				return
+			}
+
+			assert.Equal(t, tt.account, got.Account.Value())
+			assert.Equal(t, tt.owner, got.Owner)
+			assert.Equal(t, "Client 111", got.DisplayName)
+			assert.Equal(t, openingDate, got.OpeningDate)
+			assert.Equal(t, vos.ActiveAccountStatus, got.Status)
+		})
+	}
+}
+
+func TestAccount_StatusTransitions(t *testing.T) {
+	active, err := NewAccount("liability.clients.available.111", "client_111", "", time.Now())
+	require.NoError(t, err)
+
+	frozen := active
+	frozen.Status = vos.FrozenAccountStatus
+
+	closed := active
+	closed.Status = vos.ClosedAccountStatus
+
+	testCases := []struct {
+		name           string
+		transition     func() (Account, error)
+		expectedStatus vos.AccountStatus
+		expectedErr    error
+	}{
+		{
+			name:           "Freezes an active account",
+			transition:     active.Freeze,
+			expectedStatus: vos.FrozenAccountStatus,
+		},
+		{
+			name:        "Invalid when freezing a frozen account",
+			transition:  frozen.Freeze,
+			expectedErr: app.ErrInvalidAccountStatusTransition,
+		},
+		{
+			name:           "Unfreezes a frozen account",
+			transition:     frozen.Unfreeze,
+			expectedStatus: vos.ActiveAccountStatus,
+		},
+		{
+			name:        "Invalid when unfreezing an active account",
+			transition:  active.Unfreeze,
+			expectedErr: app.ErrInvalidAccountStatusTransition,
+		},
+		{
+			name:           "Closes an active account",
+			transition:     active.Close,
+			expectedStatus: vos.ClosedAccountStatus,
+		},
+		{
+			name:           "Closes a frozen account",
+			transition:     frozen.Close,
+			expectedStatus: vos.ClosedAccountStatus,
+		},
+		{
+			name:        "Invalid when closing a closed account",
+			transition:  closed.Close,
+			expectedErr: app.ErrInvalidAccountStatusTransition,
+		},
+		{
+			name:        "Invalid when unfreezing a closed account",
+			transition:  closed.Unfreeze,
+			expectedErr: app.ErrInvalidAccountStatusTransition,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.transition()
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				assert.Empty(t, got)
+				return
+			}
+
+			assert.Equal(t, tt.expectedStatus, got.Status)
+			assert.Equal(t, active.Account, got.Account)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package entities
+
+import (
+	"math"
+	"time"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+)
+
+// AllEvents is the event of the lock that applies to every event of a company without an override.
+const AllEvents uint32 = 0
+
+// CompetenceLock rejects the transactions of a company with competence dates on or before its date, so
+// the reports already filed don't change. A lock of a specific event overrides the lock of the company,
+// either way. Transactions authorized as adjustments are posted regardless.
+type CompetenceLock struct {
+	Company   string
+	Event     uint32
+	Date      time.Time
+	UpdatedBy string
+	UpdatedAt time.Time
+}
+
+// CompetenceLockChange is the audit record of a move of a lock date. A zero Date means the lock was removed,
+// and a zero PreviousDate means it didn't exist.
+type CompetenceLockChange struct {
+	Company      string
+	Event        uint32
+	PreviousDate time.Time
+	Date         time.Time
+	RequestedBy  string
+	Reason       string
+	CreatedAt    time.Time
+}
+
+// NewCompetenceLockChange moves the lock date of a company, or of one of its events, to the given date.
+// A zero date removes the lock.
+func NewCompetenceLockChange(
+	company string,
+	event uint32,
+	date time.Time,
+	requestedBy string,
+	reason string,
+	now time.Time,
+) (CompetenceLockChange, error) {
+	if company == "" {
+		return CompetenceLockChange{}, app.ErrInvalidCompetenceLockCompany
+	}
+
+	if event > math.MaxInt16 {
+		return CompetenceLockChange{}, app.ErrInvalidEventID
+	}
+
+	if requestedBy == "" {
+		return CompetenceLockChange{}, app.ErrInvalidCompetenceLockRequester
+	}
+
+	return CompetenceLockChange{
+		Company:     company,
+		Event:       event,
+		Date:        date,
+		RequestedBy: requestedBy,
+		Reason:      reason,
+		CreatedAt:   now,
+	}, nil
+}
+
+// AdjustmentAuthorization is the audit record of who authorized a transaction to be posted as an adjustment,
+// and why.
+type AdjustmentAuthorization struct {
+	RequestedBy string
+	Reason      string
+}
+
+// Authorize flags the transaction as an adjustment, to be posted regardless of the competence locks.
+func (t Transaction) Authorize(requestedBy, reason string) (Transaction, error) {
+	if requestedBy == "" {
+		return Transaction{}, app.ErrInvalidAdjustmentRequester
+	}
+
+	if reason == "" {
+		return Transaction{}, app.ErrInvalidAdjustmentReason
+	}
+
+	t.Adjustment = true
+	t.Authorization = AdjustmentAuthorization{
+		RequestedBy: requestedBy,
+		Reason:      reason,
+	}
+
+	return t, nil
+}
+
+// CheckAdjustment ensures that a transaction flagged as an adjustment tells who authorized it and why.
+func (t Transaction) CheckAdjustment() error {
+	if t.Adjustment && (t.Authorization.RequestedBy == "" || t.Authorization.Reason == "") {
+		return app.ErrUnauthorizedAdjustment
+	}
+
+	return nil
+}
+
+// Removes reports whether the change removes the lock.
+func (c CompetenceLockChange) Removes() bool {
+	return c.Date.IsZero()
+}
+
+// Lock returns the lock as of the change.
+func (c CompetenceLockChange) Lock() CompetenceLock {
+	return CompetenceLock{
+		Company:   c.Company,
+		Event:     c.Event,
+		Date:      c.Date,
+		UpdatedBy: c.RequestedBy,
+		UpdatedAt: c.CreatedAt,
+	}
+}
+
+// Locks reports whether the competence date falls on or before the lock date.
+func (l CompetenceLock) Locks(competenceDate time.Time) bool {
+	return !competenceDate.After(l.Date)
+}
+
+// CheckCompetenceLocks ensures that the transaction isn't locked by the most specific of the given locks
+// of its company, which is the lock of its event, or the lock of the company when there is none.
+func (t Transaction) CheckCompetenceLocks(locks []CompetenceLock) error {
+	if t.Adjustment && t.CheckAdjustment() == nil {
+		return nil
+	}
+
+	var (
+		lock  CompetenceLock
+		found bool
+	)
+
+	for _, l := range locks {
+		if l.Company != t.Company {
+			continue
+		}
+
+		if l.Event == t.Event {
+			lock, found = l, true
+			break
+		}
+
+		if l.Event == AllEvents {
+			lock, found = l, true
+		}
+	}
+
+	if found && lock.Locks(t.CompetenceDate) {
+		return app.CompetenceLockError{
+			Company:  t.Company,
+			Event:    lock.Event,
+			LockDate: lock.Date,
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,228 @@
+package entities
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func TestNewCompetenceLockChange(t *testing.T) {
+	date := time.Date(2021, 6, 30, 0, 0, 0, 0, time.UTC)
+	now := time.Now()
+
+	testCases := []struct {
+		name        string
+		company     string
+		event       uint32
+		requestedBy string
+		expectedErr error
+	}{
+		{
+			name:        "Valid lock of a company",
+			company:     "abc",
+			requestedBy: "accountant",
+		},
+		{
+			name:        "Valid lock of an event",
+			company:     "abc",
+			event:       7,
+			requestedBy: "accountant",
+		},
+		{
+			name:        "Lock without company",
+			requestedBy: "accountant",
+			expectedErr: app.ErrInvalidCompetenceLockCompany,
+		},
+		{
+			name:        "Lock of an invalid event",
+			company:     "abc",
+			event:       1 << 16,
+			requestedBy: "accountant",
+			expectedErr: app.ErrInvalidEventID,
+		},
+		{
+			name:        "Lock without requester",
+			company:     "abc",
+			expectedErr: app.ErrInvalidCompetenceLockRequester,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCompetenceLockChange(tt.company, tt.event, date, tt.requestedBy, "filed", now)
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr == nil {
+				assert.Equal(t, date, got.Lock().Date)
+				assert.Equal(t, tt.requestedBy, got.Lock().UpdatedBy)
+				assert.False(t, got.Removes())
+			}
+		})
+	}
+}
+
+func TestTransaction_CheckCompetenceLocks(t *testing.T) {
+	lockDate := time.Date(2021, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	companyLock := CompetenceLock{Company: "abc", Event: AllEvents, Date: lockDate}
+	eventLock := CompetenceLock{Company: "abc", Event: 2, Date: lockDate.AddDate(0, -3, 0)}
+	otherLock := CompetenceLock{Company: "other", Event: AllEvents, Date: lockDate.AddDate(1, 0, 0)}
+	locks := []CompetenceLock{companyLock, eventLock, otherLock}
+
+	testCases := []struct {
+		name         string
+		event        uint32
+		date         time.Time
+		adjustment   bool
+		unauthorized bool
+		locks        []CompetenceLock
+		expectedLock *CompetenceLock
+	}{
+		{
+			name:  "Transaction after the lock date",
+			event: 1,
+			date:  lockDate.AddDate(0, 0, 1),
+			locks: locks,
+		},
+		{
+			name:         "Transaction on the lock date",
+			event:        1,
+			date:         lockDate,
+			locks:        locks,
+			expectedLock: &companyLock,
+		},
+		{
+			name:         "Transaction before the lock date",
+			event:        1,
+			date:         lockDate.AddDate(-2, 0, 0),
+			locks:        locks,
+			expectedLock: &companyLock,
+		},
+		{
+			name:       "Adjustment before the lock date",
+			event:      1,
+			date:       lockDate.AddDate(-2, 0, 0),
+			adjustment: true,
+			locks:      locks,
+		},
+		{
+			name:         "Unauthorized adjustment before the lock date",
+			event:        1,
+			date:         lockDate.AddDate(-2, 0, 0),
+			unauthorized: true,
+			locks:        locks,
+			expectedLock: &companyLock,
+		},
+		{
+			name:  "Transaction of an event with an earlier override",
+			event: 2,
+			date:  lockDate.AddDate(0, -1, 0),
+			locks: locks,
+		},
+		{
+			name:         "Transaction of an event before its override",
+			event:        2,
+			date:         lockDate.AddDate(0, -4, 0),
+			locks:        locks,
+			expectedLock: &eventLock,
+		},
+		{
+			name:  "Transaction of a company without locks",
+			event: 1,
+			date:  lockDate.AddDate(-2, 0, 0),
+			locks: []CompetenceLock{otherLock},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			debit, err := NewEntry(uuid.New(), vos.DebitOperation, "asset.bank.account", vos.IgnoreAccountVersion, 10, "BRL", nil)
+			require.NoError(t, err)
+
+			credit, err := NewEntry(uuid.New(), vos.CreditOperation, "liability.clients.available", vos.IgnoreAccountVersion, 10, "BRL", nil)
+			require.NoError(t, err)
+
+			tx, err := NewTransaction(uuid.New(), tt.event, "abc", tt.date, debit, credit)
+			require.NoError(t, err)
+
+			if tt.adjustment {
+				tx, err = tx.Authorize("accountant", "late invoice")
+				require.NoError(t, err)
+			}
+
+			tx.Adjustment = tx.Adjustment || tt.unauthorized
+
+			err = tx.CheckCompetenceLocks(tt.locks)
+			if tt.expectedLock == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, app.ErrCompetenceDateLocked)
+
+			var lockErr app.CompetenceLockError
+			require.True(t, errors.As(err, &lockErr))
+			assert.Equal(t, tt.expectedLock.Event, lockErr.Event)
+			assert.Equal(t, tt.expectedLock.Date, lockErr.LockDate)
+		})
+	}
+}
+
+func TestTransaction_Authorize(t *testing.T) {
+	testCases := []struct {
+		name        string
+		requestedBy string
+		reason      string
+		expectedErr error
+	}{
+		{
+			name:        "Authorized adjustment",
+			requestedBy: "accountant",
+			reason:      "late invoice",
+		},
+		{
+			name:        "Adjustment without requester",
+			reason:      "late invoice",
+			expectedErr: app.ErrInvalidAdjustmentRequester,
+		},
+		{
+			name:        "Adjustment without reason",
+			requestedBy: "accountant",
+			expectedErr: app.ErrInvalidAdjustmentReason,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			debit, err := NewEntry(uuid.New(), vos.DebitOperation, "asset.bank.account", vos.IgnoreAccountVersion, 10, "BRL", nil)
+			require.NoError(t, err)
+
+			credit, err := NewEntry(uuid.New(), vos.CreditOperation, "liability.clients.available", vos.IgnoreAccountVersion, 10, "BRL", nil)
+			require.NoError(t, err)
+
+			tx, err := NewTransaction(uuid.New(), 1, "abc", time.Now(), debit, credit)
+			require.NoError(t, err)
+
+			got, err := tx.Authorize(tt.requestedBy, tt.reason)
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr == nil {
+				assert.True(t, got.Adjustment)
+				assert.Equal(t, AdjustmentAuthorization{RequestedBy: tt.requestedBy, Reason: tt.reason}, got.Authorization)
+				assert.NoError(t, got.CheckAdjustment())
+			}
+		})
+	}
+
+	t.Run("should reject an adjustment flagged without authorization", func(t *testing.T) {
+		tx := Transaction{Adjustment: true}
+		assert.ErrorIs(t, tx.CheckAdjustment(), app.ErrUnauthorizedAdjustment)
+	})
+}
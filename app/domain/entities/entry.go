@@ -15,10 +15,11 @@ type Entry struct {
 	Account   vos.Account
 	Version   vos.Version
 	Amount    int
+	Currency  vos.Currency
 	Metadata  json.RawMessage
 }
 
-func NewEntry(id uuid.UUID, operation vos.OperationType, accountID string, version vos.Version, amount int, metadata json.RawMessage) (Entry, error) {
+func NewEntry(id uuid.UUID, operation vos.OperationType, accountID string, version vos.Version, amount int, currency string, metadata json.RawMessage) (Entry, error) {
 	if id == uuid.Nil {
 		return Entry{}, app.ErrInvalidEntryID
 	}
@@ -36,12 +37,18 @@ func NewEntry(id uuid.UUID, operation vos.OperationType, accountID string, versi
 		return Entry{}, err
 	}
 
+	cur, err := vos.NewCurrency(currency)
+	if err != nil {
+		return Entry{}, err
+	}
+
 	return Entry{
 		ID:        id,
 		Operation: operation,
 		Account:   acc,
 		Version:   version,
 		Amount:    amount,
+		Currency:  cur,
 		Metadata:  metadata,
 	}, nil
 }
@@ -18,6 +18,7 @@ func TestNewEntry(t *testing.T) {
 		account   string
 		version   vos.Version
 		amount    int
+		currency  string
 		metadata  json.RawMessage
 	}
 
@@ -34,6 +35,7 @@ func TestNewEntry(t *testing.T) {
 				account:   "asset.bacen.conta_liquidacao.tesouraria",
 				version:   vos.NextAccountVersion,
 				amount:    123,
+				currency:  "BRL",
 				metadata:  json.RawMessage(`{}`),
 			},
 			expectedErr: nil,
@@ -46,6 +48,7 @@ func TestNewEntry(t *testing.T) {
 				account:   "asset.bacen.conta_liquidacao.tesouraria",
 				version:   vos.NextAccountVersion,
 				amount:    123,
+				currency:  "BRL",
 				metadata:  json.RawMessage(`{}`),
 			},
 			expectedErr: app.ErrInvalidEntryID,
@@ -58,6 +61,7 @@ func TestNewEntry(t *testing.T) {
 				account:   "asset.bacen.conta_liquidacao.tesouraria",
 				version:   vos.NextAccountVersion,
 				amount:    123,
+				currency:  "BRL",
 				metadata:  json.RawMessage(`{}`),
 			},
 			expectedErr: app.ErrInvalidOperation,
@@ -70,6 +74,7 @@ func TestNewEntry(t *testing.T) {
 				account:   "asset.bacen.conta_liquidacao.tesouraria",
 				version:   vos.NextAccountVersion,
 				amount:    0,
+				currency:  "BRL",
 				metadata:  json.RawMessage(`{}`),
 			},
 			expectedErr: app.ErrInvalidAmount,
@@ -82,6 +87,7 @@ func TestNewEntry(t *testing.T) {
 				account:   "asset.bacen.conta_liquidacao.tesouraria",
 				version:   vos.NextAccountVersion,
 				amount:    -1,
+				currency:  "BRL",
 				metadata:  json.RawMessage(`{}`),
 			},
 			expectedErr: app.ErrInvalidAmount,
@@ -94,15 +100,29 @@ func TestNewEntry(t *testing.T) {
 				account:   "asset.bacen",
 				version:   vos.NextAccountVersion,
 				amount:    123,
+				currency:  "BRL",
 				metadata:  json.RawMessage(`{}`),
 			},
 			expectedErr: app.ErrInvalidAccountStructure,
 		},
+		{
+			name: "Invalid when currency is invalid",
+			args: args{
+				id:        uuid.New(),
+				operation: vos.CreditOperation,
+				account:   "asset.bacen.conta_liquidacao.tesouraria",
+				version:   vos.NextAccountVersion,
+				amount:    123,
+				currency:  "brl",
+				metadata:  json.RawMessage(`{}`),
+			},
+			expectedErr: app.ErrInvalidCurrency,
+		},
 	}
 
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
-			entry, err := NewEntry(tt.args.id, tt.args.operation, tt.args.account, tt.args.version, tt.args.amount, tt.args.metadata)
+			entry, err := NewEntry(tt.args.id, tt.args.operation, tt.args.account, tt.args.version, tt.args.amount, tt.args.currency, tt.args.metadata)
 			assert.ErrorIs(t, err, tt.expectedErr)
 
 			if err != nil {
@@ -113,6 +133,7 @@ func TestNewEntry(t *testing.T) {
 				assert.Equal(t, tt.args.account, entry.Account.Value())
 				assert.Equal(t, tt.args.version, entry.Version)
 				assert.Equal(t, tt.args.amount, entry.Amount)
+				assert.Equal(t, tt.args.currency, entry.Currency.String())
 				assert.Equal(t, string(tt.args.metadata), string(entry.Metadata))
 			}
 		})
@@ -0,0 +1,50 @@
+package entities
+
+import (
+	"math"
+	"time"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+)
+
+// Event is an entry of the event catalog, which lists the events that may trigger transactions.
+// Deprecated events are kept for the entries already posted, but reject new postings.
+type Event struct {
+	ID           uint32
+	Name         string
+	Description  string
+	DeprecatedAt time.Time
+	CreatedAt    time.Time
+}
+
+func NewEvent(id uint32, name, description string) (Event, error) {
+	if id == 0 || id > math.MaxInt16 {
+		return Event{}, app.ErrInvalidEventID
+	}
+
+	if name == "" {
+		return Event{}, app.ErrInvalidEventName
+	}
+
+	return Event{
+		ID:          id,
+		Name:        name,
+		Description: description,
+	}, nil
+}
+
+// Deprecated reports whether the event rejects new postings.
+func (e Event) Deprecated() bool {
+	return !e.DeprecatedAt.IsZero()
+}
+
+// Deprecate blocks the event from being used by new postings.
+func (e Event) Deprecate(now time.Time) (Event, error) {
+	if e.Deprecated() {
+		return Event{}, app.ErrEventAlreadyDeprecated
+	}
+
+	e.DeprecatedAt = now
+
+	return e, nil
+}
@@ -0,0 +1,149 @@
+package entities
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+)
+
+// EventMetadataSchema is a version of the JSON Schema the metadata of every entry triggered by an event must match.
+// Only the latest version of an event applies to new postings.
+type EventMetadataSchema struct {
+	Event     uint32
+	Version   int
+	Schema    json.RawMessage
+	CreatedAt time.Time
+
+	compiled *gojsonschema.Schema
+}
+
+func NewEventMetadataSchema(event uint32, schema json.RawMessage) (EventMetadataSchema, error) {
+	if event == 0 || event > math.MaxInt16 {
+		return EventMetadataSchema{}, app.ErrInvalidEventID
+	}
+
+	var document interface{}
+	if err := json.Unmarshal(schema, &document); err != nil {
+		return EventMetadataSchema{}, app.ErrInvalidMetadataSchema
+	}
+
+	if hasRemoteRef(document) {
+		return EventMetadataSchema{}, app.ErrRemoteMetadataSchemaRef
+	}
+
+	if _, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(schema)); err != nil {
+		return EventMetadataSchema{}, app.ErrInvalidMetadataSchema
+	}
+
+	return EventMetadataSchema{
+		Event:  event,
+		Schema: schema,
+	}, nil
+}
+
+// hasRemoteRef reports whether the schema references anything but its own definitions, which would be
+// loaded from the network or the file system while validating postings.
+func hasRemoteRef(node interface{}) bool {
+	switch node := node.(type) {
+	case map[string]interface{}:
+		for key, value := range node {
+			if ref, ok := value.(string); ok && key == "$ref" && !strings.HasPrefix(ref, "#") {
+				return true
+			}
+
+			if hasRemoteRef(value) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, value := range node {
+			if hasRemoteRef(value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Compile loads the schema to validate transactions. Compiling is costly, so the compiled
+// schema is meant to be kept and reused, as a version of a schema never changes.
+func (s EventMetadataSchema) Compile() (EventMetadataSchema, error) {
+	if s.compiled != nil {
+		return s, nil
+	}
+
+	compiled, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(s.Schema))
+	if err != nil {
+		return EventMetadataSchema{}, fmt.Errorf("failed to load metadata schema of event %d: %w", s.Event, err)
+	}
+
+	s.compiled = compiled
+
+	return s, nil
+}
+
+// ValidateTransaction checks the metadata of every entry of the transaction, reporting all the fields
+// that don't match the schema. The schema is compiled first, unless it already is.
+func (s EventMetadataSchema) ValidateTransaction(transaction Transaction) error {
+	s, err := s.Compile()
+	if err != nil {
+		return err
+	}
+
+	violations := make([]app.MetadataViolation, 0)
+
+	for _, entry := range transaction.Entries {
+		metadata := entry.Metadata
+		if len(metadata) == 0 {
+			metadata = json.RawMessage(`{}`)
+		}
+
+		result, err := s.compiled.Validate(gojsonschema.NewBytesLoader(metadata))
+		if err != nil {
+			return fmt.Errorf("failed to validate metadata: %w", err)
+		}
+
+		for _, resultErr := range result.Errors() {
+			violations = append(violations, app.MetadataViolation{
+				Field:       metadataField(entry.ID, resultErr),
+				Description: resultErr.Description(),
+			})
+		}
+	}
+
+	if len(violations) > 0 {
+		return app.MetadataSchemaError{
+			Event:      s.Event,
+			Version:    s.Version,
+			Violations: violations,
+		}
+	}
+
+	return nil
+}
+
+// metadataField tells the field of the violation from the entry on, pointing to the missing property
+// of a required error rather than to the object that lacks it. Entries are told by their ids, as
+// transactions sort their entries by account, so their positions don't match the request.
+func metadataField(entry uuid.UUID, resultErr gojsonschema.ResultError) string {
+	field := fmt.Sprintf("entries[id=%s].metadata", entry)
+
+	path := resultErr.Field()
+	if path != gojsonschema.STRING_ROOT_SCHEMA_PROPERTY {
+		field += "." + path
+	}
+
+	if property, ok := resultErr.Details()["property"].(string); ok && resultErr.Type() == "required" {
+		field += "." + property
+	}
+
+	return field
+}
@@ -0,0 +1,173 @@
+package entities
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+const orderSchema = `{
+	"type": "object",
+	"required": ["order_id"],
+	"properties": {
+		"order_id": {"type": "string"},
+		"installments": {"type": "integer", "minimum": 1}
+	}
+}`
+
+func TestNewEventMetadataSchema(t *testing.T) {
+	testCases := []struct {
+		name        string
+		event       uint32
+		schema      string
+		expectedErr error
+	}{
+		{
+			name:   "Successfully creates a schema",
+			event:  1,
+			schema: orderSchema,
+		},
+		{
+			name:        "Invalid when the event is zero",
+			event:       0,
+			schema:      orderSchema,
+			expectedErr: app.ErrInvalidEventID,
+		},
+		{
+			name:        "Invalid when the schema isn't JSON",
+			event:       1,
+			schema:      `{"type":`,
+			expectedErr: app.ErrInvalidMetadataSchema,
+		},
+		{
+			name:   "Successfully creates a schema referencing its own definitions",
+			event:  1,
+			schema: `{"definitions": {"id": {"type": "string"}}, "properties": {"order_id": {"$ref": "#/definitions/id"}}}`,
+		},
+		{
+			name:        "Invalid when the schema references a remote schema",
+			event:       1,
+			schema:      `{"properties": {"order_id": {"$ref": "https://example.com/order.json"}}}`,
+			expectedErr: app.ErrRemoteMetadataSchemaRef,
+		},
+		{
+			name:        "Invalid when the schema references a file",
+			event:       1,
+			schema:      `{"allOf": [{"$ref": "file:///etc/order.json"}]}`,
+			expectedErr: app.ErrRemoteMetadataSchemaRef,
+		},
+		{
+			name:        "Invalid when the schema isn't a JSON Schema",
+			event:       1,
+			schema:      `{"type": "nothing"}`,
+			expectedErr: app.ErrInvalidMetadataSchema,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewEventMetadataSchema(tt.event, json.RawMessage(tt.schema))
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr == nil {
+				assert.Equal(t, tt.event, got.Event)
+				assert.JSONEq(t, tt.schema, string(got.Schema))
+			}
+		})
+	}
+}
+
+func TestEventMetadataSchema_ValidateTransaction(t *testing.T) {
+	schema, err := NewEventMetadataSchema(1, json.RawMessage(orderSchema))
+	require.NoError(t, err)
+	schema.Version = 2
+
+	newTransaction := func(metadata ...string) Transaction {
+		entries := make([]Entry, 0, len(metadata))
+		for i, m := range metadata {
+			op := vos.DebitOperation
+			if i%2 == 1 {
+				op = vos.CreditOperation
+			}
+
+			entry, err := NewEntry(uuid.New(), op, "liability.clients.available", vos.IgnoreAccountVersion, 100, "BRL", json.RawMessage(m))
+			require.NoError(t, err)
+
+			entries = append(entries, entry)
+		}
+
+		return Transaction{Event: 1, Entries: entries, CompetenceDate: time.Now()}
+	}
+
+	t.Run("valid metadata", func(t *testing.T) {
+		err := schema.ValidateTransaction(newTransaction(`{"order_id": "abc"}`, `{"order_id": "abc", "installments": 3}`))
+		assert.NoError(t, err)
+	})
+
+	t.Run("reports every field of every entry", func(t *testing.T) {
+		tx := newTransaction(`{"orderId": "abc"}`, `{"order_id": 1, "installments": 0}`)
+		err := schema.ValidateTransaction(tx)
+		assert.ErrorIs(t, err, app.ErrMetadataSchemaViolation)
+
+		var schemaErr app.MetadataSchemaError
+		require.ErrorAs(t, err, &schemaErr)
+		assert.Equal(t, uint32(1), schemaErr.Event)
+		assert.Equal(t, 2, schemaErr.Version)
+
+		fields := make([]string, 0, len(schemaErr.Violations))
+		for _, violation := range schemaErr.Violations {
+			fields = append(fields, violation.Field)
+		}
+
+		assert.ElementsMatch(t, []string{
+			"entries[id=" + tx.Entries[0].ID.String() + "].metadata.order_id",
+			"entries[id=" + tx.Entries[1].ID.String() + "].metadata.order_id",
+			"entries[id=" + tx.Entries[1].ID.String() + "].metadata.installments",
+		}, fields)
+	})
+
+	t.Run("tells the entries by id regardless of their order", func(t *testing.T) {
+		valid, err := NewEntry(uuid.New(), vos.DebitOperation, "liability.clients.zeta", vos.IgnoreAccountVersion, 100, "BRL", json.RawMessage(`{"order_id": "abc"}`))
+		require.NoError(t, err)
+
+		invalid, err := NewEntry(uuid.New(), vos.CreditOperation, "liability.clients.alpha", vos.IgnoreAccountVersion, 100, "BRL", json.RawMessage(`{}`))
+		require.NoError(t, err)
+
+		// the transaction sorts the entries by account, so the invalid entry moves ahead of the valid one
+		tx, err := NewTransaction(uuid.New(), 1, "abc", time.Now(), valid, invalid)
+		require.NoError(t, err)
+		require.Equal(t, invalid.ID, tx.Entries[0].ID)
+
+		err = schema.ValidateTransaction(tx)
+
+		var schemaErr app.MetadataSchemaError
+		require.ErrorAs(t, err, &schemaErr)
+		require.Len(t, schemaErr.Violations, 1)
+		assert.Equal(t, "entries[id="+invalid.ID.String()+"].metadata.order_id", schemaErr.Violations[0].Field)
+	})
+
+	t.Run("a compiled schema validates like the raw one", func(t *testing.T) {
+		compiled, err := schema.Compile()
+		require.NoError(t, err)
+
+		assert.NoError(t, compiled.ValidateTransaction(newTransaction(`{"order_id": "abc"}`, `{"order_id": "abc"}`)))
+		assert.ErrorIs(t, compiled.ValidateTransaction(newTransaction(`{}`, `{"order_id": "abc"}`)), app.ErrMetadataSchemaViolation)
+	})
+
+	t.Run("empty metadata is an empty object", func(t *testing.T) {
+		tx := newTransaction(``, `{"order_id": "abc"}`)
+		err := schema.ValidateTransaction(tx)
+
+		var schemaErr app.MetadataSchemaError
+		require.ErrorAs(t, err, &schemaErr)
+		assert.Len(t, schemaErr.Violations, 1)
+		assert.Equal(t, "entries[id="+tx.Entries[0].ID.String()+"].metadata.order_id", schemaErr.Violations[0].Field)
+	})
+}
@@ -0,0 +1,75 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+)
+
+func TestNewEvent(t *testing.T) {
+	testCases := []struct {
+		name        string
+		id          uint32
+		eventName   string
+		expectedErr error
+	}{
+		{
+			name:      "Successfully creates an event",
+			id:        1,
+			eventName: "pix_transfer",
+		},
+		{
+			name:        "Invalid when id is zero",
+			id:          0,
+			eventName:   "pix_transfer",
+			expectedErr: app.ErrInvalidEventID,
+		},
+		{
+			name:        "Invalid when id doesn't fit a smallint",
+			id:          32768,
+			eventName:   "pix_transfer",
+			expectedErr: app.ErrInvalidEventID,
+		},
+		{
+			name:        "Invalid when name is empty",
+			id:          1,
+			eventName:   "",
+			expectedErr: app.ErrInvalidEventName,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewEvent(tt.id, tt.eventName, "description")
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				assert.Empty(t, got)
+				return
+			}
+
+			assert.Equal(t, tt.id, got.ID)
+			assert.Equal(t, tt.eventName, got.Name)
+			assert.Equal(t, "description", got.Description)
+			assert.False(t, got.Deprecated())
+		})
+	}
+}
+
+func TestEvent_Deprecate(t *testing.T) {
+	event, err := NewEvent(1, "pix_transfer", "")
+	assert.NoError(t, err)
+
+	now := time.Now()
+
+	deprecated, err := event.Deprecate(now)
+	assert.NoError(t, err)
+	assert.True(t, deprecated.Deprecated())
+	assert.Equal(t, now, deprecated.DeprecatedAt)
+
+	_, err = deprecated.Deprecate(now)
+	assert.ErrorIs(t, err, app.ErrEventAlreadyDeprecated)
+}
@@ -0,0 +1,231 @@
+package entities
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+// FiscalPeriod is a period of the fiscal calendar of a company, from Start (inclusive) to End (exclusive).
+// Closing a period zeroes the income statement accounts of the company into the retained earnings,
+// and rejects new entries with competence dates within it until it's reopened.
+type FiscalPeriod struct {
+	ID        uuid.UUID
+	Company   string
+	Start     time.Time
+	End       time.Time
+	Status    vos.FiscalPeriodStatus
+	ClosedAt  time.Time
+	ClosedBy  string
+	CreatedAt time.Time
+}
+
+// FiscalPeriodChange is the audit record of a close or reopen of a fiscal period.
+type FiscalPeriodChange struct {
+	Action      vos.FiscalPeriodAction
+	RequestedBy string
+	Reason      string
+	// TransactionID is the closing transaction, for closes that had balances to zero.
+	TransactionID uuid.UUID
+	CreatedAt     time.Time
+}
+
+func NewFiscalPeriod(id uuid.UUID, company string, start, end time.Time) (FiscalPeriod, error) {
+	if id == uuid.Nil {
+		return FiscalPeriod{}, app.ErrInvalidFiscalPeriodID
+	}
+
+	if company == "" {
+		return FiscalPeriod{}, app.ErrInvalidFiscalPeriodCompany
+	}
+
+	if !end.After(start) {
+		return FiscalPeriod{}, app.ErrInvalidFiscalPeriodDates
+	}
+
+	return FiscalPeriod{
+		ID:      id,
+		Company: company,
+		Start:   start,
+		End:     end,
+		Status:  vos.OpenFiscalPeriodStatus,
+	}, nil
+}
+
+// Closed reports whether the period rejects new entries.
+func (p FiscalPeriod) Closed() bool {
+	return p.Status == vos.ClosedFiscalPeriodStatus
+}
+
+// ClosingDate is the competence date of the closing entries, the last instant of the period
+// kept by the database, so they are reported within it.
+func (p FiscalPeriod) ClosingDate() time.Time {
+	return p.End.Add(-time.Microsecond)
+}
+
+// Close marks the period as closed, returning the change to be audited along with it.
+func (p FiscalPeriod) Close(requestedBy string, now time.Time) (FiscalPeriod, FiscalPeriodChange, error) {
+	if requestedBy == "" {
+		return FiscalPeriod{}, FiscalPeriodChange{}, app.ErrInvalidFiscalPeriodRequester
+	}
+
+	if p.Closed() {
+		return FiscalPeriod{}, FiscalPeriodChange{}, app.ErrFiscalPeriodAlreadyClosed
+	}
+
+	p.Status = vos.ClosedFiscalPeriodStatus
+	p.ClosedAt = now
+	p.ClosedBy = requestedBy
+
+	return p, FiscalPeriodChange{
+		Action:      vos.CloseFiscalPeriodAction,
+		RequestedBy: requestedBy,
+		CreatedAt:   now,
+	}, nil
+}
+
+// Reopen accepts new entries within a closed period again. As it undoes a close, it must be justified.
+func (p FiscalPeriod) Reopen(requestedBy, reason string, now time.Time) (FiscalPeriod, FiscalPeriodChange, error) {
+	if requestedBy == "" {
+		return FiscalPeriod{}, FiscalPeriodChange{}, app.ErrInvalidFiscalPeriodRequester
+	}
+
+	if reason == "" {
+		return FiscalPeriod{}, FiscalPeriodChange{}, app.ErrInvalidReopenReason
+	}
+
+	if !p.Closed() {
+		return FiscalPeriod{}, FiscalPeriodChange{}, app.ErrFiscalPeriodNotClosed
+	}
+
+	p.Status = vos.OpenFiscalPeriodStatus
+	p.ClosedAt = time.Time{}
+	p.ClosedBy = ""
+
+	return p, FiscalPeriodChange{
+		Action:      vos.ReopenFiscalPeriodAction,
+		RequestedBy: requestedBy,
+		Reason:      reason,
+		CreatedAt:   now,
+	}, nil
+}
+
+// FiscalPeriodClosing closes a fiscal period, posting a transaction with the given id and event that zeroes the
+// income statement accounts of the company, as of the end of the period, into the retained earnings account.
+type FiscalPeriodClosing struct {
+	Period           FiscalPeriod
+	Change           FiscalPeriodChange
+	TransactionID    uuid.UUID
+	Event            uint32
+	RetainedEarnings vos.Account
+}
+
+func NewFiscalPeriodClosing(
+	period FiscalPeriod,
+	transactionID uuid.UUID,
+	event uint32,
+	retainedEarnings vos.Account,
+	requestedBy string,
+	now time.Time,
+) (FiscalPeriodClosing, error) {
+	if transactionID == uuid.Nil {
+		return FiscalPeriodClosing{}, app.ErrInvalidTransactionID
+	}
+
+	if !isRetainedEarningsAccount(retainedEarnings) {
+		return FiscalPeriodClosing{}, app.ErrInvalidRetainedEarningsAccount
+	}
+
+	closed, change, err := period.Close(requestedBy, now)
+	if err != nil {
+		return FiscalPeriodClosing{}, err
+	}
+
+	change.TransactionID = transactionID
+
+	return FiscalPeriodClosing{
+		Period:           closed,
+		Change:           change,
+		TransactionID:    transactionID,
+		Event:            event,
+		RetainedEarnings: retainedEarnings,
+	}, nil
+}
+
+// Transaction builds the closing transaction out of the balances (credits minus debits) of the income statement
+// accounts, offsetting them into the retained earnings account in each currency. Reopened periods are closed
+// again by zeroing only what was posted after the previous close.
+func (c FiscalPeriodClosing) Transaction(balances []vos.AccountBalance) (Transaction, error) {
+	metadata, err := json.Marshal(map[string]string{"fiscal_period": c.Period.ID.String()})
+	if err != nil {
+		return Transaction{}, err
+	}
+
+	entries := make([]Entry, 0, len(balances)+1)
+	earnings := make(map[vos.Currency]int)
+	currencies := make([]vos.Currency, 0, 1)
+
+	for _, balance := range balances {
+		if balance.Balance == 0 {
+			continue
+		}
+
+		if _, ok := earnings[balance.Currency]; !ok {
+			currencies = append(currencies, balance.Currency)
+		}
+
+		earnings[balance.Currency] += balance.Balance
+		entries = append(entries, closingEntry(c.TransactionID, balance.Account, balance.Currency, -balance.Balance, metadata))
+	}
+
+	for _, currency := range currencies {
+		if earnings[currency] != 0 {
+			entries = append(entries, closingEntry(c.TransactionID, c.RetainedEarnings, currency, earnings[currency], metadata))
+		}
+	}
+
+	transaction, err := NewTransaction(c.TransactionID, c.Event, c.Period.Company, c.Period.ClosingDate(), entries...)
+	if err != nil {
+		return Transaction{}, err
+	}
+
+	// the closing date is usually already locked by the time the period is closed
+	return transaction.Authorize(c.Change.RequestedBy, fmt.Sprintf("closing of fiscal period %s", c.Period.ID))
+}
+
+// closingEntry credits the account when amount is positive and debits it otherwise.
+// Entry ids are derived from the transaction id, the account and the currency, as each of them is zeroed once.
+func closingEntry(id uuid.UUID, account vos.Account, currency vos.Currency, amount int, metadata json.RawMessage) Entry {
+	operation := vos.CreditOperation
+	if amount < 0 {
+		operation = vos.DebitOperation
+		amount = -amount
+	}
+
+	return Entry{
+		ID:        uuid.NewSHA1(id, []byte(account.Value()+":"+currency.String())),
+		Operation: operation,
+		Account:   account,
+		Version:   vos.IgnoreAccountVersion,
+		Amount:    amount,
+		Currency:  currency,
+		Metadata:  metadata,
+	}
+}
+
+// isRetainedEarningsAccount reports whether the account can receive the retained earnings, which must be an
+// analytic account out of the income statement, or closing would leave balances behind.
+func isRetainedEarningsAccount(account vos.Account) bool {
+	if account.Type() != vos.Analytic {
+		return false
+	}
+
+	class, ok := account.Class()
+
+	return ok && class.Statement != vos.IncomeStatement
+}
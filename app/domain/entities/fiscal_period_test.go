@@ -0,0 +1,175 @@
+package entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func TestNewFiscalPeriod(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+
+	testCases := []struct {
+		name        string
+		id          uuid.UUID
+		company     string
+		start       time.Time
+		end         time.Time
+		expectedErr error
+	}{
+		{
+			name:    "Valid fiscal period",
+			id:      uuid.New(),
+			company: "abc",
+			start:   start,
+			end:     end,
+		},
+		{
+			name:        "Fiscal period without id",
+			company:     "abc",
+			start:       start,
+			end:         end,
+			expectedErr: app.ErrInvalidFiscalPeriodID,
+		},
+		{
+			name:        "Fiscal period without company",
+			id:          uuid.New(),
+			start:       start,
+			end:         end,
+			expectedErr: app.ErrInvalidFiscalPeriodCompany,
+		},
+		{
+			name:        "Fiscal period ending at its start",
+			id:          uuid.New(),
+			company:     "abc",
+			start:       start,
+			end:         start,
+			expectedErr: app.ErrInvalidFiscalPeriodDates,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewFiscalPeriod(tt.id, tt.company, tt.start, tt.end)
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr == nil {
+				assert.Equal(t, vos.OpenFiscalPeriodStatus, got.Status)
+				assert.True(t, got.ClosingDate().Before(tt.end))
+				assert.True(t, got.ClosingDate().After(tt.start))
+			}
+		})
+	}
+}
+
+func TestFiscalPeriod_CloseAndReopen(t *testing.T) {
+	now := time.Now().UTC()
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	period, err := NewFiscalPeriod(uuid.New(), "abc", start, start.AddDate(1, 0, 0))
+	require.NoError(t, err)
+
+	_, _, err = period.Close("", now)
+	assert.ErrorIs(t, err, app.ErrInvalidFiscalPeriodRequester)
+
+	_, _, err = period.Reopen("admin", "late entries", now)
+	assert.ErrorIs(t, err, app.ErrFiscalPeriodNotClosed)
+
+	closed, change, err := period.Close("accountant", now)
+	require.NoError(t, err)
+	assert.True(t, closed.Closed())
+	assert.Equal(t, now, closed.ClosedAt)
+	assert.Equal(t, "accountant", closed.ClosedBy)
+	assert.Equal(t, vos.CloseFiscalPeriodAction, change.Action)
+	assert.Equal(t, "accountant", change.RequestedBy)
+
+	_, _, err = closed.Close("accountant", now)
+	assert.ErrorIs(t, err, app.ErrFiscalPeriodAlreadyClosed)
+
+	_, _, err = closed.Reopen("admin", "", now)
+	assert.ErrorIs(t, err, app.ErrInvalidReopenReason)
+
+	reopened, change, err := closed.Reopen("admin", "late entries", now)
+	require.NoError(t, err)
+	assert.False(t, reopened.Closed())
+	assert.True(t, reopened.ClosedAt.IsZero())
+	assert.Empty(t, reopened.ClosedBy)
+	assert.Equal(t, vos.ReopenFiscalPeriodAction, change.Action)
+	assert.Equal(t, "admin", change.RequestedBy)
+	assert.Equal(t, "late entries", change.Reason)
+}
+
+func TestNewFiscalPeriodClosing(t *testing.T) {
+	now := time.Now().UTC()
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	period, err := NewFiscalPeriod(uuid.New(), "abc", start, start.AddDate(1, 0, 0))
+	require.NoError(t, err)
+
+	retained, err := vos.NewAccount("equity.retained_earnings.accumulated")
+	require.NoError(t, err)
+
+	t.Run("should reject an income statement account as retained earnings", func(t *testing.T) {
+		revenue, accErr := vos.NewAccount("revenue.fees.card")
+		require.NoError(t, accErr)
+
+		_, err = NewFiscalPeriodClosing(period, uuid.New(), 1, revenue, "accountant", now)
+		assert.ErrorIs(t, err, app.ErrInvalidRetainedEarningsAccount)
+	})
+
+	t.Run("should reject a missing retained earnings account", func(t *testing.T) {
+		_, err = NewFiscalPeriodClosing(period, uuid.New(), 1, vos.Account{}, "accountant", now)
+		assert.ErrorIs(t, err, app.ErrInvalidRetainedEarningsAccount)
+	})
+
+	t.Run("should zero the balances into the retained earnings of each currency", func(t *testing.T) {
+		closing, closingErr := NewFiscalPeriodClosing(period, uuid.New(), 1, retained, "accountant", now)
+		require.NoError(t, closingErr)
+		assert.True(t, closing.Period.Closed())
+		assert.Equal(t, closing.TransactionID, closing.Change.TransactionID)
+
+		fees, _ := vos.NewAccount("revenue.fees.card")
+		rent, _ := vos.NewAccount("expense.office.rent")
+
+		tx, txErr := closing.Transaction([]vos.AccountBalance{
+			vos.NewAnalyticAccountBalance(fees, vos.DefaultCurrency, vos.IgnoreAccountVersion, 500, 0),
+			vos.NewAnalyticAccountBalance(rent, vos.DefaultCurrency, vos.IgnoreAccountVersion, 0, 200),
+			vos.NewAnalyticAccountBalance(fees, "USD", vos.IgnoreAccountVersion, 100, 0),
+		})
+		require.NoError(t, txErr)
+
+		assert.Equal(t, closing.TransactionID, tx.ID)
+		assert.Equal(t, "abc", tx.Company)
+		assert.Equal(t, period.ClosingDate(), tx.CompetenceDate)
+		assert.True(t, tx.Adjustment)
+		assert.Equal(t, "accountant", tx.Authorization.RequestedBy)
+		assert.Equal(t, "closing of fiscal period "+period.ID.String(), tx.Authorization.Reason)
+
+		type entry struct {
+			account   string
+			currency  string
+			operation vos.OperationType
+			amount    int
+		}
+
+		got := make([]entry, 0, len(tx.Entries))
+		for _, e := range tx.Entries {
+			got = append(got, entry{e.Account.Value(), e.Currency.String(), e.Operation, e.Amount})
+		}
+
+		assert.ElementsMatch(t, []entry{
+			{"revenue.fees.card", "BRL", vos.DebitOperation, 500},
+			{"expense.office.rent", "BRL", vos.CreditOperation, 200},
+			{"equity.retained_earnings.accumulated", "BRL", vos.CreditOperation, 300},
+			{"revenue.fees.card", "USD", vos.DebitOperation, 100},
+			{"equity.retained_earnings.accumulated", "USD", vos.CreditOperation, 100},
+		}, got)
+	})
+}
@@ -0,0 +1,94 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+// PendingTransaction is an authorized transaction whose entries hold amounts against their accounts,
+// without counting in the posted balance, until it's captured, voided or expires.
+// Its debits are deducted from the available balance of the accounts while the transaction is pending.
+type PendingTransaction struct {
+	Transaction Transaction
+	Status      vos.PendingStatus
+	ExpiresAt   time.Time
+}
+
+func NewPendingTransaction(transaction Transaction) PendingTransaction {
+	return PendingTransaction{
+		Transaction: transaction,
+		Status:      vos.PendingPendingStatus,
+	}
+}
+
+// CurrentStatus reports the status of the transaction at the given moment, taking its expiration into account.
+func (p PendingTransaction) CurrentStatus(now time.Time) vos.PendingStatus {
+	if p.Status == vos.PendingPendingStatus && !now.Before(p.ExpiresAt) {
+		return vos.ExpiredPendingStatus
+	}
+
+	return p.Status
+}
+
+// Capture posts the pending transaction, or part of it, under a new transaction id. When amount is lower than
+// the pending total, each entry is scaled proportionally and the remaining hold is released.
+func (p PendingTransaction) Capture(id uuid.UUID, amount int, now time.Time) (Capture, error) {
+	if err := p.checkPending(now); err != nil {
+		return Capture{}, err
+	}
+
+	if id == uuid.Nil || id == p.Transaction.ID {
+		return Capture{}, app.ErrInvalidTransactionID
+	}
+
+	entries, amount, total, err := scaleEntries(id, p.Transaction.Entries, amount, false)
+	if err != nil {
+		return Capture{}, app.ErrInvalidCaptureAmount
+	}
+
+	tx, err := NewTransaction(id, p.Transaction.Event, p.Transaction.Company, p.Transaction.CompetenceDate, entries...)
+	if err != nil {
+		return Capture{}, err
+	}
+
+	return Capture{
+		Transaction:          tx,
+		PendingTransactionID: p.Transaction.ID,
+		Amount:               amount,
+		Total:                total,
+	}, nil
+}
+
+// Void releases the hold of the pending transaction without posting it.
+func (p PendingTransaction) Void(now time.Time) (PendingTransaction, error) {
+	if err := p.checkPending(now); err != nil {
+		return PendingTransaction{}, err
+	}
+
+	p.Status = vos.VoidedPendingStatus
+
+	return p, nil
+}
+
+func (p PendingTransaction) checkPending(now time.Time) error {
+	switch p.CurrentStatus(now) {
+	case vos.PendingPendingStatus:
+		return nil
+	case vos.ExpiredPendingStatus:
+		return app.ErrPendingTransactionExpired
+	default:
+		return app.ErrPendingTransactionNotPending
+	}
+}
+
+// Capture is the posted transaction settling a pending one.
+type Capture struct {
+	Transaction          Transaction
+	PendingTransactionID uuid.UUID
+	Amount               int
+	Total                int
+}
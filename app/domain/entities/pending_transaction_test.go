@@ -0,0 +1,124 @@
+package entities
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func TestPendingTransaction_Capture(t *testing.T) {
+	now := time.Now()
+	metadata := json.RawMessage(`{}`)
+
+	e1, _ := NewEntry(uuid.New(), vos.DebitOperation, "liability.clients.available.111", vos.NextAccountVersion, 300, "BRL", metadata)
+	e2, _ := NewEntry(uuid.New(), vos.CreditOperation, "liability.clients.pending.222", vos.IgnoreAccountVersion, 300, "BRL", metadata)
+
+	tx, err := NewTransaction(uuid.New(), 1, "abc", now.Add(-time.Hour), e1, e2)
+	require.NoError(t, err)
+
+	pending := NewPendingTransaction(tx)
+	pending.ExpiresAt = now.Add(time.Hour)
+
+	captured := pending
+	captured.Status = vos.CapturedPendingStatus
+
+	expired := pending
+	expired.ExpiresAt = now
+
+	testCases := []struct {
+		name            string
+		pending         PendingTransaction
+		id              uuid.UUID
+		amount          int
+		expectedAmounts []int
+		expectedErr     error
+	}{
+		{
+			name:            "Full capture",
+			pending:         pending,
+			id:              uuid.New(),
+			amount:          0,
+			expectedAmounts: []int{300, 300},
+		},
+		{
+			name:            "Partial capture",
+			pending:         pending,
+			id:              uuid.New(),
+			amount:          120,
+			expectedAmounts: []int{120, 120},
+		},
+		{
+			name:        "Capture greater than the authorized amount",
+			pending:     pending,
+			id:          uuid.New(),
+			amount:      301,
+			expectedErr: app.ErrInvalidCaptureAmount,
+		},
+		{
+			name:        "Capture with the pending transaction id",
+			pending:     pending,
+			id:          tx.ID,
+			expectedErr: app.ErrInvalidTransactionID,
+		},
+		{
+			name:        "Capture of a captured transaction",
+			pending:     captured,
+			id:          uuid.New(),
+			expectedErr: app.ErrPendingTransactionNotPending,
+		},
+		{
+			name:        "Capture of an expired transaction",
+			pending:     expired,
+			id:          uuid.New(),
+			expectedErr: app.ErrPendingTransactionExpired,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.pending.Capture(tt.id, tt.amount, now)
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			assert.Equal(t, tx.ID, got.PendingTransactionID)
+			assert.Equal(t, 300, got.Total)
+			assert.Equal(t, tx.CompetenceDate, got.Transaction.CompetenceDate)
+
+			amounts := make([]int, 0, len(got.Transaction.Entries))
+			for i, entry := range got.Transaction.Entries {
+				amounts = append(amounts, entry.Amount)
+				assert.Equal(t, tx.Entries[i].Operation, entry.Operation)
+				assert.Equal(t, tx.Entries[i].Version, entry.Version)
+			}
+
+			assert.Equal(t, tt.expectedAmounts, amounts)
+		})
+	}
+}
+
+func TestPendingTransaction_Void(t *testing.T) {
+	now := time.Now()
+
+	pending := PendingTransaction{Status: vos.PendingPendingStatus, ExpiresAt: now.Add(time.Minute)}
+
+	voided, err := pending.Void(now)
+	assert.NoError(t, err)
+	assert.Equal(t, vos.VoidedPendingStatus, voided.Status)
+
+	_, err = voided.Void(now)
+	assert.ErrorIs(t, err, app.ErrPendingTransactionNotPending)
+
+	_, err = pending.Void(now.Add(time.Minute))
+	assert.ErrorIs(t, err, app.ErrPendingTransactionExpired)
+	assert.Equal(t, vos.ExpiredPendingStatus, pending.CurrentStatus(now.Add(time.Minute)))
+}
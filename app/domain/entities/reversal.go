@@ -0,0 +1,171 @@
+package entities
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+// Reversal is a transaction that mirrors a previously created one, with every operation inverted.
+// When Amount is lower than the original transaction total, each entry is scaled proportionally.
+// Partial reversals are only supported for transactions with entries in a single currency.
+type Reversal struct {
+	Transaction           Transaction
+	OriginalTransactionID uuid.UUID
+	Reason                string
+	Amount                int
+	Total                 int
+}
+
+func NewReversal(id uuid.UUID, original Transaction, reason string, amount int, competenceDate time.Time) (Reversal, error) {
+	if id == uuid.Nil || id == original.ID {
+		return Reversal{}, app.ErrInvalidTransactionID
+	}
+
+	entries, amount, total, err := scaleEntries(id, original.Entries, amount, true)
+	if err != nil {
+		return Reversal{}, app.ErrInvalidReversalAmount
+	}
+
+	tx, err := NewTransaction(id, original.Event, original.Company, competenceDate, entries...)
+	if err != nil {
+		return Reversal{}, err
+	}
+
+	return Reversal{
+		Transaction:           tx,
+		OriginalTransactionID: original.ID,
+		Reason:                reason,
+		Amount:                amount,
+		Total:                 total,
+	}, nil
+}
+
+// Diff lists the differences between the reversal and the stored one with the same id, which are
+// none when the reversal is retried.
+func (r Reversal) Diff(stored Reversal) []string {
+	var diff []string
+
+	if r.OriginalTransactionID != stored.OriginalTransactionID {
+		diff = append(diff, fmt.Sprintf("reverted transaction: %s != %s", r.OriginalTransactionID, stored.OriginalTransactionID))
+	}
+
+	if r.Amount != stored.Amount {
+		diff = append(diff, fmt.Sprintf("amount: %d != %d", r.Amount, stored.Amount))
+	}
+
+	return diff
+}
+
+// IsFull reports whether the reversal reverts the whole original transaction.
+func (r Reversal) IsFull() bool {
+	return r.Amount == r.Total
+}
+
+// scaleEntries copies the given entries, with their operations inverted if requested, scaling them so that
+// each side sums to amount (the total of the debits when zero). It returns the scaled entries along with the
+// effective amount and the total. Partial amounts are only supported for entries in a single currency.
+func scaleEntries(id uuid.UUID, original []Entry, amount int, invert bool) ([]Entry, int, int, error) {
+	total := 0
+	currencies := make(map[vos.Currency]struct{})
+	for _, entry := range original {
+		currencies[entry.Currency] = struct{}{}
+		if entry.Operation == vos.DebitOperation {
+			total += entry.Amount
+		}
+	}
+
+	if amount == 0 {
+		amount = total
+	}
+
+	if amount < 0 || amount > total {
+		return nil, 0, 0, app.ErrInvalidAmount
+	}
+
+	// amounts in different currencies can't be compared, so there is no single total to scale them by.
+	if len(currencies) > 1 && amount != total {
+		return nil, 0, 0, app.ErrInvalidAmount
+	}
+
+	debits := make([]Entry, 0, len(original))
+	credits := make([]Entry, 0, len(original))
+
+	for _, entry := range original {
+		// accounts that were not versioned by the original transaction are kept that way.
+		version := vos.NextAccountVersion
+		if entry.Version == vos.IgnoreAccountVersion {
+			version = vos.IgnoreAccountVersion
+		}
+
+		scaled := Entry{
+			// entry ids are derived from the new transaction id, so retrying the same operation is idempotent.
+			ID:        uuid.NewSHA1(id, entry.ID[:]),
+			Account:   entry.Account,
+			Version:   version,
+			Amount:    scaleAmount(entry.Amount, amount, total),
+			Currency:  entry.Currency,
+			Metadata:  entry.Metadata,
+			Operation: entry.Operation,
+		}
+
+		if invert {
+			scaled.Operation = vos.DebitOperation
+			if entry.Operation == vos.DebitOperation {
+				scaled.Operation = vos.CreditOperation
+			}
+		}
+
+		if scaled.Operation == vos.DebitOperation {
+			debits = append(debits, scaled)
+		} else {
+			credits = append(credits, scaled)
+		}
+	}
+
+	entries := make([]Entry, 0, len(original))
+	entries = append(entries, distributeRemainder(debits, amount)...)
+	entries = append(entries, distributeRemainder(credits, amount)...)
+
+	return entries, amount, total, nil
+}
+
+// scaleAmount returns floor(value * amount / total), avoiding overflows on big amounts.
+func scaleAmount(value, amount, total int) int {
+	if amount == total {
+		return value
+	}
+
+	scaled := new(big.Int).Mul(big.NewInt(int64(value)), big.NewInt(int64(amount)))
+	scaled.Quo(scaled, big.NewInt(int64(total)))
+
+	return int(scaled.Int64())
+}
+
+// distributeRemainder spreads the cents lost when flooring the scaled amounts, one by one,
+// so that the entries of one side sum exactly to amount. Entries left with no amount are dropped.
+func distributeRemainder(entries []Entry, amount int) []Entry {
+	remainder := amount
+	for _, entry := range entries {
+		remainder -= entry.Amount
+	}
+
+	result := make([]Entry, 0, len(entries))
+	for _, entry := range entries {
+		if remainder > 0 {
+			entry.Amount++
+			remainder--
+		}
+
+		if entry.Amount > 0 {
+			result = append(result, entry)
+		}
+	}
+
+	return result
+}
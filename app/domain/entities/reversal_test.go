@@ -0,0 +1,218 @@
+package entities
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func TestNewReversal(t *testing.T) {
+	competenceDate := time.Now()
+	metadata := json.RawMessage(`{}`)
+
+	e1, _ := NewEntry(uuid.New(), vos.DebitOperation, "liability.clients.available.111", vos.Version(3), 300, "BRL", metadata)
+	e2, _ := NewEntry(uuid.New(), vos.CreditOperation, "liability.clients.available.222", vos.Version(5), 100, "BRL", metadata)
+	e3, _ := NewEntry(uuid.New(), vos.CreditOperation, "liability.clients.available.333", vos.Version(7), 200, "BRL", metadata)
+
+	original, err := NewTransaction(uuid.New(), 1, "abc", competenceDate.Add(-time.Hour), e1, e2, e3)
+	require.NoError(t, err)
+
+	e4, _ := NewEntry(uuid.New(), vos.DebitOperation, "liability.clients.available.444", vos.Version(2), 50, "USD", metadata)
+	e5, _ := NewEntry(uuid.New(), vos.CreditOperation, "liability.clients.available.555", vos.Version(4), 50, "USD", metadata)
+
+	multiCurrency, err := NewTransaction(uuid.New(), 1, "abc", competenceDate.Add(-time.Hour), e1, e2, e3, e4, e5)
+	require.NoError(t, err)
+
+	type entryResult struct {
+		account   string
+		operation vos.OperationType
+		amount    int
+		currency  vos.Currency
+	}
+
+	testCases := []struct {
+		name            string
+		id              uuid.UUID
+		original        Transaction
+		amount          int
+		expectedEntries []entryResult
+		expectedFull    bool
+		expectedErr     error
+	}{
+		{
+			name:     "Full reversal when amount is empty",
+			id:       uuid.New(),
+			original: original,
+			amount:   0,
+			expectedEntries: []entryResult{
+				{account: "liability.clients.available.111", operation: vos.CreditOperation, amount: 300, currency: "BRL"},
+				{account: "liability.clients.available.222", operation: vos.DebitOperation, amount: 100, currency: "BRL"},
+				{account: "liability.clients.available.333", operation: vos.DebitOperation, amount: 200, currency: "BRL"},
+			},
+			expectedFull: true,
+		},
+		{
+			name:     "Full reversal when amount is the transaction total",
+			id:       uuid.New(),
+			original: original,
+			amount:   300,
+			expectedEntries: []entryResult{
+				{account: "liability.clients.available.111", operation: vos.CreditOperation, amount: 300, currency: "BRL"},
+				{account: "liability.clients.available.222", operation: vos.DebitOperation, amount: 100, currency: "BRL"},
+				{account: "liability.clients.available.333", operation: vos.DebitOperation, amount: 200, currency: "BRL"},
+			},
+			expectedFull: true,
+		},
+		{
+			name:     "Partial reversal scales every entry",
+			id:       uuid.New(),
+			original: original,
+			amount:   150,
+			expectedEntries: []entryResult{
+				{account: "liability.clients.available.111", operation: vos.CreditOperation, amount: 150, currency: "BRL"},
+				{account: "liability.clients.available.222", operation: vos.DebitOperation, amount: 50, currency: "BRL"},
+				{account: "liability.clients.available.333", operation: vos.DebitOperation, amount: 100, currency: "BRL"},
+			},
+			expectedFull: false,
+		},
+		{
+			name:     "Partial reversal distributes rounding remainder",
+			id:       uuid.New(),
+			original: original,
+			amount:   100,
+			expectedEntries: []entryResult{
+				{account: "liability.clients.available.111", operation: vos.CreditOperation, amount: 100, currency: "BRL"},
+				{account: "liability.clients.available.222", operation: vos.DebitOperation, amount: 34, currency: "BRL"},
+				{account: "liability.clients.available.333", operation: vos.DebitOperation, amount: 66, currency: "BRL"},
+			},
+			expectedFull: false,
+		},
+		{
+			name:        "Invalid when amount is greater than the transaction total",
+			id:          uuid.New(),
+			original:    original,
+			amount:      301,
+			expectedErr: app.ErrInvalidReversalAmount,
+		},
+		{
+			name:        "Invalid when amount is negative",
+			id:          uuid.New(),
+			original:    original,
+			amount:      -1,
+			expectedErr: app.ErrInvalidReversalAmount,
+		},
+		{
+			name:     "Full reversal of a transaction with multiple currencies",
+			id:       uuid.New(),
+			original: multiCurrency,
+			amount:   0,
+			expectedEntries: []entryResult{
+				{account: "liability.clients.available.111", operation: vos.CreditOperation, amount: 300, currency: "BRL"},
+				{account: "liability.clients.available.222", operation: vos.DebitOperation, amount: 100, currency: "BRL"},
+				{account: "liability.clients.available.333", operation: vos.DebitOperation, amount: 200, currency: "BRL"},
+				{account: "liability.clients.available.444", operation: vos.CreditOperation, amount: 50, currency: "USD"},
+				{account: "liability.clients.available.555", operation: vos.DebitOperation, amount: 50, currency: "USD"},
+			},
+			expectedFull: true,
+		},
+		{
+			name:        "Invalid partial reversal of a transaction with multiple currencies",
+			id:          uuid.New(),
+			original:    multiCurrency,
+			amount:      100,
+			expectedErr: app.ErrInvalidReversalAmount,
+		},
+		{
+			name:        "Invalid when id is empty",
+			id:          uuid.Nil,
+			original:    original,
+			expectedErr: app.ErrInvalidTransactionID,
+		},
+		{
+			name:        "Invalid when id is the same as the original transaction",
+			id:          original.ID,
+			original:    original,
+			expectedErr: app.ErrInvalidTransactionID,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewReversal(tt.id, tt.original, "wrong posting", tt.amount, competenceDate)
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				assert.Empty(t, got)
+				return
+			}
+
+			assert.Equal(t, tt.original.ID, got.OriginalTransactionID)
+			assert.Equal(t, "wrong posting", got.Reason)
+			assert.Equal(t, tt.expectedFull, got.IsFull())
+			assert.Equal(t, tt.id, got.Transaction.ID)
+			assert.Equal(t, original.Event, got.Transaction.Event)
+			assert.Equal(t, original.Company, got.Transaction.Company)
+			assert.Equal(t, competenceDate, got.Transaction.CompetenceDate)
+
+			entries := make([]entryResult, 0, len(got.Transaction.Entries))
+			for _, entry := range got.Transaction.Entries {
+				assert.Equal(t, vos.NextAccountVersion, entry.Version)
+				entries = append(entries, entryResult{
+					account:   entry.Account.Value(),
+					operation: entry.Operation,
+					amount:    entry.Amount,
+					currency:  entry.Currency,
+				})
+			}
+			assert.Equal(t, tt.expectedEntries, entries)
+
+			again, err := NewReversal(tt.id, tt.original, "wrong posting", tt.amount, competenceDate)
+			assert.NoError(t, err)
+			assert.Equal(t, got.Transaction.Entries[0].ID, again.Transaction.Entries[0].ID)
+		})
+	}
+}
+
+func TestReversal_Diff(t *testing.T) {
+	e1, err := NewEntry(uuid.New(), vos.DebitOperation, "liability.clients.available.111", vos.NextAccountVersion, 100, "BRL", nil)
+	require.NoError(t, err)
+
+	e2, err := NewEntry(uuid.New(), vos.CreditOperation, "liability.clients.available.222", vos.NextAccountVersion, 100, "BRL", nil)
+	require.NoError(t, err)
+
+	original, err := NewTransaction(uuid.New(), 1, "abc", time.Now(), e1, e2)
+	require.NoError(t, err)
+
+	id := uuid.New()
+
+	reversal, err := NewReversal(id, original, "wrong posting", 0, time.Now())
+	require.NoError(t, err)
+
+	stored := Reversal{
+		Transaction:           Transaction{ID: id},
+		OriginalTransactionID: original.ID,
+		Reason:                "wrong posting",
+		Amount:                100,
+		Total:                 100,
+	}
+
+	assert.Empty(t, reversal.Diff(stored))
+
+	partial, err := NewReversal(id, original, "wrong posting", 40, time.Now())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"amount: 40 != 100"}, partial.Diff(stored))
+
+	other := stored
+	other.OriginalTransactionID = uuid.New()
+
+	assert.Equal(t, []string{fmt.Sprintf("reverted transaction: %s != %s", original.ID, other.OriginalTransactionID)}, reversal.Diff(other))
+}
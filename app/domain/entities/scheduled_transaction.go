@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+// ScheduledTransaction is a transaction to be posted by the scheduler once its competence date comes due.
+// The transaction id guards the posting, so a scheduled transaction is posted at most once.
+type ScheduledTransaction struct {
+	Transaction Transaction
+	Status      vos.ScheduleStatus
+	// Reason is why the posting failed, for failed transactions.
+	Reason    string
+	UpdatedAt time.Time
+}
+
+func NewScheduledTransaction(transaction Transaction, now time.Time) (ScheduledTransaction, error) {
+	if !transaction.CompetenceDate.After(now) {
+		return ScheduledTransaction{}, app.ErrInvalidScheduleDate
+	}
+
+	return ScheduledTransaction{
+		Transaction: transaction,
+		Status:      vos.ScheduledScheduleStatus,
+	}, nil
+}
+
+// Cancel prevents the transaction from being posted. Only transactions not yet claimed by the scheduler can be canceled.
+func (s ScheduledTransaction) Cancel() (ScheduledTransaction, error) {
+	return s.transition(vos.CanceledScheduleStatus, vos.ScheduledScheduleStatus)
+}
+
+// Post marks a transaction claimed by the scheduler as posted.
+func (s ScheduledTransaction) Post() (ScheduledTransaction, error) {
+	return s.transition(vos.PostedScheduleStatus, vos.ProcessingScheduleStatus)
+}
+
+// Fail marks a transaction claimed by the scheduler as failed, as it was rejected by the ledger.
+func (s ScheduledTransaction) Fail(reason string) (ScheduledTransaction, error) {
+	failed, err := s.transition(vos.FailedScheduleStatus, vos.ProcessingScheduleStatus)
+	if err != nil {
+		return ScheduledTransaction{}, err
+	}
+
+	failed.Reason = reason
+
+	return failed, nil
+}
+
+func (s ScheduledTransaction) transition(to, from vos.ScheduleStatus) (ScheduledTransaction, error) {
+	if s.Status != from {
+		return ScheduledTransaction{}, app.ErrScheduledTransactionNotScheduled
+	}
+
+	s.Status = to
+
+	return s, nil
+}
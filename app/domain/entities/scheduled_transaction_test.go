@@ -0,0 +1,126 @@
+package entities
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func TestNewScheduledTransaction(t *testing.T) {
+	now := time.Now()
+	metadata := json.RawMessage(`{}`)
+
+	e1, _ := NewEntry(uuid.New(), vos.DebitOperation, "liability.clients.available.111", vos.IgnoreAccountVersion, 300, "BRL", metadata)
+	e2, _ := NewEntry(uuid.New(), vos.CreditOperation, "liability.clients.available.222", vos.IgnoreAccountVersion, 300, "BRL", metadata)
+
+	testCases := []struct {
+		name           string
+		competenceDate time.Time
+		expectedErr    error
+	}{
+		{
+			name:           "Transaction scheduled to the future",
+			competenceDate: now.Add(24 * time.Hour),
+		},
+		{
+			name:           "Transaction scheduled to now",
+			competenceDate: now,
+			expectedErr:    app.ErrInvalidScheduleDate,
+		},
+		{
+			name:           "Transaction scheduled to the past",
+			competenceDate: now.Add(-time.Hour),
+			expectedErr:    app.ErrInvalidScheduleDate,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			tx, err := NewTransaction(uuid.New(), 1, "abc", tt.competenceDate, e1, e2)
+			require.NoError(t, err)
+
+			got, err := NewScheduledTransaction(tx, now)
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr == nil {
+				assert.Equal(t, vos.ScheduledScheduleStatus, got.Status)
+				assert.Equal(t, tx, got.Transaction)
+			}
+		})
+	}
+}
+
+func TestScheduledTransaction_Transitions(t *testing.T) {
+	testCases := []struct {
+		name           string
+		from           vos.ScheduleStatus
+		transition     func(ScheduledTransaction) (ScheduledTransaction, error)
+		expectedStatus vos.ScheduleStatus
+		expectedReason string
+		expectedErr    error
+	}{
+		{
+			name:           "Cancel scheduled transaction",
+			from:           vos.ScheduledScheduleStatus,
+			transition:     ScheduledTransaction.Cancel,
+			expectedStatus: vos.CanceledScheduleStatus,
+		},
+		{
+			name:        "Cancel transaction being posted",
+			from:        vos.ProcessingScheduleStatus,
+			transition:  ScheduledTransaction.Cancel,
+			expectedErr: app.ErrScheduledTransactionNotScheduled,
+		},
+		{
+			name:        "Cancel posted transaction",
+			from:        vos.PostedScheduleStatus,
+			transition:  ScheduledTransaction.Cancel,
+			expectedErr: app.ErrScheduledTransactionNotScheduled,
+		},
+		{
+			name:           "Post claimed transaction",
+			from:           vos.ProcessingScheduleStatus,
+			transition:     ScheduledTransaction.Post,
+			expectedStatus: vos.PostedScheduleStatus,
+		},
+		{
+			name:        "Post canceled transaction",
+			from:        vos.CanceledScheduleStatus,
+			transition:  ScheduledTransaction.Post,
+			expectedErr: app.ErrScheduledTransactionNotScheduled,
+		},
+		{
+			name: "Fail claimed transaction",
+			from: vos.ProcessingScheduleStatus,
+			transition: func(s ScheduledTransaction) (ScheduledTransaction, error) {
+				return s.Fail("invalid version")
+			},
+			expectedStatus: vos.FailedScheduleStatus,
+			expectedReason: "invalid version",
+		},
+		{
+			name: "Fail scheduled transaction",
+			from: vos.ScheduledScheduleStatus,
+			transition: func(s ScheduledTransaction) (ScheduledTransaction, error) {
+				return s.Fail("invalid version")
+			},
+			expectedErr: app.ErrScheduledTransactionNotScheduled,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.transition(ScheduledTransaction{Status: tt.from})
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expectedStatus, got.Status)
+			assert.Equal(t, tt.expectedReason, got.Reason)
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package entities
 
 import (
+	"encoding/json"
+	"fmt"
+	"reflect"
 	"sort"
 	"time"
 
@@ -16,6 +19,11 @@ type Transaction struct {
 	Event          uint32
 	Company        string
 	CompetenceDate time.Time
+	// Adjustment authorizes the transaction to be posted on or before the competence lock date.
+	// It's only set through Authorize, which records who requested the adjustment and why.
+	Adjustment    bool
+	Authorization AdjustmentAuthorization
+	CreatedAt     time.Time
 }
 
 func NewTransaction(id uuid.UUID, event uint32, company string, competenceDate time.Time, entries ...Entry) (Transaction, error) {
@@ -36,17 +44,20 @@ func NewTransaction(id uuid.UUID, event uint32, company string, competenceDate t
 		return entries[i].Account.Value() == entries[j].Account.Value() && entries[i].Version < entries[j].Version
 	})
 
-	balance := 0
+	// amounts in different currencies can't offset each other, so each currency must balance by itself
+	balances := make(map[vos.Currency]int)
 	for _, entry := range entries {
 		if entry.Operation == vos.DebitOperation {
-			balance += entry.Amount
+			balances[entry.Currency] += entry.Amount
 		} else {
-			balance -= entry.Amount
+			balances[entry.Currency] -= entry.Amount
 		}
 	}
 
-	if balance != 0 {
-		return Transaction{}, app.ErrInvalidBalance
+	for _, balance := range balances {
+		if balance != 0 {
+			return Transaction{}, app.ErrInvalidBalance
+		}
 	}
 
 	t := Transaction{
@@ -59,3 +70,110 @@ func NewTransaction(id uuid.UUID, event uint32, company string, competenceDate t
 
 	return t, nil
 }
+
+// Diff describes every field in which the transaction differs from the stored one, so an empty
+// result means the transaction is a replay of the stored one. Entries are matched by id, and an
+// entry requesting the next account version matches any version assigned to the stored entry.
+func (t Transaction) Diff(stored Transaction) []string {
+	var diff []string
+
+	if t.ID != stored.ID {
+		diff = append(diff, fmt.Sprintf("entries belong to transaction %s", stored.ID))
+	}
+
+	if t.Event != stored.Event {
+		diff = append(diff, fmt.Sprintf("event: %d != %d", t.Event, stored.Event))
+	}
+
+	if t.Company != stored.Company {
+		diff = append(diff, fmt.Sprintf("company: %q != %q", t.Company, stored.Company))
+	}
+
+	if !t.CompetenceDate.Equal(stored.CompetenceDate) {
+		diff = append(diff, fmt.Sprintf("competence date: %s != %s", t.CompetenceDate.UTC().Format(time.RFC3339), stored.CompetenceDate.UTC().Format(time.RFC3339)))
+	}
+
+	if t.Adjustment != stored.Adjustment {
+		diff = append(diff, fmt.Sprintf("adjustment: %t != %t", t.Adjustment, stored.Adjustment))
+	}
+
+	if t.Authorization != stored.Authorization {
+		diff = append(diff, "adjustment authorization differs")
+	}
+
+	storedEntries := make(map[uuid.UUID]Entry, len(stored.Entries))
+	for _, entry := range stored.Entries {
+		storedEntries[entry.ID] = entry
+	}
+
+	for _, entry := range t.Entries {
+		storedEntry, ok := storedEntries[entry.ID]
+		if !ok {
+			diff = append(diff, fmt.Sprintf("entry %s: not in the stored transaction", entry.ID))
+			continue
+		}
+
+		delete(storedEntries, entry.ID)
+
+		for _, d := range entry.diff(storedEntry) {
+			diff = append(diff, fmt.Sprintf("entry %s: %s", entry.ID, d))
+		}
+	}
+
+	for _, entry := range stored.Entries {
+		if _, ok := storedEntries[entry.ID]; ok {
+			diff = append(diff, fmt.Sprintf("entry %s: missing", entry.ID))
+		}
+	}
+
+	return diff
+}
+
+func (e Entry) diff(stored Entry) []string {
+	var diff []string
+
+	if e.Operation != stored.Operation {
+		diff = append(diff, fmt.Sprintf("operation: %s != %s", e.Operation, stored.Operation))
+	}
+
+	if e.Account.Value() != stored.Account.Value() {
+		diff = append(diff, fmt.Sprintf("account: %s != %s", e.Account.Value(), stored.Account.Value()))
+	}
+
+	if e.Version != vos.NextAccountVersion && e.Version != stored.Version {
+		diff = append(diff, fmt.Sprintf("version: %d != %d", e.Version, stored.Version))
+	}
+
+	if e.Amount != stored.Amount {
+		diff = append(diff, fmt.Sprintf("amount: %d != %d", e.Amount, stored.Amount))
+	}
+
+	if e.Currency != stored.Currency {
+		diff = append(diff, fmt.Sprintf("currency: %s != %s", e.Currency, stored.Currency))
+	}
+
+	if !equalMetadata(e.Metadata, stored.Metadata) {
+		diff = append(diff, "metadata differs")
+	}
+
+	return diff
+}
+
+// equalMetadata compares metadata by value, as the database doesn't keep its formatting nor key order.
+func equalMetadata(a, b json.RawMessage) bool {
+	var va, vb interface{}
+
+	if len(a) > 0 {
+		if err := json.Unmarshal(a, &va); err != nil {
+			return false
+		}
+	}
+
+	if len(b) > 0 {
+		if err := json.Unmarshal(b, &vb); err != nil {
+			return false
+		}
+	}
+
+	return reflect.DeepEqual(va, vb)
+}
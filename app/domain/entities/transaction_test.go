@@ -19,21 +19,25 @@ func TestNewTransaction(t *testing.T) {
 	competenceDate := time.Now()
 	metadata := json.RawMessage(`{}`)
 
-	e11, _ := NewEntry(uuid.New(), vos.DebitOperation, "liability.clients.available.111", vos.NextAccountVersion, 123, metadata)
-	e12, _ := NewEntry(uuid.New(), vos.CreditOperation, "liability.clients.available.222", vos.NextAccountVersion, 123, metadata)
+	e11, _ := NewEntry(uuid.New(), vos.DebitOperation, "liability.clients.available.111", vos.NextAccountVersion, 123, "BRL", metadata)
+	e12, _ := NewEntry(uuid.New(), vos.CreditOperation, "liability.clients.available.222", vos.NextAccountVersion, 123, "BRL", metadata)
 	validTwoEntries := []Entry{e11, e12}
 
-	e21, _ := NewEntry(uuid.New(), vos.DebitOperation, "liability.clients.available.333", vos.NextAccountVersion, 400, metadata)
-	e22, _ := NewEntry(uuid.New(), vos.CreditOperation, "liability.clients.available.444", vos.NextAccountVersion, 300, metadata)
-	e23, _ := NewEntry(uuid.New(), vos.CreditOperation, "liability.clients.available.555", vos.NextAccountVersion, 100, metadata)
+	e21, _ := NewEntry(uuid.New(), vos.DebitOperation, "liability.clients.available.333", vos.NextAccountVersion, 400, "BRL", metadata)
+	e22, _ := NewEntry(uuid.New(), vos.CreditOperation, "liability.clients.available.444", vos.NextAccountVersion, 300, "BRL", metadata)
+	e23, _ := NewEntry(uuid.New(), vos.CreditOperation, "liability.clients.available.555", vos.NextAccountVersion, 100, "BRL", metadata)
 	validThreeEntries := []Entry{e21, e22, e23}
 
-	e31, _ := NewEntry(uuid.New(), vos.DebitOperation, "liability.clients.available.333", vos.NextAccountVersion, 100, metadata)
-	e32, _ := NewEntry(uuid.New(), vos.DebitOperation, "liability.clients.available.333", vos.Version(2), 200, metadata)
-	e33, _ := NewEntry(uuid.New(), vos.DebitOperation, "liability.clients.available.333", vos.Version(3), 300, metadata)
-	e34, _ := NewEntry(uuid.New(), vos.CreditOperation, "liability.clients.available.444", vos.Version(4), 600, metadata)
+	e31, _ := NewEntry(uuid.New(), vos.DebitOperation, "liability.clients.available.333", vos.NextAccountVersion, 100, "BRL", metadata)
+	e32, _ := NewEntry(uuid.New(), vos.DebitOperation, "liability.clients.available.333", vos.Version(2), 200, "BRL", metadata)
+	e33, _ := NewEntry(uuid.New(), vos.DebitOperation, "liability.clients.available.333", vos.Version(3), 300, "BRL", metadata)
+	e34, _ := NewEntry(uuid.New(), vos.CreditOperation, "liability.clients.available.444", vos.Version(4), 600, "BRL", metadata)
 	validFourEntries := []Entry{e31, e32, e33, e34}
 
+	e41, _ := NewEntry(uuid.New(), vos.DebitOperation, "liability.clients.available.666", vos.NextAccountVersion, 123, "USD", metadata)
+	e42, _ := NewEntry(uuid.New(), vos.CreditOperation, "liability.clients.available.777", vos.NextAccountVersion, 123, "USD", metadata)
+	validMultiCurrencyEntries := []Entry{e11, e12, e41, e42}
+
 	testCases := []struct {
 		name                string
 		id                  uuid.UUID
@@ -134,6 +138,26 @@ func TestNewTransaction(t *testing.T) {
 			expectedTransaction: Transaction{},
 			expectedErr:         app.ErrInvalidBalance,
 		},
+		{
+			name:    "Valid transaction with entries balanced per currency",
+			id:      id,
+			entries: []Entry{e42, e12, e41, e11},
+			expectedTransaction: Transaction{
+				ID:             id,
+				Entries:        validMultiCurrencyEntries,
+				Event:          event,
+				Company:        company,
+				CompetenceDate: competenceDate,
+			},
+			expectedErr: nil,
+		},
+		{
+			name:                "Invalid transaction balanced only across currencies",
+			id:                  id,
+			entries:             []Entry{e11, e42},
+			expectedTransaction: Transaction{},
+			expectedErr:         app.ErrInvalidBalance,
+		},
 		{
 			name:                "Invalid transaction with empty ID",
 			id:                  uuid.Nil,
@@ -152,3 +176,53 @@ func TestNewTransaction(t *testing.T) {
 		})
 	}
 }
+
+func TestTransaction_Diff(t *testing.T) {
+	competenceDate := time.Now().Truncate(time.Second)
+
+	e1, err := NewEntry(uuid.New(), vos.DebitOperation, "liability.clients.available.111", vos.NextAccountVersion, 123, "BRL", json.RawMessage(`{"a": 1}`))
+	assert.NoError(t, err)
+
+	e2, err := NewEntry(uuid.New(), vos.CreditOperation, "liability.clients.available.222", vos.Version(3), 123, "BRL", nil)
+	assert.NoError(t, err)
+
+	tx, err := NewTransaction(uuid.New(), 1, "abc", competenceDate, e1, e2)
+	assert.NoError(t, err)
+
+	stored := tx
+	stored.Entries = []Entry{tx.Entries[0], tx.Entries[1]}
+	stored.Entries[0].Version = vos.Version(5)
+	stored.Entries[0].Metadata = json.RawMessage(`{ "a":1 }`)
+	stored.CompetenceDate = competenceDate.UTC()
+
+	t.Run("should match an identical replay", func(t *testing.T) {
+		assert.Empty(t, tx.Diff(stored))
+	})
+
+	t.Run("should describe every difference", func(t *testing.T) {
+		e3, err := NewEntry(uuid.New(), vos.CreditOperation, "liability.clients.available.222", vos.Version(4), 100, "BRL", nil)
+		assert.NoError(t, err)
+
+		replay := tx
+		replay.Company = "xyz"
+		replay.Entries = []Entry{tx.Entries[0], e3}
+		replay.Entries[0].Amount = 100
+		replay.Entries[0].Metadata = json.RawMessage(`{"a": 2}`)
+
+		assert.Equal(t, []string{
+			`company: "xyz" != "abc"`,
+			"entry " + e1.ID.String() + ": amount: 100 != 123",
+			"entry " + e1.ID.String() + ": metadata differs",
+			"entry " + e3.ID.String() + ": not in the stored transaction",
+			"entry " + e2.ID.String() + ": missing",
+		}, replay.Diff(stored))
+	})
+
+	t.Run("should reject a different explicit version", func(t *testing.T) {
+		replay := stored
+		replay.Entries = []Entry{stored.Entries[0], stored.Entries[1]}
+		replay.Entries[1].Version = vos.Version(2)
+
+		assert.Equal(t, []string{"entry " + e2.ID.String() + ": version: 2 != 3"}, replay.Diff(stored))
+	})
+}
@@ -4,16 +4,58 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
 	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
 	"github.com/stone-co/the-amazing-ledger/app/pagination"
 )
 
 type Repository interface {
-	CreateTransaction(context.Context, entities.Transaction) error
-	GetBoundedAccountBalance(context.Context, vos.Account, time.Time, time.Time) (vos.AccountBalance, error)
-	GetAnalyticAccountBalance(context.Context, vos.Account) (vos.AccountBalance, error)
-	GetSyntheticAccountBalance(context.Context, vos.Account) (vos.AccountBalance, error)
-	GetSyntheticReport(context.Context, vos.Account, int, time.Time, time.Time) (*vos.SyntheticReport, error)
+	CreateTransaction(context.Context, entities.Transaction) (entities.Transaction, error)
+	CreateTransactions(context.Context, []entities.Transaction) error
+	CreateTransactionsBestEffort(context.Context, []entities.Transaction) ([]error, error)
+	GetBoundedAccountBalance(context.Context, vos.Account, vos.Currency, time.Time, time.Time, vos.TimeView) (vos.AccountBalance, error)
+	GetBalanceHistory(context.Context, vos.BalanceHistoryRequest) (vos.BalanceHistory, error)
+	GetAnalyticAccountBalance(context.Context, vos.Account, vos.Currency) (vos.AccountBalance, error)
+	GetSyntheticAccountBalance(context.Context, vos.Account, vos.Currency) (vos.AccountBalance, error)
+	GetSyntheticReport(context.Context, vos.SyntheticReportRequest) (*vos.SyntheticReport, error)
+	GetTrialBalance(context.Context, vos.TrialBalanceRequest) (vos.TrialBalance, error)
+	GetStatementLines(context.Context, vos.StatementRequest) ([]vos.StatementLine, error)
 	ListAccountEntries(context.Context, vos.AccountEntryRequest) ([]vos.AccountEntry, pagination.Cursor, error)
+	ListAccounts(context.Context, vos.AccountListRequest) ([]vos.AccountSummary, pagination.Cursor, error)
+	ListAccountChildren(context.Context, vos.AccountChildrenRequest) ([]vos.AccountChild, pagination.Cursor, error)
+	GetTransaction(context.Context, uuid.UUID) (entities.Transaction, error)
+	RevertTransaction(context.Context, entities.Reversal) error
+	GetReversal(context.Context, uuid.UUID) (entities.Reversal, error)
+	OpenAccount(context.Context, entities.Account) (entities.Account, error)
+	GetAccount(context.Context, vos.Account) (entities.Account, error)
+	UpdateAccountStatus(context.Context, entities.Account, vos.AccountStatus) (entities.Account, error)
+	SetBalanceLimit(context.Context, vos.BalanceLimit) error
+	DeleteBalanceLimit(context.Context, vos.Account, vos.Currency) error
+	ListBalanceLimits(context.Context) ([]vos.BalanceLimit, error)
+	AuthorizeTransaction(context.Context, entities.PendingTransaction) (entities.PendingTransaction, error)
+	GetPendingTransaction(context.Context, uuid.UUID) (entities.PendingTransaction, error)
+	CaptureTransaction(context.Context, entities.Capture) error
+	VoidTransaction(context.Context, entities.PendingTransaction) error
+	ScheduleTransaction(context.Context, entities.ScheduledTransaction) (entities.ScheduledTransaction, error)
+	GetScheduledTransaction(context.Context, uuid.UUID) (entities.ScheduledTransaction, error)
+	ListScheduledTransactions(context.Context, vos.ScheduleStatus, pagination.Page) ([]entities.ScheduledTransaction, pagination.Cursor, error)
+	ClaimDueScheduledTransactions(context.Context, int) ([]entities.ScheduledTransaction, error)
+	UpdateScheduledTransactionStatus(context.Context, entities.ScheduledTransaction, vos.ScheduleStatus) error
+	CreateEvent(context.Context, entities.Event) (entities.Event, error)
+	GetEvent(context.Context, uint32) (entities.Event, error)
+	ListEvents(context.Context, bool) ([]entities.Event, error)
+	DeprecateEvent(context.Context, entities.Event) error
+	CreateEventMetadataSchema(context.Context, entities.EventMetadataSchema) (entities.EventMetadataSchema, error)
+	ListEventMetadataSchemas(context.Context, uint32) ([]entities.EventMetadataSchema, error)
+	CreateFiscalPeriod(context.Context, entities.FiscalPeriod) (entities.FiscalPeriod, error)
+	GetFiscalPeriod(context.Context, uuid.UUID) (entities.FiscalPeriod, error)
+	ListFiscalPeriods(context.Context, string) ([]entities.FiscalPeriod, error)
+	ListFiscalPeriodChanges(context.Context, uuid.UUID) ([]entities.FiscalPeriodChange, error)
+	CloseFiscalPeriod(context.Context, entities.FiscalPeriodClosing) (entities.FiscalPeriodClosing, error)
+	ReopenFiscalPeriod(context.Context, entities.FiscalPeriod, entities.FiscalPeriodChange) error
+	ChangeCompetenceLock(context.Context, entities.CompetenceLockChange) (entities.CompetenceLockChange, error)
+	ListCompetenceLocks(context.Context, string) ([]entities.CompetenceLock, error)
+	ListCompetenceLockChanges(context.Context, string) ([]entities.CompetenceLockChange, error)
 }
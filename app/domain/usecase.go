@@ -4,19 +4,146 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
 	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/pagination"
 )
 
 type UseCase interface {
-	CreateTransaction(context.Context, entities.Transaction) error
+	CreateTransaction(context.Context, entities.Transaction) (entities.Transaction, error)
+	CreateTransactions(context.Context, CreateTransactionsInput) ([]error, error)
 	GetAccountBalance(context.Context, GetAccountBalanceInput) (vos.AccountBalance, error)
-	GetSyntheticReport(context.Context, vos.Account, int, time.Time, time.Time) (*vos.SyntheticReport, error)
+	GetBalanceHistory(context.Context, vos.BalanceHistoryRequest) (vos.BalanceHistory, error)
+	GetSyntheticReport(context.Context, vos.SyntheticReportRequest) (*vos.SyntheticReport, error)
+	GetTrialBalance(context.Context, vos.TrialBalanceRequest) (vos.TrialBalance, error)
+	GetBalanceSheet(context.Context, FinancialStatementInput) (vos.FinancialStatement, error)
+	GetIncomeStatement(context.Context, FinancialStatementInput) (vos.FinancialStatement, error)
 	ListAccountEntries(context.Context, vos.AccountEntryRequest) (vos.AccountEntryResponse, error)
+	ListAccounts(context.Context, vos.AccountListRequest) (vos.AccountList, error)
+	ListAccountChildren(context.Context, vos.AccountChildrenRequest) (vos.AccountChildren, error)
+	RevertTransaction(context.Context, RevertTransactionInput) error
+	GetTransaction(context.Context, uuid.UUID) (entities.Transaction, error)
+	OpenAccount(context.Context, entities.Account) (entities.Account, error)
+	DescribeAccount(context.Context, vos.Account) (entities.Account, error)
+	FreezeAccount(context.Context, vos.Account) (entities.Account, error)
+	UnfreezeAccount(context.Context, vos.Account) (entities.Account, error)
+	CloseAccount(context.Context, vos.Account) (entities.Account, error)
+	SetBalanceLimit(context.Context, vos.BalanceLimit) error
+	DeleteBalanceLimit(context.Context, vos.Account, vos.Currency) error
+	ListBalanceLimits(context.Context) ([]vos.BalanceLimit, error)
+	AuthorizeTransaction(context.Context, entities.Transaction) (entities.PendingTransaction, error)
+	CaptureTransaction(context.Context, CaptureTransactionInput) error
+	VoidTransaction(context.Context, uuid.UUID) error
+	ScheduleTransaction(context.Context, entities.Transaction) (entities.ScheduledTransaction, error)
+	CancelScheduledTransaction(context.Context, uuid.UUID) error
+	ListScheduledTransactions(context.Context, ListScheduledTransactionsInput) (ListScheduledTransactionsOutput, error)
+	ClaimDueScheduledTransactions(context.Context, int) ([]entities.ScheduledTransaction, error)
+	PostScheduledTransaction(context.Context, entities.ScheduledTransaction) error
+	CreateEvent(context.Context, entities.Event) (entities.Event, error)
+	DescribeEvent(context.Context, uint32) (entities.Event, error)
+	ListEvents(context.Context, ListEventsInput) ([]entities.Event, error)
+	DeprecateEvent(context.Context, uint32) (entities.Event, error)
+	SetEventMetadataSchema(context.Context, entities.EventMetadataSchema) (entities.EventMetadataSchema, error)
+	ListEventMetadataSchemas(context.Context, uint32) ([]entities.EventMetadataSchema, error)
+	ListChartOfAccounts(context.Context) (vos.ChartOfAccounts, error)
+	CreateFiscalPeriod(context.Context, entities.FiscalPeriod) (entities.FiscalPeriod, error)
+	DescribeFiscalPeriod(context.Context, uuid.UUID) (DescribeFiscalPeriodOutput, error)
+	ListFiscalPeriods(context.Context, string) ([]entities.FiscalPeriod, error)
+	CloseFiscalPeriod(context.Context, CloseFiscalPeriodInput) (entities.FiscalPeriodClosing, error)
+	ReopenFiscalPeriod(context.Context, ReopenFiscalPeriodInput) (entities.FiscalPeriod, error)
+	SetCompetenceLock(context.Context, CompetenceLockInput) (entities.CompetenceLockChange, error)
+	ClearCompetenceLock(context.Context, CompetenceLockInput) (entities.CompetenceLockChange, error)
+	ListCompetenceLocks(context.Context, string) (ListCompetenceLocksOutput, error)
+}
+
+type CreateTransactionsInput struct {
+	Transactions []entities.Transaction
+	// BestEffort posts each transaction independently, instead of either all or none of them.
+	BestEffort bool
 }
 
 type GetAccountBalanceInput struct {
 	Account   vos.Account
+	Currency  vos.Currency
 	StartDate time.Time
 	EndDate   time.Time
+	// Time applies the dates to the competence date or the recorded time, and bounds the balance to what
+	// was known at an instant. The current balance is returned when neither the dates nor the bound are set.
+	Time vos.TimeView
+}
+
+// FinancialStatementInput asks for a financial statement of the current and comparative periods, with the
+// accounts grouped by their first labels. The balance sheet only takes the end of the periods into account.
+type FinancialStatementInput struct {
+	Company     string
+	Current     vos.Period
+	Comparative vos.Period
+	Level       int
+	Time        vos.TimeView
+}
+
+type RevertTransactionInput struct {
+	ID            uuid.UUID
+	TransactionID uuid.UUID
+	Reason        string
+	Amount        int
+}
+
+type CaptureTransactionInput struct {
+	ID                   uuid.UUID
+	PendingTransactionID uuid.UUID
+	Amount               int
+}
+
+type ListScheduledTransactionsInput struct {
+	// Status filters the transactions by status, all of them are listed when invalid.
+	Status vos.ScheduleStatus
+	Page   pagination.Page
+}
+
+type ListScheduledTransactionsOutput struct {
+	Transactions []entities.ScheduledTransaction
+	NextPage     pagination.Cursor
+}
+
+type ListEventsInput struct {
+	// IncludeDeprecated also lists the events that no longer accept postings.
+	IncludeDeprecated bool
+}
+
+type DescribeFiscalPeriodOutput struct {
+	Period entities.FiscalPeriod
+	// Changes are the closes and reopens of the period, oldest first.
+	Changes []entities.FiscalPeriodChange
+}
+
+type CloseFiscalPeriodInput struct {
+	ID uuid.UUID
+	// Event triggers the closing transaction.
+	Event       uint32
+	RequestedBy string
+}
+
+type ReopenFiscalPeriodInput struct {
+	ID          uuid.UUID
+	RequestedBy string
+	Reason      string
+}
+
+// CompetenceLockInput moves the lock date of a company, or of one of its events when Event is set.
+// The date is ignored when clearing the lock.
+type CompetenceLockInput struct {
+	Company     string
+	Event       uint32
+	Date        time.Time
+	RequestedBy string
+	Reason      string
+}
+
+type ListCompetenceLocksOutput struct {
+	Locks []entities.CompetenceLock
+	// Changes are the moves of the lock dates of the company, oldest first.
+	Changes []entities.CompetenceLockChange
 }
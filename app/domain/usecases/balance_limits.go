@@ -0,0 +1,33 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func (l *LedgerUseCase) SetBalanceLimit(ctx context.Context, limit vos.BalanceLimit) error {
+	if err := l.repository.SetBalanceLimit(ctx, limit); err != nil {
+		return fmt.Errorf("failed to set balance limit: %w", err)
+	}
+
+	return nil
+}
+
+func (l *LedgerUseCase) DeleteBalanceLimit(ctx context.Context, account vos.Account, currency vos.Currency) error {
+	if err := l.repository.DeleteBalanceLimit(ctx, account, currency); err != nil {
+		return fmt.Errorf("failed to delete balance limit: %w", err)
+	}
+
+	return nil
+}
+
+func (l *LedgerUseCase) ListBalanceLimits(ctx context.Context) ([]vos.BalanceLimit, error) {
+	limits, err := l.repository.ListBalanceLimits(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list balance limits: %w", err)
+	}
+
+	return limits, nil
+}
@@ -0,0 +1,78 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+)
+
+func TestLedgerUseCase_BalanceLimits(t *testing.T) {
+	floor := 0
+
+	account, err := vos.NewAccount("liability.clients.available.*")
+	require.NoError(t, err)
+
+	limit, err := vos.NewBalanceLimit(account, vos.DefaultCurrency, &floor, nil)
+	require.NoError(t, err)
+
+	t.Run("Should set a balance limit", func(t *testing.T) {
+		repo := &mocks.RepositoryMock{
+			SetBalanceLimitFunc: func(ctx context.Context, l vos.BalanceLimit) error {
+				return nil
+			},
+		}
+		usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+		err := usecase.SetBalanceLimit(context.Background(), limit)
+		assert.NoError(t, err)
+		assert.Equal(t, limit, repo.SetBalanceLimitCalls()[0].BalanceLimit)
+	})
+
+	t.Run("Should return an error if limit does not exist", func(t *testing.T) {
+		repo := &mocks.RepositoryMock{
+			DeleteBalanceLimitFunc: func(ctx context.Context, a vos.Account, c vos.Currency) error {
+				return app.ErrBalanceLimitNotFound
+			},
+		}
+		usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+		err := usecase.DeleteBalanceLimit(context.Background(), account, vos.DefaultCurrency)
+		assert.ErrorIs(t, err, app.ErrBalanceLimitNotFound)
+	})
+
+	t.Run("Should list balance limits", func(t *testing.T) {
+		repo := &mocks.RepositoryMock{
+			ListBalanceLimitsFunc: func(ctx context.Context) ([]vos.BalanceLimit, error) {
+				return []vos.BalanceLimit{limit}, nil
+			},
+		}
+		usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+		got, err := usecase.ListBalanceLimits(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []vos.BalanceLimit{limit}, got)
+	})
+
+	t.Run("Should return an error if listing fails", func(t *testing.T) {
+		repoErr := errors.New("some error")
+		repo := &mocks.RepositoryMock{
+			ListBalanceLimitsFunc: func(ctx context.Context) ([]vos.BalanceLimit, error) {
+				return nil, repoErr
+			},
+		}
+		usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+		got, err := usecase.ListBalanceLimits(context.Background())
+		assert.ErrorIs(t, err, repoErr)
+		assert.Nil(t, got)
+	})
+}
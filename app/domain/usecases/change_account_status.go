@@ -0,0 +1,41 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func (l *LedgerUseCase) FreezeAccount(ctx context.Context, account vos.Account) (entities.Account, error) {
+	return l.changeAccountStatus(ctx, account, entities.Account.Freeze)
+}
+
+func (l *LedgerUseCase) UnfreezeAccount(ctx context.Context, account vos.Account) (entities.Account, error) {
+	return l.changeAccountStatus(ctx, account, entities.Account.Unfreeze)
+}
+
+func (l *LedgerUseCase) CloseAccount(ctx context.Context, account vos.Account) (entities.Account, error) {
+	return l.changeAccountStatus(ctx, account, entities.Account.Close)
+}
+
+func (l *LedgerUseCase) changeAccountStatus(ctx context.Context, account vos.Account, transition func(entities.Account) (entities.Account, error)) (entities.Account, error) {
+	current, err := l.repository.GetAccount(ctx, account)
+	if err != nil {
+		return entities.Account{}, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	next, err := transition(current)
+	if err != nil {
+		return entities.Account{}, fmt.Errorf("failed to change account status: %w", err)
+	}
+
+	// the previous status is checked again on update, so concurrent changes are not overwritten
+	updated, err := l.repository.UpdateAccountStatus(ctx, next, current.Status)
+	if err != nil {
+		return entities.Account{}, fmt.Errorf("failed to update account status: %w", err)
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,137 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+)
+
+func TestLedgerUseCase_ChangeAccountStatus(t *testing.T) {
+	active, err := entities.NewAccount(testdata.GenerateAccountPath(), "owner", "Display Name", time.Now())
+	require.NoError(t, err)
+
+	frozen := active
+	frozen.Status = vos.FrozenAccountStatus
+
+	getAccount := func(account entities.Account) func(context.Context, vos.Account) (entities.Account, error) {
+		return func(context.Context, vos.Account) (entities.Account, error) {
+			return account, nil
+		}
+	}
+
+	updateAccountStatus := func(ctx context.Context, account entities.Account, previous vos.AccountStatus) (entities.Account, error) {
+		return account, nil
+	}
+
+	testCases := []struct {
+		name             string
+		repoSetup        *mocks.RepositoryMock
+		change           func(*LedgerUseCase) func(context.Context, vos.Account) (entities.Account, error)
+		expectedStatus   vos.AccountStatus
+		expectedPrevious vos.AccountStatus
+		expectedErr      error
+	}{
+		{
+			name: "Should freeze an active account",
+			repoSetup: &mocks.RepositoryMock{
+				GetAccountFunc:          getAccount(active),
+				UpdateAccountStatusFunc: updateAccountStatus,
+			},
+			change: func(l *LedgerUseCase) func(context.Context, vos.Account) (entities.Account, error) {
+				return l.FreezeAccount
+			},
+			expectedStatus:   vos.FrozenAccountStatus,
+			expectedPrevious: vos.ActiveAccountStatus,
+		},
+		{
+			name: "Should unfreeze a frozen account",
+			repoSetup: &mocks.RepositoryMock{
+				GetAccountFunc:          getAccount(frozen),
+				UpdateAccountStatusFunc: updateAccountStatus,
+			},
+			change: func(l *LedgerUseCase) func(context.Context, vos.Account) (entities.Account, error) {
+				return l.UnfreezeAccount
+			},
+			expectedStatus:   vos.ActiveAccountStatus,
+			expectedPrevious: vos.FrozenAccountStatus,
+		},
+		{
+			name: "Should close a frozen account",
+			repoSetup: &mocks.RepositoryMock{
+				GetAccountFunc:          getAccount(frozen),
+				UpdateAccountStatusFunc: updateAccountStatus,
+			},
+			change: func(l *LedgerUseCase) func(context.Context, vos.Account) (entities.Account, error) {
+				return l.CloseAccount
+			},
+			expectedStatus:   vos.ClosedAccountStatus,
+			expectedPrevious: vos.FrozenAccountStatus,
+		},
+		{
+			name: "Should return an error if transition is invalid",
+			repoSetup: &mocks.RepositoryMock{
+				GetAccountFunc: getAccount(active),
+			},
+			change: func(l *LedgerUseCase) func(context.Context, vos.Account) (entities.Account, error) {
+				return l.UnfreezeAccount
+			},
+			expectedErr: app.ErrInvalidAccountStatusTransition,
+		},
+		{
+			name: "Should return an error if account does not exist",
+			repoSetup: &mocks.RepositoryMock{
+				GetAccountFunc: func(context.Context, vos.Account) (entities.Account, error) {
+					return entities.Account{}, app.ErrAccountNotFound
+				},
+			},
+			change: func(l *LedgerUseCase) func(context.Context, vos.Account) (entities.Account, error) {
+				return l.FreezeAccount
+			},
+			expectedErr: app.ErrAccountNotFound,
+		},
+		{
+			name: "Should return an error if status was concurrently changed",
+			repoSetup: &mocks.RepositoryMock{
+				GetAccountFunc: getAccount(active),
+				UpdateAccountStatusFunc: func(context.Context, entities.Account, vos.AccountStatus) (entities.Account, error) {
+					return entities.Account{}, app.ErrInvalidAccountStatusTransition
+				},
+			},
+			change: func(l *LedgerUseCase) func(context.Context, vos.Account) (entities.Account, error) {
+				return l.CloseAccount
+			},
+			expectedErr: app.ErrInvalidAccountStatusTransition,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase := NewLedgerUseCase(tt.repoSetup, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+			got, err := tt.change(usecase)(context.Background(), active.Account)
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				assert.Empty(t, got)
+				return
+			}
+
+			assert.Equal(t, tt.expectedStatus, got.Status)
+
+			calls := tt.repoSetup.UpdateAccountStatusCalls()
+			require.Len(t, calls, 1)
+			assert.Equal(t, tt.expectedPrevious, calls[0].AccountStatus)
+		})
+	}
+}
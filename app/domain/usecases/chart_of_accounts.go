@@ -0,0 +1,24 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func (l *LedgerUseCase) ListChartOfAccounts(context.Context) (vos.ChartOfAccounts, error) {
+	return l.chart, nil
+}
+
+// checkChartOfAccounts ensures that every account receiving entries matches the chart of accounts.
+func (l *LedgerUseCase) checkChartOfAccounts(entries []entities.Entry) error {
+	for _, entry := range entries {
+		if !l.chart.Allows(entry.Account) {
+			return app.AccountNotInChartError{Account: entry.Account.Value()}
+		}
+	}
+
+	return nil
+}
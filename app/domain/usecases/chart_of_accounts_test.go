@@ -0,0 +1,123 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+)
+
+func TestLedgerUseCase_ChartOfAccounts(t *testing.T) {
+	chart, err := vos.NewChartOfAccounts("liability.clients.{available,blocked}.<uuid>", "asset.bacen.*")
+	require.NoError(t, err)
+
+	newTransaction := func(t *testing.T, account string) entities.Transaction {
+		e1, err := entities.NewEntry(uuid.New(), vos.DebitOperation, account, vos.NextAccountVersion, 100, "BRL", nil)
+		require.NoError(t, err)
+
+		e2, err := entities.NewEntry(uuid.New(), vos.CreditOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100, "BRL", nil)
+		require.NoError(t, err)
+
+		tx, err := entities.NewTransaction(uuid.New(), 1, "abc", time.Now(), e1, e2)
+		require.NoError(t, err)
+
+		return tx
+	}
+
+	newRepository := func() *mocks.RepositoryMock {
+		return &mocks.RepositoryMock{
+			CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+				return transaction, nil
+			},
+			CreateTransactionsFunc: func(ctx context.Context, transactions []entities.Transaction) error {
+				return nil
+			},
+			CreateTransactionsBestEffortFunc: func(ctx context.Context, transactions []entities.Transaction) ([]error, error) {
+				return make([]error, len(transactions)), nil
+			},
+		}
+	}
+
+	t.Run("should list the patterns of the chart", func(t *testing.T) {
+		usecase := NewLedgerUseCase(&mocks.RepositoryMock{}, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}), WithChartOfAccounts(chart))
+
+		got, err := usecase.ListChartOfAccounts(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, chart, got)
+	})
+
+	t.Run("should post entries into accounts of the chart", func(t *testing.T) {
+		repo := newRepository()
+		usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}), WithChartOfAccounts(chart))
+
+		_, err := usecase.CreateTransaction(context.Background(), newTransaction(t, "asset.bacen.reserves"))
+		assert.NoError(t, err)
+		assert.Len(t, repo.CreateTransactionCalls(), 1)
+	})
+
+	t.Run("should reject entries into accounts out of the chart", func(t *testing.T) {
+		repo := newRepository()
+		usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}), WithChartOfAccounts(chart))
+
+		_, err := usecase.CreateTransaction(context.Background(), newTransaction(t, "asset.bacne.reserves"))
+		assert.ErrorIs(t, err, app.ErrAccountNotInChart)
+		assert.EqualError(t, err, "account does not match the chart of accounts: asset.bacne.reserves")
+		assert.Empty(t, repo.CreateTransactionCalls())
+	})
+
+	t.Run("should accept any account without a chart", func(t *testing.T) {
+		repo := newRepository()
+		usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+		_, err := usecase.CreateTransaction(context.Background(), newTransaction(t, "asset.bacne.reserves"))
+		assert.NoError(t, err)
+	})
+
+	t.Run("should reject the whole batch in all-or-nothing mode", func(t *testing.T) {
+		repo := newRepository()
+		usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}), WithChartOfAccounts(chart))
+
+		_, err := usecase.CreateTransactions(context.Background(), domain.CreateTransactionsInput{
+			Transactions: []entities.Transaction{
+				newTransaction(t, "asset.bacen.reserves"),
+				newTransaction(t, "asset.bacne.reserves"),
+			},
+		})
+		assert.ErrorIs(t, err, app.ErrAccountNotInChart)
+		assert.Empty(t, repo.CreateTransactionsCalls())
+	})
+
+	t.Run("should post only the transactions of the chart in best-effort mode", func(t *testing.T) {
+		repo := newRepository()
+		usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}), WithChartOfAccounts(chart))
+
+		allowed := newTransaction(t, "asset.bacen.reserves")
+
+		errs, err := usecase.CreateTransactions(context.Background(), domain.CreateTransactionsInput{
+			Transactions: []entities.Transaction{
+				newTransaction(t, "asset.bacne.reserves"),
+				allowed,
+			},
+			BestEffort: true,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, errs, 2)
+		assert.ErrorIs(t, errs[0], app.ErrAccountNotInChart)
+		assert.NoError(t, errs[1])
+
+		require.Len(t, repo.CreateTransactionsBestEffortCalls(), 1)
+		assert.Equal(t, []entities.Transaction{allowed}, repo.CreateTransactionsBestEffortCalls()[0].Transactions)
+	})
+}
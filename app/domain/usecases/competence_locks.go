@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+)
+
+func (l *LedgerUseCase) SetCompetenceLock(ctx context.Context, input domain.CompetenceLockInput) (entities.CompetenceLockChange, error) {
+	if input.Date.IsZero() {
+		return entities.CompetenceLockChange{}, app.ErrInvalidCompetenceLockDate
+	}
+
+	return l.changeCompetenceLock(ctx, input, input.Date)
+}
+
+func (l *LedgerUseCase) ClearCompetenceLock(ctx context.Context, input domain.CompetenceLockInput) (entities.CompetenceLockChange, error) {
+	return l.changeCompetenceLock(ctx, input, time.Time{})
+}
+
+func (l *LedgerUseCase) ListCompetenceLocks(ctx context.Context, company string) (domain.ListCompetenceLocksOutput, error) {
+	locks, err := l.repository.ListCompetenceLocks(ctx, company)
+	if err != nil {
+		return domain.ListCompetenceLocksOutput{}, fmt.Errorf("failed to list competence locks: %w", err)
+	}
+
+	changes, err := l.repository.ListCompetenceLockChanges(ctx, company)
+	if err != nil {
+		return domain.ListCompetenceLocksOutput{}, fmt.Errorf("failed to list competence lock changes: %w", err)
+	}
+
+	return domain.ListCompetenceLocksOutput{
+		Locks:   locks,
+		Changes: changes,
+	}, nil
+}
+
+func (l *LedgerUseCase) changeCompetenceLock(ctx context.Context, input domain.CompetenceLockInput, date time.Time) (entities.CompetenceLockChange, error) {
+	change, err := entities.NewCompetenceLockChange(
+		input.Company,
+		input.Event,
+		date,
+		input.RequestedBy,
+		input.Reason,
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return entities.CompetenceLockChange{}, fmt.Errorf("failed to create competence lock change: %w", err)
+	}
+
+	changed, err := l.repository.ChangeCompetenceLock(ctx, change)
+	if err != nil {
+		return entities.CompetenceLockChange{}, fmt.Errorf("failed to change competence lock: %w", err)
+	}
+
+	return changed, nil
+}
@@ -0,0 +1,88 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+)
+
+func TestLedgerUseCase_SetCompetenceLock(t *testing.T) {
+	date := time.Date(2021, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		input       domain.CompetenceLockInput
+		changeErr   error
+		expectedErr error
+	}{
+		{
+			name:  "Should move the lock date",
+			input: domain.CompetenceLockInput{Company: "abc", Event: 2, Date: date, RequestedBy: "accountant", Reason: "filed"},
+		},
+		{
+			name:        "Should return an error if there is no date",
+			input:       domain.CompetenceLockInput{Company: "abc", RequestedBy: "accountant"},
+			expectedErr: app.ErrInvalidCompetenceLockDate,
+		},
+		{
+			name:        "Should return an error if there is no requester",
+			input:       domain.CompetenceLockInput{Company: "abc", Date: date},
+			expectedErr: app.ErrInvalidCompetenceLockRequester,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.RepositoryMock{
+				ChangeCompetenceLockFunc: func(ctx context.Context, change entities.CompetenceLockChange) (entities.CompetenceLockChange, error) {
+					assert.Equal(t, tt.input.Company, change.Company)
+					assert.Equal(t, tt.input.Event, change.Event)
+					assert.Equal(t, tt.input.Date, change.Date)
+					assert.Equal(t, tt.input.RequestedBy, change.RequestedBy)
+					assert.Equal(t, tt.input.Reason, change.Reason)
+
+					return change, tt.changeErr
+				},
+			}
+
+			usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+			_, err := usecase.SetCompetenceLock(context.Background(), tt.input)
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr == nil {
+				assert.Len(t, repo.ChangeCompetenceLockCalls(), 1)
+			} else {
+				assert.Empty(t, repo.ChangeCompetenceLockCalls())
+			}
+		})
+	}
+}
+
+func TestLedgerUseCase_ClearCompetenceLock(t *testing.T) {
+	repo := &mocks.RepositoryMock{
+		ChangeCompetenceLockFunc: func(ctx context.Context, change entities.CompetenceLockChange) (entities.CompetenceLockChange, error) {
+			assert.True(t, change.Removes())
+
+			return change, app.ErrCompetenceLockNotFound
+		},
+	}
+
+	usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+	_, err := usecase.ClearCompetenceLock(context.Background(), domain.CompetenceLockInput{
+		Company:     "abc",
+		Date:        time.Now(),
+		RequestedBy: "accountant",
+	})
+	assert.ErrorIs(t, err, app.ErrCompetenceLockNotFound)
+}
@@ -2,16 +2,54 @@ package usecases
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/stone-co/the-amazing-ledger/app"
 	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
 )
 
-func (l *LedgerUseCase) CreateTransaction(ctx context.Context, transaction entities.Transaction) error {
-	err := l.repository.CreateTransaction(ctx, transaction)
+// CreateTransaction posts the transaction and returns it as stored. The transaction and entry ids are
+// idempotency keys: replaying a transaction already posted returns the stored one, while reusing them
+// for a different payload fails with a TransactionConflictError describing the differences.
+// Adjustments must be authorized, telling who requested them and why, and the accounts must match the chart of accounts.
+func (l *LedgerUseCase) CreateTransaction(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+	if err := transaction.CheckAdjustment(); err != nil {
+		return entities.Transaction{}, err
+	}
+
+	if err := l.checkChartOfAccounts(transaction.Entries); err != nil {
+		return entities.Transaction{}, err
+	}
+
+	posted, err := l.repository.CreateTransaction(ctx, transaction)
+	if errors.Is(err, app.ErrIdempotencyKeyViolation) {
+		posted, err = l.replayTransaction(ctx, transaction)
+	}
+
 	if err != nil {
-		return fmt.Errorf("failed to create transaction: %w", err)
+		return entities.Transaction{}, fmt.Errorf("failed to create transaction: %w", err)
+	}
+
+	return posted, nil
+}
+
+// replayTransaction compares the transaction with the stored one whose ids it reuses.
+func (l *LedgerUseCase) replayTransaction(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+	stored, err := l.repository.GetTransaction(ctx, transaction.ID)
+	if errors.Is(err, app.ErrTransactionNotFound) {
+		return entities.Transaction{}, app.TransactionConflictError{
+			Differences: []string{"entry ids already used by another transaction"},
+		}
+	}
+
+	if err != nil {
+		return entities.Transaction{}, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	if diff := transaction.Diff(stored); len(diff) > 0 {
+		return entities.Transaction{}, app.TransactionConflictError{Differences: diff}
 	}
 
-	return nil
+	return stored, nil
 }
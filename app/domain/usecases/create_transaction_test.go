@@ -3,6 +3,7 @@ package usecases
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -32,15 +33,15 @@ func TestLedgerUseCase_CreateTransaction(t *testing.T) {
 		{
 			name: "Should create a transaction successfully",
 			repoSetup: &mocks.RepositoryMock{
-				CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) error {
-					return nil
+				CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+					return transaction, nil
 				},
 			},
 			entries: func(t *testing.T) []entities.Entry {
-				e1, err := entities.NewEntry(uuid.New(), vos.DebitOperation, accountID1, vos.NextAccountVersion, 123, metadata)
+				e1, err := entities.NewEntry(uuid.New(), vos.DebitOperation, accountID1, vos.NextAccountVersion, 123, "BRL", metadata)
 				assert.NoError(t, err)
 
-				e2, err := entities.NewEntry(uuid.New(), vos.CreditOperation, accountID2, vos.NextAccountVersion, 123, metadata)
+				e2, err := entities.NewEntry(uuid.New(), vos.CreditOperation, accountID2, vos.NextAccountVersion, 123, "BRL", metadata)
 				assert.NoError(t, err)
 
 				return []entities.Entry{e1, e2}
@@ -50,15 +51,15 @@ func TestLedgerUseCase_CreateTransaction(t *testing.T) {
 		{
 			name: "Should return an error if entry tries to skip one version",
 			repoSetup: &mocks.RepositoryMock{
-				CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) error {
-					return app.ErrInvalidVersion
+				CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+					return entities.Transaction{}, app.ErrInvalidVersion
 				},
 			},
 			entries: func(t *testing.T) []entities.Entry {
-				e1, err := entities.NewEntry(uuid.New(), vos.DebitOperation, accountID1, vos.Version(1), 123, metadata)
+				e1, err := entities.NewEntry(uuid.New(), vos.DebitOperation, accountID1, vos.Version(1), 123, "BRL", metadata)
 				assert.NoError(t, err)
 
-				e2, err := entities.NewEntry(uuid.New(), vos.CreditOperation, accountID2, vos.Version(3), 123, metadata)
+				e2, err := entities.NewEntry(uuid.New(), vos.CreditOperation, accountID2, vos.Version(3), 123, "BRL", metadata)
 				assert.NoError(t, err)
 
 				return []entities.Entry{e1, e2}
@@ -66,24 +67,27 @@ func TestLedgerUseCase_CreateTransaction(t *testing.T) {
 			expectedErr: app.ErrInvalidVersion,
 		},
 		{
-			name: "Should return an error if violates idempotency key",
+			name: "Should return a conflict if entry ids belong to another transaction",
 			repoSetup: &mocks.RepositoryMock{
-				CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) error {
-					return app.ErrIdempotencyKeyViolation
+				CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+					return entities.Transaction{}, app.ErrIdempotencyKeyViolation
+				},
+				GetTransactionFunc: func(ctx context.Context, id uuid.UUID) (entities.Transaction, error) {
+					return entities.Transaction{}, app.ErrTransactionNotFound
 				},
 			},
 			entries: func(t *testing.T) []entities.Entry {
 				idempotencyKey := uuid.New()
 
-				e1, err := entities.NewEntry(idempotencyKey, vos.DebitOperation, accountID1, vos.NextAccountVersion, 123, metadata)
+				e1, err := entities.NewEntry(idempotencyKey, vos.DebitOperation, accountID1, vos.NextAccountVersion, 123, "BRL", metadata)
 				assert.NoError(t, err)
 
-				e2, err := entities.NewEntry(idempotencyKey, vos.CreditOperation, accountID2, vos.NextAccountVersion, 123, metadata)
+				e2, err := entities.NewEntry(idempotencyKey, vos.CreditOperation, accountID2, vos.NextAccountVersion, 123, "BRL", metadata)
 				assert.NoError(t, err)
 
 				return []entities.Entry{e1, e2}
 			},
-			expectedErr: app.ErrIdempotencyKeyViolation,
+			expectedErr: app.ErrTransactionConflict,
 		},
 	}
 
@@ -94,8 +98,129 @@ func TestLedgerUseCase_CreateTransaction(t *testing.T) {
 			tx, err := entities.NewTransaction(uuid.New(), 1, "abc", time.Now(), tt.entries(t)...)
 			assert.NoError(t, err)
 
-			err = usecase.CreateTransaction(context.Background(), tx)
+			_, err = usecase.CreateTransaction(context.Background(), tx)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestLedgerUseCase_CreateTransactionAdjustment(t *testing.T) {
+	e1, err := entities.NewEntry(uuid.New(), vos.DebitOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 123, "BRL", nil)
+	assert.NoError(t, err)
+
+	e2, err := entities.NewEntry(uuid.New(), vos.CreditOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 123, "BRL", nil)
+	assert.NoError(t, err)
+
+	tx, err := entities.NewTransaction(uuid.New(), 1, "abc", time.Now(), e1, e2)
+	assert.NoError(t, err)
+
+	t.Run("should reject an adjustment without authorization", func(t *testing.T) {
+		usecase := NewLedgerUseCase(&mocks.RepositoryMock{}, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+		unauthorized := tx
+		unauthorized.Adjustment = true
+
+		_, err = usecase.CreateTransaction(context.Background(), unauthorized)
+		assert.ErrorIs(t, err, app.ErrUnauthorizedAdjustment)
+	})
+
+	t.Run("should post an authorized adjustment", func(t *testing.T) {
+		repo := &mocks.RepositoryMock{
+			CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+				return transaction, nil
+			},
+		}
+		usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+		adjustment, authErr := tx.Authorize("accountant", "late invoice")
+		assert.NoError(t, authErr)
+
+		posted, postErr := usecase.CreateTransaction(context.Background(), adjustment)
+		assert.NoError(t, postErr)
+		assert.Equal(t, "accountant", posted.Authorization.RequestedBy)
+		assert.Len(t, repo.CreateTransactionCalls(), 1)
+	})
+}
+
+func TestLedgerUseCase_CreateTransactionReplay(t *testing.T) {
+	metadata := json.RawMessage(`{"a": 1, "b": "c"}`)
+
+	e1, err := entities.NewEntry(uuid.New(), vos.DebitOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 123, "BRL", metadata)
+	assert.NoError(t, err)
+
+	e2, err := entities.NewEntry(uuid.New(), vos.CreditOperation, testdata.GenerateAccountPath(), vos.IgnoreAccountVersion, 123, "BRL", metadata)
+	assert.NoError(t, err)
+
+	tx, err := entities.NewTransaction(uuid.New(), 1, "abc", time.Now().Truncate(time.Second), e1, e2)
+	assert.NoError(t, err)
+
+	stored := tx
+	stored.Entries = []entities.Entry{tx.Entries[0], tx.Entries[1]}
+	stored.CreatedAt = time.Now()
+
+	for i := range stored.Entries {
+		stored.Entries[i].Metadata = json.RawMessage(`{"b": "c", "a": 1}`)
+		if stored.Entries[i].Version == vos.NextAccountVersion {
+			stored.Entries[i].Version = vos.Version(7)
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		transaction func() entities.Transaction
+		expectedErr error
+		diff        []string
+	}{
+		{
+			name: "Should return the stored transaction on an identical replay",
+			transaction: func() entities.Transaction {
+				return tx
+			},
+		},
+		{
+			name: "Should return a conflict if the payload differs",
+			transaction: func() entities.Transaction {
+				replay := tx
+				replay.Event = 2
+				replay.Entries = []entities.Entry{tx.Entries[0], tx.Entries[1]}
+				replay.Entries[0].Amount = 321
+				replay.Entries[1].Amount = 321
+
+				return replay
+			},
+			expectedErr: app.ErrTransactionConflict,
+			diff: []string{
+				"event: 2 != 1",
+				fmt.Sprintf("entry %s: amount: 321 != 123", tx.Entries[0].ID),
+				fmt.Sprintf("entry %s: amount: 321 != 123", tx.Entries[1].ID),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase := NewLedgerUseCase(&mocks.RepositoryMock{
+				CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+					return entities.Transaction{}, app.ErrIdempotencyKeyViolation
+				},
+				GetTransactionFunc: func(ctx context.Context, id uuid.UUID) (entities.Transaction, error) {
+					assert.Equal(t, tx.ID, id)
+					return stored, nil
+				},
+			}, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+			got, err := usecase.CreateTransaction(context.Background(), tt.transaction())
 			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				var conflictErr app.TransactionConflictError
+				assert.ErrorAs(t, err, &conflictErr)
+				assert.Equal(t, tt.diff, conflictErr.Differences)
+
+				return
+			}
+
+			assert.Equal(t, stored, got)
 		})
 	}
 }
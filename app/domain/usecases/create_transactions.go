@@ -0,0 +1,118 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+)
+
+// CreateTransactions posts a batch of transactions, returning the error of each transaction in best-effort mode.
+// In all-or-nothing mode, a rejected transaction rejects the whole batch. Unauthorized adjustments
+// reject the batch in either mode. Transactions already posted are replayed like CreateTransaction does.
+// Transactions into accounts out of the chart of accounts aren't sent to the repository.
+func (l *LedgerUseCase) CreateTransactions(ctx context.Context, input domain.CreateTransactionsInput) ([]error, error) {
+	for _, transaction := range input.Transactions {
+		if err := transaction.CheckAdjustment(); err != nil {
+			return nil, err
+		}
+	}
+
+	if !input.BestEffort {
+		for _, transaction := range input.Transactions {
+			if err := l.checkChartOfAccounts(transaction.Entries); err != nil {
+				return nil, fmt.Errorf("failed to create transactions: %w", err)
+			}
+		}
+
+		if err := l.createTransactions(ctx, input.Transactions); err != nil {
+			return nil, fmt.Errorf("failed to create transactions: %w", err)
+		}
+
+		return make([]error, len(input.Transactions)), nil
+	}
+
+	errs := make([]error, len(input.Transactions))
+
+	// allowed holds the index of each transaction sent to the repository
+	allowed := make([]int, 0, len(input.Transactions))
+	transactions := make([]entities.Transaction, 0, len(input.Transactions))
+
+	for i, transaction := range input.Transactions {
+		if errs[i] = l.checkChartOfAccounts(transaction.Entries); errs[i] == nil {
+			allowed = append(allowed, i)
+			transactions = append(transactions, transaction)
+		}
+	}
+
+	if len(transactions) == 0 {
+		return errs, nil
+	}
+
+	txErrs, err := l.repository.CreateTransactionsBestEffort(ctx, transactions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transactions: %w", err)
+	}
+
+	for j, txErr := range txErrs {
+		i := allowed[j]
+		errs[i] = txErr
+
+		if !errors.Is(txErr, app.ErrIdempotencyKeyViolation) {
+			continue
+		}
+
+		if _, errs[i] = l.replayTransaction(ctx, input.Transactions[i]); errs[i] != nil && !app.IsDomainError(errs[i]) {
+			return nil, fmt.Errorf("failed to create transactions: %w", errs[i])
+		}
+	}
+
+	return errs, nil
+}
+
+// createTransactions posts all the transactions at once. When some of them were already posted, they're
+// replayed and only the others are posted, so a batch retried as a whole succeeds.
+func (l *LedgerUseCase) createTransactions(ctx context.Context, transactions []entities.Transaction) error {
+	err := l.repository.CreateTransactions(ctx, transactions)
+	if !errors.Is(err, app.ErrIdempotencyKeyViolation) {
+		return err
+	}
+
+	pending := make([]entities.Transaction, 0, len(transactions))
+
+	for _, transaction := range transactions {
+		stored, getErr := l.repository.GetTransaction(ctx, transaction.ID)
+		if errors.Is(getErr, app.ErrTransactionNotFound) {
+			pending = append(pending, transaction)
+			continue
+		}
+
+		if getErr != nil {
+			return fmt.Errorf("failed to get transaction: %w", getErr)
+		}
+
+		if diff := transaction.Diff(stored); len(diff) > 0 {
+			return app.TransactionConflictError{Differences: diff}
+		}
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	// when none was posted, the ids are used by transactions out of the batch
+	if len(pending) < len(transactions) {
+		err = l.repository.CreateTransactions(ctx, pending)
+	}
+
+	if errors.Is(err, app.ErrIdempotencyKeyViolation) {
+		return app.TransactionConflictError{
+			Differences: []string{"entry ids already used by another transaction"},
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,151 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+)
+
+func TestLedgerUseCase_CreateTransactions(t *testing.T) {
+	transactions := []entities.Transaction{
+		newPendingTransaction(t, time.Time{}).Transaction,
+		newPendingTransaction(t, time.Time{}).Transaction,
+	}
+	dbErr := errors.New("connection refused")
+
+	changed := transactions[1]
+	changed.Company = "other"
+
+	// getStored returns the given transactions as stored, and no other
+	getStored := func(stored ...entities.Transaction) func(ctx context.Context, id uuid.UUID) (entities.Transaction, error) {
+		return func(ctx context.Context, id uuid.UUID) (entities.Transaction, error) {
+			for _, tx := range stored {
+				if tx.ID == id {
+					return tx, nil
+				}
+			}
+
+			return entities.Transaction{}, app.ErrTransactionNotFound
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		bestEffort   bool
+		repoSetup    *mocks.RepositoryMock
+		expectedErrs []error
+		expectedErr  error
+	}{
+		{
+			name: "Should create all transactions at once",
+			repoSetup: &mocks.RepositoryMock{
+				CreateTransactionsFunc: func(ctx context.Context, txs []entities.Transaction) error {
+					assert.Equal(t, transactions, txs)
+					return nil
+				},
+			},
+			expectedErrs: []error{nil, nil},
+		},
+		{
+			name: "Should reject all transactions when one of them is rejected",
+			repoSetup: &mocks.RepositoryMock{
+				CreateTransactionsFunc: func(ctx context.Context, txs []entities.Transaction) error {
+					return app.ErrInvalidVersion
+				},
+			},
+			expectedErr: app.ErrInvalidVersion,
+		},
+		{
+			name: "Should replay the transactions already posted and post the others",
+			repoSetup: &mocks.RepositoryMock{
+				CreateTransactionsFunc: func(ctx context.Context, txs []entities.Transaction) error {
+					if len(txs) == len(transactions) {
+						return app.ErrIdempotencyKeyViolation
+					}
+
+					assert.Equal(t, transactions[1:], txs)
+
+					return nil
+				},
+				GetTransactionFunc: getStored(transactions[0]),
+			},
+			expectedErrs: []error{nil, nil},
+		},
+		{
+			name: "Should reject all transactions when a replayed one differs",
+			repoSetup: &mocks.RepositoryMock{
+				CreateTransactionsFunc: func(ctx context.Context, txs []entities.Transaction) error {
+					return app.ErrIdempotencyKeyViolation
+				},
+				GetTransactionFunc: getStored(transactions[0], changed),
+			},
+			expectedErr: app.ErrTransactionConflict,
+		},
+		{
+			name: "Should reject all transactions when their ids belong to other transactions",
+			repoSetup: &mocks.RepositoryMock{
+				CreateTransactionsFunc: func(ctx context.Context, txs []entities.Transaction) error {
+					return app.ErrIdempotencyKeyViolation
+				},
+				GetTransactionFunc: getStored(),
+			},
+			expectedErr: app.ErrTransactionConflict,
+		},
+		{
+			name:       "Should replay each transaction already posted in best-effort mode",
+			bestEffort: true,
+			repoSetup: &mocks.RepositoryMock{
+				CreateTransactionsBestEffortFunc: func(ctx context.Context, txs []entities.Transaction) ([]error, error) {
+					return []error{app.ErrIdempotencyKeyViolation, app.ErrIdempotencyKeyViolation}, nil
+				},
+				GetTransactionFunc: getStored(transactions[0], changed),
+			},
+			expectedErrs: []error{nil, app.TransactionConflictError{Differences: transactions[1].Diff(changed)}},
+		},
+		{
+			name:       "Should report the error of each transaction in best-effort mode",
+			bestEffort: true,
+			repoSetup: &mocks.RepositoryMock{
+				CreateTransactionsBestEffortFunc: func(ctx context.Context, txs []entities.Transaction) ([]error, error) {
+					assert.Equal(t, transactions, txs)
+					return []error{nil, app.ErrInvalidVersion}, nil
+				},
+			},
+			expectedErrs: []error{nil, app.ErrInvalidVersion},
+		},
+		{
+			name:       "Should return batch errors in best-effort mode",
+			bestEffort: true,
+			repoSetup: &mocks.RepositoryMock{
+				CreateTransactionsBestEffortFunc: func(ctx context.Context, txs []entities.Transaction) ([]error, error) {
+					return nil, dbErr
+				},
+			},
+			expectedErr: dbErr,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase := NewLedgerUseCase(tt.repoSetup, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+			errs, err := usecase.CreateTransactions(context.Background(), domain.CreateTransactionsInput{
+				Transactions: transactions,
+				BestEffort:   tt.bestEffort,
+			})
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expectedErrs, errs)
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func (l *LedgerUseCase) DescribeAccount(ctx context.Context, account vos.Account) (entities.Account, error) {
+	acc, err := l.repository.GetAccount(ctx, account)
+	if err != nil {
+		return entities.Account{}, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	return acc, nil
+}
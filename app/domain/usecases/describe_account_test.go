@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+)
+
+func TestLedgerUseCase_DescribeAccount(t *testing.T) {
+	account, err := entities.NewAccount(testdata.GenerateAccountPath(), "owner", "Display Name", time.Now())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		repoSetup   *mocks.RepositoryMock
+		expected    entities.Account
+		expectedErr error
+	}{
+		{
+			name: "Should describe an account successfully",
+			repoSetup: &mocks.RepositoryMock{
+				GetAccountFunc: func(ctx context.Context, acc vos.Account) (entities.Account, error) {
+					return account, nil
+				},
+			},
+			expected:    account,
+			expectedErr: nil,
+		},
+		{
+			name: "Should return an error if account does not exist",
+			repoSetup: &mocks.RepositoryMock{
+				GetAccountFunc: func(ctx context.Context, acc vos.Account) (entities.Account, error) {
+					return entities.Account{}, app.ErrAccountNotFound
+				},
+			},
+			expected:    entities.Account{},
+			expectedErr: app.ErrAccountNotFound,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase := NewLedgerUseCase(tt.repoSetup, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+			got, err := usecase.DescribeAccount(context.Background(), account.Account)
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+)
+
+func (l *LedgerUseCase) CreateEvent(ctx context.Context, event entities.Event) (entities.Event, error) {
+	created, err := l.repository.CreateEvent(ctx, event)
+	if err != nil {
+		return entities.Event{}, fmt.Errorf("failed to create event: %w", err)
+	}
+
+	return created, nil
+}
+
+func (l *LedgerUseCase) DescribeEvent(ctx context.Context, id uint32) (entities.Event, error) {
+	event, err := l.repository.GetEvent(ctx, id)
+	if err != nil {
+		return entities.Event{}, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	return event, nil
+}
+
+func (l *LedgerUseCase) ListEvents(ctx context.Context, input domain.ListEventsInput) ([]entities.Event, error) {
+	events, err := l.repository.ListEvents(ctx, input.IncludeDeprecated)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	return events, nil
+}
+
+func (l *LedgerUseCase) DeprecateEvent(ctx context.Context, id uint32) (entities.Event, error) {
+	event, err := l.repository.GetEvent(ctx, id)
+	if err != nil {
+		return entities.Event{}, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	deprecated, err := event.Deprecate(time.Now().UTC())
+	if err != nil {
+		return entities.Event{}, fmt.Errorf("failed to deprecate event: %w", err)
+	}
+
+	// the event is only deprecated once, even when it's concurrently deprecated after being read
+	if err = l.repository.DeprecateEvent(ctx, deprecated); err != nil {
+		return entities.Event{}, fmt.Errorf("failed to deprecate event: %w", err)
+	}
+
+	return deprecated, nil
+}
+
+// SetEventMetadataSchema adds a new version of the schema of an event, which applies to the postings from then on.
+func (l *LedgerUseCase) SetEventMetadataSchema(ctx context.Context, schema entities.EventMetadataSchema) (entities.EventMetadataSchema, error) {
+	created, err := l.repository.CreateEventMetadataSchema(ctx, schema)
+	if err != nil {
+		return entities.EventMetadataSchema{}, fmt.Errorf("failed to create event metadata schema: %w", err)
+	}
+
+	return created, nil
+}
+
+func (l *LedgerUseCase) ListEventMetadataSchemas(ctx context.Context, event uint32) ([]entities.EventMetadataSchema, error) {
+	if _, err := l.repository.GetEvent(ctx, event); err != nil {
+		return nil, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	schemas, err := l.repository.ListEventMetadataSchemas(ctx, event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list event metadata schemas: %w", err)
+	}
+
+	return schemas, nil
+}
@@ -0,0 +1,151 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+)
+
+func TestLedgerUseCase_ListEvents(t *testing.T) {
+	event, err := entities.NewEvent(1, "pix_transfer", "")
+	require.NoError(t, err)
+
+	repo := &mocks.RepositoryMock{
+		ListEventsFunc: func(ctx context.Context, includeDeprecated bool) ([]entities.Event, error) {
+			assert.True(t, includeDeprecated)
+			return []entities.Event{event}, nil
+		},
+	}
+
+	usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+	got, err := usecase.ListEvents(context.Background(), domain.ListEventsInput{IncludeDeprecated: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []entities.Event{event}, got)
+}
+
+func TestLedgerUseCase_DeprecateEvent(t *testing.T) {
+	active, err := entities.NewEvent(1, "pix_transfer", "")
+	require.NoError(t, err)
+
+	deprecated, err := active.Deprecate(time.Now())
+	require.NoError(t, err)
+
+	dbErr := errors.New("connection refused")
+
+	testCases := []struct {
+		name        string
+		current     entities.Event
+		getErr      error
+		deprecate   error
+		expectedErr error
+	}{
+		{
+			name:    "Should deprecate an active event",
+			current: active,
+		},
+		{
+			name:        "Should return an error if the event doesn't exist",
+			getErr:      app.ErrEventNotFound,
+			expectedErr: app.ErrEventNotFound,
+		},
+		{
+			name:        "Should return an error if the event is already deprecated",
+			current:     deprecated,
+			expectedErr: app.ErrEventAlreadyDeprecated,
+		},
+		{
+			name:        "Should return an error if the event was concurrently deprecated",
+			current:     active,
+			deprecate:   app.ErrEventAlreadyDeprecated,
+			expectedErr: app.ErrEventAlreadyDeprecated,
+		},
+		{
+			name:        "Should return an error if the event can't be updated",
+			current:     active,
+			deprecate:   dbErr,
+			expectedErr: dbErr,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.RepositoryMock{
+				GetEventFunc: func(ctx context.Context, id uint32) (entities.Event, error) {
+					assert.Equal(t, uint32(1), id)
+					return tt.current, tt.getErr
+				},
+				DeprecateEventFunc: func(ctx context.Context, event entities.Event) error {
+					assert.True(t, event.Deprecated())
+					return tt.deprecate
+				},
+			}
+
+			usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+			got, err := usecase.DeprecateEvent(context.Background(), 1)
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				assert.Empty(t, got)
+				return
+			}
+
+			assert.True(t, got.Deprecated())
+			assert.Len(t, repo.DeprecateEventCalls(), 1)
+		})
+	}
+}
+
+func TestLedgerUseCase_ListEventMetadataSchemas(t *testing.T) {
+	schema, err := entities.NewEventMetadataSchema(1, json.RawMessage(`{"required": ["order_id"]}`))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		getErr      error
+		expected    []entities.EventMetadataSchema
+		expectedErr error
+	}{
+		{
+			name:     "Should list the versions of the schema",
+			expected: []entities.EventMetadataSchema{schema},
+		},
+		{
+			name:        "Should return an error if the event doesn't exist",
+			getErr:      app.ErrEventNotFound,
+			expectedErr: app.ErrEventNotFound,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.RepositoryMock{
+				GetEventFunc: func(ctx context.Context, id uint32) (entities.Event, error) {
+					return entities.Event{ID: id}, tt.getErr
+				},
+				ListEventMetadataSchemasFunc: func(ctx context.Context, event uint32) ([]entities.EventMetadataSchema, error) {
+					return []entities.EventMetadataSchema{schema}, nil
+				},
+			}
+
+			usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+			got, err := usecase.ListEventMetadataSchemas(context.Background(), 1)
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+// GetBalanceSheet reports the balances of the balance sheet classes at the end of the current and
+// comparative periods, along with the retained earnings accumulated until then.
+func (l *LedgerUseCase) GetBalanceSheet(ctx context.Context, input domain.FinancialStatementInput) (vos.FinancialStatement, error) {
+	defer l.instrumentator.MonitorSegment(ctx).End()
+
+	current := vos.Period{End: input.Current.End}
+
+	var comparative vos.Period
+	if !input.Comparative.End.IsZero() {
+		comparative = vos.Period{End: input.Comparative.End}
+	}
+
+	lines, err := l.repository.GetStatementLines(ctx, vos.StatementRequest{
+		Classes:     vos.StatementClasses(vos.BalanceSheet),
+		Company:     input.Company,
+		Current:     current,
+		Comparative: comparative,
+		Level:       input.Level,
+		Time:        input.Time,
+	})
+	if err != nil {
+		return vos.FinancialStatement{}, fmt.Errorf("failed to get balance sheet: %w", err)
+	}
+
+	earnings, err := l.repository.GetStatementLines(ctx, vos.StatementRequest{
+		Classes:     vos.StatementClasses(vos.IncomeStatement),
+		Company:     input.Company,
+		Current:     current,
+		Comparative: comparative,
+		Level:       1,
+		Time:        input.Time,
+	})
+	if err != nil {
+		return vos.FinancialStatement{}, fmt.Errorf("failed to get retained earnings: %w", err)
+	}
+
+	return vos.NewFinancialStatement(lines, earnings), nil
+}
+
+// GetIncomeStatement reports the balances of the income statement classes within the current and
+// comparative periods, along with their net as the retained earnings of each period.
+func (l *LedgerUseCase) GetIncomeStatement(ctx context.Context, input domain.FinancialStatementInput) (vos.FinancialStatement, error) {
+	defer l.instrumentator.MonitorSegment(ctx).End()
+
+	lines, err := l.repository.GetStatementLines(ctx, vos.StatementRequest{
+		Classes:     vos.StatementClasses(vos.IncomeStatement),
+		Company:     input.Company,
+		Current:     input.Current,
+		Comparative: input.Comparative,
+		Level:       input.Level,
+		Time:        input.Time,
+	})
+	if err != nil {
+		return vos.FinancialStatement{}, fmt.Errorf("failed to get income statement: %w", err)
+	}
+
+	return vos.NewFinancialStatement(lines, lines), nil
+}
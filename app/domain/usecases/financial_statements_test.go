@@ -0,0 +1,118 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+)
+
+func TestLedgerUseCase_GetBalanceSheet(t *testing.T) {
+	end := time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC)
+	comparativeEnd := end.AddDate(-1, 0, 0)
+
+	input := domain.FinancialStatementInput{
+		Company:     "abc",
+		Current:     vos.Period{Start: end.AddDate(0, -1, 0), End: end},
+		Comparative: vos.Period{Start: comparativeEnd.AddDate(0, -1, 0), End: comparativeEnd},
+		Level:       2,
+	}
+
+	asset, err := vos.NewAccount("asset.bacen.*")
+	require.NoError(t, err)
+
+	revenue, err := vos.NewAccount("revenue.*")
+	require.NoError(t, err)
+
+	t.Run("should report the balances at the end of the periods along with the retained earnings", func(t *testing.T) {
+		repository := &mocks.RepositoryMock{
+			GetStatementLinesFunc: func(ctx context.Context, req vos.StatementRequest) ([]vos.StatementLine, error) {
+				assert.Equal(t, "abc", req.Company)
+				assert.Equal(t, vos.Period{End: end}, req.Current)
+				assert.Equal(t, vos.Period{End: comparativeEnd}, req.Comparative)
+
+				if req.Classes[0].Statement == vos.IncomeStatement {
+					assert.Equal(t, 1, req.Level)
+					return []vos.StatementLine{{Account: revenue, Currency: vos.DefaultCurrency, Current: 30, Comparative: 10}}, nil
+				}
+
+				assert.Equal(t, vos.StatementClasses(vos.BalanceSheet), req.Classes)
+				assert.Equal(t, 2, req.Level)
+
+				return []vos.StatementLine{{Account: asset, Currency: vos.DefaultCurrency, Current: -30, Comparative: -10}}, nil
+			},
+		}
+
+		useCase := NewLedgerUseCase(repository, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+		got, err := useCase.GetBalanceSheet(context.Background(), input)
+		assert.NoError(t, err)
+		assert.Equal(t, []vos.StatementLine{{Account: asset, Currency: vos.DefaultCurrency, Current: 30, Comparative: 10}}, got.Lines)
+		assert.Equal(t, []vos.StatementAmount{{Currency: vos.DefaultCurrency, Current: 30, Comparative: 10}}, got.RetainedEarnings)
+		assert.Len(t, repository.GetStatementLinesCalls(), 2)
+	})
+
+	t.Run("should return an error if the repository fails", func(t *testing.T) {
+		repoErr := errors.New("some error")
+
+		repository := &mocks.RepositoryMock{
+			GetStatementLinesFunc: func(ctx context.Context, req vos.StatementRequest) ([]vos.StatementLine, error) {
+				return nil, repoErr
+			},
+		}
+
+		useCase := NewLedgerUseCase(repository, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+		_, err := useCase.GetBalanceSheet(context.Background(), input)
+		assert.ErrorIs(t, err, repoErr)
+	})
+}
+
+func TestLedgerUseCase_GetIncomeStatement(t *testing.T) {
+	input := domain.FinancialStatementInput{
+		Current:     vos.Period{Start: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Comparative: vos.Period{Start: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Level:       2,
+	}
+
+	revenue, err := vos.NewAccount("revenue.fees.*")
+	require.NoError(t, err)
+
+	expense, err := vos.NewAccount("expense.salaries.*")
+	require.NoError(t, err)
+
+	repository := &mocks.RepositoryMock{
+		GetStatementLinesFunc: func(ctx context.Context, req vos.StatementRequest) ([]vos.StatementLine, error) {
+			assert.Equal(t, vos.StatementRequest{
+				Classes:     vos.StatementClasses(vos.IncomeStatement),
+				Current:     input.Current,
+				Comparative: input.Comparative,
+				Level:       2,
+			}, req)
+
+			return []vos.StatementLine{
+				{Account: expense, Currency: vos.DefaultCurrency, Current: -40, Comparative: -20},
+				{Account: revenue, Currency: vos.DefaultCurrency, Current: 100, Comparative: 50},
+			}, nil
+		},
+	}
+
+	useCase := NewLedgerUseCase(repository, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+	got, err := useCase.GetIncomeStatement(context.Background(), input)
+	assert.NoError(t, err)
+	assert.Equal(t, []vos.StatementLine{
+		{Account: expense, Currency: vos.DefaultCurrency, Current: 40, Comparative: 20},
+		{Account: revenue, Currency: vos.DefaultCurrency, Current: 100, Comparative: 50},
+	}, got.Lines)
+	assert.Equal(t, []vos.StatementAmount{{Currency: vos.DefaultCurrency, Current: 60, Comparative: 30}}, got.RetainedEarnings)
+}
@@ -0,0 +1,92 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+)
+
+func (l *LedgerUseCase) CreateFiscalPeriod(ctx context.Context, period entities.FiscalPeriod) (entities.FiscalPeriod, error) {
+	created, err := l.repository.CreateFiscalPeriod(ctx, period)
+	if err != nil {
+		return entities.FiscalPeriod{}, fmt.Errorf("failed to create fiscal period: %w", err)
+	}
+
+	return created, nil
+}
+
+func (l *LedgerUseCase) DescribeFiscalPeriod(ctx context.Context, id uuid.UUID) (domain.DescribeFiscalPeriodOutput, error) {
+	period, err := l.repository.GetFiscalPeriod(ctx, id)
+	if err != nil {
+		return domain.DescribeFiscalPeriodOutput{}, fmt.Errorf("failed to get fiscal period: %w", err)
+	}
+
+	changes, err := l.repository.ListFiscalPeriodChanges(ctx, id)
+	if err != nil {
+		return domain.DescribeFiscalPeriodOutput{}, fmt.Errorf("failed to list fiscal period changes: %w", err)
+	}
+
+	return domain.DescribeFiscalPeriodOutput{
+		Period:  period,
+		Changes: changes,
+	}, nil
+}
+
+func (l *LedgerUseCase) ListFiscalPeriods(ctx context.Context, company string) ([]entities.FiscalPeriod, error) {
+	periods, err := l.repository.ListFiscalPeriods(ctx, company)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fiscal periods: %w", err)
+	}
+
+	return periods, nil
+}
+
+func (l *LedgerUseCase) CloseFiscalPeriod(ctx context.Context, input domain.CloseFiscalPeriodInput) (entities.FiscalPeriodClosing, error) {
+	period, err := l.repository.GetFiscalPeriod(ctx, input.ID)
+	if err != nil {
+		return entities.FiscalPeriodClosing{}, fmt.Errorf("failed to get fiscal period: %w", err)
+	}
+
+	closing, err := entities.NewFiscalPeriodClosing(
+		period,
+		uuid.New(),
+		input.Event,
+		l.retainedEarnings,
+		input.RequestedBy,
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return entities.FiscalPeriodClosing{}, fmt.Errorf("failed to create fiscal period closing: %w", err)
+	}
+
+	// the period is only closed once, even when it's concurrently closed after being read
+	closed, err := l.repository.CloseFiscalPeriod(ctx, closing)
+	if err != nil {
+		return entities.FiscalPeriodClosing{}, fmt.Errorf("failed to close fiscal period: %w", err)
+	}
+
+	return closed, nil
+}
+
+func (l *LedgerUseCase) ReopenFiscalPeriod(ctx context.Context, input domain.ReopenFiscalPeriodInput) (entities.FiscalPeriod, error) {
+	period, err := l.repository.GetFiscalPeriod(ctx, input.ID)
+	if err != nil {
+		return entities.FiscalPeriod{}, fmt.Errorf("failed to get fiscal period: %w", err)
+	}
+
+	reopened, change, err := period.Reopen(input.RequestedBy, input.Reason, time.Now().UTC())
+	if err != nil {
+		return entities.FiscalPeriod{}, fmt.Errorf("failed to reopen fiscal period: %w", err)
+	}
+
+	if err = l.repository.ReopenFiscalPeriod(ctx, reopened, change); err != nil {
+		return entities.FiscalPeriod{}, fmt.Errorf("failed to reopen fiscal period: %w", err)
+	}
+
+	return reopened, nil
+}
@@ -0,0 +1,193 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+)
+
+func TestLedgerUseCase_CloseFiscalPeriod(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	open, err := entities.NewFiscalPeriod(uuid.New(), "abc", start, start.AddDate(1, 0, 0))
+	require.NoError(t, err)
+
+	closed, _, err := open.Close("accountant", time.Now())
+	require.NoError(t, err)
+
+	retained, err := vos.NewAccount("equity.retained_earnings.accumulated")
+	require.NoError(t, err)
+
+	dbErr := errors.New("connection refused")
+
+	testCases := []struct {
+		name        string
+		current     entities.FiscalPeriod
+		getErr      error
+		retained    vos.Account
+		closeErr    error
+		expectedErr error
+	}{
+		{
+			name:     "Should close an open period",
+			current:  open,
+			retained: retained,
+		},
+		{
+			name:        "Should return an error if the period doesn't exist",
+			getErr:      app.ErrFiscalPeriodNotFound,
+			retained:    retained,
+			expectedErr: app.ErrFiscalPeriodNotFound,
+		},
+		{
+			name:        "Should return an error if the period is already closed",
+			current:     closed,
+			retained:    retained,
+			expectedErr: app.ErrFiscalPeriodAlreadyClosed,
+		},
+		{
+			name:        "Should return an error if there is no retained earnings account",
+			current:     open,
+			expectedErr: app.ErrInvalidRetainedEarningsAccount,
+		},
+		{
+			name:        "Should return an error if the closing transaction is rejected",
+			current:     open,
+			retained:    retained,
+			closeErr:    app.ErrUnknownEvent,
+			expectedErr: app.ErrUnknownEvent,
+		},
+		{
+			name:        "Should return an error if the period can't be updated",
+			current:     open,
+			retained:    retained,
+			closeErr:    dbErr,
+			expectedErr: dbErr,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.RepositoryMock{
+				GetFiscalPeriodFunc: func(ctx context.Context, id uuid.UUID) (entities.FiscalPeriod, error) {
+					return tt.current, tt.getErr
+				},
+				CloseFiscalPeriodFunc: func(ctx context.Context, closing entities.FiscalPeriodClosing) (entities.FiscalPeriodClosing, error) {
+					assert.True(t, closing.Period.Closed())
+					assert.Equal(t, uint32(7), closing.Event)
+					assert.Equal(t, retained, closing.RetainedEarnings)
+					assert.Equal(t, "accountant", closing.Change.RequestedBy)
+					assert.NotEqual(t, uuid.Nil, closing.TransactionID)
+
+					return closing, tt.closeErr
+				},
+			}
+
+			usecase := NewLedgerUseCase(
+				repo,
+				instrumentators.NewLedgerInstrumentator(&newrelic.Application{}),
+				WithRetainedEarningsAccount(tt.retained),
+			)
+
+			got, err := usecase.CloseFiscalPeriod(context.Background(), domain.CloseFiscalPeriodInput{
+				ID:          open.ID,
+				Event:       7,
+				RequestedBy: "accountant",
+			})
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr == nil {
+				assert.Equal(t, vos.ClosedFiscalPeriodStatus, got.Period.Status)
+				assert.Len(t, repo.CloseFiscalPeriodCalls(), 1)
+			} else if tt.closeErr == nil {
+				assert.Empty(t, repo.CloseFiscalPeriodCalls())
+			}
+		})
+	}
+}
+
+func TestLedgerUseCase_ReopenFiscalPeriod(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	open, err := entities.NewFiscalPeriod(uuid.New(), "abc", start, start.AddDate(1, 0, 0))
+	require.NoError(t, err)
+
+	closed, _, err := open.Close("accountant", time.Now())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		current     entities.FiscalPeriod
+		reason      string
+		reopenErr   error
+		expectedErr error
+	}{
+		{
+			name:    "Should reopen a closed period",
+			current: closed,
+			reason:  "late entries",
+		},
+		{
+			name:        "Should return an error if there is no reason",
+			current:     closed,
+			expectedErr: app.ErrInvalidReopenReason,
+		},
+		{
+			name:        "Should return an error if the period is open",
+			current:     open,
+			reason:      "late entries",
+			expectedErr: app.ErrFiscalPeriodNotClosed,
+		},
+		{
+			name:        "Should return an error if the period was concurrently reopened",
+			current:     closed,
+			reason:      "late entries",
+			reopenErr:   app.ErrFiscalPeriodNotClosed,
+			expectedErr: app.ErrFiscalPeriodNotClosed,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.RepositoryMock{
+				GetFiscalPeriodFunc: func(ctx context.Context, id uuid.UUID) (entities.FiscalPeriod, error) {
+					return tt.current, nil
+				},
+				ReopenFiscalPeriodFunc: func(ctx context.Context, period entities.FiscalPeriod, change entities.FiscalPeriodChange) error {
+					assert.False(t, period.Closed())
+					assert.Equal(t, vos.ReopenFiscalPeriodAction, change.Action)
+					assert.Equal(t, "admin", change.RequestedBy)
+					assert.Equal(t, tt.reason, change.Reason)
+
+					return tt.reopenErr
+				},
+			}
+
+			usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+			got, err := usecase.ReopenFiscalPeriod(context.Background(), domain.ReopenFiscalPeriodInput{
+				ID:          closed.ID,
+				RequestedBy: "admin",
+				Reason:      tt.reason,
+			})
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr == nil {
+				assert.Equal(t, vos.OpenFiscalPeriodStatus, got.Status)
+			}
+		})
+	}
+}
@@ -10,7 +10,7 @@ import (
 )
 
 func (l *LedgerUseCase) GetAccountBalance(ctx context.Context, input domain.GetAccountBalanceInput) (vos.AccountBalance, error) {
-	if !input.StartDate.IsZero() || !input.EndDate.IsZero() {
+	if !input.StartDate.IsZero() || !input.EndDate.IsZero() || !input.Time.KnownAt.IsZero() {
 		return l.getBoundedAccountBalance(ctx, input)
 	}
 
@@ -18,7 +18,7 @@ func (l *LedgerUseCase) GetAccountBalance(ctx context.Context, input domain.GetA
 }
 
 func (l *LedgerUseCase) getBoundedAccountBalance(ctx context.Context, input domain.GetAccountBalanceInput) (vos.AccountBalance, error) {
-	balance, err := l.repository.GetBoundedAccountBalance(ctx, input.Account, input.StartDate, input.EndDate)
+	balance, err := l.repository.GetBoundedAccountBalance(ctx, input.Account, input.Currency, input.StartDate, input.EndDate, input.Time)
 	if err != nil {
 		return vos.AccountBalance{}, fmt.Errorf("get bounded account balance: %w", err)
 	}
@@ -34,9 +34,9 @@ func (l *LedgerUseCase) getRecentAccountBalance(ctx context.Context, input domai
 
 	switch input.Account.Type() {
 	case vos.Analytic:
-		accountBalance, err = l.repository.GetAnalyticAccountBalance(ctx, input.Account)
+		accountBalance, err = l.repository.GetAnalyticAccountBalance(ctx, input.Account, input.Currency)
 	case vos.Synthetic:
-		accountBalance, err = l.repository.GetSyntheticAccountBalance(ctx, input.Account)
+		accountBalance, err = l.repository.GetSyntheticAccountBalance(ctx, input.Account, input.Currency)
 	default:
 		err = app.ErrInvalidAccountType
 	}
@@ -47,3 +47,12 @@ func (l *LedgerUseCase) getRecentAccountBalance(ctx context.Context, input domai
 
 	return accountBalance, nil
 }
+
+func (l *LedgerUseCase) GetBalanceHistory(ctx context.Context, req vos.BalanceHistoryRequest) (vos.BalanceHistory, error) {
+	history, err := l.repository.GetBalanceHistory(ctx, req)
+	if err != nil {
+		return vos.BalanceHistory{}, fmt.Errorf("get balance history: %w", err)
+	}
+
+	return history, nil
+}
@@ -21,20 +21,22 @@ func TestLedgerUseCase_GetAccountBalance_Analytic(t *testing.T) {
 		accountPath, err := vos.NewAnalyticAccount(testdata.GenerateAccountPath())
 		assert.NoError(t, err)
 
-		accountBalance := vos.NewAnalyticAccountBalance(accountPath, vos.Version(1), 150)
+		accountBalance := vos.NewAnalyticAccountBalance(accountPath, vos.Currency("USD"), vos.Version(1), 150, 0)
 		mockedRepository := &mocks.RepositoryMock{
-			GetAnalyticAccountBalanceFunc: func(ctx context.Context, account vos.Account) (vos.AccountBalance, error) {
+			GetAnalyticAccountBalanceFunc: func(ctx context.Context, account vos.Account, currency vos.Currency) (vos.AccountBalance, error) {
+				assert.Equal(t, vos.Currency("USD"), currency)
 				return accountBalance, nil
 			},
 		}
 		usecase := NewLedgerUseCase(mockedRepository, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
 
-		input := domain.GetAccountBalanceInput{Account: accountPath}
+		input := domain.GetAccountBalanceInput{Account: accountPath, Currency: vos.Currency("USD")}
 
 		got, err := usecase.GetAccountBalance(context.Background(), input)
 		assert.NoError(t, err)
 
 		assert.Equal(t, accountBalance.Account, got.Account)
+		assert.Equal(t, accountBalance.Currency, got.Currency)
 		assert.Equal(t, accountBalance.CurrentVersion, got.CurrentVersion)
 		assert.Equal(t, accountBalance.Balance, got.Balance)
 	})
@@ -44,7 +46,7 @@ func TestLedgerUseCase_GetAccountBalance_Analytic(t *testing.T) {
 		assert.NoError(t, err)
 
 		mockedRepository := &mocks.RepositoryMock{
-			GetAnalyticAccountBalanceFunc: func(ctx context.Context, account vos.Account) (vos.AccountBalance, error) {
+			GetAnalyticAccountBalanceFunc: func(ctx context.Context, account vos.Account, currency vos.Currency) (vos.AccountBalance, error) {
 				return vos.AccountBalance{}, app.ErrAccountNotFound
 			},
 		}
@@ -63,9 +65,9 @@ func TestLedgerUseCase_GetAccountBalance_Synthetic(t *testing.T) {
 		account, err := vos.NewAccount("liability.stone.clients.*")
 		assert.NoError(t, err)
 
-		queryBalance := vos.NewSyntheticAccountBalance(account, 20)
+		queryBalance := vos.NewSyntheticAccountBalance(account, vos.DefaultCurrency, 20, 0)
 		mockedRepository := &mocks.RepositoryMock{
-			GetSyntheticAccountBalanceFunc: func(ctx context.Context, account vos.Account) (vos.AccountBalance, error) {
+			GetSyntheticAccountBalanceFunc: func(ctx context.Context, account vos.Account, currency vos.Currency) (vos.AccountBalance, error) {
 				return queryBalance, nil
 			},
 		}
@@ -85,7 +87,7 @@ func TestLedgerUseCase_GetAccountBalance_Synthetic(t *testing.T) {
 		assert.NoError(t, err)
 
 		mockedRepository := &mocks.RepositoryMock{
-			GetSyntheticAccountBalanceFunc: func(ctx context.Context, account vos.Account) (vos.AccountBalance, error) {
+			GetSyntheticAccountBalanceFunc: func(ctx context.Context, account vos.Account, currency vos.Currency) (vos.AccountBalance, error) {
 				return vos.AccountBalance{}, app.ErrAccountNotFound
 			},
 		}
@@ -106,9 +108,9 @@ func TestLedgerUseCase_GetAccountBalance_Bounded(t *testing.T) {
 		account, err := vos.NewAccount("liability.stone.clients.*")
 		assert.NoError(t, err)
 
-		queryBalance := vos.NewSyntheticAccountBalance(account, 20)
+		queryBalance := vos.NewSyntheticAccountBalance(account, vos.DefaultCurrency, 20, 0)
 		mockedRepository := &mocks.RepositoryMock{
-			GetBoundedAccountBalanceFunc: func(_ context.Context, _ vos.Account, _, _ time.Time) (vos.AccountBalance, error) {
+			GetBoundedAccountBalanceFunc: func(_ context.Context, _ vos.Account, _ vos.Currency, _, _ time.Time, _ vos.TimeView) (vos.AccountBalance, error) {
 				return queryBalance, nil
 			},
 		}
@@ -126,4 +128,31 @@ func TestLedgerUseCase_GetAccountBalance_Bounded(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, queryBalance.Balance, got.Balance)
 	})
+	t.Run("should return the balance as known at an instant without dates", func(t *testing.T) {
+		account, err := vos.NewAccount("liability.stone.clients.*")
+		assert.NoError(t, err)
+
+		knownAt := time.Now().Add(-time.Hour)
+
+		mockedRepository := &mocks.RepositoryMock{
+			GetBoundedAccountBalanceFunc: func(_ context.Context, _ vos.Account, _ vos.Currency, start, end time.Time, view vos.TimeView) (vos.AccountBalance, error) {
+				assert.True(t, start.IsZero())
+				assert.True(t, end.IsZero())
+				assert.Equal(t, knownAt, view.KnownAt)
+
+				return vos.NewSyntheticAccountBalance(account, vos.DefaultCurrency, 20, 0), nil
+			},
+		}
+
+		nr, _ := newrelic.NewApplication()
+		usecase := NewLedgerUseCase(mockedRepository, instrumentators.NewLedgerInstrumentator(nr))
+
+		got, err := usecase.GetAccountBalance(context.Background(), domain.GetAccountBalanceInput{
+			Account: account,
+			Time:    vos.TimeView{KnownAt: knownAt},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 20, got.Balance)
+		assert.Len(t, mockedRepository.GetBoundedAccountBalanceCalls(), 1)
+	})
 }
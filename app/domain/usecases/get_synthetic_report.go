@@ -4,19 +4,18 @@ import (
 	"context"
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
 )
 
-func (l *LedgerUseCase) GetSyntheticReport(ctx context.Context, query vos.Account, level int, startTime time.Time, endTime time.Time) (*vos.SyntheticReport, error) {
+func (l *LedgerUseCase) GetSyntheticReport(ctx context.Context, req vos.SyntheticReportRequest) (*vos.SyntheticReport, error) {
 	defer l.instrumentator.MonitorSegment(ctx).End()
 
-	if level < 1 {
-		level = len(strings.Split(query.Value(), "."))
+	if req.Level < 1 {
+		req.Level = len(strings.Split(req.Account.Value(), "."))
 	}
 
-	syntheticReport, err := l.repository.GetSyntheticReport(ctx, query, level, startTime, endTime)
+	syntheticReport, err := l.repository.GetSyntheticReport(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get synthetic report: %w", err)
 	}
@@ -18,9 +18,6 @@ func TestLedgerUseCase_GetSyntheticReport(t *testing.T) {
 		query, err := vos.NewAccount("liability.credit_card.invoice.*")
 		assert.NoError(t, err)
 
-		totalCredit := int64(2000)
-		totalDebit := int64(1000)
-
 		accountPath, err := vos.NewAnalyticAccount("liability.credit_card.invoice")
 		assert.NoError(t, err)
 
@@ -33,21 +30,20 @@ func TestLedgerUseCase_GetSyntheticReport(t *testing.T) {
 		level := 3
 		date := time.Now()
 
-		fakeSyntheticReport, err := vos.NewSyntheticReport(totalCredit, totalDebit, paths)
+		fakeSyntheticReport, err := vos.NewSyntheticReport(paths)
 		assert.NoError(t, err)
 
 		mockedRepository := mocks.RepositoryMock{
-			GetSyntheticReportFunc: func(ctx context.Context, account vos.Account, level int, startTime, endTime time.Time) (*vos.SyntheticReport, error) {
+			GetSyntheticReportFunc: func(ctx context.Context, req vos.SyntheticReportRequest) (*vos.SyntheticReport, error) {
 				return fakeSyntheticReport, nil
 			},
 		}
 
 		useCase := NewLedgerUseCase(&mockedRepository, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
 
-		got, err := useCase.GetSyntheticReport(context.Background(), query, level, date, date)
+		got, err := useCase.GetSyntheticReport(context.Background(), vos.SyntheticReportRequest{Account: query, Level: level, StartDate: date, EndDate: date})
 		assert.NoError(t, err)
-		assert.Equal(t, fakeSyntheticReport.TotalCredit, got.TotalCredit)
-		assert.Equal(t, fakeSyntheticReport.TotalDebit, got.TotalDebit)
+		assert.Equal(t, fakeSyntheticReport.Totals, got.Totals)
 		assert.Equal(t, len(fakeSyntheticReport.Results), len(got.Results))
 	})
 }
@@ -0,0 +1,19 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+)
+
+func (l *LedgerUseCase) GetTransaction(ctx context.Context, id uuid.UUID) (entities.Transaction, error) {
+	tx, err := l.repository.GetTransaction(ctx, id)
+	if err != nil {
+		return entities.Transaction{}, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,71 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+)
+
+func TestLedgerUseCase_GetTransaction(t *testing.T) {
+	metadata := json.RawMessage(`{}`)
+
+	e1, err := entities.NewEntry(uuid.New(), vos.DebitOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100, "BRL", metadata)
+	require.NoError(t, err)
+
+	e2, err := entities.NewEntry(uuid.New(), vos.CreditOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100, "BRL", metadata)
+	require.NoError(t, err)
+
+	tx, err := entities.NewTransaction(uuid.New(), 1, "abc", time.Now(), e1, e2)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		repoSetup   *mocks.RepositoryMock
+		expected    entities.Transaction
+		expectedErr error
+	}{
+		{
+			name: "Should get a transaction successfully",
+			repoSetup: &mocks.RepositoryMock{
+				GetTransactionFunc: func(ctx context.Context, id uuid.UUID) (entities.Transaction, error) {
+					return tx, nil
+				},
+			},
+			expected:    tx,
+			expectedErr: nil,
+		},
+		{
+			name: "Should return an error if transaction does not exist",
+			repoSetup: &mocks.RepositoryMock{
+				GetTransactionFunc: func(ctx context.Context, id uuid.UUID) (entities.Transaction, error) {
+					return entities.Transaction{}, app.ErrTransactionNotFound
+				},
+			},
+			expected:    entities.Transaction{},
+			expectedErr: app.ErrTransactionNotFound,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase := NewLedgerUseCase(tt.repoSetup, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+			got, err := usecase.GetTransaction(context.Background(), tx.ID)
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
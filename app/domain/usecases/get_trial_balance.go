@@ -0,0 +1,19 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func (l *LedgerUseCase) GetTrialBalance(ctx context.Context, req vos.TrialBalanceRequest) (vos.TrialBalance, error) {
+	defer l.instrumentator.MonitorSegment(ctx).End()
+
+	trialBalance, err := l.repository.GetTrialBalance(ctx, req)
+	if err != nil {
+		return vos.TrialBalance{}, fmt.Errorf("failed to get trial balance: %w", err)
+	}
+
+	return trialBalance, nil
+}
@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+)
+
+func TestLedgerUseCase_GetTrialBalance(t *testing.T) {
+	req := vos.TrialBalanceRequest{Company: "abc", AsOf: time.Now(), Level: 2}
+
+	t.Run("should get the trial balance", func(t *testing.T) {
+		account, err := vos.NewAccount("asset.bacen.*")
+		assert.NoError(t, err)
+
+		trialBalance := vos.NewTrialBalance([]vos.AccountResult{{Account: account, Currency: vos.DefaultCurrency, Debit: 100}})
+
+		repository := &mocks.RepositoryMock{
+			GetTrialBalanceFunc: func(ctx context.Context, got vos.TrialBalanceRequest) (vos.TrialBalance, error) {
+				assert.Equal(t, req, got)
+				return trialBalance, nil
+			},
+		}
+
+		useCase := NewLedgerUseCase(repository, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+		got, err := useCase.GetTrialBalance(context.Background(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, trialBalance, got)
+	})
+
+	t.Run("should return an error if the repository fails", func(t *testing.T) {
+		repoErr := errors.New("some error")
+
+		repository := &mocks.RepositoryMock{
+			GetTrialBalanceFunc: func(ctx context.Context, got vos.TrialBalanceRequest) (vos.TrialBalance, error) {
+				return vos.TrialBalance{}, repoErr
+			},
+		}
+
+		useCase := NewLedgerUseCase(repository, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+		_, err := useCase.GetTrialBalance(context.Background(), req)
+		assert.ErrorIs(t, err, repoErr)
+	})
+}
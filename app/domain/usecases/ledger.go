@@ -3,6 +3,7 @@ package usecases
 import (
 	"github.com/stone-co/the-amazing-ledger/app/domain"
 	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
 )
 
 var _ domain.UseCase = &LedgerUseCase{}
@@ -10,11 +11,42 @@ var _ domain.UseCase = &LedgerUseCase{}
 type LedgerUseCase struct {
 	instrumentator *instrumentators.LedgerInstrumentator
 	repository     domain.Repository
+
+	// retainedEarnings receives the income statement balances when fiscal periods are closed.
+	retainedEarnings vos.Account
+
+	// chart rejects entries into accounts that don't match any of its patterns, unless it's empty.
+	chart vos.ChartOfAccounts
+}
+
+// Option configures optional LedgerUseCase behaviour.
+type Option func(*LedgerUseCase)
+
+// WithRetainedEarningsAccount sets the account that receives the income statement balances when
+// fiscal periods are closed. Periods can't be closed without it.
+func WithRetainedEarningsAccount(account vos.Account) Option {
+	return func(l *LedgerUseCase) {
+		l.retainedEarnings = account
+	}
 }
 
-func NewLedgerUseCase(repository domain.Repository, instrumentator *instrumentators.LedgerInstrumentator) *LedgerUseCase {
-	return &LedgerUseCase{
+// WithChartOfAccounts makes the use case reject entries into accounts
+// that don't match any pattern of the chart of accounts.
+func WithChartOfAccounts(chart vos.ChartOfAccounts) Option {
+	return func(l *LedgerUseCase) {
+		l.chart = chart
+	}
+}
+
+func NewLedgerUseCase(repository domain.Repository, instrumentator *instrumentators.LedgerInstrumentator, opts ...Option) *LedgerUseCase {
+	l := &LedgerUseCase{
 		repository:     repository,
 		instrumentator: instrumentator,
 	}
+
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	return l
 }
@@ -0,0 +1,32 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func (l *LedgerUseCase) ListAccounts(ctx context.Context, req vos.AccountListRequest) (vos.AccountList, error) {
+	accounts, nextPage, err := l.repository.ListAccounts(ctx, req)
+	if err != nil {
+		return vos.AccountList{}, fmt.Errorf("failed to list accounts: %w", err)
+	}
+
+	return vos.AccountList{
+		Accounts: accounts,
+		NextPage: nextPage,
+	}, nil
+}
+
+func (l *LedgerUseCase) ListAccountChildren(ctx context.Context, req vos.AccountChildrenRequest) (vos.AccountChildren, error) {
+	children, nextPage, err := l.repository.ListAccountChildren(ctx, req)
+	if err != nil {
+		return vos.AccountChildren{}, fmt.Errorf("failed to list account children: %w", err)
+	}
+
+	return vos.AccountChildren{
+		Children: children,
+		NextPage: nextPage,
+	}, nil
+}
@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/pagination"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+)
+
+func TestLedgerUseCase_ListAccounts(t *testing.T) {
+	t.Run("should list accounts with the next page", func(t *testing.T) {
+		account, err := vos.NewAnalyticAccount(testdata.GenerateAccountPath())
+		assert.NoError(t, err)
+
+		query, err := vos.NewAccount("liability.clients.*")
+		assert.NoError(t, err)
+
+		cursor := pagination.Cursor(`{"account":"liability.clients.available"}`)
+
+		mockedRepository := &mocks.RepositoryMock{
+			ListAccountsFunc: func(ctx context.Context, req vos.AccountListRequest) ([]vos.AccountSummary, pagination.Cursor, error) {
+				assert.Equal(t, query, req.Account)
+				assert.True(t, req.WithBalance)
+
+				return []vos.AccountSummary{
+					{
+						Account:        account,
+						CurrentVersion: 3,
+						FirstActivity:  time.Now(),
+						LastActivity:   time.Now(),
+					},
+				}, cursor, nil
+			},
+		}
+		usecase := NewLedgerUseCase(mockedRepository, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+		got, err := usecase.ListAccounts(context.Background(), vos.AccountListRequest{Account: query, WithBalance: true})
+		assert.NoError(t, err)
+		assert.Len(t, got.Accounts, 1)
+		assert.Equal(t, cursor, got.NextPage)
+	})
+
+	t.Run("should return an error if the repository fails", func(t *testing.T) {
+		mockedRepository := &mocks.RepositoryMock{
+			ListAccountsFunc: func(ctx context.Context, req vos.AccountListRequest) ([]vos.AccountSummary, pagination.Cursor, error) {
+				return nil, nil, errors.New("some error")
+			},
+		}
+		usecase := NewLedgerUseCase(mockedRepository, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+		got, err := usecase.ListAccounts(context.Background(), vos.AccountListRequest{})
+		assert.Error(t, err)
+		assert.Empty(t, got.Accounts)
+	})
+}
+
+func TestLedgerUseCase_ListAccountChildren(t *testing.T) {
+	path, err := vos.NewAccountPath("liability.clients.*")
+	assert.NoError(t, err)
+
+	mockedRepository := &mocks.RepositoryMock{
+		ListAccountChildrenFunc: func(ctx context.Context, req vos.AccountChildrenRequest) ([]vos.AccountChild, pagination.Cursor, error) {
+			assert.Equal(t, path, req.Path)
+
+			return []vos.AccountChild{
+				{Label: "available", Path: "liability.clients.available", Accounts: 2},
+				{Label: "blocked", Path: "liability.clients.blocked", Accounts: 1},
+			}, nil, nil
+		},
+	}
+	usecase := NewLedgerUseCase(mockedRepository, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+	got, err := usecase.ListAccountChildren(context.Background(), vos.AccountChildrenRequest{Path: path})
+	assert.NoError(t, err)
+	assert.Len(t, got.Children, 2)
+	assert.Nil(t, got.NextPage)
+}
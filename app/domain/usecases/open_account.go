@@ -0,0 +1,17 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+)
+
+func (l *LedgerUseCase) OpenAccount(ctx context.Context, account entities.Account) (entities.Account, error) {
+	opened, err := l.repository.OpenAccount(ctx, account)
+	if err != nil {
+		return entities.Account{}, fmt.Errorf("failed to open account: %w", err)
+	}
+
+	return opened, nil
+}
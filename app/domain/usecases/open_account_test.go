@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+)
+
+func TestLedgerUseCase_OpenAccount(t *testing.T) {
+	account, err := entities.NewAccount(testdata.GenerateAccountPath(), "owner", "Display Name", time.Now())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		repoSetup   *mocks.RepositoryMock
+		expected    entities.Account
+		expectedErr error
+	}{
+		{
+			name: "Should open an account successfully",
+			repoSetup: &mocks.RepositoryMock{
+				OpenAccountFunc: func(ctx context.Context, acc entities.Account) (entities.Account, error) {
+					return acc, nil
+				},
+			},
+			expected:    account,
+			expectedErr: nil,
+		},
+		{
+			name: "Should return an error if account was already opened",
+			repoSetup: &mocks.RepositoryMock{
+				OpenAccountFunc: func(ctx context.Context, acc entities.Account) (entities.Account, error) {
+					return entities.Account{}, app.ErrAccountAlreadyOpened
+				},
+			},
+			expected:    entities.Account{},
+			expectedErr: app.ErrAccountAlreadyOpened,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase := NewLedgerUseCase(tt.repoSetup, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+			got, err := usecase.OpenAccount(context.Background(), account)
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
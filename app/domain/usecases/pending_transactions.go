@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+)
+
+func (l *LedgerUseCase) AuthorizeTransaction(ctx context.Context, transaction entities.Transaction) (entities.PendingTransaction, error) {
+	if err := l.checkChartOfAccounts(transaction.Entries); err != nil {
+		return entities.PendingTransaction{}, err
+	}
+
+	pending, err := l.repository.AuthorizeTransaction(ctx, entities.NewPendingTransaction(transaction))
+	if err != nil {
+		return entities.PendingTransaction{}, fmt.Errorf("failed to authorize transaction: %w", err)
+	}
+
+	return pending, nil
+}
+
+func (l *LedgerUseCase) CaptureTransaction(ctx context.Context, input domain.CaptureTransactionInput) error {
+	pending, err := l.repository.GetPendingTransaction(ctx, input.PendingTransactionID)
+	if err != nil {
+		return fmt.Errorf("failed to get pending transaction: %w", err)
+	}
+
+	capture, err := pending.Capture(input.ID, input.Amount, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to create capture: %w", err)
+	}
+
+	if err = l.repository.CaptureTransaction(ctx, capture); err != nil {
+		return fmt.Errorf("failed to capture transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (l *LedgerUseCase) VoidTransaction(ctx context.Context, id uuid.UUID) error {
+	pending, err := l.repository.GetPendingTransaction(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get pending transaction: %w", err)
+	}
+
+	voided, err := pending.Void(time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to void transaction: %w", err)
+	}
+
+	if err = l.repository.VoidTransaction(ctx, voided); err != nil {
+		return fmt.Errorf("failed to void transaction: %w", err)
+	}
+
+	return nil
+}
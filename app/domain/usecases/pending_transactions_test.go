@@ -0,0 +1,161 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+)
+
+func newPendingTransaction(t *testing.T, expiresAt time.Time) entities.PendingTransaction {
+	t.Helper()
+
+	e1, err := entities.NewEntry(uuid.New(), vos.DebitOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100, "BRL", json.RawMessage(`{}`))
+	require.NoError(t, err)
+
+	e2, err := entities.NewEntry(uuid.New(), vos.CreditOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100, "BRL", json.RawMessage(`{}`))
+	require.NoError(t, err)
+
+	tx, err := entities.NewTransaction(uuid.New(), 1, "abc", time.Now(), e1, e2)
+	require.NoError(t, err)
+
+	pending := entities.NewPendingTransaction(tx)
+	pending.ExpiresAt = expiresAt
+
+	return pending
+}
+
+func TestLedgerUseCase_AuthorizeTransaction(t *testing.T) {
+	pending := newPendingTransaction(t, time.Time{})
+	expiresAt := time.Now().Add(time.Hour)
+
+	repo := &mocks.RepositoryMock{
+		AuthorizeTransactionFunc: func(ctx context.Context, p entities.PendingTransaction) (entities.PendingTransaction, error) {
+			p.ExpiresAt = expiresAt
+			return p, nil
+		},
+	}
+	usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+	got, err := usecase.AuthorizeTransaction(context.Background(), pending.Transaction)
+	assert.NoError(t, err)
+	assert.Equal(t, vos.PendingPendingStatus, got.Status)
+	assert.Equal(t, expiresAt, got.ExpiresAt)
+	assert.Equal(t, pending.Transaction, got.Transaction)
+}
+
+func TestLedgerUseCase_CaptureTransaction(t *testing.T) {
+	pending := newPendingTransaction(t, time.Now().Add(time.Hour))
+	expired := newPendingTransaction(t, time.Now().Add(-time.Second))
+
+	testCases := []struct {
+		name           string
+		repoSetup      *mocks.RepositoryMock
+		amount         int
+		expectedAmount int
+		expectedErr    error
+	}{
+		{
+			name: "Should capture a pending transaction partially",
+			repoSetup: &mocks.RepositoryMock{
+				GetPendingTransactionFunc: func(context.Context, uuid.UUID) (entities.PendingTransaction, error) {
+					return pending, nil
+				},
+				CaptureTransactionFunc: func(context.Context, entities.Capture) error {
+					return nil
+				},
+			},
+			amount:         40,
+			expectedAmount: 40,
+		},
+		{
+			name: "Should return an error if pending transaction does not exist",
+			repoSetup: &mocks.RepositoryMock{
+				GetPendingTransactionFunc: func(context.Context, uuid.UUID) (entities.PendingTransaction, error) {
+					return entities.PendingTransaction{}, app.ErrPendingTransactionNotFound
+				},
+			},
+			expectedErr: app.ErrPendingTransactionNotFound,
+		},
+		{
+			name: "Should return an error if pending transaction expired",
+			repoSetup: &mocks.RepositoryMock{
+				GetPendingTransactionFunc: func(context.Context, uuid.UUID) (entities.PendingTransaction, error) {
+					return expired, nil
+				},
+			},
+			expectedErr: app.ErrPendingTransactionExpired,
+		},
+		{
+			name: "Should return an error if pending transaction was concurrently settled",
+			repoSetup: &mocks.RepositoryMock{
+				GetPendingTransactionFunc: func(context.Context, uuid.UUID) (entities.PendingTransaction, error) {
+					return pending, nil
+				},
+				CaptureTransactionFunc: func(context.Context, entities.Capture) error {
+					return app.ErrPendingTransactionNotPending
+				},
+			},
+			expectedErr: app.ErrPendingTransactionNotPending,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase := NewLedgerUseCase(tt.repoSetup, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+			input := domain.CaptureTransactionInput{
+				ID:                   uuid.New(),
+				PendingTransactionID: pending.Transaction.ID,
+				Amount:               tt.amount,
+			}
+
+			err := usecase.CaptureTransaction(context.Background(), input)
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr != nil {
+				return
+			}
+
+			calls := tt.repoSetup.CaptureTransactionCalls()
+			require.Len(t, calls, 1)
+			assert.Equal(t, input.ID, calls[0].Capture.Transaction.ID)
+			assert.Equal(t, tt.expectedAmount, calls[0].Capture.Amount)
+			assert.Equal(t, 100, calls[0].Capture.Total)
+		})
+	}
+}
+
+func TestLedgerUseCase_VoidTransaction(t *testing.T) {
+	pending := newPendingTransaction(t, time.Now().Add(time.Hour))
+
+	repo := &mocks.RepositoryMock{
+		GetPendingTransactionFunc: func(context.Context, uuid.UUID) (entities.PendingTransaction, error) {
+			return pending, nil
+		},
+		VoidTransactionFunc: func(context.Context, entities.PendingTransaction) error {
+			return nil
+		},
+	}
+	usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+	err := usecase.VoidTransaction(context.Background(), pending.Transaction.ID)
+	assert.NoError(t, err)
+
+	calls := repo.VoidTransactionCalls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, vos.VoidedPendingStatus, calls[0].PendingTransaction.Status)
+}
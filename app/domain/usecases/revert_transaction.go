@@ -0,0 +1,58 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+)
+
+// RevertTransaction reverts the transaction, fully or partially. The id of the reversal is an idempotency key:
+// retrying a reversal succeeds without reverting the transaction again, while reusing the id for another
+// reversal fails with a TransactionConflictError describing the differences.
+func (l *LedgerUseCase) RevertTransaction(ctx context.Context, input domain.RevertTransactionInput) error {
+	original, err := l.repository.GetTransaction(ctx, input.TransactionID)
+	if err != nil {
+		return fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	reversal, err := entities.NewReversal(input.ID, original, input.Reason, input.Amount, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to create reversal: %w", err)
+	}
+
+	err = l.repository.RevertTransaction(ctx, reversal)
+	if errors.Is(err, app.ErrIdempotencyKeyViolation) {
+		err = l.replayReversal(ctx, reversal)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to revert transaction: %w", err)
+	}
+
+	return nil
+}
+
+// replayReversal compares the reversal with the stored one whose id it reuses.
+func (l *LedgerUseCase) replayReversal(ctx context.Context, reversal entities.Reversal) error {
+	stored, err := l.repository.GetReversal(ctx, reversal.Transaction.ID)
+	if errors.Is(err, app.ErrTransactionNotFound) {
+		return app.TransactionConflictError{
+			Differences: []string{"id already used by another transaction"},
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to get reversal: %w", err)
+	}
+
+	if diff := reversal.Diff(stored); len(diff) > 0 {
+		return app.TransactionConflictError{Differences: diff}
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+)
+
+func TestLedgerUseCase_RevertTransaction(t *testing.T) {
+	metadata := json.RawMessage(`{}`)
+
+	e1, err := entities.NewEntry(uuid.New(), vos.DebitOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100, "BRL", metadata)
+	require.NoError(t, err)
+
+	e2, err := entities.NewEntry(uuid.New(), vos.CreditOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100, "BRL", metadata)
+	require.NoError(t, err)
+
+	original, err := entities.NewTransaction(uuid.New(), 1, "abc", time.Now(), e1, e2)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		repoSetup   *mocks.RepositoryMock
+		amount      int
+		expectedErr error
+	}{
+		{
+			name: "Should revert a transaction successfully",
+			repoSetup: &mocks.RepositoryMock{
+				GetTransactionFunc: func(ctx context.Context, id uuid.UUID) (entities.Transaction, error) {
+					return original, nil
+				},
+				RevertTransactionFunc: func(ctx context.Context, reversal entities.Reversal) error {
+					return nil
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "Should return an error if transaction does not exist",
+			repoSetup: &mocks.RepositoryMock{
+				GetTransactionFunc: func(ctx context.Context, id uuid.UUID) (entities.Transaction, error) {
+					return entities.Transaction{}, app.ErrTransactionNotFound
+				},
+			},
+			expectedErr: app.ErrTransactionNotFound,
+		},
+		{
+			name: "Should return an error if amount is greater than the transaction total",
+			repoSetup: &mocks.RepositoryMock{
+				GetTransactionFunc: func(ctx context.Context, id uuid.UUID) (entities.Transaction, error) {
+					return original, nil
+				},
+			},
+			amount:      101,
+			expectedErr: app.ErrInvalidReversalAmount,
+		},
+		{
+			name: "Should return an error if transaction was already reverted",
+			repoSetup: &mocks.RepositoryMock{
+				GetTransactionFunc: func(ctx context.Context, id uuid.UUID) (entities.Transaction, error) {
+					return original, nil
+				},
+				RevertTransactionFunc: func(ctx context.Context, reversal entities.Reversal) error {
+					return app.ErrTransactionAlreadyReverted
+				},
+			},
+			expectedErr: app.ErrTransactionAlreadyReverted,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase := NewLedgerUseCase(tt.repoSetup, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+			input := domain.RevertTransactionInput{
+				ID:            uuid.New(),
+				TransactionID: original.ID,
+				Reason:        "wrong posting",
+				Amount:        tt.amount,
+			}
+
+			err := usecase.RevertTransaction(context.Background(), input)
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr == nil {
+				calls := tt.repoSetup.RevertTransactionCalls()
+				require.Len(t, calls, 1)
+				assert.Equal(t, original.ID, calls[0].Reversal.OriginalTransactionID)
+				assert.Equal(t, input.ID, calls[0].Reversal.Transaction.ID)
+				assert.True(t, calls[0].Reversal.IsFull())
+			}
+		})
+	}
+}
+
+func TestLedgerUseCase_RevertTransactionReplay(t *testing.T) {
+	e1, err := entities.NewEntry(uuid.New(), vos.DebitOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100, "BRL", nil)
+	require.NoError(t, err)
+
+	e2, err := entities.NewEntry(uuid.New(), vos.CreditOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100, "BRL", nil)
+	require.NoError(t, err)
+
+	original, err := entities.NewTransaction(uuid.New(), 1, "abc", time.Now(), e1, e2)
+	require.NoError(t, err)
+
+	id := uuid.New()
+
+	testCases := []struct {
+		name        string
+		stored      entities.Reversal
+		getErr      error
+		expectedErr error
+		differences []string
+	}{
+		{
+			name:   "Should succeed when the same reversal is retried",
+			stored: entities.Reversal{OriginalTransactionID: original.ID, Amount: 100, Total: 100},
+		},
+		{
+			name:        "Should return a conflict when the id was used for another amount",
+			stored:      entities.Reversal{OriginalTransactionID: original.ID, Amount: 40, Total: 100},
+			expectedErr: app.ErrTransactionConflict,
+			differences: []string{"amount: 100 != 40"},
+		},
+		{
+			name:        "Should return a conflict when the id was used by a transaction that isn't a reversal",
+			getErr:      app.ErrTransactionNotFound,
+			expectedErr: app.ErrTransactionConflict,
+			differences: []string{"id already used by another transaction"},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.RepositoryMock{
+				GetTransactionFunc: func(ctx context.Context, id uuid.UUID) (entities.Transaction, error) {
+					return original, nil
+				},
+				RevertTransactionFunc: func(ctx context.Context, reversal entities.Reversal) error {
+					return app.ErrIdempotencyKeyViolation
+				},
+				GetReversalFunc: func(ctx context.Context, id uuid.UUID) (entities.Reversal, error) {
+					return tt.stored, tt.getErr
+				},
+			}
+			usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+			err := usecase.RevertTransaction(context.Background(), domain.RevertTransactionInput{
+				ID:            id,
+				TransactionID: original.ID,
+				Reason:        "wrong posting",
+			})
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			var conflict app.TransactionConflictError
+			if tt.differences != nil {
+				require.ErrorAs(t, err, &conflict)
+				assert.Equal(t, tt.differences, conflict.Differences)
+			}
+
+			require.Len(t, repo.GetReversalCalls(), 1)
+			assert.Equal(t, id, repo.GetReversalCalls()[0].UUID)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func (l *LedgerUseCase) ScheduleTransaction(ctx context.Context, transaction entities.Transaction) (entities.ScheduledTransaction, error) {
+	if err := l.checkChartOfAccounts(transaction.Entries); err != nil {
+		return entities.ScheduledTransaction{}, err
+	}
+
+	scheduled, err := entities.NewScheduledTransaction(transaction, time.Now())
+	if err != nil {
+		return entities.ScheduledTransaction{}, fmt.Errorf("failed to create scheduled transaction: %w", err)
+	}
+
+	scheduled, err = l.repository.ScheduleTransaction(ctx, scheduled)
+	if err != nil {
+		return entities.ScheduledTransaction{}, fmt.Errorf("failed to schedule transaction: %w", err)
+	}
+
+	return scheduled, nil
+}
+
+func (l *LedgerUseCase) CancelScheduledTransaction(ctx context.Context, id uuid.UUID) error {
+	scheduled, err := l.repository.GetScheduledTransaction(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get scheduled transaction: %w", err)
+	}
+
+	canceled, err := scheduled.Cancel()
+	if err != nil {
+		return fmt.Errorf("failed to cancel scheduled transaction: %w", err)
+	}
+
+	// the status is checked again on update, so a transaction claimed meanwhile by the scheduler isn't canceled
+	if err = l.repository.UpdateScheduledTransactionStatus(ctx, canceled, scheduled.Status); err != nil {
+		return fmt.Errorf("failed to cancel scheduled transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (l *LedgerUseCase) ListScheduledTransactions(ctx context.Context, input domain.ListScheduledTransactionsInput) (domain.ListScheduledTransactionsOutput, error) {
+	transactions, cursor, err := l.repository.ListScheduledTransactions(ctx, input.Status, input.Page)
+	if err != nil {
+		return domain.ListScheduledTransactionsOutput{}, fmt.Errorf("failed to list scheduled transactions: %w", err)
+	}
+
+	return domain.ListScheduledTransactionsOutput{
+		Transactions: transactions,
+		NextPage:     cursor,
+	}, nil
+}
+
+func (l *LedgerUseCase) ClaimDueScheduledTransactions(ctx context.Context, limit int) ([]entities.ScheduledTransaction, error) {
+	transactions, err := l.repository.ClaimDueScheduledTransactions(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim due scheduled transactions: %w", err)
+	}
+
+	return transactions, nil
+}
+
+// PostScheduledTransaction posts a transaction claimed by the scheduler. The transaction id is the
+// idempotency key: if an earlier attempt already posted it, the replay succeeds and the transaction is
+// just marked as posted.
+// Transactions rejected by the ledger are marked as failed, while other errors are returned, and the
+// transaction is claimed again once its claim times out.
+func (l *LedgerUseCase) PostScheduledTransaction(ctx context.Context, scheduled entities.ScheduledTransaction) error {
+	var (
+		next entities.ScheduledTransaction
+		err  error
+	)
+
+	_, postErr := l.CreateTransaction(ctx, scheduled.Transaction)
+
+	switch {
+	case postErr == nil:
+		next, err = scheduled.Post()
+	case app.IsDomainError(postErr):
+		next, err = scheduled.Fail(postErr.Error())
+	default:
+		return fmt.Errorf("failed to post scheduled transaction: %w", postErr)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to post scheduled transaction: %w", err)
+	}
+
+	if err = l.repository.UpdateScheduledTransactionStatus(ctx, next, vos.ProcessingScheduleStatus); err != nil {
+		return fmt.Errorf("failed to update scheduled transaction: %w", err)
+	}
+
+	return nil
+}
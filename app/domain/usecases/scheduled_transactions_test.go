@@ -0,0 +1,239 @@
+package usecases
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/pagination"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+)
+
+func newScheduledTransaction(t *testing.T, competenceDate time.Time, status vos.ScheduleStatus) entities.ScheduledTransaction {
+	t.Helper()
+
+	e1, err := entities.NewEntry(uuid.New(), vos.DebitOperation, testdata.GenerateAccountPath(), vos.IgnoreAccountVersion, 100, "BRL", json.RawMessage(`{}`))
+	require.NoError(t, err)
+
+	e2, err := entities.NewEntry(uuid.New(), vos.CreditOperation, testdata.GenerateAccountPath(), vos.IgnoreAccountVersion, 100, "BRL", json.RawMessage(`{}`))
+	require.NoError(t, err)
+
+	tx, err := entities.NewTransaction(uuid.New(), 1, "abc", competenceDate, e1, e2)
+	require.NoError(t, err)
+
+	return entities.ScheduledTransaction{Transaction: tx, Status: status}
+}
+
+func TestLedgerUseCase_ScheduleTransaction(t *testing.T) {
+	testCases := []struct {
+		name        string
+		scheduled   entities.ScheduledTransaction
+		repoErr     error
+		expectedErr error
+	}{
+		{
+			name:      "Should schedule a transaction",
+			scheduled: newScheduledTransaction(t, time.Now().Add(time.Hour), vos.ScheduledScheduleStatus),
+		},
+		{
+			name:        "Should not schedule a transaction to the past",
+			scheduled:   newScheduledTransaction(t, time.Now().Add(-time.Hour), vos.ScheduledScheduleStatus),
+			expectedErr: app.ErrInvalidScheduleDate,
+		},
+		{
+			name:        "Should return repository errors",
+			scheduled:   newScheduledTransaction(t, time.Now().Add(time.Hour), vos.ScheduledScheduleStatus),
+			repoErr:     app.ErrIdempotencyKeyViolation,
+			expectedErr: app.ErrIdempotencyKeyViolation,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.RepositoryMock{
+				ScheduleTransactionFunc: func(ctx context.Context, s entities.ScheduledTransaction) (entities.ScheduledTransaction, error) {
+					return s, tt.repoErr
+				},
+			}
+			usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+			got, err := usecase.ScheduleTransaction(context.Background(), tt.scheduled.Transaction)
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr == nil {
+				assert.Equal(t, tt.scheduled, got)
+			}
+		})
+	}
+}
+
+func TestLedgerUseCase_CancelScheduledTransaction(t *testing.T) {
+	scheduled := newScheduledTransaction(t, time.Now().Add(time.Hour), vos.ScheduledScheduleStatus)
+	processing := newScheduledTransaction(t, time.Now().Add(-time.Hour), vos.ProcessingScheduleStatus)
+
+	testCases := []struct {
+		name        string
+		scheduled   entities.ScheduledTransaction
+		getErr      error
+		updateErr   error
+		expectedErr error
+	}{
+		{
+			name:      "Should cancel a scheduled transaction",
+			scheduled: scheduled,
+		},
+		{
+			name:        "Should not cancel an unknown transaction",
+			getErr:      app.ErrScheduledTransactionNotFound,
+			expectedErr: app.ErrScheduledTransactionNotFound,
+		},
+		{
+			name:        "Should not cancel a transaction being posted",
+			scheduled:   processing,
+			expectedErr: app.ErrScheduledTransactionNotScheduled,
+		},
+		{
+			name:        "Should not cancel a transaction claimed concurrently",
+			scheduled:   scheduled,
+			updateErr:   app.ErrScheduledTransactionNotScheduled,
+			expectedErr: app.ErrScheduledTransactionNotScheduled,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.RepositoryMock{
+				GetScheduledTransactionFunc: func(context.Context, uuid.UUID) (entities.ScheduledTransaction, error) {
+					return tt.scheduled, tt.getErr
+				},
+				UpdateScheduledTransactionStatusFunc: func(ctx context.Context, s entities.ScheduledTransaction, from vos.ScheduleStatus) error {
+					assert.Equal(t, vos.CanceledScheduleStatus, s.Status)
+					assert.Equal(t, vos.ScheduledScheduleStatus, from)
+					return tt.updateErr
+				},
+			}
+			usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+			err := usecase.CancelScheduledTransaction(context.Background(), tt.scheduled.Transaction.ID)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestLedgerUseCase_ListScheduledTransactions(t *testing.T) {
+	scheduled := newScheduledTransaction(t, time.Now().Add(time.Hour), vos.ScheduledScheduleStatus)
+	cursor := pagination.Cursor(`{"id":"abc"}`)
+	page := pagination.Page{Size: 10}
+
+	repo := &mocks.RepositoryMock{
+		ListScheduledTransactionsFunc: func(ctx context.Context, status vos.ScheduleStatus, p pagination.Page) ([]entities.ScheduledTransaction, pagination.Cursor, error) {
+			assert.Equal(t, vos.ScheduledScheduleStatus, status)
+			assert.Equal(t, page, p)
+			return []entities.ScheduledTransaction{scheduled}, cursor, nil
+		},
+	}
+	usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+	got, err := usecase.ListScheduledTransactions(context.Background(), domain.ListScheduledTransactionsInput{
+		Status: vos.ScheduledScheduleStatus,
+		Page:   page,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ListScheduledTransactionsOutput{
+		Transactions: []entities.ScheduledTransaction{scheduled},
+		NextPage:     cursor,
+	}, got)
+}
+
+func TestLedgerUseCase_PostScheduledTransaction(t *testing.T) {
+	scheduled := newScheduledTransaction(t, time.Now().Add(-time.Minute), vos.ProcessingScheduleStatus)
+	dbErr := errors.New("connection refused")
+
+	testCases := []struct {
+		name           string
+		createErr      error
+		getErr         error
+		expectedStatus vos.ScheduleStatus
+		expectedReason string
+		expectedErr    error
+	}{
+		{
+			name:           "Should post a due transaction",
+			expectedStatus: vos.PostedScheduleStatus,
+		},
+		{
+			name:           "Should mark as posted a transaction posted by an earlier attempt",
+			createErr:      app.ErrIdempotencyKeyViolation,
+			expectedStatus: vos.PostedScheduleStatus,
+		},
+		{
+			name:           "Should fail a transaction whose entries collide with other transactions",
+			createErr:      app.ErrIdempotencyKeyViolation,
+			getErr:         app.ErrTransactionNotFound,
+			expectedStatus: vos.FailedScheduleStatus,
+			expectedReason: "failed to create transaction: transaction conflicts with an existing one: entry ids already used by another transaction",
+		},
+		{
+			name:           "Should fail a transaction rejected by the ledger",
+			createErr:      app.BalanceLimitError{Account: "liability.abc", Currency: "BRL", Balance: -1},
+			expectedStatus: vos.FailedScheduleStatus,
+			expectedReason: "failed to create transaction: balance limit exceeded: account liability.abc would have a BRL balance of -1",
+		},
+		{
+			name:        "Should keep the transaction claimed on unexpected errors",
+			createErr:   dbErr,
+			expectedErr: dbErr,
+		},
+		{
+			name:        "Should keep the transaction claimed when the posting can't be checked",
+			createErr:   app.ErrIdempotencyKeyViolation,
+			getErr:      dbErr,
+			expectedErr: dbErr,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mocks.RepositoryMock{
+				CreateTransactionFunc: func(ctx context.Context, tx entities.Transaction) (entities.Transaction, error) {
+					assert.Equal(t, scheduled.Transaction, tx)
+					return tx, tt.createErr
+				},
+				GetTransactionFunc: func(ctx context.Context, id uuid.UUID) (entities.Transaction, error) {
+					return scheduled.Transaction, tt.getErr
+				},
+				UpdateScheduledTransactionStatusFunc: func(ctx context.Context, s entities.ScheduledTransaction, from vos.ScheduleStatus) error {
+					return nil
+				},
+			}
+			usecase := NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+
+			err := usecase.PostScheduledTransaction(context.Background(), scheduled)
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			calls := repo.UpdateScheduledTransactionStatusCalls()
+			if tt.expectedErr != nil {
+				assert.Empty(t, calls)
+				return
+			}
+
+			require.Len(t, calls, 1)
+			assert.Equal(t, tt.expectedStatus, calls[0].ScheduledTransaction.Status)
+			assert.Equal(t, tt.expectedReason, calls[0].ScheduledTransaction.Reason)
+			assert.Equal(t, vos.ProcessingScheduleStatus, calls[0].ScheduleStatus)
+		})
+	}
+}
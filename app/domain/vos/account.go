@@ -15,7 +15,8 @@ import (
 // When the account represents a group, it has a more flexible syntax, allowing a wildcard '*' in a label,
 // which follows the behavior described in the Postgres docs (https://www.postgresql.org/docs/current/ltree.html).
 //
-// The fist label if a given account is called 'class', and it can only be one of the following:
+// The fist label if a given account is called 'class', and it can only be one of the registered classes
+// (see RegisterAccountClasses), which by default are:
 //  - liability
 //  - asset
 //  - revenue
@@ -43,6 +44,33 @@ func (a Account) Type() AccountType {
 	return a.accountType
 }
 
+// Class returns the registered class of the account, which is always found for an analytic account.
+// A synthetic account starting with a wildcard has no class.
+func (a Account) Class() (AccountClass, bool) {
+	name := a.value
+	if i := strings.IndexByte(name, dot); i >= 0 {
+		name = name[:i]
+	}
+
+	return LookupAccountClass(name)
+}
+
+// RootLevel returns the number of leading labels of the account without a wildcard, which is the
+// level of the deepest account all the accounts it matches descend from.
+func (a Account) RootLevel() int {
+	level := 0
+
+	for _, label := range strings.Split(a.value, string(dot)) {
+		if strings.ContainsRune(label, star) {
+			break
+		}
+
+		level++
+	}
+
+	return level
+}
+
 // AccountType indicates what the given account represents, being either analytic or a synthetic.
 type AccountType uint8
 
@@ -51,17 +79,6 @@ const (
 	Synthetic
 )
 
-// Available classes
-const (
-	asset            = "asset"
-	conciliateCredit = "conciliate_credit"
-	conciliateDebit  = "conciliate_debit"
-	equity           = "equity"
-	expense          = "expense"
-	liability        = "liability"
-	revenue          = "revenue"
-)
-
 // Symbols
 const (
 	lowerLetterStart = 'a'
@@ -143,9 +160,7 @@ func newAccount(account string, analyticOnly bool) (Account, error) {
 	}
 
 	if st.totalComponents == 0 && !st.componentHasStar {
-		switch account[:st.componentSize] {
-		case asset, conciliateCredit, conciliateDebit, equity, expense, revenue, liability:
-		default:
+		if _, ok := LookupAccountClass(account[:st.componentSize]); !ok {
 			return Account{}, app.ErrAccountPathViolation
 		}
 	} else if st.totalComponents < 2 && st.strategy != Synthetic {
@@ -170,9 +185,7 @@ func treatDot(account string, st *state) error {
 
 	// Checks if the account has a valid class and if number of components is greater than maximum.
 	if st.totalComponents == 0 && !st.componentHasStar {
-		switch account[:st.componentSize] {
-		case asset, conciliateCredit, conciliateDebit, equity, expense, revenue, liability:
-		default:
+		if _, ok := LookupAccountClass(account[:st.componentSize]); !ok {
 			return app.ErrAccountPathViolation
 		}
 	} else if st.totalComponents >= maxComponents {
@@ -1,23 +1,61 @@
 package vos
 
+// AccountBalance holds the posted balance of an account. Available is the posted balance net of the
+// amounts held by pending transactions, and equals Balance when nothing is held.
+//
+// Balance is the credit minus the debit, unless it's turned to the natural orientation of the account class.
 type AccountBalance struct {
 	Account        Account
+	Currency       Currency
 	CurrentVersion Version
+	Credit         int
+	Debit          int
 	Balance        int
+	Available      int
 }
 
-func NewAnalyticAccountBalance(account Account, version Version, balance int) AccountBalance {
+func NewAnalyticAccountBalance(account Account, currency Currency, version Version, credit, debit int) AccountBalance {
 	return AccountBalance{
 		Account:        account,
+		Currency:       currency,
 		CurrentVersion: version,
-		Balance:        balance,
+		Credit:         credit,
+		Debit:          debit,
+		Balance:        credit - debit,
+		Available:      credit - debit,
 	}
 }
 
-func NewSyntheticAccountBalance(account Account, balance int) AccountBalance {
+func NewSyntheticAccountBalance(account Account, currency Currency, credit, debit int) AccountBalance {
 	return AccountBalance{
 		Account:        account,
+		Currency:       currency,
 		CurrentVersion: IgnoreAccountVersion,
-		Balance:        balance,
+		Credit:         credit,
+		Debit:          debit,
+		Balance:        credit - debit,
+		Available:      credit - debit,
 	}
 }
+
+// Natural returns the balance in the natural orientation of the account class, so the balances of
+// debit-natural classes (eg.: asset) are positive when the debit exceeds the credit.
+// Accounts without a class, like synthetic accounts starting with a wildcard, are kept as they are.
+func (b AccountBalance) Natural() AccountBalance {
+	sign := naturalSign(b.Account)
+
+	b.Balance *= sign
+	b.Available *= sign
+
+	return b
+}
+
+// naturalSign returns the natural sign of the account class, or 1 when the account has no class.
+func naturalSign(account Account) int {
+	class, ok := account.Class()
+	if !ok {
+		return 1
+	}
+
+	return class.NaturalSign()
+}
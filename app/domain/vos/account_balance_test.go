@@ -10,12 +10,16 @@ func TestNewAnalyticAccountBalance(t *testing.T) {
 	account, err := NewAnalyticAccount("liability.clients.available.user_1.block")
 	assert.NoError(t, err)
 
-	accountBalance := NewAnalyticAccountBalance(account, Version(3), 50)
+	accountBalance := NewAnalyticAccountBalance(account, Currency("USD"), Version(3), 80, 30)
 
 	assert.Equal(t, AccountBalance{
 		Account:        account,
+		Currency:       Currency("USD"),
 		CurrentVersion: Version(3),
+		Credit:         80,
+		Debit:          30,
 		Balance:        50,
+		Available:      50,
 	}, accountBalance)
 }
 
@@ -23,11 +27,40 @@ func TestNewSyntheticAccountBalance(t *testing.T) {
 	account, err := NewAccount("liability.clients.available.user_1.*")
 	assert.NoError(t, err)
 
-	accountBalance := NewSyntheticAccountBalance(account, 50)
+	accountBalance := NewSyntheticAccountBalance(account, DefaultCurrency, 50, 0)
 
 	assert.Equal(t, AccountBalance{
 		Account:        account,
+		Currency:       DefaultCurrency,
 		CurrentVersion: IgnoreAccountVersion,
+		Credit:         50,
+		Debit:          0,
 		Balance:        50,
+		Available:      50,
 	}, accountBalance)
 }
+
+func TestAccountBalance_Natural(t *testing.T) {
+	testCases := []struct {
+		name     string
+		account  string
+		expected int
+	}{
+		{name: "Credit-natural class keeps the sign", account: "liability.clients.available.user_1", expected: -70},
+		{name: "Debit-natural class flips the sign", account: "asset.bacen.reserves", expected: 70},
+		{name: "Account without class keeps the sign", account: "*.bacen.reserves", expected: -70},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			account, err := NewAccount(tt.account)
+			assert.NoError(t, err)
+
+			got := NewSyntheticAccountBalance(account, DefaultCurrency, 30, 100).Natural()
+			assert.Equal(t, tt.expected, got.Balance)
+			assert.Equal(t, tt.expected, got.Available)
+			assert.Equal(t, 30, got.Credit)
+			assert.Equal(t, 100, got.Debit)
+		})
+	}
+}
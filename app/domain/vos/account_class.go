@@ -0,0 +1,209 @@
+package vos
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+)
+
+// AccountClass is the first label of an account, telling what its accounts represent and on which side
+// they naturally grow: debit-natural classes (eg.: asset, expense) grow with debits, while
+// credit-natural classes (eg.: liability, revenue) grow with credits.
+//
+// A class may also be reported on a financial statement, like the balance sheet or the income statement.
+type AccountClass struct {
+	Name        string
+	NaturalSide OperationType
+	Statement   Statement
+}
+
+// Statement is the financial statement on which the accounts of a class are reported.
+type Statement int8
+
+const (
+	NoStatement Statement = iota
+	BalanceSheet
+	IncomeStatement
+)
+
+var _statements = []string{"no_statement", "balance_sheet", "income_statement"}
+
+func (s Statement) String() string {
+	return _statements[s]
+}
+
+func StatementFromString(statement string) (Statement, bool) {
+	for i, s := range _statements {
+		if s == strings.ToLower(statement) {
+			return Statement(i), true
+		}
+	}
+
+	return NoStatement, false
+}
+
+func NewAccountClass(name string, naturalSide OperationType, statement Statement) (AccountClass, error) {
+	name = strings.ToLower(name)
+	if !isLiteralLabel(name) {
+		return AccountClass{}, app.ErrInvalidAccountClass
+	}
+
+	if naturalSide != CreditOperation && naturalSide != DebitOperation {
+		return AccountClass{}, app.ErrInvalidAccountClass
+	}
+
+	if statement < NoStatement || statement > IncomeStatement {
+		return AccountClass{}, app.ErrInvalidAccountClass
+	}
+
+	return AccountClass{
+		Name:        name,
+		NaturalSide: naturalSide,
+		Statement:   statement,
+	}, nil
+}
+
+// ParseAccountClasses parses classes declared as '<name>:<natural side>[:<statement>]',
+// like 'memo:debit' or 'asset:debit:balance_sheet'.
+func ParseAccountClasses(classes ...string) ([]AccountClass, error) {
+	parsed := make([]AccountClass, 0, len(classes))
+	for _, class := range classes {
+		c, err := parseAccountClass(class)
+		if err != nil {
+			return nil, err
+		}
+
+		parsed = append(parsed, c)
+	}
+
+	return parsed, nil
+}
+
+func parseAccountClass(class string) (AccountClass, error) {
+	parts := strings.Split(class, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return AccountClass{}, app.ErrInvalidAccountClass
+	}
+
+	statement := NoStatement
+	if len(parts) == 3 {
+		var ok bool
+		if statement, ok = StatementFromString(parts[2]); !ok || statement == NoStatement {
+			return AccountClass{}, app.ErrInvalidAccountClass
+		}
+	}
+
+	return NewAccountClass(parts[0], OperationTypeFromString(parts[1]), statement)
+}
+
+// NaturalSign returns the sign that turns a balance (credits minus debits) of the class accounts
+// into a naturally positive value, being 1 for credit-natural classes and -1 for debit-natural ones.
+func (c AccountClass) NaturalSign() int {
+	if c.NaturalSide == DebitOperation {
+		return -1
+	}
+
+	return 1
+}
+
+func (c AccountClass) String() string {
+	if c.Statement == NoStatement {
+		return c.Name + ":" + c.NaturalSide.String()
+	}
+
+	return c.Name + ":" + c.NaturalSide.String() + ":" + c.Statement.String()
+}
+
+// DefaultAccountClasses are the classes registered when none are configured.
+var DefaultAccountClasses = []AccountClass{
+	{Name: "asset", NaturalSide: DebitOperation, Statement: BalanceSheet},
+	{Name: "conciliate_credit", NaturalSide: CreditOperation},
+	{Name: "conciliate_debit", NaturalSide: DebitOperation},
+	{Name: "equity", NaturalSide: CreditOperation, Statement: BalanceSheet},
+	{Name: "expense", NaturalSide: DebitOperation, Statement: IncomeStatement},
+	{Name: "liability", NaturalSide: CreditOperation, Statement: BalanceSheet},
+	{Name: "revenue", NaturalSide: CreditOperation, Statement: IncomeStatement},
+}
+
+// classRegistry holds the registered classes, in registration order and by name.
+type classRegistry struct {
+	classes []AccountClass
+	byName  map[string]AccountClass
+}
+
+var registeredClasses atomic.Value
+
+func init() {
+	registry, _ := newClassRegistry(DefaultAccountClasses)
+	registeredClasses.Store(registry)
+}
+
+func newClassRegistry(classes []AccountClass) (*classRegistry, error) {
+	registry := &classRegistry{
+		classes: make([]AccountClass, 0, len(classes)),
+		byName:  make(map[string]AccountClass, len(classes)),
+	}
+
+	for _, class := range classes {
+		class, err := NewAccountClass(class.Name, class.NaturalSide, class.Statement)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := registry.byName[class.Name]; ok {
+			return nil, app.ErrInvalidAccountClass
+		}
+
+		registry.classes = append(registry.classes, class)
+		registry.byName[class.Name] = class
+	}
+
+	return registry, nil
+}
+
+// RegisterAccountClasses replaces the registered classes, which are the only ones accounts, account
+// patterns and reports may use. It's meant to be called once, at startup, before any account is parsed.
+// Registering no classes restores the default ones.
+func RegisterAccountClasses(classes ...AccountClass) error {
+	if len(classes) == 0 {
+		classes = DefaultAccountClasses
+	}
+
+	registry, err := newClassRegistry(classes)
+	if err != nil {
+		return err
+	}
+
+	registeredClasses.Store(registry)
+
+	return nil
+}
+
+// AccountClasses returns the registered classes, in registration order.
+func AccountClasses() []AccountClass {
+	registry := registeredClasses.Load().(*classRegistry)
+
+	classes := make([]AccountClass, len(registry.classes))
+	copy(classes, registry.classes)
+
+	return classes
+}
+
+// LookupAccountClass returns the registered class with the given name.
+func LookupAccountClass(name string) (AccountClass, bool) {
+	class, ok := registeredClasses.Load().(*classRegistry).byName[name]
+	return class, ok
+}
+
+// StatementClasses returns the registered classes reported on the given financial statement.
+func StatementClasses(statement Statement) []AccountClass {
+	var classes []AccountClass
+	for _, class := range registeredClasses.Load().(*classRegistry).classes {
+		if class.Statement == statement {
+			classes = append(classes, class)
+		}
+	}
+
+	return classes
+}
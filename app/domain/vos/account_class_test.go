@@ -0,0 +1,105 @@
+package vos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+)
+
+func TestParseAccountClasses(t *testing.T) {
+	testCases := []struct {
+		name        string
+		classes     []string
+		expected    []AccountClass
+		expectedErr error
+	}{
+		{
+			name:    "Valid classes",
+			classes: []string{"memo:debit", "Off_Balance:CREDIT"},
+			expected: []AccountClass{
+				{Name: "memo", NaturalSide: DebitOperation},
+				{Name: "off_balance", NaturalSide: CreditOperation},
+			},
+		},
+		{
+			name:    "Valid classes with statements",
+			classes: []string{"asset:debit:balance_sheet", "revenue:credit:income_statement"},
+			expected: []AccountClass{
+				{Name: "asset", NaturalSide: DebitOperation, Statement: BalanceSheet},
+				{Name: "revenue", NaturalSide: CreditOperation, Statement: IncomeStatement},
+			},
+		},
+		{name: "Invalid class without natural side", classes: []string{"memo"}, expectedErr: app.ErrInvalidAccountClass},
+		{name: "Invalid class with unknown statement", classes: []string{"memo:debit:cash_flow"}, expectedErr: app.ErrInvalidAccountClass},
+		{name: "Invalid class with unknown natural side", classes: []string{"memo:both"}, expectedErr: app.ErrInvalidAccountClass},
+		{name: "Invalid class with invalid name", classes: []string{"memo.x:debit"}, expectedErr: app.ErrInvalidAccountClass},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAccountClasses(tt.classes...)
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestStatementClasses(t *testing.T) {
+	assert.Equal(t, []AccountClass{
+		{Name: "asset", NaturalSide: DebitOperation, Statement: BalanceSheet},
+		{Name: "equity", NaturalSide: CreditOperation, Statement: BalanceSheet},
+		{Name: "liability", NaturalSide: CreditOperation, Statement: BalanceSheet},
+	}, StatementClasses(BalanceSheet))
+	assert.Equal(t, []AccountClass{
+		{Name: "expense", NaturalSide: DebitOperation, Statement: IncomeStatement},
+		{Name: "revenue", NaturalSide: CreditOperation, Statement: IncomeStatement},
+	}, StatementClasses(IncomeStatement))
+}
+
+func TestAccountClass_NaturalSign(t *testing.T) {
+	assert.Equal(t, 1, AccountClass{Name: "liability", NaturalSide: CreditOperation}.NaturalSign())
+	assert.Equal(t, -1, AccountClass{Name: "asset", NaturalSide: DebitOperation}.NaturalSign())
+}
+
+func TestRegisterAccountClasses(t *testing.T) {
+	t.Cleanup(func() {
+		require.NoError(t, RegisterAccountClasses(DefaultAccountClasses...))
+	})
+
+	t.Run("should reject repeated classes", func(t *testing.T) {
+		err := RegisterAccountClasses(AccountClass{Name: "memo", NaturalSide: DebitOperation}, AccountClass{Name: "memo", NaturalSide: CreditOperation})
+		assert.ErrorIs(t, err, app.ErrInvalidAccountClass)
+	})
+
+	t.Run("should restore the default classes when none are registered", func(t *testing.T) {
+		require.NoError(t, RegisterAccountClasses(AccountClass{Name: "memo", NaturalSide: DebitOperation}))
+		require.NoError(t, RegisterAccountClasses())
+		assert.Equal(t, DefaultAccountClasses, AccountClasses())
+	})
+
+	t.Run("should parse accounts of the registered classes only", func(t *testing.T) {
+		_, err := NewAccount("memo.guarantees.contract_1")
+		assert.ErrorIs(t, err, app.ErrAccountPathViolation)
+
+		memo := AccountClass{Name: "memo", NaturalSide: DebitOperation}
+		require.NoError(t, RegisterAccountClasses(append(DefaultAccountClasses, memo)...))
+
+		account, err := NewAccount("memo.guarantees.contract_1")
+		assert.NoError(t, err)
+
+		class, ok := account.Class()
+		assert.True(t, ok)
+		assert.Equal(t, memo, class)
+
+		_, err = NewAccountPattern("memo.guarantees.<id>")
+		assert.NoError(t, err)
+
+		require.NoError(t, RegisterAccountClasses(memo))
+
+		_, err = NewAccount("liability.clients.available")
+		assert.ErrorIs(t, err, app.ErrAccountPathViolation)
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/stone-co/the-amazing-ledger/app"
 	"github.com/stone-co/the-amazing-ledger/app/pagination"
 	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
 )
@@ -15,24 +16,69 @@ type AccountEntryRequest struct {
 	EndDate   time.Time
 	Filter    AccountEntryFilter
 	Page      pagination.Page
+	// Time applies the dates to the competence date or the recorded time, while the entries are still
+	// ordered by competence date.
+	Time TimeView
 }
 
 type AccountEntryFilter struct {
 	Companies []string
 	Events    []int32
 	Operation OperationType
+	Metadata  MetadataFilter
 }
 
-func NewEntryFilter(filter *proto.ListAccountEntriesRequest_Filter) AccountEntryFilter {
+// MetadataFilter matches the entries whose metadata contain an object, have some top-level keys
+// and have some text values at some paths, all at once.
+type MetadataFilter struct {
+	Contains map[string]interface{}
+	Keys     []string
+	Equals   []MetadataPathValue
+}
+
+type MetadataPathValue struct {
+	Path  []string
+	Value string
+}
+
+func NewEntryFilter(filter *proto.ListAccountEntriesRequest_Filter) (AccountEntryFilter, error) {
 	if filter == nil {
-		return AccountEntryFilter{}
+		return AccountEntryFilter{}, nil
+	}
+
+	metadata, err := NewMetadataFilter(filter.Metadata)
+	if err != nil {
+		return AccountEntryFilter{}, err
 	}
 
 	return AccountEntryFilter{
 		Companies: filter.Companies,
 		Events:    filter.Events,
 		Operation: OperationType(proto.Operation_value[filter.Operation.String()]),
+		Metadata:  metadata,
+	}, nil
+}
+
+func NewMetadataFilter(filter *proto.MetadataFilter) (MetadataFilter, error) {
+	if filter == nil {
+		return MetadataFilter{}, nil
+	}
+
+	metadata := MetadataFilter{Keys: filter.HasKeys}
+
+	if len(filter.Contains.GetFields()) > 0 {
+		metadata.Contains = filter.Contains.AsMap()
 	}
+
+	for _, equals := range filter.Equals {
+		if len(equals.Path) == 0 {
+			return MetadataFilter{}, app.ErrInvalidMetadataFilter
+		}
+
+		metadata.Equals = append(metadata.Equals, MetadataPathValue{Path: equals.Path, Value: equals.Value})
+	}
+
+	return metadata, nil
 }
 
 type AccountEntryResponse struct {
@@ -46,6 +92,7 @@ type AccountEntry struct {
 	Version        Version
 	Operation      OperationType
 	Amount         int
+	Currency       Currency
 	Event          int
 	CreatedAt      time.Time
 	CompetenceDate time.Time
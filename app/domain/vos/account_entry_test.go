@@ -0,0 +1,77 @@
+package vos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func TestNewEntryFilter(t *testing.T) {
+	contains, err := structpb.NewStruct(map[string]interface{}{"merchant_id": "abc"})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		filter  *proto.ListAccountEntriesRequest_Filter
+		want    AccountEntryFilter
+		wantErr error
+	}{
+		{
+			name:   "nil filter",
+			filter: nil,
+			want:   AccountEntryFilter{},
+		},
+		{
+			name: "all filters",
+			filter: &proto.ListAccountEntriesRequest_Filter{
+				Companies: []string{"abc"},
+				Events:    []int32{1},
+				Operation: proto.Operation_OPERATION_DEBIT,
+				Metadata: &proto.MetadataFilter{
+					Contains: contains,
+					HasKeys:  []string{"order"},
+					Equals:   []*proto.MetadataFilter_PathValue{{Path: []string{"order", "id"}, Value: "123"}},
+				},
+			},
+			want: AccountEntryFilter{
+				Companies: []string{"abc"},
+				Events:    []int32{1},
+				Operation: DebitOperation,
+				Metadata: MetadataFilter{
+					Contains: map[string]interface{}{"merchant_id": "abc"},
+					Keys:     []string{"order"},
+					Equals:   []MetadataPathValue{{Path: []string{"order", "id"}, Value: "123"}},
+				},
+			},
+		},
+		{
+			name: "empty metadata object is ignored",
+			filter: &proto.ListAccountEntriesRequest_Filter{
+				Metadata: &proto.MetadataFilter{Contains: &structpb.Struct{}},
+			},
+			want: AccountEntryFilter{},
+		},
+		{
+			name: "metadata path without keys",
+			filter: &proto.ListAccountEntriesRequest_Filter{
+				Metadata: &proto.MetadataFilter{
+					Equals: []*proto.MetadataFilter_PathValue{{Value: "123"}},
+				},
+			},
+			want:    AccountEntryFilter{},
+			wantErr: app.ErrInvalidMetadataFilter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewEntryFilter(tt.filter)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
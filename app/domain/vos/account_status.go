@@ -0,0 +1,17 @@
+package vos
+
+// AccountStatus is the lifecycle state of an opened account. Only active accounts accept new entries.
+type AccountStatus int8
+
+const (
+	InvalidAccountStatus AccountStatus = iota
+	ActiveAccountStatus
+	FrozenAccountStatus
+	ClosedAccountStatus
+)
+
+var _accountStatuses = []string{"invalid_account_status", "active", "frozen", "closed"}
+
+func (s AccountStatus) String() string {
+	return _accountStatuses[s]
+}
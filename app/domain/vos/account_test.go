@@ -168,3 +168,24 @@ func TestNewAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestAccount_RootLevel(t *testing.T) {
+	tests := []struct {
+		account string
+		want    int
+	}{
+		{account: "liability.clients.available", want: 3},
+		{account: "liability.clients.*", want: 2},
+		{account: "liability.*.available", want: 1},
+		{account: "liability.cli*", want: 1},
+		{account: "*", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.account, func(t *testing.T) {
+			account, err := NewAccount(tt.account)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want, account.RootLevel())
+		})
+	}
+}
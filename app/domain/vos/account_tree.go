@@ -0,0 +1,97 @@
+package vos
+
+import (
+	"strings"
+	"time"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/pagination"
+)
+
+type AccountListRequest struct {
+	// Account is an analytic account or a synthetic one matching the accounts to list.
+	Account Account
+	// WithBalance also returns the current balance of each account, in every currency it was used.
+	WithBalance bool
+	Page        pagination.Page
+}
+
+// AccountSummary describes an analytic account known by the ledger, which is any account that
+// ever received an entry, registered or not.
+type AccountSummary struct {
+	Account Account
+	// CurrentVersion is IgnoreAccountVersion when every entry of the account was posted ignoring its version.
+	CurrentVersion Version
+	// FirstActivity and LastActivity are the earliest and latest competence dates of its entries.
+	FirstActivity time.Time
+	LastActivity  time.Time
+	Balances      []AccountBalance
+}
+
+type AccountList struct {
+	Accounts []AccountSummary
+	NextPage pagination.Cursor
+}
+
+type AccountChildrenRequest struct {
+	Path AccountPath
+	Page pagination.Page
+}
+
+// AccountChild is a label right below a path, grouping all the analytic accounts under it.
+type AccountChild struct {
+	Label string
+	Path  string
+	// Accounts is the number of analytic accounts under the child, including itself.
+	Accounts int
+	// Analytic tells whether the child is itself an analytic account.
+	Analytic bool
+}
+
+type AccountChildren struct {
+	Children []AccountChild
+	NextPage pagination.Cursor
+}
+
+// AccountPath is a path of the account tree, whose children are the labels right below it.
+// The root path is empty, and its children are the account classes.
+type AccountPath struct {
+	value string
+}
+
+// NewAccountPath takes the labels of a path, like 'liability.clients', optionally followed by a wildcard
+// label ('liability.clients.*'). Both empty and '*' are the root path.
+func NewAccountPath(path string) (AccountPath, error) {
+	if path == string(star) {
+		path = ""
+	}
+
+	path = strings.TrimSuffix(path, string(dot)+string(star))
+	if path == "" {
+		return AccountPath{}, nil
+	}
+
+	if strings.ContainsRune(path, star) {
+		return AccountPath{}, app.ErrInvalidAccountPath
+	}
+
+	account, err := NewAccount(path + string(dot) + string(star))
+	if err != nil {
+		return AccountPath{}, err
+	}
+
+	return AccountPath{value: strings.TrimSuffix(account.Value(), string(dot)+string(star))}, nil
+}
+
+func (p AccountPath) Value() string {
+	return p.value
+}
+
+// Level returns the number of labels of the path, which is zero for the root path.
+func (p AccountPath) Level() int {
+	if p.value == "" {
+		return 0
+	}
+
+	return strings.Count(p.value, string(dot)) + 1
+}
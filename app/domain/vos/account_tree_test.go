@@ -0,0 +1,45 @@
+package vos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+)
+
+func TestNewAccountPath(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string
+		want      string
+		wantLevel int
+		wantErr   error
+	}{
+		{name: "empty path is the root", path: "", want: "", wantLevel: 0},
+		{name: "wildcard is the root", path: "*", want: "", wantLevel: 0},
+		{name: "class", path: "liability", want: "liability", wantLevel: 1},
+		{name: "class with wildcard", path: "liability.*", want: "liability", wantLevel: 1},
+		{name: "synthetic path", path: "liability.Clients.*", want: "liability.clients", wantLevel: 2},
+		{name: "analytic account", path: "liability.clients.available", want: "liability.clients.available", wantLevel: 3},
+		{name: "wildcard in the middle", path: "liability.*.available", wantErr: app.ErrInvalidAccountPath},
+		{name: "partial wildcard label", path: "liability.cli*", wantErr: app.ErrInvalidAccountPath},
+		{name: "path without wildcard", path: "liability.clients", want: "liability.clients", wantLevel: 2},
+		{name: "empty label", path: "liability..clients", wantErr: app.ErrInvalidAccountComponentSize},
+		{name: "unknown class", path: "foo.*", wantErr: app.ErrAccountPathViolation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAccountPath(tt.path)
+			assert.ErrorIs(t, err, tt.wantErr)
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			assert.Equal(t, tt.want, got.Value())
+			assert.Equal(t, tt.wantLevel, got.Level())
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package vos
+
+import (
+	"time"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+)
+
+// MaxBalanceHistoryPoints bounds the number of points of a balance history, so a long range
+// of days doesn't turn into an unbounded response.
+const MaxBalanceHistoryPoints = 1000
+
+// Granularity is the length of the buckets of a balance history. Buckets follow the UTC calendar,
+// and weeks start on Mondays.
+type Granularity int8
+
+const (
+	InvalidGranularity Granularity = iota
+	DayGranularity
+	WeekGranularity
+	MonthGranularity
+)
+
+var _granularities = []string{"invalid_granularity", "day", "week", "month"}
+
+func (g Granularity) String() string {
+	return _granularities[g]
+}
+
+// Truncate returns the start of the bucket that holds t.
+func (g Granularity) Truncate(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+
+	switch g {
+	case WeekGranularity:
+		start := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		return start.AddDate(0, 0, -(int(start.Weekday())+6)%7)
+	case MonthGranularity:
+		return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// Next returns the start of the bucket that follows the one starting at t.
+func (g Granularity) Next(t time.Time) time.Time {
+	switch g {
+	case WeekGranularity:
+		return t.AddDate(0, 0, 7)
+	case MonthGranularity:
+		return t.AddDate(0, 1, 0)
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
+
+type BalanceHistoryRequest struct {
+	Account  Account
+	Currency Currency
+	// Start is the competence date of the first bucket, which starts at its truncation.
+	Start time.Time
+	// End bounds the competence dates of the last bucket, exclusive.
+	End         time.Time
+	Granularity Granularity
+	Time        TimeView
+}
+
+func NewBalanceHistoryRequest(account Account, currency Currency, start, end time.Time, granularity Granularity) (BalanceHistoryRequest, error) {
+	if granularity <= InvalidGranularity || granularity > MonthGranularity {
+		return BalanceHistoryRequest{}, app.ErrInvalidGranularity
+	}
+
+	if start.IsZero() || end.IsZero() || !end.After(start) {
+		return BalanceHistoryRequest{}, app.ErrInvalidBalanceHistoryDates
+	}
+
+	points := 0
+	for bucket := granularity.Truncate(start); bucket.Before(end); bucket = granularity.Next(bucket) {
+		if points++; points > MaxBalanceHistoryPoints {
+			return BalanceHistoryRequest{}, app.ErrBalanceHistoryTooLong
+		}
+	}
+
+	return BalanceHistoryRequest{
+		Account:     account,
+		Currency:    currency,
+		Start:       start,
+		End:         end,
+		Granularity: granularity,
+	}, nil
+}
+
+// BalancePoint is the balance of an account at the end of a bucket.
+type BalancePoint struct {
+	// Date is the start of the bucket.
+	Date time.Time
+	// Credit and Debit are the amounts posted within the bucket.
+	Credit int
+	Debit  int
+	// Balance is the running balance, the credit minus the debit of every entry up to the end of the bucket.
+	Balance int
+}
+
+// BalanceHistory has a point for every bucket of the requested range, including the ones without entries.
+type BalanceHistory struct {
+	Account  Account
+	Currency Currency
+	Points   []BalancePoint
+}
+
+// Natural returns the history with the balances in the natural orientation of the account class.
+func (h BalanceHistory) Natural() BalanceHistory {
+	sign := naturalSign(h.Account)
+
+	points := make([]BalancePoint, len(h.Points))
+	for i, point := range h.Points {
+		point.Balance *= sign
+		points[i] = point
+	}
+
+	h.Points = points
+
+	return h
+}
@@ -0,0 +1,104 @@
+package vos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+)
+
+func TestGranularity_Truncate(t *testing.T) {
+	// a Wednesday
+	date := time.Date(2021, 9, 15, 13, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2021, 9, 15, 0, 0, 0, 0, time.UTC), DayGranularity.Truncate(date))
+	assert.Equal(t, time.Date(2021, 9, 13, 0, 0, 0, 0, time.UTC), WeekGranularity.Truncate(date))
+	assert.Equal(t, time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC), MonthGranularity.Truncate(date))
+
+	sunday := time.Date(2021, 9, 19, 23, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2021, 9, 13, 0, 0, 0, 0, time.UTC), WeekGranularity.Truncate(sunday))
+}
+
+func TestNewBalanceHistoryRequest(t *testing.T) {
+	account, err := NewAccount("asset.bacen.reserves")
+	require.NoError(t, err)
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		start       time.Time
+		end         time.Time
+		granularity Granularity
+		expectedErr error
+	}{
+		{
+			name:        "Daily history",
+			start:       start,
+			end:         start.AddDate(0, 1, 0),
+			granularity: DayGranularity,
+		},
+		{
+			name:        "Monthly history of many years",
+			start:       start,
+			end:         start.AddDate(50, 0, 0),
+			granularity: MonthGranularity,
+		},
+		{
+			name:        "History without granularity",
+			start:       start,
+			end:         start.AddDate(0, 1, 0),
+			expectedErr: app.ErrInvalidGranularity,
+		},
+		{
+			name:        "History without start",
+			end:         start,
+			granularity: DayGranularity,
+			expectedErr: app.ErrInvalidBalanceHistoryDates,
+		},
+		{
+			name:        "History ending at its start",
+			start:       start,
+			end:         start,
+			granularity: DayGranularity,
+			expectedErr: app.ErrInvalidBalanceHistoryDates,
+		},
+		{
+			name:        "Daily history of many years",
+			start:       start,
+			end:         start.AddDate(3, 0, 0),
+			granularity: DayGranularity,
+			expectedErr: app.ErrBalanceHistoryTooLong,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewBalanceHistoryRequest(account, DefaultCurrency, tt.start, tt.end, tt.granularity)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestBalanceHistory_Natural(t *testing.T) {
+	account, err := NewAccount("asset.bacen.reserves")
+	require.NoError(t, err)
+
+	history := BalanceHistory{
+		Account:  account,
+		Currency: DefaultCurrency,
+		Points: []BalancePoint{
+			{Debit: 100, Balance: -100},
+			{Credit: 30, Balance: -70},
+		},
+	}
+
+	natural := history.Natural()
+	assert.Equal(t, 100, natural.Points[0].Balance)
+	assert.Equal(t, 70, natural.Points[1].Balance)
+	assert.Equal(t, 30, natural.Points[1].Credit)
+	assert.Equal(t, -100, history.Points[0].Balance)
+}
@@ -0,0 +1,44 @@
+package vos
+
+import "github.com/stone-co/the-amazing-ledger/app"
+
+// BalanceLimit bounds the balance (credits minus debits) analytic accounts may reach in a currency.
+// When the account is synthetic, the limit applies to each matching analytic account individually,
+// and when several limits match the same account, the strictest bounds are enforced.
+// A nil minimum or maximum means the balance is unbounded in that direction.
+type BalanceLimit struct {
+	Account    Account
+	Currency   Currency
+	MinBalance *int
+	MaxBalance *int
+}
+
+func NewBalanceLimit(account Account, currency Currency, minBalance, maxBalance *int) (BalanceLimit, error) {
+	if minBalance == nil && maxBalance == nil {
+		return BalanceLimit{}, app.ErrInvalidBalanceLimit
+	}
+
+	if minBalance != nil && maxBalance != nil && *minBalance > *maxBalance {
+		return BalanceLimit{}, app.ErrInvalidBalanceLimit
+	}
+
+	return BalanceLimit{
+		Account:    account,
+		Currency:   currency,
+		MinBalance: minBalance,
+		MaxBalance: maxBalance,
+	}, nil
+}
+
+// Allows reports whether the given balance is within the limit.
+func (l BalanceLimit) Allows(balance int) bool {
+	if l.MinBalance != nil && balance < *l.MinBalance {
+		return false
+	}
+
+	if l.MaxBalance != nil && balance > *l.MaxBalance {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,76 @@
+package vos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+)
+
+func TestNewBalanceLimit(t *testing.T) {
+	zero, negative := 0, -100
+
+	account, err := NewAccount("liability.clients.available.*")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		minBalance  *int
+		maxBalance  *int
+		expectedErr error
+	}{
+		{
+			name:       "Valid floor",
+			minBalance: &zero,
+		},
+		{
+			name:       "Valid ceiling",
+			maxBalance: &zero,
+		},
+		{
+			name:       "Valid range",
+			minBalance: &negative,
+			maxBalance: &zero,
+		},
+		{
+			name:        "Invalid limit without bounds",
+			expectedErr: app.ErrInvalidBalanceLimit,
+		},
+		{
+			name:        "Invalid limit with minimum greater than maximum",
+			minBalance:  &zero,
+			maxBalance:  &negative,
+			expectedErr: app.ErrInvalidBalanceLimit,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewBalanceLimit(account, DefaultCurrency, tt.minBalance, tt.maxBalance)
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr == nil {
+				assert.Equal(t, account, got.Account)
+				assert.Equal(t, tt.minBalance, got.MinBalance)
+				assert.Equal(t, tt.maxBalance, got.MaxBalance)
+			}
+		})
+	}
+}
+
+func TestBalanceLimit_Allows(t *testing.T) {
+	overdraft, ceiling := -100, 1000
+
+	limit := BalanceLimit{MinBalance: &overdraft, MaxBalance: &ceiling}
+
+	assert.True(t, limit.Allows(-100))
+	assert.True(t, limit.Allows(0))
+	assert.True(t, limit.Allows(1000))
+	assert.False(t, limit.Allows(-101))
+	assert.False(t, limit.Allows(1001))
+
+	floor := BalanceLimit{MinBalance: &overdraft}
+	assert.True(t, floor.Allows(1<<40))
+}
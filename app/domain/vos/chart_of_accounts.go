@@ -0,0 +1,204 @@
+package vos
+
+import (
+	"strings"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+)
+
+// Pattern placeholders
+const (
+	uuidPlaceholder  = "<uuid>"
+	labelPlaceholder = "<id>"
+)
+
+// AccountPattern declares a family of analytic accounts allowed by the chart of accounts. Its labels are
+// separated by a dot (.), and each one can be:
+//  - a literal label, like 'clients';
+//  - a set of alternatives, like '{available,blocked}';
+//  - the placeholder '<uuid>', matching a UUID label with underscores (eg.: 96a131a8_c4ac_495e_8971_fcecdbdd003a);
+//  - the placeholder '<id>', matching any single label;
+//  - a star (*), only as the last label, matching any number of labels, including none.
+//
+// The first label is the class, which must be a literal of a registered class.
+//
+// Some examples:
+//  - liability.clients.{available,blocked}.<uuid>
+//  - asset.bacen.*
+type AccountPattern struct {
+	value  string
+	labels []patternLabel
+}
+
+type patternLabel struct {
+	alternatives []string
+	placeholder  string
+	star         bool
+}
+
+func NewAccountPattern(pattern string) (AccountPattern, error) {
+	parts := strings.Split(strings.ToLower(pattern), string(dot))
+	if len(parts) < 2 {
+		return AccountPattern{}, app.ErrInvalidAccountPattern
+	}
+
+	labels := make([]patternLabel, len(parts))
+	for i, part := range parts {
+		label, err := newPatternLabel(part)
+		if err != nil {
+			return AccountPattern{}, err
+		}
+
+		if (i == 0 && (label.star || label.placeholder != "" || len(label.alternatives) > 1)) ||
+			(label.star && i != len(parts)-1) {
+			return AccountPattern{}, app.ErrInvalidAccountPattern
+		}
+
+		labels[i] = label
+	}
+
+	if _, ok := LookupAccountClass(parts[0]); !ok {
+		return AccountPattern{}, app.ErrInvalidAccountPattern
+	}
+
+	return AccountPattern{
+		value:  strings.ToLower(pattern),
+		labels: labels,
+	}, nil
+}
+
+func newPatternLabel(label string) (patternLabel, error) {
+	switch {
+	case label == string(star):
+		return patternLabel{star: true}, nil
+	case label == uuidPlaceholder || label == labelPlaceholder:
+		return patternLabel{placeholder: label}, nil
+	case strings.HasPrefix(label, "{") && strings.HasSuffix(label, "}"):
+		alternatives := strings.Split(label[1:len(label)-1], ",")
+		for _, alternative := range alternatives {
+			if !isLiteralLabel(alternative) {
+				return patternLabel{}, app.ErrInvalidAccountPattern
+			}
+		}
+
+		return patternLabel{alternatives: alternatives}, nil
+	case isLiteralLabel(label):
+		return patternLabel{alternatives: []string{label}}, nil
+	default:
+		return patternLabel{}, app.ErrInvalidAccountPattern
+	}
+}
+
+func (p AccountPattern) Value() string {
+	return p.value
+}
+
+// Class returns the first label of the pattern.
+func (p AccountPattern) Class() string {
+	return p.labels[0].alternatives[0]
+}
+
+// Matches reports whether the analytic account belongs to the pattern.
+func (p AccountPattern) Matches(account Account) bool {
+	labels := strings.Split(account.Value(), string(dot))
+
+	for i, label := range p.labels {
+		if label.star {
+			return true
+		}
+
+		if i >= len(labels) || !label.matches(labels[i]) {
+			return false
+		}
+	}
+
+	return len(labels) == len(p.labels)
+}
+
+func (l patternLabel) matches(label string) bool {
+	switch l.placeholder {
+	case uuidPlaceholder:
+		return isUUIDLabel(label)
+	case labelPlaceholder:
+		return true
+	}
+
+	for _, alternative := range l.alternatives {
+		if label == alternative {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ChartOfAccounts lists the patterns analytic accounts must match to receive entries.
+// An empty chart allows any account.
+type ChartOfAccounts []AccountPattern
+
+func NewChartOfAccounts(patterns ...string) (ChartOfAccounts, error) {
+	chart := make(ChartOfAccounts, 0, len(patterns))
+	for _, pattern := range patterns {
+		p, err := NewAccountPattern(pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		chart = append(chart, p)
+	}
+
+	return chart, nil
+}
+
+// Allows reports whether the account matches any pattern of the chart.
+func (c ChartOfAccounts) Allows(account Account) bool {
+	if len(c) == 0 {
+		return true
+	}
+
+	for _, pattern := range c {
+		if pattern.Matches(account) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isLiteralLabel(label string) bool {
+	if len(label) == 0 || uint(len(label)) > maxLabelSize {
+		return false
+	}
+
+	for i := 0; i < len(label); i++ {
+		c := label[i]
+		if !(c >= lowerLetterStart && c <= lowerLetterEnd) && !(c >= digitStart && c <= digitEnd) && c != underscore {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isUUIDLabel reports whether the label is a UUID with its dashes replaced by underscores.
+func isUUIDLabel(label string) bool {
+	if len(label) != 36 {
+		return false
+	}
+
+	for i := 0; i < len(label); i++ {
+		c := label[i]
+		switch i {
+		case 8, 13, 18, 23:
+			if c != underscore {
+				return false
+			}
+		default:
+			if !(c >= digitStart && c <= digitEnd) && !(c >= lowerLetterStart && c <= 'f') {
+				return false
+			}
+		}
+	}
+
+	return true
+}
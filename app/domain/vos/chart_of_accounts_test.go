@@ -0,0 +1,82 @@
+package vos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+)
+
+func TestNewAccountPattern(t *testing.T) {
+	testCases := []struct {
+		name        string
+		pattern     string
+		expectedErr error
+	}{
+		{name: "Valid pattern with alternatives and placeholder", pattern: "liability.clients.{available,blocked}.<uuid>"},
+		{name: "Valid pattern with star", pattern: "asset.bacen.*"},
+		{name: "Valid pattern with any label", pattern: "expense.<id>.fees"},
+		{name: "Invalid pattern with a single label", pattern: "asset", expectedErr: app.ErrInvalidAccountPattern},
+		{name: "Invalid pattern with star in the middle", pattern: "asset.*.bacen", expectedErr: app.ErrInvalidAccountPattern},
+		{name: "Invalid pattern with placeholder as class", pattern: "<id>.bacen", expectedErr: app.ErrInvalidAccountPattern},
+		{name: "Invalid pattern with unknown placeholder", pattern: "asset.<cpf>", expectedErr: app.ErrInvalidAccountPattern},
+		{name: "Invalid pattern with empty alternative", pattern: "asset.{a,}", expectedErr: app.ErrInvalidAccountPattern},
+		{name: "Invalid pattern with empty label", pattern: "asset..bacen", expectedErr: app.ErrInvalidAccountPattern},
+		{name: "Invalid pattern with unregistered class", pattern: "memo.bacen.*", expectedErr: app.ErrInvalidAccountPattern},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAccountPattern(tt.pattern)
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			if tt.expectedErr == nil {
+				assert.Equal(t, tt.pattern, got.Value())
+			}
+		})
+	}
+}
+
+func TestChartOfAccounts_Allows(t *testing.T) {
+	chart, err := NewChartOfAccounts(
+		"liability.clients.{available,blocked}.<uuid>",
+		"asset.bacen.*",
+		"expense.<id>.fees",
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "liability", chart[0].Class())
+
+	testCases := []struct {
+		account string
+		allowed bool
+	}{
+		{account: "liability.clients.available.96a131a8_c4ac_495e_8971_fcecdbdd003a", allowed: true},
+		{account: "liability.clients.blocked.96a131a8_c4ac_495e_8971_fcecdbdd003a", allowed: true},
+		{account: "liability.cleints.available.96a131a8_c4ac_495e_8971_fcecdbdd003a", allowed: false},
+		{account: "liability.clients.available.not_an_uuid", allowed: false},
+		{account: "liability.clients.available.96a131a8_c4ac_495e_8971_fcecdbdd003a.detail", allowed: false},
+		{account: "asset.bacen.reserves", allowed: true},
+		{account: "asset.bacen.reserves.daily", allowed: true},
+		{account: "asset.treasury.reserves", allowed: false},
+		{account: "expense.bank_xyz.fees", allowed: true},
+		{account: "expense.bank_xyz.taxes", allowed: false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.account, func(t *testing.T) {
+			account, err := NewAnalyticAccount(tt.account)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.allowed, chart.Allows(account))
+		})
+	}
+
+	t.Run("empty chart allows any account", func(t *testing.T) {
+		account, err := NewAnalyticAccount("liability.cleints.available.abc")
+		require.NoError(t, err)
+
+		assert.True(t, ChartOfAccounts(nil).Allows(account))
+	})
+}
@@ -0,0 +1,38 @@
+package vos
+
+import "github.com/stone-co/the-amazing-ledger/app"
+
+// Currency is the code of the currency or asset an entry amount is denominated in, like 'BRL' or 'USD'.
+// A code must start with an uppercase letter, followed only by uppercase letters and digits, and have
+// between 3 and 12 characters, so card-scheme settlement currencies and other assets can also be represented.
+type Currency string
+
+// DefaultCurrency is used whenever a currency is not informed.
+const DefaultCurrency Currency = "BRL"
+
+// Limits
+const (
+	minCurrencySize = 3
+	maxCurrencySize = 12
+)
+
+func NewCurrency(code string) (Currency, error) {
+	if len(code) < minCurrencySize || len(code) > maxCurrencySize {
+		return "", app.ErrInvalidCurrency
+	}
+
+	for i, c := range code {
+		isUpperLetter := c >= upperLetterStart && c <= upperLetterEnd
+		isDigit := c >= digitStart && c <= digitEnd
+
+		if !isUpperLetter && (i == 0 || !isDigit) {
+			return "", app.ErrInvalidCurrency
+		}
+	}
+
+	return Currency(code), nil
+}
+
+func (c Currency) String() string {
+	return string(c)
+}
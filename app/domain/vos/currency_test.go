@@ -0,0 +1,67 @@
+package vos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+)
+
+func TestNewCurrency(t *testing.T) {
+	testCases := []struct {
+		name        string
+		code        string
+		expected    Currency
+		expectedErr error
+	}{
+		{
+			name:     "Valid ISO 4217 code",
+			code:     "USD",
+			expected: Currency("USD"),
+		},
+		{
+			name:     "Valid code with digits",
+			code:     "SETTLE01",
+			expected: Currency("SETTLE01"),
+		},
+		{
+			name:        "Invalid empty code",
+			code:        "",
+			expectedErr: app.ErrInvalidCurrency,
+		},
+		{
+			name:        "Invalid short code",
+			code:        "US",
+			expectedErr: app.ErrInvalidCurrency,
+		},
+		{
+			name:        "Invalid long code",
+			code:        "ABCDEFGHIJKLM",
+			expectedErr: app.ErrInvalidCurrency,
+		},
+		{
+			name:        "Invalid lowercase code",
+			code:        "brl",
+			expectedErr: app.ErrInvalidCurrency,
+		},
+		{
+			name:        "Invalid code starting with a digit",
+			code:        "1BRL",
+			expectedErr: app.ErrInvalidCurrency,
+		},
+		{
+			name:        "Invalid code with symbols",
+			code:        "BR_L",
+			expectedErr: app.ErrInvalidCurrency,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCurrency(tt.code)
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package vos
+
+import "time"
+
+// Period is a range of dates, from Start (inclusive) to End (exclusive), on the time axis of the query.
+// A zero Start means since the first entry.
+type Period struct {
+	Start time.Time
+	End   time.Time
+}
+
+// StatementRequest asks for the balances of the accounts of some classes, grouped by their first labels,
+// within a current and a comparative period. A zero comparative period has no entries.
+type StatementRequest struct {
+	Classes     []AccountClass
+	Company     string
+	Current     Period
+	Comparative Period
+	Level       int
+	Time        TimeView
+}
+
+// StatementLine holds the balances (credits minus debits) of a group of accounts within the current
+// and comparative periods.
+type StatementLine struct {
+	Account     Account
+	Currency    Currency
+	Current     int64
+	Comparative int64
+}
+
+// StatementTotal holds the balances of all the accounts of a class in a currency.
+type StatementTotal struct {
+	Class       AccountClass
+	Currency    Currency
+	Current     int64
+	Comparative int64
+}
+
+// StatementAmount holds the current and comparative amounts of a currency.
+type StatementAmount struct {
+	Currency    Currency
+	Current     int64
+	Comparative int64
+}
+
+// FinancialStatement is a report of the balances of the accounts of the classes reported on a financial
+// statement, in their natural orientation, along with the totals of each class and the retained earnings,
+// which are the net of the income statement classes. All of them are split by currency.
+type FinancialStatement struct {
+	Lines            []StatementLine
+	Totals           []StatementTotal
+	RetainedEarnings []StatementAmount
+}
+
+// NewFinancialStatement builds a financial statement out of the lines of its classes and the lines of
+// the income statement classes, which are summed up into the retained earnings.
+func NewFinancialStatement(lines []StatementLine, earnings []StatementLine) FinancialStatement {
+	statement := FinancialStatement{
+		Lines:            make([]StatementLine, 0, len(lines)),
+		Totals:           make([]StatementTotal, 0),
+		RetainedEarnings: make([]StatementAmount, 0, 1),
+	}
+
+	totals := make(map[string]int)
+
+	for _, line := range lines {
+		sign := int64(naturalSign(line.Account))
+		line.Current *= sign
+		line.Comparative *= sign
+
+		statement.Lines = append(statement.Lines, line)
+
+		class, _ := line.Account.Class()
+		key := class.Name + ":" + line.Currency.String()
+
+		i, ok := totals[key]
+		if !ok {
+			i = len(statement.Totals)
+			totals[key] = i
+			statement.Totals = append(statement.Totals, StatementTotal{Class: class, Currency: line.Currency})
+		}
+
+		statement.Totals[i].Current += line.Current
+		statement.Totals[i].Comparative += line.Comparative
+	}
+
+	retained := make(map[Currency]int)
+
+	for _, line := range earnings {
+		i, ok := retained[line.Currency]
+		if !ok {
+			i = len(statement.RetainedEarnings)
+			retained[line.Currency] = i
+			statement.RetainedEarnings = append(statement.RetainedEarnings, StatementAmount{Currency: line.Currency})
+		}
+
+		// credits minus debits is already the natural orientation of earnings, as they belong to the equity.
+		statement.RetainedEarnings[i].Current += line.Current
+		statement.RetainedEarnings[i].Comparative += line.Comparative
+	}
+
+	return statement
+}
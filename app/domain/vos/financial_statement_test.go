@@ -0,0 +1,50 @@
+package vos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFinancialStatement(t *testing.T) {
+	account := func(value string) Account {
+		acc, err := NewAccount(value)
+		assert.NoError(t, err)
+
+		return acc
+	}
+
+	revenue := account("revenue.fees.*")
+	expense := account("expense.salaries.*")
+	otherExpense := account("expense.rent.*")
+
+	lines := []StatementLine{
+		{Account: expense, Currency: DefaultCurrency, Current: -300, Comparative: -200},
+		{Account: otherExpense, Currency: DefaultCurrency, Current: -100, Comparative: -100},
+		{Account: revenue, Currency: DefaultCurrency, Current: 1000, Comparative: 500},
+		{Account: revenue, Currency: Currency("USD"), Current: 10},
+	}
+
+	got := NewFinancialStatement(lines, lines)
+
+	expenseClass, _ := LookupAccountClass("expense")
+	revenueClass, _ := LookupAccountClass("revenue")
+
+	assert.Equal(t, FinancialStatement{
+		Lines: []StatementLine{
+			{Account: expense, Currency: DefaultCurrency, Current: 300, Comparative: 200},
+			{Account: otherExpense, Currency: DefaultCurrency, Current: 100, Comparative: 100},
+			{Account: revenue, Currency: DefaultCurrency, Current: 1000, Comparative: 500},
+			{Account: revenue, Currency: Currency("USD"), Current: 10},
+		},
+		Totals: []StatementTotal{
+			{Class: expenseClass, Currency: DefaultCurrency, Current: 400, Comparative: 300},
+			{Class: revenueClass, Currency: DefaultCurrency, Current: 1000, Comparative: 500},
+			{Class: revenueClass, Currency: Currency("USD"), Current: 10},
+		},
+		RetainedEarnings: []StatementAmount{
+			{Currency: DefaultCurrency, Current: 600, Comparative: 200},
+			{Currency: Currency("USD"), Current: 10},
+		},
+	}, got)
+}
@@ -0,0 +1,31 @@
+package vos
+
+// FiscalPeriodStatus is the state of a fiscal period. Closed periods reject entries with competence dates within them.
+type FiscalPeriodStatus int8
+
+const (
+	InvalidFiscalPeriodStatus FiscalPeriodStatus = iota
+	OpenFiscalPeriodStatus
+	ClosedFiscalPeriodStatus
+)
+
+var _fiscalPeriodStatuses = []string{"invalid_fiscal_period_status", "open", "closed"}
+
+func (s FiscalPeriodStatus) String() string {
+	return _fiscalPeriodStatuses[s]
+}
+
+// FiscalPeriodAction is a change of the status of a fiscal period, recorded along with who requested it.
+type FiscalPeriodAction int8
+
+const (
+	InvalidFiscalPeriodAction FiscalPeriodAction = iota
+	CloseFiscalPeriodAction
+	ReopenFiscalPeriodAction
+)
+
+var _fiscalPeriodActions = []string{"invalid_fiscal_period_action", "close", "reopen"}
+
+func (a FiscalPeriodAction) String() string {
+	return _fiscalPeriodActions[a]
+}
@@ -0,0 +1,19 @@
+package vos
+
+// PendingStatus is the state of a pending (authorized) transaction. Only pending transactions hold amounts
+// against their accounts; a pending transaction past its expiration date is reported as expired.
+type PendingStatus int8
+
+const (
+	InvalidPendingStatus PendingStatus = iota
+	PendingPendingStatus
+	CapturedPendingStatus
+	VoidedPendingStatus
+	ExpiredPendingStatus
+)
+
+var _pendingStatuses = []string{"invalid_pending_status", "pending", "captured", "voided", "expired"}
+
+func (s PendingStatus) String() string {
+	return _pendingStatuses[s]
+}
@@ -0,0 +1,20 @@
+package vos
+
+// ScheduleStatus is the state of a scheduled transaction. A scheduled transaction is claimed by the
+// scheduler (processing) when it comes due, and then either posted or failed.
+type ScheduleStatus int8
+
+const (
+	InvalidScheduleStatus ScheduleStatus = iota
+	ScheduledScheduleStatus
+	ProcessingScheduleStatus
+	PostedScheduleStatus
+	CanceledScheduleStatus
+	FailedScheduleStatus
+)
+
+var _scheduleStatuses = []string{"invalid_schedule_status", "scheduled", "processing", "posted", "canceled", "failed"}
+
+func (s ScheduleStatus) String() string {
+	return _scheduleStatuses[s]
+}
@@ -1,28 +1,164 @@
 package vos
 
-import "github.com/stone-co/the-amazing-ledger/app"
+import (
+	"strings"
+	"time"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/pagination"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+type SyntheticReportRequest struct {
+	Account Account
+	// Level groups the entries by the first N labels of their accounts.
+	Level     int
+	StartDate time.Time
+	EndDate   time.Time
+	Time      TimeView
+	Filter    AccountEntryFilter
+	// GroupBy splits the results of each account by other dimensions of the entries.
+	GroupBy ReportGrouping
+	Page    pagination.Page
+	// Tree also returns the subtotals of every level between the root of the account and Level,
+	// in depth-first order.
+	Tree bool
+}
+
+// ReportGrouping tells which dimensions of the entries, besides the account and the currency, split the results.
+type ReportGrouping struct {
+	Company bool
+	Event   bool
+}
+
+func NewSyntheticReportFilter(filters *proto.GetSyntheticReportFilters) (AccountEntryFilter, error) {
+	if filters == nil {
+		return AccountEntryFilter{}, nil
+	}
+
+	metadata, err := NewMetadataFilter(filters.Metadata)
+	if err != nil {
+		return AccountEntryFilter{}, err
+	}
+
+	return AccountEntryFilter{
+		Companies: filters.Companies,
+		Events:    filters.Events,
+		Operation: OperationType(proto.Operation_value[filters.Operation.String()]),
+		Metadata:  metadata,
+	}, nil
+}
 
 // TODO: improve struct name(Common Language)
 type AccountResult struct {
-	Account Account
+	Account  Account
+	Currency Currency
+	// Company and Event are only set when the report is grouped by them.
+	Company string
+	Event   int32
 	Credit  int64
 	Debit   int64
 }
 
+// Balance returns the credit minus the debit of the result.
+func (r AccountResult) Balance() int64 {
+	return r.Credit - r.Debit
+}
+
+// NaturalBalance returns the balance of the result in the natural orientation of its account class.
+func (r AccountResult) NaturalBalance() int64 {
+	return r.Balance() * int64(naturalSign(r.Account))
+}
+
+// CurrencyTotal holds the credit and debit accumulated by all the report results of a single currency.
+type CurrencyTotal struct {
+	Currency Currency
+	Credit   int64
+	Debit    int64
+}
+
+// SyntheticReport holds a page of the results, while the totals cover all the pages.
+// There are no totals across currencies, as summing amounts of different currencies makes no sense.
 type SyntheticReport struct {
-	TotalCredit int64
-	TotalDebit  int64
-	Results     []AccountResult
+	Totals   []CurrencyTotal
+	Results  []AccountResult
+	NextPage pagination.Cursor
 }
 
-func NewSyntheticReport(totalCredit, totalDebit int64, accounts []AccountResult) (*SyntheticReport, error) {
+func NewSyntheticReport(accounts []AccountResult) (*SyntheticReport, error) {
 	if accounts == nil || len(accounts) < 1 {
 		return nil, app.ErrInvalidSyntheticReportStructure
 	}
 
 	return &SyntheticReport{
-		TotalCredit: totalCredit,
-		TotalDebit:  totalDebit,
-		Results:     accounts,
+		Totals:  currencyTotals(accounts),
+		Results: accounts,
 	}, nil
 }
+
+// currencyTotals sums the results by currency, keeping the order in which each currency first appears.
+func currencyTotals(accounts []AccountResult) []CurrencyTotal {
+	totals := make([]CurrencyTotal, 0, 1)
+	indexes := make(map[Currency]int)
+
+	for _, account := range accounts {
+		i, ok := indexes[account.Currency]
+		if !ok {
+			i = len(totals)
+			indexes[account.Currency] = i
+			totals = append(totals, CurrencyTotal{Currency: account.Currency})
+		}
+
+		totals[i].Credit += account.Credit
+		totals[i].Debit += account.Debit
+	}
+
+	return totals
+}
+
+// AccountNode is a result of a tree report, nested under the result of its parent account in the same currency.
+type AccountNode struct {
+	AccountResult
+	Children []*AccountNode
+}
+
+// Tree nests the results of the report under their closest ancestors in the same currency and dimensions, taking a
+// synthetic account ending in a wildcard as its path. The results whose ancestors aren't in the report,
+// as the ones continuing a previous page, become roots.
+func (r SyntheticReport) Tree() []*AccountNode {
+	type key struct {
+		account  string
+		currency Currency
+		company  string
+		event    int32
+	}
+
+	roots := make([]*AccountNode, 0)
+	nodes := make(map[key]*AccountNode, len(r.Results))
+
+	for _, result := range r.Results {
+		node := &AccountNode{AccountResult: result, Children: make([]*AccountNode, 0)}
+		k := key{
+			account:  strings.TrimSuffix(result.Account.Value(), ".*"),
+			currency: result.Currency,
+			company:  result.Company,
+			event:    result.Event,
+		}
+		nodes[k] = node
+
+		var parent *AccountNode
+		for i := strings.LastIndexByte(k.account, dot); i > 0 && parent == nil; i = strings.LastIndexByte(k.account, dot) {
+			k.account = k.account[:i]
+			parent = nodes[k]
+		}
+
+		if parent == nil {
+			roots = append(roots, node)
+			continue
+		}
+
+		parent.Children = append(parent.Children, node)
+	}
+
+	return roots
+}
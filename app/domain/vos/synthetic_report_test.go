@@ -9,12 +9,12 @@ import (
 // test the creation of a new synthetic report object
 func TestSyntheticReport(t *testing.T) {
 	accountLiquidacao, _ := NewAnalyticAccount("assets.bacen.conta_liquidacao")
+	accountTesouraria, _ := NewAnalyticAccount("assets.bacen.tesouraria")
 
 	type wants struct {
-		results     []AccountResult
-		totalCredit int64
-		totalDebit  int64
-		err         error
+		results []AccountResult
+		totals  []CurrencyTotal
+		err     error
 	}
 
 	tests := []struct {
@@ -27,27 +27,105 @@ func TestSyntheticReport(t *testing.T) {
 			wants: wants{
 				results: []AccountResult{
 					{
-						Account: accountLiquidacao,
-						Credit:  200,
-						Debit:   300,
+						Account:  accountLiquidacao,
+						Currency: DefaultCurrency,
+						Credit:   200,
+						Debit:    300,
 					},
 				},
-				totalCredit: 200,
-				totalDebit:  300,
-				err:         nil,
+				totals: []CurrencyTotal{
+					{Currency: DefaultCurrency, Credit: 200, Debit: 300},
+				},
+				err: nil,
+			},
+		},
+		{
+			name: "Successfully creates a synthetic report split by currency",
+			wants: wants{
+				results: []AccountResult{
+					{
+						Account:  accountLiquidacao,
+						Currency: DefaultCurrency,
+						Credit:   200,
+						Debit:    300,
+					},
+					{
+						Account:  accountLiquidacao,
+						Currency: Currency("USD"),
+						Credit:   50,
+						Debit:    10,
+					},
+					{
+						Account:  accountTesouraria,
+						Currency: DefaultCurrency,
+						Credit:   100,
+						Debit:    0,
+					},
+				},
+				totals: []CurrencyTotal{
+					{Currency: DefaultCurrency, Credit: 300, Debit: 300},
+					{Currency: Currency("USD"), Credit: 50, Debit: 10},
+				},
+				err: nil,
 			},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewSyntheticReport(tt.wants.totalCredit, tt.wants.totalDebit, tt.wants.results)
+			got, err := NewSyntheticReport(tt.wants.results)
 
 			assert.Nil(t, err)
 			assert.Equal(t, len(tt.wants.results), len(got.Results))
-			assert.Equal(t, tt.wants.totalCredit, got.TotalCredit)
-			assert.Equal(t, tt.wants.totalDebit, got.TotalDebit)
+			assert.Equal(t, tt.wants.totals, got.Totals)
 		})
 	}
 
 }
+
+func TestSyntheticReport_Tree(t *testing.T) {
+	newResult := func(account string, currency Currency, credit int64) AccountResult {
+		acc, err := NewAccount(account)
+		assert.NoError(t, err)
+
+		return AccountResult{Account: acc, Currency: currency, Credit: credit}
+	}
+
+	report := SyntheticReport{
+		Results: []AccountResult{
+			newResult("liability.clients.*", DefaultCurrency, 30),
+			newResult("liability.clients.*", Currency("USD"), 5),
+			newResult("liability.clients.available", DefaultCurrency, 10),
+			newResult("liability.clients.available", Currency("USD"), 5),
+			newResult("liability.clients.blocked", DefaultCurrency, 20),
+		},
+	}
+
+	tree := report.Tree()
+	assert.Len(t, tree, 2)
+
+	assert.Equal(t, "liability.clients.*", tree[0].Account.Value())
+	assert.Equal(t, DefaultCurrency, tree[0].Currency)
+	assert.Len(t, tree[0].Children, 2)
+	assert.Equal(t, "liability.clients.available", tree[0].Children[0].Account.Value())
+	assert.Equal(t, "liability.clients.blocked", tree[0].Children[1].Account.Value())
+
+	assert.Equal(t, Currency("USD"), tree[1].Currency)
+	assert.Len(t, tree[1].Children, 1)
+	assert.Equal(t, int64(5), tree[1].Children[0].Credit)
+
+	t.Run("results of other companies are nested apart", func(t *testing.T) {
+		child := newResult("liability.clients.available", DefaultCurrency, 10)
+		child.Company = "abc"
+
+		grouped := SyntheticReport{Results: []AccountResult{report.Results[0], child}}
+
+		assert.Len(t, grouped.Tree(), 2)
+	})
+
+	t.Run("a page starting inside a subtree has its first nodes as roots", func(t *testing.T) {
+		page := SyntheticReport{Results: report.Results[2:]}
+
+		assert.Len(t, page.Tree(), 3)
+	})
+}
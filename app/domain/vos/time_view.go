@@ -0,0 +1,43 @@
+package vos
+
+import "time"
+
+// TimeAxis is the date of the entries that the date ranges of a query apply to. The competence date
+// is when an entry takes effect, and the recorded time is when the ledger learned about it.
+type TimeAxis int8
+
+const (
+	// DefaultTimeAxis leaves the axis up to the ledger, which is FallbackTimeAxis for every query.
+	DefaultTimeAxis TimeAxis = iota
+	CompetenceTimeAxis
+	RecordedTimeAxis
+)
+
+// FallbackTimeAxis is the axis of the queries that don't tell one.
+const FallbackTimeAxis = CompetenceTimeAxis
+
+var _timeAxes = []string{"default_time_axis", "competence", "recorded"}
+
+func (a TimeAxis) String() string {
+	return _timeAxes[a]
+}
+
+// TimeView tells how a query places the entries in time.
+//
+// Entries are never updated or deleted, so only considering the ones recorded up to KnownAt reproduces
+// the ledger exactly as it was known at that instant. Along with the competence axis, it answers
+// bitemporal questions like "what was the balance effective at T2, as known at T1".
+type TimeView struct {
+	Axis TimeAxis
+	// KnownAt only considers the entries recorded up to it, inclusive, when set.
+	KnownAt time.Time
+}
+
+// EffectiveAxis returns the axis of the view, or FallbackTimeAxis when the view has the default axis.
+func (v TimeView) EffectiveAxis() TimeAxis {
+	if v.Axis == DefaultTimeAxis {
+		return FallbackTimeAxis
+	}
+
+	return v.Axis
+}
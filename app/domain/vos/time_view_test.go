@@ -0,0 +1,13 @@
+package vos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeView_EffectiveAxis(t *testing.T) {
+	assert.Equal(t, CompetenceTimeAxis, TimeView{}.EffectiveAxis(), "the default axis is the competence date")
+	assert.Equal(t, CompetenceTimeAxis, TimeView{Axis: CompetenceTimeAxis}.EffectiveAxis())
+	assert.Equal(t, RecordedTimeAxis, TimeView{Axis: RecordedTimeAxis}.EffectiveAxis())
+}
@@ -0,0 +1,40 @@
+package vos
+
+import "time"
+
+type TrialBalanceRequest struct {
+	// Company filters the entries of a single company, all of them are considered when empty.
+	Company string
+	// AsOf only considers the entries with a date up to it, inclusive, on the time axis of Time.
+	AsOf time.Time
+	// Level groups the accounts by their first labels into synthetic accounts (eg.: 'asset.bacen.*'),
+	// listing the analytic accounts when zero.
+	Level int
+	Time  TimeView
+}
+
+// TrialBalance lists the credit and debit of every account, split by currency, along with the totals
+// of each currency.
+type TrialBalance struct {
+	Accounts []AccountResult
+	Totals   []CurrencyTotal
+}
+
+func NewTrialBalance(accounts []AccountResult) TrialBalance {
+	return TrialBalance{
+		Accounts: accounts,
+		Totals:   currencyTotals(accounts),
+	}
+}
+
+// Balanced reports whether the debits equal the credits in every currency, which always holds
+// for a double-entry ledger.
+func (t TrialBalance) Balanced() bool {
+	for _, total := range t.Totals {
+		if total.Credit != total.Debit {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,51 @@
+package vos
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrialBalance_Balanced(t *testing.T) {
+	asset, err := NewAnalyticAccount("asset.bacen.reserves")
+	assert.NoError(t, err)
+
+	liability, err := NewAnalyticAccount("liability.clients.available.user_1")
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		accounts []AccountResult
+		want     bool
+	}{
+		{
+			name: "Debits equal credits in every currency",
+			accounts: []AccountResult{
+				{Account: asset, Currency: DefaultCurrency, Debit: 100},
+				{Account: liability, Currency: DefaultCurrency, Credit: 100},
+				{Account: asset, Currency: Currency("USD"), Credit: 10},
+				{Account: liability, Currency: Currency("USD"), Debit: 10},
+			},
+			want: true,
+		},
+		{
+			name: "Debits differ from credits in a currency",
+			accounts: []AccountResult{
+				{Account: asset, Currency: DefaultCurrency, Debit: 100},
+				{Account: liability, Currency: DefaultCurrency, Credit: 100},
+				{Account: asset, Currency: Currency("USD"), Credit: 10},
+			},
+			want: false,
+		},
+		{
+			name: "No accounts",
+			want: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewTrialBalance(tt.accounts).Balanced())
+		})
+	}
+}
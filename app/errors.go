@@ -1,5 +1,12 @@
 package app
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
 const (
 	ErrInvalidTransactionID                    = DomainError("invalid transaction id")
 	ErrInvalidEntryID                          = DomainError("invalid entry id")
@@ -19,6 +26,63 @@ const (
 	ErrInvalidPageSize                         = DomainError("invalid page size")
 	ErrInvalidPageCursor                       = DomainError("invalid page cursor")
 	ErrInvalidAccountType                      = DomainError("invalid account type")
+	ErrTransactionNotFound                     = DomainError("transaction not found")
+	ErrTransactionAlreadyReverted              = DomainError("transaction already reverted")
+	ErrInvalidReversalAmount                   = DomainError("invalid reversal amount")
+	ErrInvalidCurrency                         = DomainError("invalid currency")
+	ErrInvalidAccountOwner                     = DomainError("account owner must have a value")
+	ErrAccountAlreadyOpened                    = DomainError("account already opened")
+	ErrAccountNotOpened                        = DomainError("account was never opened")
+	ErrAccountNotActive                        = DomainError("account is frozen or closed")
+	ErrInvalidAccountStatusTransition          = DomainError("invalid account status transition")
+	ErrInvalidBalanceLimit                     = DomainError("balance limit must have a minimum or a maximum, and the minimum can't be greater than the maximum")
+	ErrBalanceLimitNotFound                    = DomainError("balance limit not found")
+	ErrBalanceLimitExceeded                    = DomainError("balance limit exceeded")
+	ErrPendingTransactionNotFound              = DomainError("pending transaction not found")
+	ErrPendingTransactionNotPending            = DomainError("pending transaction already captured or voided")
+	ErrPendingTransactionExpired               = DomainError("pending transaction expired")
+	ErrInvalidCaptureAmount                    = DomainError("invalid capture amount")
+	ErrScheduledTransactionNotFound            = DomainError("scheduled transaction not found")
+	ErrScheduledTransactionNotScheduled        = DomainError("scheduled transaction already posted or canceled")
+	ErrInvalidScheduleDate                     = DomainError("scheduled competence date must be in the future")
+	ErrTransactionConflict                     = DomainError("transaction conflicts with an existing one")
+	ErrInvalidEventID                          = DomainError("event id must be between 1 and 32767")
+	ErrInvalidEventName                        = DomainError("event name must have a value")
+	ErrEventNotFound                           = DomainError("event not found")
+	ErrEventAlreadyExists                      = DomainError("event already exists")
+	ErrEventAlreadyDeprecated                  = DomainError("event already deprecated")
+	ErrUnknownEvent                            = DomainError("unknown event")
+	ErrEventDeprecated                         = DomainError("event is deprecated")
+	ErrInvalidAccountPattern                   = DomainError("invalid account pattern")
+	ErrAccountNotInChart                       = DomainError("account does not match the chart of accounts")
+	ErrInvalidAccountPath                      = DomainError("account path can only have a wildcard as its last label (eg.: liability.clients.*)")
+	ErrInvalidAccountClass                     = DomainError("account class must be a label with a natural side of credit or debit, and can't be repeated")
+	ErrInvalidFiscalPeriodID                   = DomainError("invalid fiscal period id")
+	ErrInvalidFiscalPeriodCompany              = DomainError("fiscal period company must have a value")
+	ErrInvalidFiscalPeriodDates                = DomainError("fiscal period end date must be after its start date")
+	ErrInvalidFiscalPeriodRequester            = DomainError("fiscal period changes must tell who requested them")
+	ErrInvalidReopenReason                     = DomainError("reopening a fiscal period must have a reason")
+	ErrFiscalPeriodNotFound                    = DomainError("fiscal period not found")
+	ErrFiscalPeriodOverlap                     = DomainError("fiscal period overlaps another period of the company")
+	ErrFiscalPeriodAlreadyClosed               = DomainError("fiscal period already closed")
+	ErrFiscalPeriodNotClosed                   = DomainError("fiscal period is not closed")
+	ErrFiscalPeriodClosed                      = DomainError("competence date within a closed fiscal period")
+	ErrInvalidRetainedEarningsAccount          = DomainError("retained earnings account must be an analytic account out of the income statement")
+	ErrInvalidCompetenceLockCompany            = DomainError("competence lock company must have a value")
+	ErrInvalidCompetenceLockRequester          = DomainError("competence lock changes must tell who requested them")
+	ErrInvalidCompetenceLockDate               = DomainError("competence lock date must have a value")
+	ErrCompetenceLockNotFound                  = DomainError("competence lock not found")
+	ErrCompetenceDateLocked                    = DomainError("competence date on or before the lock date")
+	ErrInvalidAdjustmentRequester              = DomainError("adjustments must tell who requested them")
+	ErrInvalidAdjustmentReason                 = DomainError("adjustments must have a reason")
+	ErrUnauthorizedAdjustment                  = DomainError("adjustments must be authorized through the admin API")
+	ErrInvalidGranularity                      = DomainError("granularity must be day, week or month")
+	ErrInvalidBalanceHistoryDates              = DomainError("balance history must end after it starts")
+	ErrBalanceHistoryTooLong                   = DomainError("balance history has too many points")
+	ErrInvalidMetadataFilter                   = DomainError("metadata filter paths must have at least one key")
+	ErrInvalidMetadataSchema                   = DomainError("metadata schema must be a valid JSON Schema")
+	ErrRemoteMetadataSchemaRef                 = DomainError("metadata schema can only reference its own definitions")
+	ErrMetadataSchemaViolation                 = DomainError("metadata doesn't match the schema of the event")
 )
 
 type DomainError string
@@ -26,3 +90,97 @@ type DomainError string
 func (err DomainError) Error() string {
 	return string(err)
 }
+
+// IsDomainError reports whether err is, or wraps, a DomainError, as opposed to an infrastructure error.
+func IsDomainError(err error) bool {
+	var domainErr DomainError
+	return errors.As(err, &domainErr)
+}
+
+// BalanceLimitError reports the account whose balance limit would be exceeded by a transaction.
+type BalanceLimitError struct {
+	Account  string
+	Currency string
+	Balance  int
+}
+
+func (err BalanceLimitError) Error() string {
+	return fmt.Sprintf("%s: account %s would have a %s balance of %d", ErrBalanceLimitExceeded, err.Account, err.Currency, err.Balance)
+}
+
+func (err BalanceLimitError) Unwrap() error {
+	return ErrBalanceLimitExceeded
+}
+
+// AccountNotInChartError reports the account that doesn't match any pattern of the chart of accounts.
+type AccountNotInChartError struct {
+	Account string
+}
+
+func (err AccountNotInChartError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrAccountNotInChart, err.Account)
+}
+
+func (err AccountNotInChartError) Unwrap() error {
+	return ErrAccountNotInChart
+}
+
+// CompetenceLockError reports the lock date that rejected a transaction, along with the company and the
+// event of the lock, which is 0 for the lock of the whole company.
+type CompetenceLockError struct {
+	Company  string
+	Event    uint32
+	LockDate time.Time
+}
+
+func (err CompetenceLockError) Error() string {
+	if err.Event == 0 {
+		return fmt.Sprintf("%s: company %s is locked until %s", ErrCompetenceDateLocked, err.Company, err.LockDate.UTC().Format(time.RFC3339))
+	}
+
+	return fmt.Sprintf("%s: event %d of company %s is locked until %s", ErrCompetenceDateLocked, err.Event, err.Company, err.LockDate.UTC().Format(time.RFC3339))
+}
+
+func (err CompetenceLockError) Unwrap() error {
+	return ErrCompetenceDateLocked
+}
+
+// TransactionConflictError reports how a transaction differs from the one already stored
+// under the same transaction or entry ids.
+type TransactionConflictError struct {
+	Differences []string
+}
+
+func (err TransactionConflictError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrTransactionConflict, strings.Join(err.Differences, "; "))
+}
+
+func (err TransactionConflictError) Unwrap() error {
+	return ErrTransactionConflict
+}
+
+// MetadataSchemaError reports the fields of the entries metadata that don't match the schema of the event.
+type MetadataSchemaError struct {
+	Event      uint32
+	Version    int
+	Violations []MetadataViolation
+}
+
+// MetadataViolation is a field, like entries[id=<entry id>].metadata.order_id, and what's wrong with it.
+type MetadataViolation struct {
+	Field       string
+	Description string
+}
+
+func (err MetadataSchemaError) Error() string {
+	violations := make([]string, 0, len(err.Violations))
+	for _, violation := range err.Violations {
+		violations = append(violations, fmt.Sprintf("%s: %s", violation.Field, violation.Description))
+	}
+
+	return fmt.Sprintf("%s %d (version %d): %s", ErrMetadataSchemaViolation, err.Event, err.Version, strings.Join(violations, "; "))
+}
+
+func (err MetadataSchemaError) Unwrap() error {
+	return ErrMetadataSchemaViolation
+}
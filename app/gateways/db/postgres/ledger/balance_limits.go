@@ -0,0 +1,122 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/instrumentation/newrelic"
+)
+
+const setBalanceLimitQuery = `
+insert into balance_limit (account, currency, min_balance, max_balance)
+values ($1, $2, $3, $4)
+on conflict (account, currency) do update
+set
+	min_balance = excluded.min_balance,
+	max_balance = excluded.max_balance,
+	updated_at = now();
+`
+
+const deleteBalanceLimitQuery = `
+delete from balance_limit
+where
+	account = $1 and currency = $2;
+`
+
+const listBalanceLimitsQuery = `
+select
+	account,
+	currency,
+	min_balance,
+	max_balance
+from
+	balance_limit
+order by
+	account, currency
+;
+`
+
+func (r Repository) SetBalanceLimit(ctx context.Context, limit vos.BalanceLimit) error {
+	const operation = "Repository.SetBalanceLimit"
+
+	defer newrelic.NewDatastoreSegment(ctx, balanceLimitCollection, operation, setBalanceLimitQuery).End()
+
+	_, err := r.db.Exec(
+		ctx,
+		setBalanceLimitQuery,
+		limit.Account.Value(),
+		limit.Currency,
+		limit.MinBalance,
+		limit.MaxBalance,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set balance limit: %w", err)
+	}
+
+	return nil
+}
+
+func (r Repository) DeleteBalanceLimit(ctx context.Context, account vos.Account, currency vos.Currency) error {
+	const operation = "Repository.DeleteBalanceLimit"
+
+	defer newrelic.NewDatastoreSegment(ctx, balanceLimitCollection, operation, deleteBalanceLimitQuery).End()
+
+	tag, err := r.db.Exec(ctx, deleteBalanceLimitQuery, account.Value(), currency)
+	if err != nil {
+		return fmt.Errorf("failed to delete balance limit: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return app.ErrBalanceLimitNotFound
+	}
+
+	return nil
+}
+
+func (r Repository) ListBalanceLimits(ctx context.Context) ([]vos.BalanceLimit, error) {
+	const operation = "Repository.ListBalanceLimits"
+
+	defer newrelic.NewDatastoreSegment(ctx, balanceLimitCollection, operation, listBalanceLimitsQuery).End()
+
+	rows, err := r.db.Query(ctx, listBalanceLimitsQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	defer rows.Close()
+
+	limits := make([]vos.BalanceLimit, 0)
+
+	for rows.Next() {
+		var (
+			account    string
+			currency   vos.Currency
+			minBalance *int
+			maxBalance *int
+		)
+
+		if err = rows.Scan(&account, &currency, &minBalance, &maxBalance); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		acc, accErr := vos.NewAccount(account)
+		if accErr != nil {
+			return nil, fmt.Errorf("failed to load balance limit account: %w", accErr)
+		}
+
+		limits = append(limits, vos.BalanceLimit{
+			Account:    acc,
+			Currency:   currency,
+			MinBalance: minBalance,
+			MaxBalance: maxBalance,
+		})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s rows have error: %w", operation, err)
+	}
+
+	return limits, nil
+}
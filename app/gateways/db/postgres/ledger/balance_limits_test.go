@@ -0,0 +1,284 @@
+package ledger
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+)
+
+func newBalanceLimit(t *testing.T, account string, minBalance, maxBalance *int) vos.BalanceLimit {
+	t.Helper()
+
+	acc, err := vos.NewAccount(account)
+	require.NoError(t, err)
+
+	limit, err := vos.NewBalanceLimit(acc, vos.DefaultCurrency, minBalance, maxBalance)
+	require.NoError(t, err)
+
+	return limit
+}
+
+func TestLedgerRepository_BalanceLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	floor, overdraft := 0, -500
+
+	limit := newBalanceLimit(t, "liability.clients.available.*", &floor, nil)
+
+	err := r.SetBalanceLimit(ctx, limit)
+	assert.NoError(t, err)
+
+	got, err := r.ListBalanceLimits(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []vos.BalanceLimit{limit}, got)
+
+	limit.MinBalance = &overdraft
+
+	err = r.SetBalanceLimit(ctx, limit)
+	assert.NoError(t, err)
+
+	got, err = r.ListBalanceLimits(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []vos.BalanceLimit{limit}, got)
+
+	err = r.DeleteBalanceLimit(ctx, limit.Account, limit.Currency)
+	assert.NoError(t, err)
+
+	err = r.DeleteBalanceLimit(ctx, limit.Account, limit.Currency)
+	assert.ErrorIs(t, err, app.ErrBalanceLimitNotFound)
+
+	got, err = r.ListBalanceLimits(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestLedgerRepository_CreateTransactionBalanceLimits(t *testing.T) {
+	t.Parallel()
+
+	floor, overdraft, ceiling := 0, -100, 150
+
+	testCases := []struct {
+		name        string
+		limits      func(account string) []vos.BalanceLimit
+		amount      int
+		expectedErr error
+	}{
+		{
+			name:        "accepts debits without limits",
+			limits:      func(string) []vos.BalanceLimit { return nil },
+			amount:      300,
+			expectedErr: nil,
+		},
+		{
+			name: "accepts debits down to the floor",
+			limits: func(account string) []vos.BalanceLimit {
+				return []vos.BalanceLimit{newBalanceLimit(t, account, &floor, nil)}
+			},
+			amount:      100,
+			expectedErr: nil,
+		},
+		{
+			name: "rejects debits below the floor of an analytic account",
+			limits: func(account string) []vos.BalanceLimit {
+				return []vos.BalanceLimit{newBalanceLimit(t, account, &floor, nil)}
+			},
+			amount:      101,
+			expectedErr: app.ErrBalanceLimitExceeded,
+		},
+		{
+			name: "accepts debits within the overdraft of a pattern",
+			limits: func(string) []vos.BalanceLimit {
+				return []vos.BalanceLimit{newBalanceLimit(t, "liability.clients.available.*", &overdraft, nil)}
+			},
+			amount:      200,
+			expectedErr: nil,
+		},
+		{
+			name: "rejects debits beyond the overdraft of a pattern",
+			limits: func(string) []vos.BalanceLimit {
+				return []vos.BalanceLimit{newBalanceLimit(t, "liability.clients.available.*", &overdraft, nil)}
+			},
+			amount:      201,
+			expectedErr: app.ErrBalanceLimitExceeded,
+		},
+		{
+			name: "enforces the strictest of overlapping limits",
+			limits: func(account string) []vos.BalanceLimit {
+				return []vos.BalanceLimit{
+					newBalanceLimit(t, "liability.clients.available.*", &overdraft, nil),
+					newBalanceLimit(t, account, &floor, nil),
+				}
+			},
+			amount:      150,
+			expectedErr: app.ErrBalanceLimitExceeded,
+		},
+		{
+			name: "rejects credits above the ceiling of the counterpart",
+			limits: func(string) []vos.BalanceLimit {
+				return []vos.BalanceLimit{newBalanceLimit(t, "liability.clients.counterpart", nil, &ceiling)}
+			},
+			amount:      151,
+			expectedErr: app.ErrBalanceLimitExceeded,
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+			account := testdata.GenerateAccountPath()
+			const counterpart = "liability.clients.counterpart"
+
+			// account starts with a balance of 100
+			createTransaction(t, ctx, r,
+				createEntry(t, vos.CreditOperation, account, vos.IgnoreAccountVersion, 100),
+				createEntry(t, vos.DebitOperation, "asset.bank.cash", vos.IgnoreAccountVersion, 100),
+			)
+
+			for _, limit := range tt.limits(account) {
+				require.NoError(t, r.SetBalanceLimit(ctx, limit))
+			}
+
+			tx, err := entities.NewTransaction(uuid.New(), 1, "abc", time.Now(),
+				createEntry(t, vos.DebitOperation, account, vos.IgnoreAccountVersion, tt.amount),
+				createEntry(t, vos.CreditOperation, counterpart, vos.IgnoreAccountVersion, tt.amount),
+			)
+			require.NoError(t, err)
+
+			_, err = r.CreateTransaction(ctx, tx)
+			assert.ErrorIs(t, err, tt.expectedErr)
+
+			// a rejected transaction must not leave any of its entries behind
+			got, err := r.GetTransaction(ctx, tx.ID)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, app.ErrTransactionNotFound)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, got.Entries, 2)
+		})
+	}
+}
+
+func TestLedgerRepository_CreateTransactionBalanceLimitsConcurrency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	account := testdata.GenerateAccountPath()
+
+	createTransaction(t, ctx, r,
+		createEntry(t, vos.CreditOperation, account, vos.IgnoreAccountVersion, 100),
+		createEntry(t, vos.DebitOperation, "asset.bank.cash", vos.IgnoreAccountVersion, 100),
+	)
+
+	floor := 0
+	require.NoError(t, r.SetBalanceLimit(ctx, newBalanceLimit(t, account, &floor, nil)))
+
+	const workers = 10
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		accepted int
+	)
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			tx, err := entities.NewTransaction(uuid.New(), 1, "abc", time.Now(),
+				createEntry(t, vos.DebitOperation, account, vos.IgnoreAccountVersion, 30),
+				createEntry(t, vos.CreditOperation, "asset.bank.cash", vos.IgnoreAccountVersion, 30),
+			)
+			assert.NoError(t, err)
+
+			_, err = r.CreateTransaction(ctx, tx)
+			if err == nil {
+				mu.Lock()
+				accepted++
+				mu.Unlock()
+
+				return
+			}
+
+			assert.ErrorIs(t, err, app.ErrBalanceLimitExceeded)
+		}()
+	}
+
+	wg.Wait()
+
+	// only three debits of 30 fit into a balance of 100
+	assert.Equal(t, 3, accepted)
+}
+
+func TestLedgerRepository_CreateTransactionBalanceLimitsSnapshot(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	account := testdata.GenerateAccountPath()
+
+	floor := 0
+	require.NoError(t, r.SetBalanceLimit(ctx, newBalanceLimit(t, account, &floor, nil)))
+
+	newTransaction := func(operation vos.OperationType, amount int) entities.Transaction {
+		counterpart := vos.DebitOperation
+		if operation == vos.DebitOperation {
+			counterpart = vos.CreditOperation
+		}
+
+		tx, err := entities.NewTransaction(uuid.New(), 1, "abc", time.Now(),
+			createEntry(t, operation, account, vos.IgnoreAccountVersion, amount),
+			createEntry(t, counterpart, "asset.bank.cash", vos.IgnoreAccountVersion, amount),
+		)
+		require.NoError(t, err)
+
+		return tx
+	}
+
+	for i := 0; i < 3; i++ {
+		_, err := r.CreateTransaction(ctx, newTransaction(vos.CreditOperation, 100))
+		require.NoError(t, err)
+	}
+
+	// the checks read the balance through the snapshot, which holds every posting but the latest one
+	var credit int
+	err := r.db.QueryRow(ctx, `select credit from account_balance where account = $1 and currency = $2`, account, vos.DefaultCurrency).Scan(&credit)
+	require.NoError(t, err)
+	assert.Equal(t, 100, credit)
+
+	err = r.CreateTransactions(ctx, []entities.Transaction{
+		newTransaction(vos.DebitOperation, 200),
+		newTransaction(vos.DebitOperation, 101),
+	})
+	assert.ErrorIs(t, err, app.ErrBalanceLimitExceeded, "the debits of a batch add up")
+
+	err = r.CreateTransactions(ctx, []entities.Transaction{
+		newTransaction(vos.DebitOperation, 200),
+		newTransaction(vos.DebitOperation, 100),
+	})
+	assert.NoError(t, err)
+}
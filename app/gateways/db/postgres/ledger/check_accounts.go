@@ -0,0 +1,107 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+// Locks the registered accounts in shared mode so that a concurrent status change waits for the
+// entries to be committed (and vice versa). Advisory locks are used instead of row share locks,
+// which would write a multixact into the rows of the busiest accounts. Accounts are locked by
+// stripe, in a stable order, so a batch takes at most 128 locks however many accounts it posts
+// into, at the cost of a status change also waiting for the postings into its stripe.
+const lockAccountsQuery = `
+select
+	pg_advisory_xact_lock_shared(hashtext('account_status:' || a.stripe))
+from
+	(
+		select distinct
+			hashtext(account::text) & 127 as stripe
+		from
+			account
+		where
+			account = any($1::text[]::ltree[])
+		order by
+			stripe
+	) a
+;
+`
+
+// Locks the stripe of the account, like lockAccountsQuery, before its status is changed.
+const lockAccountQuery = `
+select pg_advisory_xact_lock(hashtext('account_status:' || (hashtext($1::text) & 127)));
+`
+
+// The accounts are read once locked, so a status change committed in the meantime is seen.
+const checkAccountsQuery = `
+select
+	account,
+	status
+from
+	account
+where
+	account = any($1::text[]::ltree[])
+;
+`
+
+// checkAccounts ensures that every account receiving entries is allowed to
+// do so: registered accounts must be active and, in strict mode, every account
+// must have been opened beforehand.
+func (r Repository) checkAccounts(ctx context.Context, tx pgx.Tx, entries []entities.Entry) error {
+	accounts := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		accounts = append(accounts, entry.Account.Value())
+	}
+
+	if _, err := tx.Exec(ctx, lockAccountsQuery, accounts); err != nil {
+		return fmt.Errorf("failed to lock accounts: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, checkAccountsQuery, accounts)
+	if err != nil {
+		return fmt.Errorf("failed to check accounts: %w", err)
+	}
+
+	defer rows.Close()
+
+	opened := make(map[string]struct{}, len(accounts))
+
+	for rows.Next() {
+		var (
+			account string
+			status  vos.AccountStatus
+		)
+
+		if err = rows.Scan(&account, &status); err != nil {
+			return fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		if status != vos.ActiveAccountStatus {
+			return app.ErrAccountNotActive
+		}
+
+		opened[account] = struct{}{}
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("failed to check accounts: %w", err)
+	}
+
+	if !r.strictAccounts {
+		return nil
+	}
+
+	for _, account := range accounts {
+		if _, ok := opened[account]; !ok {
+			return app.ErrAccountNotOpened
+		}
+	}
+
+	return nil
+}
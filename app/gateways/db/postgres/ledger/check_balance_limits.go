@@ -0,0 +1,164 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+// When several limits match the same account, the strictest bounds win.
+const getBalanceLimitsQuery = `
+select
+	e.account,
+	e.currency,
+	max(l.min_balance),
+	min(l.max_balance)
+from
+	unnest($1::text[], $2::text[]) as e(account, currency)
+	join balance_limit l on l.currency = e.currency and e.account::ltree ~ l.account::lquery
+group by
+	e.account, e.currency
+order by
+	e.account, e.currency
+;
+`
+
+// Serializes postings into the same limited account and currency until the end of the database transaction.
+const lockAccountBalanceQuery = `
+select pg_advisory_xact_lock(hashtext($1::text || ':' || $2::text));
+`
+
+// The balance is read through the balance snapshot of the account, which it also refreshes, so each check
+// only sums the entries posted since the previous one. The function fails for accounts without entries,
+// so it's only called for accounts that have some.
+const limitedAccountBalanceQuery = `
+select
+	case
+		when exists (select 1 from entry where account = $1 and currency = $2)
+		then (select total_credit - total_debit from get_analytic_account_balance($1, $2))
+		else 0
+	end
+;
+`
+
+// Limits apply to the available balance, so amounts held by pending transactions can't be spent twice.
+const limitedAccountHeldAmountQuery = `
+select get_held_amount($1::text::lquery, $2);
+`
+
+// limitedBalance is the balance of a limited account before the entries being posted.
+type limitedBalance struct {
+	limit   vos.BalanceLimit
+	balance int
+}
+
+// lockBalanceLimits returns the balance limits that apply to the given entries, along with the balances
+// of the limited accounts, locking each of them so that concurrent postings can't both pass the check
+// against the same starting balance. Locks are acquired in a stable order to avoid deadlocks.
+func (r Repository) lockBalanceLimits(ctx context.Context, tx pgx.Tx, entries []entities.Entry) ([]limitedBalance, error) {
+	type key struct {
+		account  string
+		currency vos.Currency
+	}
+
+	seen := make(map[key]struct{}, len(entries))
+	accounts := make([]string, 0, len(entries))
+	currencies := make([]string, 0, len(entries))
+
+	for _, entry := range entries {
+		k := key{account: entry.Account.Value(), currency: entry.Currency}
+		if _, ok := seen[k]; ok {
+			continue
+		}
+
+		seen[k] = struct{}{}
+		accounts = append(accounts, k.account)
+		currencies = append(currencies, k.currency.String())
+	}
+
+	rows, err := tx.Query(ctx, getBalanceLimitsQuery, accounts, currencies)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance limits: %w", err)
+	}
+
+	defer rows.Close()
+
+	var limits []limitedBalance
+
+	for rows.Next() {
+		var (
+			account string
+			limit   vos.BalanceLimit
+		)
+
+		if err = rows.Scan(&account, &limit.Currency, &limit.MinBalance, &limit.MaxBalance); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		if limit.Account, err = vos.NewAnalyticAccount(account); err != nil {
+			return nil, fmt.Errorf("failed to load limited account: %w", err)
+		}
+
+		limits = append(limits, limitedBalance{limit: limit})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get balance limits: %w", err)
+	}
+
+	for i, limited := range limits {
+		account, currency := limited.limit.Account.Value(), limited.limit.Currency.String()
+
+		if _, err = tx.Exec(ctx, lockAccountBalanceQuery, account, currency); err != nil {
+			return nil, fmt.Errorf("failed to lock account balance: %w", err)
+		}
+
+		if err = tx.QueryRow(ctx, limitedAccountBalanceQuery, account, currency).Scan(&limits[i].balance); err != nil {
+			return nil, fmt.Errorf("failed to get account balance: %w", err)
+		}
+	}
+
+	return limits, nil
+}
+
+// checkBalanceLimits checks the balances locked by lockBalanceLimits plus the given entries. It must run
+// after the entries, or the holds, were inserted, so the held amounts include them.
+func (r Repository) checkBalanceLimits(ctx context.Context, tx pgx.Tx, limits []limitedBalance, entries []entities.Entry) error {
+	for _, limited := range limits {
+		var held int
+
+		err := tx.QueryRow(ctx, limitedAccountHeldAmountQuery, limited.limit.Account.Value(), limited.limit.Currency).Scan(&held)
+		if err != nil {
+			return fmt.Errorf("failed to get held amount: %w", err)
+		}
+
+		balance := limited.balance - held
+
+		for _, entry := range entries {
+			if entry.Account.Value() != limited.limit.Account.Value() || entry.Currency != limited.limit.Currency {
+				continue
+			}
+
+			if entry.Operation == vos.CreditOperation {
+				balance += entry.Amount
+			} else {
+				balance -= entry.Amount
+			}
+		}
+
+		if !limited.limit.Allows(balance) {
+			return app.BalanceLimitError{
+				Account:  limited.limit.Account.Value(),
+				Currency: limited.limit.Currency.String(),
+				Balance:  balance,
+			}
+		}
+	}
+
+	return nil
+}
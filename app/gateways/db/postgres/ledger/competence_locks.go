@@ -0,0 +1,349 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/instrumentation/newrelic"
+)
+
+// Serializes the changes of the locks of the same company, so each change records the date it replaced.
+const lockCompetenceLocksQuery = `
+select pg_advisory_xact_lock(hashtext('competence_lock:' || $1));
+`
+
+const getCompetenceLockDateQuery = `
+select
+	lock_date
+from
+	competence_lock
+where
+	company = $1 and event = $2
+;
+`
+
+const upsertCompetenceLockQuery = `
+insert into competence_lock (company, event, lock_date, updated_by, updated_at)
+values ($1, $2, $3, $4, $5)
+on conflict (company, event) do update
+set
+	lock_date = excluded.lock_date,
+	updated_by = excluded.updated_by,
+	updated_at = excluded.updated_at
+;
+`
+
+const deleteCompetenceLockQuery = `
+delete from competence_lock
+where
+	company = $1 and event = $2
+;
+`
+
+const insertCompetenceLockChangeQuery = `
+insert into competence_lock_change (company, event, previous_date, lock_date, requested_by, reason, created_at)
+values ($1, $2, $3, $4, $5, $6, $7);
+`
+
+const listCompetenceLocksQuery = `
+select
+	company,
+	event,
+	lock_date,
+	updated_by,
+	updated_at
+from
+	competence_lock
+where
+	company = $1
+order by
+	event
+;
+`
+
+const listCompetenceLockChangesQuery = `
+select
+	company,
+	event,
+	previous_date,
+	lock_date,
+	requested_by,
+	reason,
+	created_at
+from
+	competence_lock_change
+where
+	company = $1
+order by
+	id
+;
+`
+
+// Locks the locks in share mode so that a concurrent change waits for the entries to be committed.
+const checkCompetenceLocksQuery = `
+select
+	company,
+	event,
+	lock_date,
+	updated_by,
+	updated_at
+from
+	competence_lock
+where
+	company = any($1)
+for share;
+`
+
+const insertAdjustmentAuthorizationsQuery = `
+insert into adjustment_authorization (tx_id, requested_by, reason)
+select * from unnest($1::uuid[], $2::text[], $3::text[]);
+`
+
+const getAdjustmentAuthorizationQuery = `
+select
+	requested_by,
+	reason
+from
+	adjustment_authorization
+where
+	tx_id = $1
+;
+`
+
+// ChangeCompetenceLock moves, or removes, a lock date and records the change, returning it
+// along with the date it replaced.
+func (r Repository) ChangeCompetenceLock(ctx context.Context, change entities.CompetenceLockChange) (entities.CompetenceLockChange, error) {
+	const operation = "Repository.ChangeCompetenceLock"
+
+	defer newrelic.NewDatastoreSegment(ctx, competenceLockCollection, operation, upsertCompetenceLockQuery).End()
+
+	err := r.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, lockCompetenceLocksQuery, change.Company)
+		if err != nil {
+			return fmt.Errorf("failed to lock competence locks: %w", err)
+		}
+
+		var previous *time.Time
+		err = tx.QueryRow(ctx, getCompetenceLockDateQuery, change.Company, change.Event).Scan(&previous)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("failed to get competence lock: %w", err)
+		}
+
+		if previous != nil {
+			change.PreviousDate = *previous
+		}
+
+		var date *time.Time
+		if change.Removes() {
+			if previous == nil {
+				return app.ErrCompetenceLockNotFound
+			}
+
+			_, err = tx.Exec(ctx, deleteCompetenceLockQuery, change.Company, change.Event)
+		} else {
+			date = &change.Date
+			_, err = tx.Exec(ctx, upsertCompetenceLockQuery, change.Company, change.Event, change.Date, change.RequestedBy, change.CreatedAt)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to change competence lock: %w", err)
+		}
+
+		_, err = tx.Exec(
+			ctx,
+			insertCompetenceLockChangeQuery,
+			change.Company,
+			change.Event,
+			previous,
+			date,
+			change.RequestedBy,
+			change.Reason,
+			change.CreatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert competence lock change: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entities.CompetenceLockChange{}, err
+	}
+
+	return change, nil
+}
+
+func (r Repository) ListCompetenceLocks(ctx context.Context, company string) ([]entities.CompetenceLock, error) {
+	const operation = "Repository.ListCompetenceLocks"
+
+	defer newrelic.NewDatastoreSegment(ctx, competenceLockCollection, operation, listCompetenceLocksQuery).End()
+
+	locks, err := r.queryCompetenceLocks(ctx, r.db, listCompetenceLocksQuery, company)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list competence locks: %w", err)
+	}
+
+	return locks, nil
+}
+
+func (r Repository) ListCompetenceLockChanges(ctx context.Context, company string) ([]entities.CompetenceLockChange, error) {
+	const operation = "Repository.ListCompetenceLockChanges"
+
+	defer newrelic.NewDatastoreSegment(ctx, competenceLockCollection, operation, listCompetenceLockChangesQuery).End()
+
+	rows, err := r.db.Query(ctx, listCompetenceLockChangesQuery, company)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list competence lock changes: %w", err)
+	}
+
+	defer rows.Close()
+
+	changes := make([]entities.CompetenceLockChange, 0)
+
+	for rows.Next() {
+		var (
+			change         entities.CompetenceLockChange
+			previous, date *time.Time
+		)
+
+		err = rows.Scan(
+			&change.Company,
+			&change.Event,
+			&previous,
+			&date,
+			&change.RequestedBy,
+			&change.Reason,
+			&change.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		if previous != nil {
+			change.PreviousDate = *previous
+		}
+
+		if date != nil {
+			change.Date = *date
+		}
+
+		changes = append(changes, change)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list competence lock changes: %w", err)
+	}
+
+	return changes, nil
+}
+
+// checkCompetenceLocks ensures that no transaction, unless it's an adjustment, has a competence date
+// on or before the lock date of its company or event.
+func (r Repository) checkCompetenceLocks(ctx context.Context, tx pgx.Tx, transactions ...entities.Transaction) error {
+	companies := make([]string, 0, 1)
+	seen := make(map[string]struct{}, 1)
+
+	for _, transaction := range transactions {
+		if transaction.Adjustment {
+			continue
+		}
+
+		if _, ok := seen[transaction.Company]; ok {
+			continue
+		}
+
+		seen[transaction.Company] = struct{}{}
+		companies = append(companies, transaction.Company)
+	}
+
+	if len(companies) == 0 {
+		return nil
+	}
+
+	locks, err := r.queryCompetenceLocks(ctx, tx, checkCompetenceLocksQuery, companies)
+	if err != nil {
+		return fmt.Errorf("failed to check competence locks: %w", err)
+	}
+
+	for _, transaction := range transactions {
+		if err = transaction.CheckCompetenceLocks(locks); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insertAdjustmentAuthorizations records who authorized each of the adjustments and why.
+func (r Repository) insertAdjustmentAuthorizations(ctx context.Context, db executor, transactions ...entities.Transaction) error {
+	var (
+		ids                 []uuid.UUID
+		requesters, reasons []string
+	)
+
+	for _, transaction := range transactions {
+		if !transaction.Adjustment {
+			continue
+		}
+
+		ids = append(ids, transaction.ID)
+		requesters = append(requesters, transaction.Authorization.RequestedBy)
+		reasons = append(reasons, transaction.Authorization.Reason)
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	if _, err := db.Exec(ctx, insertAdjustmentAuthorizationsQuery, ids, requesters, reasons); err != nil {
+		return fmt.Errorf("failed to insert adjustment authorizations: %w", err)
+	}
+
+	return nil
+}
+
+// getAdjustmentAuthorization loads who authorized the adjustment. Adjustments posted before the
+// authorizations were recorded have none.
+func (r Repository) getAdjustmentAuthorization(ctx context.Context, id uuid.UUID) (entities.AdjustmentAuthorization, error) {
+	var authorization entities.AdjustmentAuthorization
+
+	err := r.db.QueryRow(ctx, getAdjustmentAuthorizationQuery, id).Scan(&authorization.RequestedBy, &authorization.Reason)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return entities.AdjustmentAuthorization{}, fmt.Errorf("failed to get adjustment authorization: %w", err)
+	}
+
+	return authorization, nil
+}
+
+func (r Repository) queryCompetenceLocks(ctx context.Context, db querier, query string, args ...interface{}) ([]entities.CompetenceLock, error) {
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	locks := make([]entities.CompetenceLock, 0)
+
+	for rows.Next() {
+		var lock entities.CompetenceLock
+		if err = rows.Scan(&lock.Company, &lock.Event, &lock.Date, &lock.UpdatedBy, &lock.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		locks = append(locks, lock)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return locks, nil
+}
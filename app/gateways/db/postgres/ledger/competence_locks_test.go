@@ -0,0 +1,132 @@
+package ledger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func TestLedgerRepository_ChangeCompetenceLock(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	lockDate := time.Date(2021, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	change, err := entities.NewCompetenceLockChange("company", entities.AllEvents, lockDate, "accountant", "filed", time.Now().Round(time.Microsecond))
+	require.NoError(t, err)
+
+	changed, err := r.ChangeCompetenceLock(ctx, change)
+	require.NoError(t, err)
+	assert.True(t, changed.PreviousDate.IsZero())
+
+	newTransaction := func(t *testing.T, event uint32, date time.Time) entities.Transaction {
+		t.Helper()
+
+		tx, txErr := entities.NewTransaction(uuid.New(), event, "company", date,
+			createEntry(t, vos.DebitOperation, "asset.bank.account", vos.IgnoreAccountVersion, 10),
+			createEntry(t, vos.CreditOperation, "revenue.fees.card", vos.IgnoreAccountVersion, 10),
+		)
+		require.NoError(t, txErr)
+
+		return tx
+	}
+
+	t.Run("should reject entries on or before the lock date", func(t *testing.T) {
+		_, txErr := r.CreateTransaction(ctx, newTransaction(t, 1, lockDate))
+		assert.ErrorIs(t, txErr, app.ErrCompetenceDateLocked)
+
+		txErr = r.CreateTransactions(ctx, []entities.Transaction{
+			newTransaction(t, 1, lockDate.AddDate(0, 0, 1)),
+			newTransaction(t, 1, lockDate.AddDate(0, -1, 0)),
+		})
+		assert.ErrorIs(t, txErr, app.ErrCompetenceDateLocked)
+
+		_, txErr = r.CreateTransaction(ctx, newTransaction(t, 1, lockDate.AddDate(0, 0, 1)))
+		assert.NoError(t, txErr)
+	})
+
+	t.Run("should accept authorized adjustments", func(t *testing.T) {
+		unauthorized := newTransaction(t, 1, lockDate.AddDate(0, -1, 0))
+		unauthorized.Adjustment = true
+
+		_, txErr := r.CreateTransaction(ctx, unauthorized)
+		assert.ErrorIs(t, txErr, app.ErrCompetenceDateLocked)
+
+		adjustment, txErr := newTransaction(t, 1, lockDate.AddDate(0, -1, 0)).Authorize("controller", "late invoice")
+		require.NoError(t, txErr)
+
+		_, txErr = r.CreateTransaction(ctx, adjustment)
+		require.NoError(t, txErr)
+
+		got, txErr := r.GetTransaction(ctx, adjustment.ID)
+		require.NoError(t, txErr)
+		assert.True(t, got.Adjustment)
+		assert.Equal(t, adjustment.Authorization, got.Authorization)
+
+		batched, txErr := newTransaction(t, 1, lockDate.AddDate(0, -2, 0)).Authorize("controller", "missing fee")
+		require.NoError(t, txErr)
+
+		txErr = r.CreateTransactions(ctx, []entities.Transaction{batched, newTransaction(t, 1, lockDate.AddDate(0, 0, 1))})
+		require.NoError(t, txErr)
+
+		got, txErr = r.GetTransaction(ctx, batched.ID)
+		require.NoError(t, txErr)
+		assert.Equal(t, batched.Authorization, got.Authorization)
+	})
+
+	t.Run("should override the lock of the company with the lock of an event", func(t *testing.T) {
+		override, changeErr := entities.NewCompetenceLockChange("company", 2, lockDate.AddDate(0, -3, 0), "accountant", "", time.Now().Round(time.Microsecond))
+		require.NoError(t, changeErr)
+
+		_, changeErr = r.ChangeCompetenceLock(ctx, override)
+		require.NoError(t, changeErr)
+
+		_, txErr := r.CreateTransaction(ctx, newTransaction(t, 2, lockDate.AddDate(0, -1, 0)))
+		assert.NoError(t, txErr)
+
+		_, txErr = r.CreateTransaction(ctx, newTransaction(t, 2, lockDate.AddDate(0, -4, 0)))
+		assert.ErrorIs(t, txErr, app.ErrCompetenceDateLocked)
+	})
+
+	t.Run("should audit the moves of the lock dates", func(t *testing.T) {
+		moved, changeErr := entities.NewCompetenceLockChange("company", entities.AllEvents, lockDate.AddDate(0, 3, 0), "controller", "q3 filed", time.Now().Round(time.Microsecond))
+		require.NoError(t, changeErr)
+
+		moved, changeErr = r.ChangeCompetenceLock(ctx, moved)
+		require.NoError(t, changeErr)
+		assert.Equal(t, lockDate, moved.PreviousDate.UTC())
+
+		removal, changeErr := entities.NewCompetenceLockChange("company", 2, time.Time{}, "controller", "", time.Now().Round(time.Microsecond))
+		require.NoError(t, changeErr)
+
+		_, changeErr = r.ChangeCompetenceLock(ctx, removal)
+		require.NoError(t, changeErr)
+
+		_, changeErr = r.ChangeCompetenceLock(ctx, removal)
+		assert.ErrorIs(t, changeErr, app.ErrCompetenceLockNotFound)
+
+		locks, listErr := r.ListCompetenceLocks(ctx, "company")
+		require.NoError(t, listErr)
+		require.Len(t, locks, 1)
+		assert.Equal(t, "controller", locks[0].UpdatedBy)
+		assert.Equal(t, lockDate.AddDate(0, 3, 0), locks[0].Date.UTC())
+
+		changes, listErr := r.ListCompetenceLockChanges(ctx, "company")
+		require.NoError(t, listErr)
+		require.Len(t, changes, 4)
+		assert.Equal(t, "accountant", changes[0].RequestedBy)
+		assert.Equal(t, "q3 filed", changes[2].Reason)
+		assert.True(t, changes[3].Removes())
+	})
+}
@@ -3,64 +3,212 @@ package ledger
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
 
 	"github.com/stone-co/the-amazing-ledger/app"
 	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
 	"github.com/stone-co/the-amazing-ledger/app/instrumentation/newrelic"
 )
 
 const (
-	numArgs           = 10
+	numArgs           = 12
 	numDefaultQueries = 5
 )
 
 const createTransactionQuery = `
-insert into entry (id, tx_id, event, operation, version, amount, competence_date, account, company, metadata)
-values %s;`
+insert into entry (id, tx_id, event, operation, version, amount, currency, competence_date, account, company, metadata, adjustment)
+values %s
+returning id, version, created_at;`
 
-func (r Repository) CreateTransaction(ctx context.Context, transaction entities.Transaction) error {
+// Reused ids are looked up before the transactions are checked, so that replaying a transaction isn't rejected
+// because of what changed since it was posted, like a deprecated event or a frozen account.
+const checkPostedQuery = `
+select exists (select 1 from entry where tx_id = any($1::uuid[]) or id = any($2::uuid[]));
+`
+
+// CreateTransaction posts the transaction and returns it as stored, with the account versions
+// assigned to its entries and its creation time.
+func (r Repository) CreateTransaction(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
 	const operation = "Repository.CreateTransaction"
 
-	query := r.qb.Build(len(transaction.Entries))
-	args := make([]interface{}, 0)
+	defer newrelic.NewDatastoreSegment(ctx, collection, operation, createTransactionQuery).End()
 
-	for _, entry := range transaction.Entries {
-		args = append(
-			args,
-			entry.ID,
-			transaction.ID,
-			transaction.Event,
-			entry.Operation,
-			entry.Version,
-			entry.Amount,
-			transaction.CompetenceDate,
-			entry.Account.Value(),
-			transaction.Company,
-			entry.Metadata,
-		)
+	var posted entities.Transaction
+
+	err := r.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var err error
+		posted, err = r.postTransaction(ctx, tx, transaction)
+
+		return err
+	})
+	if err != nil {
+		return entities.Transaction{}, err
 	}
 
-	defer newrelic.NewDatastoreSegment(ctx, collection, operation, query).End()
+	return posted, nil
+}
 
-	_, err := r.db.Exec(ctx, query, args...)
+// postTransaction validates the event, the fiscal period, the competence lock and the accounts involved and inserts the transaction entries,
+// along with the authorization of adjustments, enforcing the balance limits of the affected accounts within the given database transaction.
+// It returns the transaction as stored.
+func (r Repository) postTransaction(ctx context.Context, tx pgx.Tx, transaction entities.Transaction) (entities.Transaction, error) {
+	if err := r.checkPosted(ctx, tx, transaction); err != nil {
+		return entities.Transaction{}, err
+	}
+
+	if err := r.checkEvents(ctx, tx, transaction); err != nil {
+		return entities.Transaction{}, err
+	}
+
+	if err := r.checkFiscalPeriods(ctx, tx, transaction); err != nil {
+		return entities.Transaction{}, err
+	}
+
+	if err := r.checkCompetenceLocks(ctx, tx, transaction); err != nil {
+		return entities.Transaction{}, err
+	}
+
+	if err := r.checkAccounts(ctx, tx, transaction.Entries); err != nil {
+		return entities.Transaction{}, err
+	}
+
+	limits, err := r.lockBalanceLimits(ctx, tx, transaction.Entries)
 	if err != nil {
-		var pgErr *pgconn.PgError
-		if ok := errors.As(err, &pgErr); !ok {
-			return err
-		}
+		return entities.Transaction{}, err
+	}
+
+	posted, err := r.insertTransaction(ctx, tx, transaction)
+	if err != nil {
+		return entities.Transaction{}, err
+	}
+
+	if err = r.insertAdjustmentAuthorizations(ctx, tx, transaction); err != nil {
+		return entities.Transaction{}, err
+	}
 
-		switch pgErr.Code {
-		case pgerrcode.RaiseException:
-			return app.ErrInvalidVersion
-		case pgerrcode.UniqueViolation:
-			return app.ErrIdempotencyKeyViolation
-		default:
-			return err
+	if err = r.checkBalanceLimits(ctx, tx, limits, transaction.Entries); err != nil {
+		return entities.Transaction{}, err
+	}
+
+	return posted, nil
+}
+
+// checkPosted fails with ErrIdempotencyKeyViolation when the id of any of the transactions, or of any of
+// their entries, was already posted. Concurrent postings of the same ids are still caught by the insert.
+func (r Repository) checkPosted(ctx context.Context, tx pgx.Tx, transactions ...entities.Transaction) error {
+	ids := make([]uuid.UUID, 0, len(transactions))
+	entryIDs := make([]uuid.UUID, 0, len(transactions)*2)
+
+	for _, transaction := range transactions {
+		ids = append(ids, transaction.ID)
+
+		for _, entry := range transaction.Entries {
+			entryIDs = append(entryIDs, entry.ID)
 		}
 	}
 
+	var posted bool
+	if err := tx.QueryRow(ctx, checkPostedQuery, ids, entryIDs).Scan(&posted); err != nil {
+		return fmt.Errorf("failed to check posted transactions: %w", err)
+	}
+
+	if posted {
+		return app.ErrIdempotencyKeyViolation
+	}
+
 	return nil
 }
+
+// insertTransaction inserts all transaction entries using the given querier, which can be either
+// the connection pool or an ongoing database transaction, and returns a copy of the transaction
+// with the versions assigned to its entries and its creation time.
+func (r Repository) insertTransaction(ctx context.Context, db querier, transaction entities.Transaction) (entities.Transaction, error) {
+	query := r.qb.Build(len(transaction.Entries))
+	args := make([]interface{}, 0, len(transaction.Entries)*numArgs)
+
+	for _, entry := range transaction.Entries {
+		args = appendEntryArgs(args, transaction, entry)
+	}
+
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return entities.Transaction{}, insertError(err)
+	}
+
+	defer rows.Close()
+
+	versions := make(map[uuid.UUID]vos.Version, len(transaction.Entries))
+
+	var createdAt time.Time
+
+	for rows.Next() {
+		var (
+			id      uuid.UUID
+			version vos.Version
+		)
+
+		if err = rows.Scan(&id, &version, &createdAt); err != nil {
+			return entities.Transaction{}, fmt.Errorf("failed to scan inserted entry: %w", err)
+		}
+
+		versions[id] = version
+	}
+
+	if err = rows.Err(); err != nil {
+		return entities.Transaction{}, insertError(err)
+	}
+
+	posted := transaction
+	posted.Entries = make([]entities.Entry, len(transaction.Entries))
+	posted.CreatedAt = createdAt
+
+	for i, entry := range transaction.Entries {
+		entry.Version = versions[entry.ID]
+		posted.Entries[i] = entry
+	}
+
+	return posted, nil
+}
+
+func appendEntryArgs(args []interface{}, transaction entities.Transaction, entry entities.Entry) []interface{} {
+	return append(
+		args,
+		entry.ID,
+		transaction.ID,
+		transaction.Event,
+		entry.Operation,
+		entry.Version,
+		entry.Amount,
+		entry.Currency,
+		transaction.CompetenceDate,
+		entry.Account.Value(),
+		transaction.Company,
+		entry.Metadata,
+		transaction.Adjustment,
+	)
+}
+
+func insertError(err error) error {
+	var pgErr *pgconn.PgError
+	if ok := errors.As(err, &pgErr); !ok {
+		return err
+	}
+
+	switch pgErr.Code {
+	case pgerrcode.RaiseException:
+		return app.ErrInvalidVersion
+	case pgerrcode.UniqueViolation:
+		return app.ErrIdempotencyKeyViolation
+	case pgerrcode.ForeignKeyViolation:
+		return app.ErrUnknownEvent
+	default:
+		return err
+	}
+}
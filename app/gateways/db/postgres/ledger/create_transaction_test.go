@@ -126,8 +126,18 @@ func TestLedgerRepository_CreateTransactionSuccess(t *testing.T) {
 			tx, err := entities.NewTransaction(uuid.New(), uint32(1), "abc", time.Now(), entries...)
 			assert.NoError(t, err)
 
-			err = r.CreateTransaction(ctx, tx)
+			posted, err := r.CreateTransaction(ctx, tx)
 			assert.NoError(t, err)
+			assert.False(t, posted.CreatedAt.IsZero())
+
+			for _, entry := range posted.Entries {
+				switch entry.ID {
+				case e1.ID:
+					assert.Equal(t, tt.expectedEntryVersion, entry.Version)
+				case e2.ID:
+					assert.Equal(t, vos.IgnoreAccountVersion, entry.Version)
+				}
+			}
 
 			assertMetadata(t, ctx, db, e1.ID, e1.Metadata)
 			assertMetadata(t, ctx, db, e2.ID, e2.Metadata)
@@ -232,7 +242,7 @@ func TestLedgerRepository_CreateTransactionFailure(t *testing.T) {
 			tx, err := entities.NewTransaction(uuid.New(), uint32(1), "abc", time.Now(), entries...)
 			assert.NoError(t, err)
 
-			err = r.CreateTransaction(ctx, tx)
+			_, err = r.CreateTransaction(ctx, tx)
 			assert.ErrorIs(t, err, tt.expectedErr)
 
 			assertAccountVersion(t, ctx, db, e1.Account, tt.expectedAccountVersion)
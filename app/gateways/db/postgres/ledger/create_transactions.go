@@ -0,0 +1,160 @@
+package ledger
+
+import (
+	"context"
+	"sort"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/instrumentation/newrelic"
+)
+
+// maxInsertEntries keeps each insert of a batch within the 65535 parameters allowed by Postgres.
+const maxInsertEntries = 65535 / numArgs
+
+// CreateTransactions posts all the transactions within a single database transaction,
+// so either all of them or none are created.
+func (r Repository) CreateTransactions(ctx context.Context, transactions []entities.Transaction) error {
+	const operation = "Repository.CreateTransactions"
+
+	defer newrelic.NewDatastoreSegment(ctx, collection, operation, createTransactionQuery).End()
+
+	return r.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		return r.postTransactions(ctx, tx, transactions)
+	})
+}
+
+// CreateTransactionsBestEffort posts each transaction independently, returning the domain error of each
+// rejected one. The batch is first posted at once, as most batches are fully valid, and only when it's
+// rejected the transactions are posted one by one, each within its own savepoint.
+// Any other error aborts the whole batch.
+func (r Repository) CreateTransactionsBestEffort(ctx context.Context, transactions []entities.Transaction) ([]error, error) {
+	const operation = "Repository.CreateTransactionsBestEffort"
+
+	defer newrelic.NewDatastoreSegment(ctx, collection, operation, createTransactionQuery).End()
+
+	errs := make([]error, len(transactions))
+
+	err := r.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		err := tx.BeginFunc(ctx, func(batch pgx.Tx) error {
+			return r.postTransactions(ctx, batch, transactions)
+		})
+		if err == nil || !app.IsDomainError(err) {
+			return err
+		}
+
+		// every limited account is locked up front, in a stable order, before posting the transactions one by one
+		if _, err = r.lockBalanceLimits(ctx, tx, batchEntries(transactions)); err != nil {
+			return err
+		}
+
+		for i := range transactions {
+			transaction := transactions[i]
+
+			errs[i] = tx.BeginFunc(ctx, func(single pgx.Tx) error {
+				_, err := r.postTransaction(ctx, single, transaction)
+				return err
+			})
+			if errs[i] != nil && !app.IsDomainError(errs[i]) {
+				return errs[i]
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return errs, nil
+}
+
+// postTransactions posts a batch of transactions like postTransaction does for a single one,
+// so balance limits are checked against the balances after the whole batch.
+func (r Repository) postTransactions(ctx context.Context, tx pgx.Tx, transactions []entities.Transaction) error {
+	entries := batchEntries(transactions)
+
+	if err := r.checkPosted(ctx, tx, transactions...); err != nil {
+		return err
+	}
+
+	if err := r.checkEvents(ctx, tx, transactions...); err != nil {
+		return err
+	}
+
+	if err := r.checkFiscalPeriods(ctx, tx, transactions...); err != nil {
+		return err
+	}
+
+	if err := r.checkCompetenceLocks(ctx, tx, transactions...); err != nil {
+		return err
+	}
+
+	if err := r.checkAccounts(ctx, tx, entries); err != nil {
+		return err
+	}
+
+	limits, err := r.lockBalanceLimits(ctx, tx, entries)
+	if err != nil {
+		return err
+	}
+
+	if err = r.insertTransactions(ctx, tx, transactions); err != nil {
+		return err
+	}
+
+	if err = r.insertAdjustmentAuthorizations(ctx, tx, transactions...); err != nil {
+		return err
+	}
+
+	return r.checkBalanceLimits(ctx, tx, limits, entries)
+}
+
+// insertTransactions inserts the entries of all transactions with as few statements as possible.
+// Entries are sorted by account to avoid deadlocks, keeping the order of the entries of each account.
+func (r Repository) insertTransactions(ctx context.Context, db executor, transactions []entities.Transaction) error {
+	type batchEntry struct {
+		transaction *entities.Transaction
+		entry       entities.Entry
+	}
+
+	rows := make([]batchEntry, 0, len(transactions)*2)
+	for i := range transactions {
+		for _, entry := range transactions[i].Entries {
+			rows = append(rows, batchEntry{transaction: &transactions[i], entry: entry})
+		}
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].entry.Account.Value() < rows[j].entry.Account.Value()
+	})
+
+	for start := 0; start < len(rows); start += maxInsertEntries {
+		end := start + maxInsertEntries
+		if end > len(rows) {
+			end = len(rows)
+		}
+
+		args := make([]interface{}, 0, (end-start)*numArgs)
+		for _, row := range rows[start:end] {
+			args = appendEntryArgs(args, *row.transaction, row.entry)
+		}
+
+		if _, err := db.Exec(ctx, r.qb.Build(end-start), args...); err != nil {
+			return insertError(err)
+		}
+	}
+
+	return nil
+}
+
+func batchEntries(transactions []entities.Transaction) []entities.Entry {
+	entries := make([]entities.Entry, 0, len(transactions)*2)
+	for _, transaction := range transactions {
+		entries = append(entries, transaction.Entries...)
+	}
+
+	return entries
+}
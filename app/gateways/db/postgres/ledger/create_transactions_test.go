@@ -0,0 +1,168 @@
+package ledger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+)
+
+func newTransaction(t *testing.T, entries ...entities.Entry) entities.Transaction {
+	t.Helper()
+
+	tx, err := entities.NewTransaction(uuid.New(), uint32(1), "abc", time.Now().Round(time.Microsecond), entries...)
+	require.NoError(t, err)
+
+	return tx
+}
+
+func TestLedgerRepository_CreateTransactions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	account := testdata.GenerateAccountPath()
+	const cash = "asset.bank.cash"
+
+	batch := make([]entities.Transaction, 0, 10)
+	for i := 0; i < 10; i++ {
+		batch = append(batch, newTransaction(t,
+			createEntry(t, vos.CreditOperation, account, vos.NextAccountVersion, 10),
+			createEntry(t, vos.DebitOperation, cash, vos.IgnoreAccountVersion, 10),
+		))
+	}
+
+	err := r.CreateTransactions(ctx, batch)
+	assert.NoError(t, err)
+
+	assertBalance(t, ctx, r, account, 100, 100)
+
+	acc, err := vos.NewAccount(account)
+	require.NoError(t, err)
+
+	balance, err := r.GetAnalyticAccountBalance(ctx, acc, vos.DefaultCurrency)
+	require.NoError(t, err)
+	assert.Equal(t, vos.Version(10), balance.CurrentVersion)
+
+	got, err := r.GetTransaction(ctx, batch[9].ID)
+	assert.NoError(t, err)
+	assert.Len(t, got.Entries, 2)
+
+	// a single rejected transaction rejects the whole batch
+	err = r.CreateTransactions(ctx, []entities.Transaction{
+		newTransaction(t,
+			createEntry(t, vos.CreditOperation, account, vos.IgnoreAccountVersion, 50),
+			createEntry(t, vos.DebitOperation, cash, vos.IgnoreAccountVersion, 50),
+		),
+		newTransaction(t,
+			createEntry(t, vos.CreditOperation, account, vos.Version(3), 50),
+			createEntry(t, vos.DebitOperation, cash, vos.IgnoreAccountVersion, 50),
+		),
+	})
+	assert.ErrorIs(t, err, app.ErrInvalidVersion)
+
+	assertBalance(t, ctx, r, account, 100, 100)
+}
+
+func TestLedgerRepository_CreateTransactionsBalanceLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	account := testdata.GenerateAccountPath()
+	const cash = "asset.bank.cash"
+
+	floor := 0
+	require.NoError(t, r.SetBalanceLimit(ctx, newBalanceLimit(t, account, &floor, nil)))
+
+	// limits are checked against the balances after the whole batch
+	err := r.CreateTransactions(ctx, []entities.Transaction{
+		newTransaction(t,
+			createEntry(t, vos.DebitOperation, account, vos.IgnoreAccountVersion, 50),
+			createEntry(t, vos.CreditOperation, cash, vos.IgnoreAccountVersion, 50),
+		),
+		newTransaction(t,
+			createEntry(t, vos.CreditOperation, account, vos.IgnoreAccountVersion, 80),
+			createEntry(t, vos.DebitOperation, cash, vos.IgnoreAccountVersion, 80),
+		),
+	})
+	assert.NoError(t, err)
+
+	assertBalance(t, ctx, r, account, 30, 30)
+
+	err = r.CreateTransactions(ctx, []entities.Transaction{
+		newTransaction(t,
+			createEntry(t, vos.DebitOperation, account, vos.IgnoreAccountVersion, 20),
+			createEntry(t, vos.CreditOperation, cash, vos.IgnoreAccountVersion, 20),
+		),
+		newTransaction(t,
+			createEntry(t, vos.DebitOperation, account, vos.IgnoreAccountVersion, 20),
+			createEntry(t, vos.CreditOperation, cash, vos.IgnoreAccountVersion, 20),
+		),
+	})
+	assert.ErrorIs(t, err, app.ErrBalanceLimitExceeded)
+
+	assertBalance(t, ctx, r, account, 30, 30)
+}
+
+func TestLedgerRepository_CreateTransactionsBestEffort(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	account := testdata.GenerateAccountPath()
+	const cash = "asset.bank.cash"
+
+	createTransaction(t, ctx, r,
+		createEntry(t, vos.CreditOperation, account, vos.IgnoreAccountVersion, 30),
+		createEntry(t, vos.DebitOperation, cash, vos.IgnoreAccountVersion, 30),
+	)
+
+	floor := 0
+	require.NoError(t, r.SetBalanceLimit(ctx, newBalanceLimit(t, account, &floor, nil)))
+
+	posted := createTransaction(t, ctx, r,
+		createEntry(t, vos.CreditOperation, testdata.GenerateAccountPath(), vos.IgnoreAccountVersion, 10),
+		createEntry(t, vos.DebitOperation, cash, vos.IgnoreAccountVersion, 10),
+	)
+
+	debit := func(amount int) entities.Transaction {
+		return newTransaction(t,
+			createEntry(t, vos.DebitOperation, account, vos.IgnoreAccountVersion, amount),
+			createEntry(t, vos.CreditOperation, cash, vos.IgnoreAccountVersion, amount),
+		)
+	}
+
+	t.Run("all transactions accepted", func(t *testing.T) {
+		errs, err := r.CreateTransactionsBestEffort(ctx, []entities.Transaction{debit(5), debit(5)})
+		assert.NoError(t, err)
+		assert.Equal(t, []error{nil, nil}, errs)
+
+		assertBalance(t, ctx, r, account, 20, 20)
+	})
+
+	t.Run("rejected transactions don't affect the others", func(t *testing.T) {
+		// the second debit would take the account below its floor, but the following ones still fit
+		errs, err := r.CreateTransactionsBestEffort(ctx, []entities.Transaction{debit(10), debit(15), posted, debit(5)})
+		assert.NoError(t, err)
+		require.Len(t, errs, 4)
+		assert.NoError(t, errs[0])
+		assert.ErrorIs(t, errs[1], app.ErrBalanceLimitExceeded)
+		assert.ErrorIs(t, errs[2], app.ErrIdempotencyKeyViolation)
+		assert.NoError(t, errs[3])
+
+		assertBalance(t, ctx, r, account, 5, 5)
+	})
+}
@@ -0,0 +1,429 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/instrumentation/newrelic"
+)
+
+const createEventQuery = `
+insert into event (id, name, description)
+values ($1, $2, $3)
+returning created_at;
+`
+
+const getEventQuery = `
+select
+	id,
+	name,
+	description,
+	deprecated_at,
+	created_at
+from
+	event
+where
+	id = $1
+;
+`
+
+const listEventsQuery = `
+select
+	id,
+	name,
+	description,
+	deprecated_at,
+	created_at
+from
+	event
+where
+	$1 or deprecated_at is null
+order by
+	id
+;
+`
+
+// The deprecation only applies once, so concurrent deprecations don't overwrite the date.
+const deprecateEventQuery = `
+update event
+set
+	deprecated_at = $2
+where
+	id = $1 and deprecated_at is null
+;
+`
+
+// Locks the event so that it's deprecated, or a version of its schema is created, one at a time and
+// after the entries being posted with it are committed. Postings lock it in shared mode.
+const lockEventQuery = `
+select pg_advisory_xact_lock(hashtext('event:' || $1::smallint));
+`
+
+const createEventMetadataSchemaQuery = `
+insert into event_metadata_schema (event, version, schema)
+select
+	$1,
+	coalesce(max(version), 0) + 1,
+	$2
+from
+	event_metadata_schema
+where
+	event = $1
+returning version, created_at;
+`
+
+const listEventMetadataSchemasQuery = `
+select
+	event,
+	version,
+	schema,
+	created_at
+from
+	event_metadata_schema
+where
+	event = $1
+order by
+	version desc
+;
+`
+
+// Locks the events in shared mode, in a stable order, so that a concurrent deprecation, or a new version
+// of their schemas, waits for the entries to be committed (and vice versa). Advisory locks are used
+// instead of row share locks, which would write a multixact into the rows of the busiest events.
+const lockEventsQuery = `
+select
+	pg_advisory_xact_lock_shared(hashtext('event:' || e.id))
+from
+	(select id from unnest($1::smallint[]) as id order by id) e
+;
+`
+
+// The events are read once locked, so a deprecation committed in the meantime is seen.
+const checkEventsQuery = `
+select
+	e.id,
+	e.deprecated_at is not null,
+	s.version,
+	s.schema
+from
+	event e
+	left join lateral (
+		select
+			version,
+			schema
+		from
+			event_metadata_schema
+		where
+			event = e.id
+		order by
+			version desc
+		limit 1
+	) s on true
+where
+	e.id = any($1::smallint[])
+;
+`
+
+func (r Repository) CreateEvent(ctx context.Context, event entities.Event) (entities.Event, error) {
+	const operation = "Repository.CreateEvent"
+
+	defer newrelic.NewDatastoreSegment(ctx, eventCollection, operation, createEventQuery).End()
+
+	err := r.db.QueryRow(ctx, createEventQuery, event.ID, event.Name, event.Description).Scan(&event.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return entities.Event{}, app.ErrEventAlreadyExists
+		}
+
+		return entities.Event{}, fmt.Errorf("failed to insert event: %w", err)
+	}
+
+	return event, nil
+}
+
+func (r Repository) GetEvent(ctx context.Context, id uint32) (entities.Event, error) {
+	const operation = "Repository.GetEvent"
+
+	defer newrelic.NewDatastoreSegment(ctx, eventCollection, operation, getEventQuery).End()
+
+	event, err := scanEvent(r.db.QueryRow(ctx, getEventQuery, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.Event{}, app.ErrEventNotFound
+		}
+
+		return entities.Event{}, fmt.Errorf("failed to get event: %w", err)
+	}
+
+	return event, nil
+}
+
+func (r Repository) ListEvents(ctx context.Context, includeDeprecated bool) ([]entities.Event, error) {
+	const operation = "Repository.ListEvents"
+
+	defer newrelic.NewDatastoreSegment(ctx, eventCollection, operation, listEventsQuery).End()
+
+	rows, err := r.db.Query(ctx, listEventsQuery, includeDeprecated)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	defer rows.Close()
+
+	events := make([]entities.Event, 0)
+
+	for rows.Next() {
+		event, scanErr := scanEvent(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", scanErr)
+		}
+
+		events = append(events, event)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	return events, nil
+}
+
+func (r Repository) DeprecateEvent(ctx context.Context, event entities.Event) error {
+	const operation = "Repository.DeprecateEvent"
+
+	defer newrelic.NewDatastoreSegment(ctx, eventCollection, operation, deprecateEventQuery).End()
+
+	return r.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, lockEventQuery, event.ID); err != nil {
+			return fmt.Errorf("failed to lock event: %w", err)
+		}
+
+		tag, err := tx.Exec(ctx, deprecateEventQuery, event.ID, event.DeprecatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to deprecate event: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return app.ErrEventAlreadyDeprecated
+		}
+
+		return nil
+	})
+}
+
+// CreateEventMetadataSchema adds the next version of the schema of an event.
+func (r Repository) CreateEventMetadataSchema(ctx context.Context, schema entities.EventMetadataSchema) (entities.EventMetadataSchema, error) {
+	const operation = "Repository.CreateEventMetadataSchema"
+
+	defer newrelic.NewDatastoreSegment(ctx, eventMetadataSchemaCollection, operation, createEventMetadataSchemaQuery).End()
+
+	err := r.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, lockEventQuery, schema.Event); err != nil {
+			return fmt.Errorf("failed to lock event: %w", err)
+		}
+
+		if _, err := scanEvent(tx.QueryRow(ctx, getEventQuery, schema.Event)); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return app.ErrEventNotFound
+			}
+
+			return fmt.Errorf("failed to get event: %w", err)
+		}
+
+		err := tx.QueryRow(ctx, createEventMetadataSchemaQuery, schema.Event, schema.Schema).Scan(&schema.Version, &schema.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to insert event metadata schema: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entities.EventMetadataSchema{}, err
+	}
+
+	return schema, nil
+}
+
+// ListEventMetadataSchemas returns the versions of the schema of an event, from the latest one.
+func (r Repository) ListEventMetadataSchemas(ctx context.Context, event uint32) ([]entities.EventMetadataSchema, error) {
+	const operation = "Repository.ListEventMetadataSchemas"
+
+	defer newrelic.NewDatastoreSegment(ctx, eventMetadataSchemaCollection, operation, listEventMetadataSchemasQuery).End()
+
+	rows, err := r.db.Query(ctx, listEventMetadataSchemasQuery, event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list event metadata schemas: %w", err)
+	}
+
+	defer rows.Close()
+
+	schemas := make([]entities.EventMetadataSchema, 0)
+
+	for rows.Next() {
+		var schema entities.EventMetadataSchema
+		if err = rows.Scan(&schema.Event, &schema.Version, &schema.Schema, &schema.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		schemas = append(schemas, schema)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list event metadata schemas: %w", err)
+	}
+
+	return schemas, nil
+}
+
+// checkEvents ensures that every event triggering the transactions is in the catalog and not deprecated,
+// and that the metadata of their entries match the latest schema of the event, if any.
+func (r Repository) checkEvents(ctx context.Context, tx pgx.Tx, transactions ...entities.Transaction) error {
+	ids := make([]int16, 0, 1)
+	seen := make(map[uint32]struct{}, 1)
+
+	for _, transaction := range transactions {
+		if transaction.Event > math.MaxInt16 {
+			return app.ErrUnknownEvent
+		}
+
+		if _, ok := seen[transaction.Event]; ok {
+			continue
+		}
+
+		seen[transaction.Event] = struct{}{}
+		ids = append(ids, int16(transaction.Event))
+	}
+
+	if _, err := tx.Exec(ctx, lockEventsQuery, ids); err != nil {
+		return fmt.Errorf("failed to lock events: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, checkEventsQuery, ids)
+	if err != nil {
+		return fmt.Errorf("failed to check events: %w", err)
+	}
+
+	defer rows.Close()
+
+	schemas := make(map[uint32]entities.EventMetadataSchema)
+	found := 0
+
+	for rows.Next() {
+		var (
+			id         int16
+			deprecated bool
+			version    *int
+			schema     []byte
+		)
+
+		if err = rows.Scan(&id, &deprecated, &version, &schema); err != nil {
+			return fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		if deprecated {
+			return app.ErrEventDeprecated
+		}
+
+		if version != nil {
+			compiled, compileErr := r.metadataSchemas.compile(entities.EventMetadataSchema{Event: uint32(id), Version: *version, Schema: schema})
+			if compileErr != nil {
+				return compileErr
+			}
+
+			schemas[uint32(id)] = compiled
+		}
+
+		found++
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("failed to check events: %w", err)
+	}
+
+	if found != len(ids) {
+		return app.ErrUnknownEvent
+	}
+
+	for _, transaction := range transactions {
+		schema, ok := schemas[transaction.Event]
+		if !ok {
+			continue
+		}
+
+		if err = schema.ValidateTransaction(transaction); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type metadataSchemaKey struct {
+	event   uint32
+	version int
+}
+
+// metadataSchemaCache keeps the compiled metadata schemas by event and version, so they aren't compiled
+// again for each posting. A version of a schema never changes, so the cached ones never expire.
+type metadataSchemaCache struct {
+	mu      sync.RWMutex
+	schemas map[metadataSchemaKey]entities.EventMetadataSchema
+}
+
+func newMetadataSchemaCache() *metadataSchemaCache {
+	return &metadataSchemaCache{
+		schemas: make(map[metadataSchemaKey]entities.EventMetadataSchema),
+	}
+}
+
+// compile returns the compiled version of the schema, compiling it only the first time it's seen.
+func (c *metadataSchemaCache) compile(schema entities.EventMetadataSchema) (entities.EventMetadataSchema, error) {
+	key := metadataSchemaKey{event: schema.Event, version: schema.Version}
+
+	c.mu.RLock()
+	compiled, ok := c.schemas[key]
+	c.mu.RUnlock()
+
+	if ok {
+		return compiled, nil
+	}
+
+	compiled, err := schema.Compile()
+	if err != nil {
+		return entities.EventMetadataSchema{}, err
+	}
+
+	c.mu.Lock()
+	c.schemas[key] = compiled
+	c.mu.Unlock()
+
+	return compiled, nil
+}
+
+func scanEvent(row pgx.Row) (entities.Event, error) {
+	var (
+		event        entities.Event
+		deprecatedAt *time.Time
+	)
+
+	if err := row.Scan(&event.ID, &event.Name, &event.Description, &deprecatedAt, &event.CreatedAt); err != nil {
+		return entities.Event{}, err
+	}
+
+	if deprecatedAt != nil {
+		event.DeprecatedAt = *deprecatedAt
+	}
+
+	return event, nil
+}
@@ -0,0 +1,281 @@
+package ledger
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func TestLedgerRepository_Events(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	event, err := entities.NewEvent(3, "pix_transfer", "Pix transfer")
+	require.NoError(t, err)
+
+	created, err := r.CreateEvent(ctx, event)
+	assert.NoError(t, err)
+	assert.False(t, created.CreatedAt.IsZero())
+
+	_, err = r.CreateEvent(ctx, event)
+	assert.ErrorIs(t, err, app.ErrEventAlreadyExists)
+
+	got, err := r.GetEvent(ctx, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, "pix_transfer", got.Name)
+	assert.Equal(t, "Pix transfer", got.Description)
+	assert.False(t, got.Deprecated())
+
+	_, err = r.GetEvent(ctx, 4)
+	assert.ErrorIs(t, err, app.ErrEventNotFound)
+
+	deprecated, err := got.Deprecate(time.Now())
+	require.NoError(t, err)
+
+	assert.NoError(t, r.DeprecateEvent(ctx, deprecated))
+	assert.ErrorIs(t, r.DeprecateEvent(ctx, deprecated), app.ErrEventAlreadyDeprecated)
+
+	events, err := r.ListEvents(ctx, false)
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+
+	events, err = r.ListEvents(ctx, true)
+	assert.NoError(t, err)
+	assert.Len(t, events, 3)
+	assert.Equal(t, uint32(3), events[2].ID)
+	assert.True(t, events[2].Deprecated())
+}
+
+func TestLedgerRepository_CreateTransactionEvent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	legacy, err := entities.NewEvent(3, "legacy", "")
+	require.NoError(t, err)
+
+	legacy, err = r.CreateEvent(ctx, legacy)
+	require.NoError(t, err)
+
+	legacy, err = legacy.Deprecate(time.Now())
+	require.NoError(t, err)
+	require.NoError(t, r.DeprecateEvent(ctx, legacy))
+
+	testCases := []struct {
+		name        string
+		event       uint32
+		expectedErr error
+	}{
+		{
+			name:  "should post a transaction of an active event",
+			event: 1,
+		},
+		{
+			name:        "should reject a transaction of an unknown event",
+			event:       99,
+			expectedErr: app.ErrUnknownEvent,
+		},
+		{
+			name:        "should reject a transaction of an event out of the catalog range",
+			event:       65537,
+			expectedErr: app.ErrUnknownEvent,
+		},
+		{
+			name:        "should reject a transaction of a deprecated event",
+			event:       3,
+			expectedErr: app.ErrEventDeprecated,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			e1 := createEntry(t, vos.DebitOperation, "liability.abc.account1", vos.NextAccountVersion, 100)
+			e2 := createEntry(t, vos.CreditOperation, "liability.abc.account2", vos.NextAccountVersion, 100)
+
+			tx, err := entities.NewTransaction(uuid.New(), tt.event, "abc", time.Now(), e1, e2)
+			require.NoError(t, err)
+
+			_, err = r.CreateTransaction(ctx, tx)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestLedgerRepository_EventMetadataSchemas(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	event, err := entities.NewEvent(3, "card_purchase", "")
+	require.NoError(t, err)
+
+	_, err = r.CreateEvent(ctx, event)
+	require.NoError(t, err)
+
+	_, err = r.CreateEventMetadataSchema(ctx, entities.EventMetadataSchema{Event: 99, Schema: json.RawMessage(`{}`)})
+	assert.ErrorIs(t, err, app.ErrEventNotFound)
+
+	first, err := entities.NewEventMetadataSchema(3, json.RawMessage(`{"required": ["orderId"]}`))
+	require.NoError(t, err)
+
+	first, err = r.CreateEventMetadataSchema(ctx, first)
+	require.NoError(t, err)
+	assert.Equal(t, 1, first.Version)
+
+	second, err := entities.NewEventMetadataSchema(3, json.RawMessage(`{"required": ["order_id"]}`))
+	require.NoError(t, err)
+
+	second, err = r.CreateEventMetadataSchema(ctx, second)
+	require.NoError(t, err)
+	assert.Equal(t, 2, second.Version)
+
+	schemas, err := r.ListEventMetadataSchemas(ctx, 3)
+	require.NoError(t, err)
+	require.Len(t, schemas, 2)
+	assert.Equal(t, 2, schemas[0].Version)
+	assert.JSONEq(t, `{"required": ["order_id"]}`, string(schemas[0].Schema))
+	assert.Equal(t, 1, schemas[1].Version)
+
+	newTransaction := func(event uint32, metadata string) entities.Transaction {
+		e1, err := entities.NewEntry(uuid.New(), vos.DebitOperation, "liability.abc.account1", vos.NextAccountVersion, 100, "BRL", json.RawMessage(metadata))
+		require.NoError(t, err)
+
+		e2, err := entities.NewEntry(uuid.New(), vos.CreditOperation, "liability.abc.account2", vos.NextAccountVersion, 100, "BRL", json.RawMessage(metadata))
+		require.NoError(t, err)
+
+		tx, err := entities.NewTransaction(uuid.New(), event, "abc", time.Now(), e1, e2)
+		require.NoError(t, err)
+
+		return tx
+	}
+
+	_, err = r.CreateTransaction(ctx, newTransaction(3, `{"orderId": "1"}`))
+	var schemaErr app.MetadataSchemaError
+	require.ErrorAs(t, err, &schemaErr)
+	assert.Equal(t, 2, schemaErr.Version)
+	assert.Len(t, schemaErr.Violations, 2)
+
+	_, err = r.CreateTransaction(ctx, newTransaction(3, `{"order_id": "1"}`))
+	assert.NoError(t, err)
+
+	_, err = r.CreateTransaction(ctx, newTransaction(1, `{"orderId": "1"}`))
+	assert.NoError(t, err, "events without a schema accept any metadata")
+
+	assert.Len(t, r.metadataSchemas.schemas, 1, "each version is compiled once")
+
+	third, err := entities.NewEventMetadataSchema(3, json.RawMessage(`{"required": ["order"]}`))
+	require.NoError(t, err)
+
+	_, err = r.CreateEventMetadataSchema(ctx, third)
+	require.NoError(t, err)
+
+	_, err = r.CreateTransaction(ctx, newTransaction(3, `{"order_id": "1"}`))
+	require.ErrorAs(t, err, &schemaErr)
+	assert.Equal(t, 3, schemaErr.Version)
+	assert.Len(t, r.metadataSchemas.schemas, 2)
+}
+
+func TestLedgerRepository_ReplayTransactionOfDeprecatedEvent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	legacy, err := entities.NewEvent(3, "legacy", "")
+	require.NoError(t, err)
+
+	legacy, err = r.CreateEvent(ctx, legacy)
+	require.NoError(t, err)
+
+	e1 := createEntry(t, vos.DebitOperation, "liability.abc.account1", vos.NextAccountVersion, 100)
+	e2 := createEntry(t, vos.CreditOperation, "liability.abc.account2", vos.NextAccountVersion, 100)
+
+	tx, err := entities.NewTransaction(uuid.New(), legacy.ID, "abc", time.Now(), e1, e2)
+	require.NoError(t, err)
+
+	_, err = r.CreateTransaction(ctx, tx)
+	require.NoError(t, err)
+
+	legacy, err = legacy.Deprecate(time.Now())
+	require.NoError(t, err)
+	require.NoError(t, r.DeprecateEvent(ctx, legacy))
+
+	_, err = r.CreateTransaction(ctx, tx)
+	assert.ErrorIs(t, err, app.ErrIdempotencyKeyViolation)
+
+	err = r.CreateTransactions(ctx, []entities.Transaction{tx})
+	assert.ErrorIs(t, err, app.ErrIdempotencyKeyViolation)
+
+	errs, err := r.CreateTransactionsBestEffort(ctx, []entities.Transaction{tx})
+	require.NoError(t, err)
+	assert.ErrorIs(t, errs[0], app.ErrIdempotencyKeyViolation)
+}
+
+func TestLedgerRepository_DeprecateEventWaitsForPostings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := newDB(t, t.Name())
+	r := NewRepository(db, &instrumentators.LedgerInstrumentator{})
+
+	event, err := entities.NewEvent(3, "pix_transfer", "")
+	require.NoError(t, err)
+
+	event, err = r.CreateEvent(ctx, event)
+	require.NoError(t, err)
+
+	e1 := createEntry(t, vos.DebitOperation, "liability.abc.account1", vos.NextAccountVersion, 100)
+	e2 := createEntry(t, vos.CreditOperation, "liability.abc.account2", vos.NextAccountVersion, 100)
+
+	transaction, err := entities.NewTransaction(uuid.New(), event.ID, "abc", time.Now(), e1, e2)
+	require.NoError(t, err)
+
+	posting, err := db.Begin(ctx)
+	require.NoError(t, err)
+
+	defer func() { _ = posting.Rollback(ctx) }()
+
+	require.NoError(t, r.checkEvents(ctx, posting, transaction))
+
+	var xmax string
+	require.NoError(t, db.QueryRow(ctx, "select xmax::text from event where id = 3;").Scan(&xmax))
+	assert.Equal(t, "0", xmax, "postings don't lock the event row")
+
+	deprecated, err := event.Deprecate(time.Now())
+	require.NoError(t, err)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- r.DeprecateEvent(ctx, deprecated)
+	}()
+
+	select {
+	case err = <-done:
+		t.Fatalf("event deprecated while a posting was in progress: %v", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	require.NoError(t, posting.Commit(ctx))
+	assert.NoError(t, <-done)
+
+	transaction.ID = uuid.New()
+	transaction.Entries[0].ID = uuid.New()
+	transaction.Entries[1].ID = uuid.New()
+
+	_, err = r.CreateTransaction(ctx, transaction)
+	assert.ErrorIs(t, err, app.ErrEventDeprecated)
+}
@@ -0,0 +1,524 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/instrumentation/newrelic"
+)
+
+// Serializes the creation of periods of the same company, so overlapping periods can't be created concurrently.
+const lockFiscalCalendarQuery = `
+select pg_advisory_xact_lock(hashtext('fiscal_period:' || $1));
+`
+
+const fiscalPeriodOverlapQuery = `
+select exists(
+	select
+		1
+	from
+		fiscal_period
+	where
+		company = $1 and start_date < $3 and end_date > $2
+);
+`
+
+const createFiscalPeriodQuery = `
+insert into fiscal_period (id, company, start_date, end_date, status)
+values ($1, $2, $3, $4, $5)
+returning created_at;
+`
+
+const getFiscalPeriodQuery = `
+select
+	id,
+	company,
+	start_date,
+	end_date,
+	status,
+	closed_at,
+	closed_by,
+	created_at
+from
+	fiscal_period
+where
+	id = $1
+;
+`
+
+// Locks the period so that the entries being posted within it are committed before it's closed or reopened,
+// and so that it's closed or reopened one at a time. Postings lock it in shared mode.
+const lockFiscalPeriodQuery = `
+select pg_advisory_xact_lock(hashtext('fiscal_period_status:' || $1::uuid));
+`
+
+const listFiscalPeriodsQuery = `
+select
+	id,
+	company,
+	start_date,
+	end_date,
+	status,
+	closed_at,
+	closed_by,
+	created_at
+from
+	fiscal_period
+where
+	company = $1
+order by
+	start_date
+;
+`
+
+const listFiscalPeriodChangesQuery = `
+select
+	action,
+	requested_by,
+	reason,
+	tx_id,
+	created_at
+from
+	fiscal_period_change
+where
+	period_id = $1
+order by
+	id
+;
+`
+
+// The balances of the income statement accounts of the company as of the end of the period,
+// including the entries of previous periods that were never closed.
+const closingBalancesQuery = `
+select
+	account,
+	currency,
+	coalesce(sum(amount) filter (where operation = 1), 0) as credit,
+	coalesce(sum(amount) filter (where operation = 2), 0) as debit
+from
+	entry
+where
+	company = $1 and competence_date < $2 and subpath(account, 0, 1)::text = any($3)
+group by
+	account, currency
+having
+	sum(case operation when 1 then amount else -amount end) <> 0
+order by
+	account, currency
+;
+`
+
+const updateFiscalPeriodStatusQuery = `
+update fiscal_period
+set
+	status = $2,
+	closed_at = $3,
+	closed_by = $4
+where
+	id = $1 and status = $5
+;
+`
+
+const insertFiscalPeriodChangeQuery = `
+insert into fiscal_period_change (period_id, action, requested_by, reason, tx_id, created_at)
+values ($1, $2, $3, $4, $5, $6);
+`
+
+// Locks the periods in shared mode, in a stable order, so that a concurrent close waits for the entries
+// to be committed, and is then able to zero them. Advisory locks are used instead of row share locks,
+// which would write a multixact into the row of the current period of each company on every posting.
+const lockFiscalPeriodsQuery = `
+select
+	pg_advisory_xact_lock_shared(hashtext('fiscal_period_status:' || p.id))
+from
+	(
+		select distinct
+			p.id
+		from
+			fiscal_period p
+			join unnest($1::text[], $2::timestamptz[]) as t(company, competence_date)
+				on p.company = t.company and p.start_date <= t.competence_date and p.end_date > t.competence_date
+		order by
+			p.id
+	) p
+;
+`
+
+// The periods are read once locked, so a close committed in the meantime is seen.
+const checkFiscalPeriodsQuery = `
+select
+	p.status
+from
+	fiscal_period p
+	join unnest($1::text[], $2::timestamptz[]) as t(company, competence_date)
+		on p.company = t.company and p.start_date <= t.competence_date and p.end_date > t.competence_date
+;
+`
+
+func (r Repository) CreateFiscalPeriod(ctx context.Context, period entities.FiscalPeriod) (entities.FiscalPeriod, error) {
+	const operation = "Repository.CreateFiscalPeriod"
+
+	defer newrelic.NewDatastoreSegment(ctx, fiscalPeriodCollection, operation, createFiscalPeriodQuery).End()
+
+	err := r.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, lockFiscalCalendarQuery, period.Company)
+		if err != nil {
+			return fmt.Errorf("failed to lock fiscal calendar: %w", err)
+		}
+
+		var overlap bool
+		err = tx.QueryRow(ctx, fiscalPeriodOverlapQuery, period.Company, period.Start, period.End).Scan(&overlap)
+		if err != nil {
+			return fmt.Errorf("failed to check fiscal period overlap: %w", err)
+		}
+
+		if overlap {
+			return app.ErrFiscalPeriodOverlap
+		}
+
+		err = tx.QueryRow(
+			ctx,
+			createFiscalPeriodQuery,
+			period.ID,
+			period.Company,
+			period.Start,
+			period.End,
+			period.Status,
+		).Scan(&period.CreatedAt)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+				return app.ErrIdempotencyKeyViolation
+			}
+
+			return fmt.Errorf("failed to insert fiscal period: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entities.FiscalPeriod{}, err
+	}
+
+	return period, nil
+}
+
+func (r Repository) GetFiscalPeriod(ctx context.Context, id uuid.UUID) (entities.FiscalPeriod, error) {
+	const operation = "Repository.GetFiscalPeriod"
+
+	defer newrelic.NewDatastoreSegment(ctx, fiscalPeriodCollection, operation, getFiscalPeriodQuery).End()
+
+	period, err := scanFiscalPeriod(r.db.QueryRow(ctx, getFiscalPeriodQuery, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.FiscalPeriod{}, app.ErrFiscalPeriodNotFound
+		}
+
+		return entities.FiscalPeriod{}, fmt.Errorf("failed to get fiscal period: %w", err)
+	}
+
+	return period, nil
+}
+
+func (r Repository) ListFiscalPeriods(ctx context.Context, company string) ([]entities.FiscalPeriod, error) {
+	const operation = "Repository.ListFiscalPeriods"
+
+	defer newrelic.NewDatastoreSegment(ctx, fiscalPeriodCollection, operation, listFiscalPeriodsQuery).End()
+
+	rows, err := r.db.Query(ctx, listFiscalPeriodsQuery, company)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fiscal periods: %w", err)
+	}
+
+	defer rows.Close()
+
+	periods := make([]entities.FiscalPeriod, 0)
+
+	for rows.Next() {
+		period, scanErr := scanFiscalPeriod(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", scanErr)
+		}
+
+		periods = append(periods, period)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list fiscal periods: %w", err)
+	}
+
+	return periods, nil
+}
+
+func (r Repository) ListFiscalPeriodChanges(ctx context.Context, id uuid.UUID) ([]entities.FiscalPeriodChange, error) {
+	const operation = "Repository.ListFiscalPeriodChanges"
+
+	defer newrelic.NewDatastoreSegment(ctx, fiscalPeriodCollection, operation, listFiscalPeriodChangesQuery).End()
+
+	rows, err := r.db.Query(ctx, listFiscalPeriodChangesQuery, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fiscal period changes: %w", err)
+	}
+
+	defer rows.Close()
+
+	changes := make([]entities.FiscalPeriodChange, 0)
+
+	for rows.Next() {
+		var (
+			change entities.FiscalPeriodChange
+			txID   *uuid.UUID
+		)
+
+		if err = rows.Scan(&change.Action, &change.RequestedBy, &change.Reason, &txID, &change.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		if txID != nil {
+			change.TransactionID = *txID
+		}
+
+		changes = append(changes, change)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list fiscal period changes: %w", err)
+	}
+
+	return changes, nil
+}
+
+// CloseFiscalPeriod posts the closing transaction of the period and marks it as closed within the same
+// database transaction, returning the closing without a transaction id when there was nothing to zero.
+func (r Repository) CloseFiscalPeriod(ctx context.Context, closing entities.FiscalPeriodClosing) (entities.FiscalPeriodClosing, error) {
+	const operation = "Repository.CloseFiscalPeriod"
+
+	defer newrelic.NewDatastoreSegment(ctx, fiscalPeriodCollection, operation, closingBalancesQuery).End()
+
+	err := r.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, lockFiscalPeriodQuery, closing.Period.ID); err != nil {
+			return fmt.Errorf("failed to lock fiscal period: %w", err)
+		}
+
+		current, err := scanFiscalPeriod(tx.QueryRow(ctx, getFiscalPeriodQuery, closing.Period.ID))
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return app.ErrFiscalPeriodNotFound
+			}
+
+			return fmt.Errorf("failed to get fiscal period: %w", err)
+		}
+
+		if current.Closed() {
+			return app.ErrFiscalPeriodAlreadyClosed
+		}
+
+		balances, err := r.closingBalances(ctx, tx, closing.Period)
+		if err != nil {
+			return err
+		}
+
+		if len(balances) == 0 {
+			closing.TransactionID = uuid.Nil
+			closing.Change.TransactionID = uuid.Nil
+		} else {
+			transaction, txErr := closing.Transaction(balances)
+			if txErr != nil {
+				return fmt.Errorf("failed to create closing transaction: %w", txErr)
+			}
+
+			if _, err = r.postTransaction(ctx, tx, transaction); err != nil {
+				return err
+			}
+		}
+
+		return r.changeFiscalPeriodStatus(ctx, tx, closing.Period, closing.Change, vos.OpenFiscalPeriodStatus)
+	})
+	if err != nil {
+		return entities.FiscalPeriodClosing{}, err
+	}
+
+	return closing, nil
+}
+
+// ReopenFiscalPeriod marks the period as open, auditing who requested it and why.
+func (r Repository) ReopenFiscalPeriod(ctx context.Context, period entities.FiscalPeriod, change entities.FiscalPeriodChange) error {
+	const operation = "Repository.ReopenFiscalPeriod"
+
+	defer newrelic.NewDatastoreSegment(ctx, fiscalPeriodCollection, operation, updateFiscalPeriodStatusQuery).End()
+
+	return r.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, lockFiscalPeriodQuery, period.ID); err != nil {
+			return fmt.Errorf("failed to lock fiscal period: %w", err)
+		}
+
+		return r.changeFiscalPeriodStatus(ctx, tx, period, change, vos.ClosedFiscalPeriodStatus)
+	})
+}
+
+// changeFiscalPeriodStatus updates the period status, as long as it's still the given one, and records the change.
+func (r Repository) changeFiscalPeriodStatus(
+	ctx context.Context,
+	tx pgx.Tx,
+	period entities.FiscalPeriod,
+	change entities.FiscalPeriodChange,
+	from vos.FiscalPeriodStatus,
+) error {
+	var closedAt *time.Time
+	if period.Closed() {
+		closedAt = &period.ClosedAt
+	}
+
+	tag, err := tx.Exec(ctx, updateFiscalPeriodStatusQuery, period.ID, period.Status, closedAt, period.ClosedBy, from)
+	if err != nil {
+		return fmt.Errorf("failed to update fiscal period status: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		if from == vos.OpenFiscalPeriodStatus {
+			return app.ErrFiscalPeriodAlreadyClosed
+		}
+
+		return app.ErrFiscalPeriodNotClosed
+	}
+
+	var txID *uuid.UUID
+	if change.TransactionID != uuid.Nil {
+		txID = &change.TransactionID
+	}
+
+	_, err = tx.Exec(ctx, insertFiscalPeriodChangeQuery, period.ID, change.Action, change.RequestedBy, change.Reason, txID, change.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert fiscal period change: %w", err)
+	}
+
+	return nil
+}
+
+// closingBalances returns the non-zero balances of the income statement accounts of the company as of the end of the period.
+func (r Repository) closingBalances(ctx context.Context, tx pgx.Tx, period entities.FiscalPeriod) ([]vos.AccountBalance, error) {
+	classes := vos.StatementClasses(vos.IncomeStatement)
+	names := make([]string, len(classes))
+	for i, class := range classes {
+		names[i] = class.Name
+	}
+
+	rows, err := tx.Query(ctx, closingBalancesQuery, period.Company, period.End, names)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get closing balances: %w", err)
+	}
+
+	defer rows.Close()
+
+	balances := make([]vos.AccountBalance, 0)
+
+	for rows.Next() {
+		var (
+			account       string
+			currency      string
+			credit, debit int
+		)
+
+		if err = rows.Scan(&account, &currency, &credit, &debit); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		acc, accErr := vos.NewAnalyticAccount(account)
+		if accErr != nil {
+			return nil, fmt.Errorf("failed to parse account: %w", accErr)
+		}
+
+		cur, curErr := vos.NewCurrency(currency)
+		if curErr != nil {
+			return nil, fmt.Errorf("failed to parse currency: %w", curErr)
+		}
+
+		balances = append(balances, vos.NewAnalyticAccountBalance(acc, cur, vos.IgnoreAccountVersion, credit, debit))
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get closing balances: %w", err)
+	}
+
+	return balances, nil
+}
+
+// checkFiscalPeriods ensures that no transaction has a competence date within a closed fiscal period of its company.
+func (r Repository) checkFiscalPeriods(ctx context.Context, tx pgx.Tx, transactions ...entities.Transaction) error {
+	companies := make([]string, len(transactions))
+	dates := make([]time.Time, len(transactions))
+
+	for i, transaction := range transactions {
+		companies[i] = transaction.Company
+		dates[i] = transaction.CompetenceDate
+	}
+
+	if _, err := tx.Exec(ctx, lockFiscalPeriodsQuery, companies, dates); err != nil {
+		return fmt.Errorf("failed to lock fiscal periods: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, checkFiscalPeriodsQuery, companies, dates)
+	if err != nil {
+		return fmt.Errorf("failed to check fiscal periods: %w", err)
+	}
+
+	defer rows.Close()
+
+	closed := false
+
+	for rows.Next() {
+		var status vos.FiscalPeriodStatus
+		if err = rows.Scan(&status); err != nil {
+			return fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		closed = closed || status == vos.ClosedFiscalPeriodStatus
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("failed to check fiscal periods: %w", err)
+	}
+
+	if closed {
+		return app.ErrFiscalPeriodClosed
+	}
+
+	return nil
+}
+
+func scanFiscalPeriod(row pgx.Row) (entities.FiscalPeriod, error) {
+	var (
+		period   entities.FiscalPeriod
+		closedAt *time.Time
+	)
+
+	err := row.Scan(
+		&period.ID,
+		&period.Company,
+		&period.Start,
+		&period.End,
+		&period.Status,
+		&closedAt,
+		&period.ClosedBy,
+		&period.CreatedAt,
+	)
+	if err != nil {
+		return entities.FiscalPeriod{}, err
+	}
+
+	if closedAt != nil {
+		period.ClosedAt = *closedAt
+	}
+
+	return period, nil
+}
@@ -0,0 +1,284 @@
+package ledger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func TestLedgerRepository_CreateFiscalPeriod(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	period, err := entities.NewFiscalPeriod(uuid.New(), "company", start, start.AddDate(1, 0, 0))
+	require.NoError(t, err)
+
+	created, err := r.CreateFiscalPeriod(ctx, period)
+	assert.NoError(t, err)
+	assert.False(t, created.CreatedAt.IsZero())
+
+	overlapping, err := entities.NewFiscalPeriod(uuid.New(), "company", start.AddDate(0, 6, 0), start.AddDate(1, 6, 0))
+	require.NoError(t, err)
+
+	_, err = r.CreateFiscalPeriod(ctx, overlapping)
+	assert.ErrorIs(t, err, app.ErrFiscalPeriodOverlap)
+
+	// each company has its own calendar
+	other, err := entities.NewFiscalPeriod(uuid.New(), "other", start.AddDate(0, 6, 0), start.AddDate(1, 6, 0))
+	require.NoError(t, err)
+
+	_, err = r.CreateFiscalPeriod(ctx, other)
+	assert.NoError(t, err)
+
+	next, err := entities.NewFiscalPeriod(uuid.New(), "company", start.AddDate(1, 0, 0), start.AddDate(2, 0, 0))
+	require.NoError(t, err)
+
+	_, err = r.CreateFiscalPeriod(ctx, next)
+	assert.NoError(t, err)
+
+	periods, err := r.ListFiscalPeriods(ctx, "company")
+	assert.NoError(t, err)
+	require.Len(t, periods, 2)
+	assert.Equal(t, period.ID, periods[0].ID)
+	assert.Equal(t, next.ID, periods[1].ID)
+
+	_, err = r.GetFiscalPeriod(ctx, uuid.New())
+	assert.ErrorIs(t, err, app.ErrFiscalPeriodNotFound)
+}
+
+func TestLedgerRepository_CloseFiscalPeriod(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+
+	period, err := entities.NewFiscalPeriod(uuid.New(), "company", start, end)
+	require.NoError(t, err)
+
+	period, err = r.CreateFiscalPeriod(ctx, period)
+	require.NoError(t, err)
+
+	createTransactionWithDate(t, ctx, r, start.AddDate(0, 2, 0),
+		createEntry(t, vos.DebitOperation, "asset.bank.account", vos.IgnoreAccountVersion, 500),
+		createEntry(t, vos.CreditOperation, "revenue.fees.card", vos.IgnoreAccountVersion, 500),
+	)
+	createTransactionWithDate(t, ctx, r, start.AddDate(0, 3, 0),
+		createEntry(t, vos.DebitOperation, "expense.office.rent", vos.IgnoreAccountVersion, 200),
+		createEntry(t, vos.CreditOperation, "asset.bank.account", vos.IgnoreAccountVersion, 200),
+	)
+	// entries after the period are kept for the next close
+	createTransactionWithDate(t, ctx, r, end.AddDate(0, 1, 0),
+		createEntry(t, vos.DebitOperation, "asset.bank.account", vos.IgnoreAccountVersion, 50),
+		createEntry(t, vos.CreditOperation, "revenue.fees.card", vos.IgnoreAccountVersion, 50),
+	)
+
+	retained, err := vos.NewAccount("equity.retained_earnings.accumulated")
+	require.NoError(t, err)
+
+	closing, err := entities.NewFiscalPeriodClosing(period, uuid.New(), 1, retained, "accountant", time.Now().Round(time.Microsecond))
+	require.NoError(t, err)
+
+	closed, err := r.CloseFiscalPeriod(ctx, closing)
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, closed.TransactionID)
+
+	_, err = r.CloseFiscalPeriod(ctx, closing)
+	assert.ErrorIs(t, err, app.ErrFiscalPeriodAlreadyClosed)
+
+	tx, err := r.GetTransaction(ctx, closed.TransactionID)
+	require.NoError(t, err)
+	assert.Equal(t, period.ClosingDate(), tx.CompetenceDate.UTC())
+
+	balances := make(map[string]int)
+	for _, entry := range tx.Entries {
+		amount := entry.Amount
+		if entry.Operation == vos.DebitOperation {
+			amount = -amount
+		}
+
+		balances[entry.Account.Value()] += amount
+	}
+
+	assert.Equal(t, map[string]int{
+		"revenue.fees.card":                    -500,
+		"expense.office.rent":                  200,
+		"equity.retained_earnings.accumulated": 300,
+	}, balances)
+
+	got, err := r.GetFiscalPeriod(ctx, period.ID)
+	require.NoError(t, err)
+	assert.True(t, got.Closed())
+	assert.Equal(t, "accountant", got.ClosedBy)
+
+	t.Run("should report the income statement of the closed period without the closing entries", func(t *testing.T) {
+		lines, linesErr := r.GetStatementLines(ctx, vos.StatementRequest{
+			Classes: vos.StatementClasses(vos.IncomeStatement),
+			Company: "company",
+			Current: vos.Period{Start: start, End: end},
+			Level:   1,
+		})
+		require.NoError(t, linesErr)
+
+		current := make(map[string]int64)
+		for _, line := range lines {
+			current[line.Account.Value()] = line.Current
+		}
+
+		assert.Equal(t, map[string]int64{"expense.*": -200, "revenue.*": 500}, current)
+
+		lines, linesErr = r.GetStatementLines(ctx, vos.StatementRequest{
+			Classes: vos.StatementClasses(vos.BalanceSheet),
+			Company: "company",
+			Current: vos.Period{End: end},
+			Level:   1,
+		})
+		require.NoError(t, linesErr)
+
+		for _, line := range lines {
+			assert.NotEqual(t, "equity.*", line.Account.Value())
+		}
+	})
+
+	t.Run("should reject entries within the closed period", func(t *testing.T) {
+		late, txErr := entities.NewTransaction(uuid.New(), 1, "company", start.AddDate(0, 5, 0),
+			createEntry(t, vos.DebitOperation, "asset.bank.account", vos.IgnoreAccountVersion, 10),
+			createEntry(t, vos.CreditOperation, "revenue.fees.card", vos.IgnoreAccountVersion, 10),
+		)
+		require.NoError(t, txErr)
+
+		_, txErr = r.CreateTransaction(ctx, late)
+		assert.ErrorIs(t, txErr, app.ErrFiscalPeriodClosed)
+
+		// the calendar of other companies is unaffected
+		late.ID = uuid.New()
+		late.Company = "other"
+		_, txErr = r.CreateTransaction(ctx, late)
+		assert.NoError(t, txErr)
+	})
+
+	t.Run("should audit the reopen and close only the new entries again", func(t *testing.T) {
+		reopened, change, reopenErr := got.Reopen("admin", "late entries", time.Now().Round(time.Microsecond))
+		require.NoError(t, reopenErr)
+
+		require.NoError(t, r.ReopenFiscalPeriod(ctx, reopened, change))
+		assert.ErrorIs(t, r.ReopenFiscalPeriod(ctx, reopened, change), app.ErrFiscalPeriodNotClosed)
+
+		createTransactionWithDate(t, ctx, r, start.AddDate(0, 5, 0),
+			createEntry(t, vos.DebitOperation, "asset.bank.account", vos.IgnoreAccountVersion, 10),
+			createEntry(t, vos.CreditOperation, "revenue.fees.card", vos.IgnoreAccountVersion, 10),
+		)
+
+		closing, closingErr := entities.NewFiscalPeriodClosing(reopened, uuid.New(), 1, retained, "accountant", time.Now())
+		require.NoError(t, closingErr)
+
+		reclosed, closeErr := r.CloseFiscalPeriod(ctx, closing)
+		require.NoError(t, closeErr)
+
+		tx, txErr := r.GetTransaction(ctx, reclosed.TransactionID)
+		require.NoError(t, txErr)
+		assert.Len(t, tx.Entries, 2)
+
+		changes, listErr := r.ListFiscalPeriodChanges(ctx, period.ID)
+		require.NoError(t, listErr)
+		require.Len(t, changes, 3)
+		assert.Equal(t, vos.CloseFiscalPeriodAction, changes[0].Action)
+		assert.Equal(t, closed.TransactionID, changes[0].TransactionID)
+		assert.Equal(t, vos.ReopenFiscalPeriodAction, changes[1].Action)
+		assert.Equal(t, "admin", changes[1].RequestedBy)
+		assert.Equal(t, "late entries", changes[1].Reason)
+		assert.Equal(t, uuid.Nil, changes[1].TransactionID)
+		assert.Equal(t, reclosed.TransactionID, changes[2].TransactionID)
+	})
+}
+
+func TestLedgerRepository_CloseFiscalPeriodWaitsForPostings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := newDB(t, t.Name())
+	r := NewRepository(db, &instrumentators.LedgerInstrumentator{})
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	period, err := entities.NewFiscalPeriod(uuid.New(), "company", start, start.AddDate(1, 0, 0))
+	require.NoError(t, err)
+
+	period, err = r.CreateFiscalPeriod(ctx, period)
+	require.NoError(t, err)
+
+	transaction, err := entities.NewTransaction(uuid.New(), 1, "company", start.AddDate(0, 2, 0),
+		createEntry(t, vos.DebitOperation, "asset.bank.account", vos.IgnoreAccountVersion, 500),
+		createEntry(t, vos.CreditOperation, "revenue.fees.card", vos.IgnoreAccountVersion, 500),
+	)
+	require.NoError(t, err)
+
+	posting, err := db.Begin(ctx)
+	require.NoError(t, err)
+
+	defer func() { _ = posting.Rollback(ctx) }()
+
+	_, err = r.postTransaction(ctx, posting, transaction)
+	require.NoError(t, err)
+
+	var xmax string
+	require.NoError(t, db.QueryRow(ctx, "select xmax::text from fiscal_period where id = $1;", period.ID).Scan(&xmax))
+	assert.Equal(t, "0", xmax, "postings don't lock the period row")
+
+	retained, err := vos.NewAccount("equity.retained_earnings.accumulated")
+	require.NoError(t, err)
+
+	closing, err := entities.NewFiscalPeriodClosing(period, uuid.New(), 1, retained, "accountant", time.Now().Round(time.Microsecond))
+	require.NoError(t, err)
+
+	type result struct {
+		closing entities.FiscalPeriodClosing
+		err     error
+	}
+
+	done := make(chan result, 1)
+	go func() {
+		closed, closeErr := r.CloseFiscalPeriod(ctx, closing)
+		done <- result{closing: closed, err: closeErr}
+	}()
+
+	select {
+	case res := <-done:
+		t.Fatalf("period closed while a posting was in progress: %v", res.err)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	require.NoError(t, posting.Commit(ctx))
+
+	res := <-done
+	require.NoError(t, res.err)
+
+	// the entries committed while the close waited are zeroed too
+	tx, err := r.GetTransaction(ctx, res.closing.TransactionID)
+	require.NoError(t, err)
+	assert.Len(t, tx.Entries, 2)
+
+	late, err := entities.NewTransaction(uuid.New(), 1, "company", start.AddDate(0, 3, 0),
+		createEntry(t, vos.DebitOperation, "asset.bank.account", vos.IgnoreAccountVersion, 100),
+		createEntry(t, vos.CreditOperation, "revenue.fees.card", vos.IgnoreAccountVersion, 100),
+	)
+	require.NoError(t, err)
+
+	_, err = r.CreateTransaction(ctx, late)
+	assert.ErrorIs(t, err, app.ErrFiscalPeriodClosed)
+}
@@ -0,0 +1,55 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/instrumentation/newrelic"
+)
+
+const getAccountQuery = `
+select
+	owner,
+	display_name,
+	opening_date,
+	status,
+	created_at,
+	updated_at
+from
+	account
+where
+	account = $1
+;
+`
+
+func (r Repository) GetAccount(ctx context.Context, account vos.Account) (entities.Account, error) {
+	const operation = "Repository.GetAccount"
+
+	defer newrelic.NewDatastoreSegment(ctx, accountCollection, operation, getAccountQuery).End()
+
+	acc := entities.Account{Account: account}
+
+	err := r.db.QueryRow(ctx, getAccountQuery, account.Value()).Scan(
+		&acc.Owner,
+		&acc.DisplayName,
+		&acc.OpeningDate,
+		&acc.Status,
+		&acc.CreatedAt,
+		&acc.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.Account{}, app.ErrAccountNotFound
+		}
+
+		return entities.Account{}, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	return acc, nil
+}
@@ -13,26 +13,33 @@ import (
 	"github.com/stone-co/the-amazing-ledger/app/instrumentation/newrelic"
 )
 
+// the account version is shared by all currencies, so it's taken from account_version
+// instead of from the entries of the requested currency.
 const getAccountBalanceQuery = `
 select
-	total_balance,
-	version
+	b.total_credit,
+	b.total_debit,
+	coalesce(v.version, b.version),
+	get_held_amount($1::text::lquery, $2)
 from
-	get_analytic_account_balance($1)
+	get_analytic_account_balance($1, $2) b
+	left join account_version v on v.account = $1::ltree
 ;
 `
 
-func (r Repository) GetAnalyticAccountBalance(ctx context.Context, account vos.Account) (vos.AccountBalance, error) {
+func (r Repository) GetAnalyticAccountBalance(ctx context.Context, account vos.Account, currency vos.Currency) (vos.AccountBalance, error) {
 	const operation = "Repository.GetAnalyticAccountBalance"
 
 	defer newrelic.NewDatastoreSegment(ctx, collection, operation, getAccountBalanceQuery).End()
 
-	var balance int
+	var credit, debit, held int
 	var version int64
 
-	err := r.db.QueryRow(ctx, getAccountBalanceQuery, account.Value()).Scan(
-		&balance,
+	err := r.db.QueryRow(ctx, getAccountBalanceQuery, account.Value(), currency).Scan(
+		&credit,
+		&debit,
 		&version,
+		&held,
 	)
 
 	if err != nil {
@@ -48,9 +55,14 @@ func (r Repository) GetAnalyticAccountBalance(ctx context.Context, account vos.A
 		return vos.AccountBalance{}, fmt.Errorf("failed to get account balance: %w", pgErr)
 	}
 
-	return vos.NewAnalyticAccountBalance(
+	accountBalance := vos.NewAnalyticAccountBalance(
 		account,
+		currency,
 		vos.Version(version),
-		balance,
-	), nil
+		credit,
+		debit,
+	)
+	accountBalance.Available -= held
+
+	return accountBalance, nil
 }
@@ -2,16 +2,19 @@ package ledger
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/stone-co/the-amazing-ledger/app/tests/pgtesting"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
 	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
 	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
 	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
@@ -120,11 +123,11 @@ func TestLedgerRepository_GetAccountBalanceSuccess(t *testing.T) {
 
 			tt.repoSeed(t, ctx, r)
 
-			balance, err := r.GetAnalyticAccountBalance(ctx, acc1)
+			balance, err := r.GetAnalyticAccountBalance(ctx, acc1, vos.DefaultCurrency)
 			assert.NoError(t, err)
 			assert.Equal(t, tt.wants.total.acc1Balance, balance.Balance)
 
-			balance, err = r.GetAnalyticAccountBalance(ctx, acc2)
+			balance, err = r.GetAnalyticAccountBalance(ctx, acc2, vos.DefaultCurrency)
 			assert.NoError(t, err)
 			assert.Equal(t, tt.wants.total.acc2balance, balance.Balance)
 
@@ -157,17 +160,62 @@ func TestLedgerRepository_GetAccountBalanceFailure(t *testing.T) {
 	acc, err := vos.NewAnalyticAccount(testdata.GenerateAccountPath())
 	assert.NoError(t, err)
 
-	_, err = r.GetAnalyticAccountBalance(context.Background(), acc)
+	_, err = r.GetAnalyticAccountBalance(context.Background(), acc, vos.DefaultCurrency)
 	assert.ErrorIs(t, app.ErrAccountNotFound, err)
 }
 
+func TestLedgerRepository_GetAccountBalancePerCurrency(t *testing.T) {
+	t.Parallel()
+
+	db := newDB(t, t.Name())
+
+	ctx := context.Background()
+	r := NewRepository(db, &instrumentators.LedgerInstrumentator{})
+
+	acc1, err := vos.NewAnalyticAccount(testdata.GenerateAccountPath())
+	assert.NoError(t, err)
+
+	acc2, err := vos.NewAnalyticAccount(testdata.GenerateAccountPath())
+	assert.NoError(t, err)
+
+	e1 := createEntry(t, vos.DebitOperation, acc1.Value(), vos.NextAccountVersion, 100)
+	e2 := createEntry(t, vos.CreditOperation, acc2.Value(), vos.NextAccountVersion, 100)
+	createTransaction(t, ctx, r, e1, e2)
+
+	e3, err := entities.NewEntry(uuid.New(), vos.CreditOperation, acc1.Value(), vos.NextAccountVersion, 30, "USD", json.RawMessage(`{}`))
+	assert.NoError(t, err)
+
+	e4, err := entities.NewEntry(uuid.New(), vos.DebitOperation, acc2.Value(), vos.NextAccountVersion, 30, "USD", json.RawMessage(`{}`))
+	assert.NoError(t, err)
+	createTransaction(t, ctx, r, e3, e4)
+
+	balance, err := r.GetAnalyticAccountBalance(ctx, acc1, vos.DefaultCurrency)
+	assert.NoError(t, err)
+	assert.Equal(t, vos.DefaultCurrency, balance.Currency)
+	assert.Equal(t, -100, balance.Balance)
+	assert.Equal(t, 0, balance.Credit)
+	assert.Equal(t, 100, balance.Debit)
+	assert.Equal(t, vos.Version(2), balance.CurrentVersion)
+
+	balance, err = r.GetAnalyticAccountBalance(ctx, acc1, vos.Currency("USD"))
+	assert.NoError(t, err)
+	assert.Equal(t, vos.Currency("USD"), balance.Currency)
+	assert.Equal(t, 30, balance.Balance)
+	assert.Equal(t, 30, balance.Credit)
+	assert.Equal(t, 0, balance.Debit)
+	assert.Equal(t, vos.Version(2), balance.CurrentVersion)
+
+	_, err = r.GetAnalyticAccountBalance(ctx, acc1, vos.Currency("EUR"))
+	assert.ErrorIs(t, err, app.ErrAccountNotFound)
+}
+
 type snapshot struct {
 	balance int
 	date    time.Time
 }
 
 func fetchSnapshot(ctx context.Context, db *pgxpool.Pool, account vos.Account) (snapshot, error) {
-	const query = "select balance, tx_date from account_balance where account = $1;"
+	const query = "select credit - debit, tx_date from account_balance where account = $1;"
 
 	var snap snapshot
 
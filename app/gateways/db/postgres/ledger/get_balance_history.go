@@ -0,0 +1,119 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/instrumentation/newrelic"
+)
+
+// The entries are summed per bucket, along with an empty row for every bucket of the range, and then the
+// running balance is taken over the buckets ordered by date. Buckets before the range only carry the
+// opening balance, so they are left out at the end.
+const _balanceHistoryQuery = `
+with movement as (
+	select
+		date_trunc($3, %[2]s at time zone 'UTC') as bucket,
+		coalesce(sum(amount) filter (where operation = 1), 0) as credit,
+		coalesce(sum(amount) filter (where operation = 2), 0) as debit
+	from
+		entry
+	where
+		account %[1]s $1
+		and currency = $2
+		and %[2]s < $5
+		and ($6::timestamptz is null or created_at <= $6)
+	group by
+		1
+), series as (
+	select generate_series(
+		date_trunc($3, $4::timestamptz at time zone 'UTC'),
+		$5::timestamptz at time zone 'UTC' - interval '1 microsecond',
+		('1 ' || $3)::interval
+	) as bucket
+), buckets as (
+	select
+		bucket,
+		sum(credit) as credit,
+		sum(debit) as debit
+	from (
+		select bucket, credit, debit from movement
+		union all
+		select bucket, 0, 0 from series
+	) b
+	group by
+		bucket
+), running as (
+	select
+		bucket,
+		credit,
+		debit,
+		sum(credit - debit) over (order by bucket) as balance
+	from
+		buckets
+)
+select
+	bucket,
+	credit,
+	debit,
+	balance
+from
+	running
+where
+	bucket >= date_trunc($3, $4::timestamptz at time zone 'UTC')
+order by
+	bucket
+;
+`
+
+func (r Repository) GetBalanceHistory(ctx context.Context, req vos.BalanceHistoryRequest) (vos.BalanceHistory, error) {
+	const operation = "Repository.GetBalanceHistory"
+
+	operator := "="
+	if req.Account.Type() == vos.Synthetic {
+		operator = "~"
+	}
+
+	query := fmt.Sprintf(_balanceHistoryQuery, operator, timeColumn(req.Time))
+
+	defer newrelic.NewDatastoreSegment(ctx, collection, operation, query).End()
+
+	rows, err := r.db.Query(
+		ctx,
+		query,
+		req.Account.Value(),
+		req.Currency,
+		req.Granularity.String(),
+		req.Start,
+		req.End,
+		knownAtArg(req.Time),
+	)
+	if err != nil {
+		return vos.BalanceHistory{}, fmt.Errorf("failed to get balance history: %w", err)
+	}
+
+	defer rows.Close()
+
+	points := make([]vos.BalancePoint, 0)
+
+	for rows.Next() {
+		var point vos.BalancePoint
+		if err = rows.Scan(&point.Date, &point.Credit, &point.Debit, &point.Balance); err != nil {
+			return vos.BalanceHistory{}, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		point.Date = point.Date.UTC()
+		points = append(points, point)
+	}
+
+	if err = rows.Err(); err != nil {
+		return vos.BalanceHistory{}, fmt.Errorf("failed to get balance history: %w", err)
+	}
+
+	return vos.BalanceHistory{
+		Account:  req.Account,
+		Currency: req.Currency,
+		Points:   points,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package ledger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func TestLedgerRepository_GetBalanceHistory(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	start := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	// opening balance, before the range
+	createTransactionWithDate(t, ctx, r, start.AddDate(0, 0, -10),
+		createEntry(t, vos.DebitOperation, "asset.bank.account", vos.IgnoreAccountVersion, 1000),
+		createEntry(t, vos.CreditOperation, "liability.clients.available.abc", vos.IgnoreAccountVersion, 1000),
+	)
+	createTransactionWithDate(t, ctx, r, start.Add(10*time.Hour),
+		createEntry(t, vos.DebitOperation, "liability.clients.available.abc", vos.IgnoreAccountVersion, 300),
+		createEntry(t, vos.CreditOperation, "asset.bank.account", vos.IgnoreAccountVersion, 300),
+	)
+	createTransactionWithDate(t, ctx, r, start.AddDate(0, 0, 2).Add(23*time.Hour),
+		createEntry(t, vos.DebitOperation, "asset.bank.account", vos.IgnoreAccountVersion, 50),
+		createEntry(t, vos.CreditOperation, "liability.clients.available.xyz", vos.IgnoreAccountVersion, 50),
+	)
+	// after the daily range
+	createTransactionWithDate(t, ctx, r, start.AddDate(0, 0, 3),
+		createEntry(t, vos.DebitOperation, "asset.bank.account", vos.IgnoreAccountVersion, 7),
+		createEntry(t, vos.CreditOperation, "liability.clients.available.xyz", vos.IgnoreAccountVersion, 7),
+	)
+
+	t.Run("should return the daily running balance of an analytic account", func(t *testing.T) {
+		account, err := vos.NewAccount("asset.bank.account")
+		require.NoError(t, err)
+
+		req, err := vos.NewBalanceHistoryRequest(account, vos.DefaultCurrency, start, start.AddDate(0, 0, 3), vos.DayGranularity)
+		require.NoError(t, err)
+
+		history, err := r.GetBalanceHistory(ctx, req)
+		require.NoError(t, err)
+
+		assert.Equal(t, []vos.BalancePoint{
+			{Date: start, Credit: 300, Balance: -700},
+			{Date: start.AddDate(0, 0, 1), Balance: -700},
+			{Date: start.AddDate(0, 0, 2), Debit: 50, Balance: -750},
+		}, history.Points)
+	})
+
+	t.Run("should return the weekly running balance of a synthetic account", func(t *testing.T) {
+		account, err := vos.NewAccount("liability.clients.available.*")
+		require.NoError(t, err)
+
+		req, err := vos.NewBalanceHistoryRequest(account, vos.DefaultCurrency, start, start.AddDate(0, 0, 14), vos.WeekGranularity)
+		require.NoError(t, err)
+
+		history, err := r.GetBalanceHistory(ctx, req)
+		require.NoError(t, err)
+
+		// 2021-09-01 is a Wednesday, so the first week starts on Monday, 2021-08-30
+		monday := time.Date(2021, 8, 30, 0, 0, 0, 0, time.UTC)
+
+		assert.Equal(t, []vos.BalancePoint{
+			{Date: monday, Credit: 57, Debit: 300, Balance: 757},
+			{Date: monday.AddDate(0, 0, 7), Balance: 757},
+			{Date: monday.AddDate(0, 0, 14), Balance: 757},
+		}, history.Points)
+	})
+}
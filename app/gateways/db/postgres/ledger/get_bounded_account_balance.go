@@ -15,30 +15,35 @@ import (
 )
 
 const _boundedBalanceQuery = `
-select coalesce(sum(amount) filter (where operation = 1), 0) -
+select coalesce(sum(amount) filter (where operation = 1), 0),
 	   coalesce(sum(amount) filter (where operation = 2), 0)
   from entry
  where account %s $1
+   and currency = $2
 `
 
 const _boundedBalanceQueryStartFilter = `
-   and competence_date >= $%d
+   and %s >= $%d
 `
 
 const _boundedBalanceQueryEndFilter = `
-   and competence_date <  $%d
+   and %s <  $%d
 `
 
-func (r Repository) GetBoundedAccountBalance(ctx context.Context, acc vos.Account, start, end time.Time) (vos.AccountBalance, error) {
+const _boundedBalanceQueryKnownAtFilter = `
+   and created_at <= $%d
+`
+
+func (r Repository) GetBoundedAccountBalance(ctx context.Context, acc vos.Account, currency vos.Currency, start, end time.Time, view vos.TimeView) (vos.AccountBalance, error) {
 	const operation = "Repository.GetBoundedAccountBalance"
 
-	query, args := buildBoundedBalanceQuery(acc, start, end)
+	query, args := buildBoundedBalanceQuery(acc, currency, start, end, view)
 
 	defer newrelic.NewDatastoreSegment(ctx, collection, operation, query).End()
 
-	var balance int
+	var credit, debit int
 
-	err := r.db.QueryRow(ctx, query, args...).Scan(&balance)
+	err := r.db.QueryRow(ctx, query, args...).Scan(&credit, &debit)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if !errors.As(err, &pgErr) {
@@ -52,31 +57,39 @@ func (r Repository) GetBoundedAccountBalance(ctx context.Context, acc vos.Accoun
 		return vos.AccountBalance{}, fmt.Errorf("get account balance: %w", pgErr)
 	}
 
-	return vos.NewSyntheticAccountBalance(acc, balance), nil
+	return vos.NewSyntheticAccountBalance(acc, currency, credit, debit), nil
 }
 
-func buildBoundedBalanceQuery(account vos.Account, start, end time.Time) (string, []interface{}) {
+func buildBoundedBalanceQuery(account vos.Account, currency vos.Currency, start, end time.Time, view vos.TimeView) (string, []interface{}) {
 	operator := "="
 	if account.Type() == vos.Synthetic {
 		operator = "~"
 	}
 
-	args := make([]interface{}, 0, 3)
-	args = append(args, account.Value())
-	total := 2
+	column := timeColumn(view)
+
+	args := make([]interface{}, 0, 5)
+	args = append(args, account.Value(), currency)
+	total := 3
 
 	query := fmt.Sprintf(_boundedBalanceQuery, operator)
 
 	if !start.IsZero() {
-		query += fmt.Sprintf(_boundedBalanceQueryStartFilter, total)
+		query += fmt.Sprintf(_boundedBalanceQueryStartFilter, column, total)
 		total += 1
 		args = append(args, start)
 	}
 
 	if !end.IsZero() {
-		query += fmt.Sprintf(_boundedBalanceQueryEndFilter, total)
+		query += fmt.Sprintf(_boundedBalanceQueryEndFilter, column, total)
+		total += 1
 		args = append(args, end)
 	}
 
+	if !view.KnownAt.IsZero() {
+		query += fmt.Sprintf(_boundedBalanceQueryKnownAtFilter, total)
+		args = append(args, view.KnownAt)
+	}
+
 	return query, args
 }
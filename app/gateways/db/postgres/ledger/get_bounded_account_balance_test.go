@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
 	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
@@ -82,7 +83,7 @@ func TestLedgerRepository_QueryBoundedBalance(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			balance, err := r.GetBoundedAccountBalance(ctx, tt.account, tt.start, tt.end)
+			balance, err := r.GetBoundedAccountBalance(ctx, tt.account, vos.DefaultCurrency, tt.start, tt.end, vos.TimeView{})
 			assert.NoError(t, err)
 			assert.Equal(t, tt.wants, balance.Balance)
 		})
@@ -101,8 +102,80 @@ func createTransactionWithDate(t *testing.T, ctx context.Context, r *Repository,
 	)
 	assert.NoError(t, err)
 
-	err = r.CreateTransaction(ctx, tx)
+	_, err = r.CreateTransaction(ctx, tx)
 	assert.NoError(t, err)
 
 	return tx
 }
+
+func TestLedgerRepository_QueryBoundedBalance_TimeView(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	acc, err := vos.NewAccount("liability.agg.agg1")
+	require.NoError(t, err)
+
+	first, err := entities.NewTransaction(uuid.New(), 1, "company", time.Now().Add(-48*time.Hour),
+		createEntry(t, vos.CreditOperation, acc.Value(), vos.IgnoreAccountVersion, 100),
+		createEntry(t, vos.DebitOperation, "liability.agg.agg2", vos.IgnoreAccountVersion, 100),
+	)
+	require.NoError(t, err)
+
+	first, err = r.CreateTransaction(ctx, first)
+	require.NoError(t, err)
+
+	// a late entry, recorded after the first one but effective before it
+	late, err := entities.NewTransaction(uuid.New(), 1, "company", time.Now().Add(-72*time.Hour),
+		createEntry(t, vos.CreditOperation, acc.Value(), vos.IgnoreAccountVersion, 30),
+		createEntry(t, vos.DebitOperation, "liability.agg.agg2", vos.IgnoreAccountVersion, 30),
+	)
+	require.NoError(t, err)
+
+	_, err = r.CreateTransaction(ctx, late)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name  string
+		start time.Time
+		end   time.Time
+		view  vos.TimeView
+		wants int
+	}{
+		{
+			name:  "effective now, as known now",
+			end:   time.Now(),
+			wants: 130,
+		},
+		{
+			name:  "effective now, as known right after the first entry",
+			end:   time.Now(),
+			view:  vos.TimeView{KnownAt: first.CreatedAt},
+			wants: 100,
+		},
+		{
+			name:  "effective before the first entry, as known right after it",
+			end:   time.Now().Add(-60 * time.Hour),
+			view:  vos.TimeView{Axis: vos.CompetenceTimeAxis, KnownAt: first.CreatedAt},
+			wants: 0,
+		},
+		{
+			name:  "recorded after the first entry",
+			start: first.CreatedAt.Add(time.Microsecond),
+			view:  vos.TimeView{Axis: vos.RecordedTimeAxis},
+			wants: 30,
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			balance, err := r.GetBoundedAccountBalance(ctx, acc, vos.DefaultCurrency, tt.start, tt.end, tt.view)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wants, balance.Balance)
+		})
+	}
+}
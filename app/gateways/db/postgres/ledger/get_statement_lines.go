@@ -0,0 +1,103 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/instrumentation/newrelic"
+)
+
+const statementLinesQuery = `
+select
+	subpath(account, 0, least($1, nlevel(account))),
+	currency,
+	coalesce(sum(case operation when %[1]d then amount else -amount end)
+		filter (where %[2]s >= $2 and %[2]s < $3), 0),
+	coalesce(sum(case operation when %[1]d then amount else -amount end)
+		filter (where %[2]s >= $4 and %[2]s < $5), 0)
+from
+	entry
+where
+	subpath(account, 0, 1)::text = any($6)
+	and ($7::text = '' or company = $7)
+	and ($8::timestamptz is null or created_at <= $8)
+	and not exists (select 1 from fiscal_period_change c where c.tx_id = entry.tx_id)
+	and (
+		(%[2]s >= $2 and %[2]s < $3)
+		or (%[2]s >= $4 and %[2]s < $5)
+	)
+group by 1, 2
+order by 1, 2;
+`
+
+// GetStatementLines returns the balances (credits minus debits) of the accounts of the requested classes,
+// grouped by their first labels into synthetic accounts, within the current and comparative periods.
+// The closing transactions of fiscal periods are left out, or the income statement of a closed period
+// would be zeroed by its own closing, while the retained earnings of the balance sheet are still
+// accumulated from the income statement classes.
+func (r Repository) GetStatementLines(ctx context.Context, req vos.StatementRequest) ([]vos.StatementLine, error) {
+	const operation = "Repository.GetStatementLines"
+
+	if len(req.Classes) == 0 {
+		return []vos.StatementLine{}, nil
+	}
+
+	query := fmt.Sprintf(statementLinesQuery, vos.CreditOperation, timeColumn(req.Time))
+
+	defer newrelic.NewDatastoreSegment(ctx, collection, operation, query).End()
+
+	classes := make([]string, len(req.Classes))
+	for i, class := range req.Classes {
+		classes[i] = class.Name
+	}
+
+	level := req.Level
+	if level < 1 {
+		level = 1
+	}
+
+	rows, err := r.db.Query(
+		ctx,
+		query,
+		level,
+		req.Current.Start,
+		req.Current.End,
+		req.Comparative.Start,
+		req.Comparative.End,
+		classes,
+		req.Company,
+		knownAtArg(req.Time),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get statement lines: %w", err)
+	}
+
+	defer rows.Close()
+
+	lines := make([]vos.StatementLine, 0)
+
+	for rows.Next() {
+		var (
+			account string
+			line    vos.StatementLine
+		)
+
+		if err = rows.Scan(&account, &line.Currency, &line.Current, &line.Comparative); err != nil {
+			return nil, fmt.Errorf("failed to scan statement line: %w", err)
+		}
+
+		line.Account, err = vos.NewAccount(account + ".*")
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse statement line account: %w", err)
+		}
+
+		lines = append(lines, line)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get statement lines: %w", err)
+	}
+
+	return lines, nil
+}
@@ -0,0 +1,93 @@
+package ledger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func TestLedgerRepository_GetStatementLines(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := newDB(t, t.Name())
+	r := NewRepository(db, &instrumentators.LedgerInstrumentator{})
+
+	thisYear := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	lastYear := thisYear.AddDate(-1, 0, 0)
+
+	post := func(company string, competenceDate time.Time, debit, credit string, amount int) {
+		tx, err := entities.NewTransaction(
+			uuid.New(),
+			uint32(1),
+			company,
+			competenceDate,
+			createEntry(t, vos.DebitOperation, debit, vos.IgnoreAccountVersion, amount),
+			createEntry(t, vos.CreditOperation, credit, vos.IgnoreAccountVersion, amount),
+		)
+		require.NoError(t, err)
+
+		_, err = r.CreateTransaction(ctx, tx)
+		require.NoError(t, err)
+	}
+
+	post("abc", lastYear.AddDate(0, 6, 0), "asset.bacen.reserves", "revenue.fees.transfers", 100)
+	post("abc", thisYear.AddDate(0, 6, 0), "asset.bacen.reserves", "revenue.fees.transfers", 300)
+	post("abc", thisYear.AddDate(0, 7, 0), "expense.salaries.staff", "asset.bacen.reserves", 50)
+	post("xyz", thisYear.AddDate(0, 6, 0), "asset.bacen.reserves", "revenue.fees.transfers", 1000)
+
+	account := func(value string) vos.Account {
+		acc, err := vos.NewAccount(value)
+		require.NoError(t, err)
+
+		return acc
+	}
+
+	testCases := []struct {
+		name string
+		req  vos.StatementRequest
+		want []vos.StatementLine
+	}{
+		{
+			name: "income statement classes within periods",
+			req: vos.StatementRequest{
+				Classes:     vos.StatementClasses(vos.IncomeStatement),
+				Company:     "abc",
+				Current:     vos.Period{Start: thisYear, End: thisYear.AddDate(1, 0, 0)},
+				Comparative: vos.Period{Start: lastYear, End: thisYear},
+				Level:       2,
+			},
+			want: []vos.StatementLine{
+				{Account: account("expense.salaries.*"), Currency: vos.DefaultCurrency, Current: -50},
+				{Account: account("revenue.fees.*"), Currency: vos.DefaultCurrency, Current: 300, Comparative: 100},
+			},
+		},
+		{
+			name: "balance sheet classes since the first entry without comparative period",
+			req: vos.StatementRequest{
+				Classes: vos.StatementClasses(vos.BalanceSheet),
+				Current: vos.Period{End: thisYear.AddDate(1, 0, 0)},
+			},
+			want: []vos.StatementLine{
+				{Account: account("asset.*"), Currency: vos.DefaultCurrency, Current: -1350},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.GetStatementLines(ctx, tt.req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
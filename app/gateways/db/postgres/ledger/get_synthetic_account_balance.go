@@ -14,17 +14,18 @@ import (
 )
 
 const queryAggregatedBalanceQuery = `
-select get_synthetic_account_balance($1);
+select b.total_credit, b.total_debit, get_held_amount($1, $2)
+from get_synthetic_account_balance($1, $2) b;
 `
 
-func (r Repository) GetSyntheticAccountBalance(ctx context.Context, account vos.Account) (vos.AccountBalance, error) {
+func (r Repository) GetSyntheticAccountBalance(ctx context.Context, account vos.Account, currency vos.Currency) (vos.AccountBalance, error) {
 	const operation = "Repository.GetSyntheticAccountBalance"
 
 	defer newrelic.NewDatastoreSegment(ctx, collection, operation, queryAggregatedBalanceQuery).End()
 
-	var balance int
+	var credit, debit, held int
 
-	err := r.db.QueryRow(ctx, queryAggregatedBalanceQuery, account.Value()).Scan(&balance)
+	err := r.db.QueryRow(ctx, queryAggregatedBalanceQuery, account.Value(), currency).Scan(&credit, &debit, &held)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if !errors.As(err, &pgErr) {
@@ -38,5 +39,8 @@ func (r Repository) GetSyntheticAccountBalance(ctx context.Context, account vos.
 		return vos.AccountBalance{}, fmt.Errorf("failed to query aggregated balance: %w", pgErr)
 	}
 
-	return vos.NewSyntheticAccountBalance(account, balance), nil
+	accountBalance := vos.NewSyntheticAccountBalance(account, currency, credit, debit)
+	accountBalance.Available -= held
+
+	return accountBalance, nil
 }
@@ -27,7 +27,7 @@ func TestLedgerRepository_QueryAggregatedBalanceFailure(t *testing.T) {
 	query, err := vos.NewAccount("liability.agg.*")
 	assert.NoError(t, err)
 
-	_, err = r.GetSyntheticAccountBalance(ctx, query)
+	_, err = r.GetSyntheticAccountBalance(ctx, query, vos.DefaultCurrency)
 	assert.ErrorIs(t, err, app.ErrAccountNotFound)
 }
 
@@ -102,7 +102,7 @@ func TestLedgerRepository_QueryAggregatedBalanceSuccess(t *testing.T) {
 				e3 = createEntry(t, vos.CreditOperation, acc3.Value(), vos.NextAccountVersion, 100)
 				createTransaction(t, ctx, r, e1, e3)
 
-				_, err = r.GetSyntheticAccountBalance(ctx, query)
+				_, err = r.GetSyntheticAccountBalance(ctx, query, vos.DefaultCurrency)
 				assert.NoError(t, err)
 			},
 			wants: wants{
@@ -123,7 +123,7 @@ func TestLedgerRepository_QueryAggregatedBalanceSuccess(t *testing.T) {
 			r := NewRepository(db, &instrumentators.LedgerInstrumentator{})
 			tt.repoSeed(t, ctx, r)
 
-			balance, err := r.GetSyntheticAccountBalance(ctx, query)
+			balance, err := r.GetSyntheticAccountBalance(ctx, query, vos.DefaultCurrency)
 			assert.NoError(t, err)
 			assert.Equal(t, tt.wants.accountBalance, balance.Balance)
 
@@ -145,7 +145,7 @@ type querySnapshot struct {
 }
 
 func fetchQuerySnapshot(ctx context.Context, db *pgxpool.Pool, query vos.Account) (querySnapshot, error) {
-	const cmd = "select balance, tx_date from account_balance where account = $1;"
+	const cmd = "select credit - debit, tx_date from account_balance where account = $1;"
 
 	var snap querySnapshot
 
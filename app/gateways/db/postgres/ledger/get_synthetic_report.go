@@ -2,110 +2,232 @@ package ledger
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"strconv"
-	"time"
-
-	"github.com/jackc/pgx/v4"
+	"strings"
 
 	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	pag "github.com/stone-co/the-amazing-ledger/app/pagination"
+)
+
+// Groups each entry by the subpaths of its account from depth $1 to depth $2, or to its own depth
+// when shorter. The subpaths are sorted in depth-first order, since that's how ltree compares.
+// The company and the event are constants unless the report is grouped by them.
+const (
+	_syntheticReportQueryPrefix = `
+select
+	subpath(account, 0, depth),
+	currency,
+	%[4]s,
+	%[5]s,
+	coalesce(sum(case operation when %[1]d then amount else 0::bigint end), 0::bigint) as credit,
+	coalesce(sum(case operation when %[2]d then amount else 0::bigint end), 0::bigint) as debit
+from
+	entry,
+	generate_series($1::int, greatest($1::int, least($2::int, nlevel(account)))) as depth
+where
+	account ~ $3
+	and %[3]s >= $4 and %[3]s < $5
+	and ($6::timestamptz is null or created_at <= $6)
+`
+
+	_syntheticReportQueryPagination = `
+	and (subpath(account, 0, depth), currency, %s, %s) > ($%d::ltree, $%d, $%d::text, $%d::smallint)
+`
+
+	_syntheticReportQuerySuffix = `
+group by 1, 2, 3, 4
+order by 1, 2, 3, 4
+limit $7;
+`
 )
 
-const syntheticReportQuery = `
-select 
-	subpath(account, 0, $1),
-	coalesce(SUM(CASE operation WHEN %d THEN amount ELSE 0::bigint END),0::bigint) AS creditSum, 
-	coalesce(SUM(CASE operation WHEN %d THEN amount ELSE 0::bigint END),0::bigint) AS debitSum 
-from 
-	entry 
-where 
-	account ~ $2
-and 
-	created_at >= $3 and created_at < $4 
-group by 1;
+const (
+	_syntheticReportTotalsQueryPrefix = `
+select
+	currency,
+	coalesce(sum(case operation when %[1]d then amount else 0::bigint end), 0::bigint) as credit,
+	coalesce(sum(case operation when %[2]d then amount else 0::bigint end), 0::bigint) as debit
+from
+	entry
+where
+	account ~ $1
+	and %[3]s >= $2 and %[3]s < $3
+	and ($4::timestamptz is null or created_at <= $4)
+`
+
+	_syntheticReportTotalsQuerySuffix = `
+group by 1
+order by 1;
 `
+)
 
-func (r *Repository) GetSyntheticReport(ctx context.Context, query vos.Account, level int, startTime time.Time, endTime time.Time) (*vos.SyntheticReport, error) {
+type syntheticReportCursor struct {
+	Account  string `json:"account"`
+	Currency string `json:"currency"`
+	Company  string `json:"company"`
+	Event    int32  `json:"event"`
+}
+
+// GetSyntheticReport filters the entries by their recorded time, unless the view tells otherwise.
+func (r *Repository) GetSyntheticReport(ctx context.Context, req vos.SyntheticReportRequest) (*vos.SyntheticReport, error) {
 	const operation = "Repository.GetSyntheticReport"
 
-	sqlQuery, params := buildQueryAndParams(query, level, startTime, endTime)
+	query, args, err := generateSyntheticReportQuery(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate %s query: %w", operation, err)
+	}
 
-	defer r.pb.MonitorDataSegment(ctx, collection, operation, sqlQuery).End()
-	rows, errQuery := r.db.Query(
-		ctx,
-		sqlQuery,
-		params...,
-	)
+	defer r.pb.MonitorDataSegment(ctx, collection, operation, query).End()
 
-	if errQuery != nil {
-		if errors.Is(errQuery, pgx.ErrNoRows) {
-			return &vos.SyntheticReport{}, nil
-		}
-		return nil, errQuery
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
 	}
 
 	defer rows.Close()
 
-	results := []vos.AccountResult{}
-	var totalCredit int64
-	var totalDebit int64
+	var (
+		results []vos.AccountResult
+		paths   []string
+	)
 
 	for rows.Next() {
-		var accStr string
-		var credit int64
-		var debit int64
-
-		err := rows.Scan(
-			&accStr,
-			&credit,
-			&debit,
+		var (
+			path   string
+			result vos.AccountResult
 		)
 
+		if err = rows.Scan(&path, &result.Currency, &result.Company, &result.Event, &result.Credit, &result.Debit); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		// The subpaths too short to be analytic accounts are the synthetic accounts grouping them.
+		if strings.Count(path, ".") < 2 {
+			result.Account, err = vos.NewAccount(path + ".*")
+		} else {
+			result.Account, err = vos.NewAnalyticAccount(path)
+		}
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("failed to parse synthetic report account: %w", err)
 		}
 
-		account, err := vos.NewAnalyticAccount(accStr)
+		results = append(results, result)
+		paths = append(paths, path)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s rows have error: %w", operation, err)
+	}
+
+	if len(results) == 0 {
+		return &vos.SyntheticReport{}, nil
+	}
+
+	report := &vos.SyntheticReport{Results: results}
+
+	if len(results) > req.Page.Size {
+		report.Results = results[:req.Page.Size]
+		last := report.Results[len(report.Results)-1]
+
+		// the cursor keeps the scanned subpath, as the synthetic accounts (eg.: 'liability.clients.*') aren't ltrees.
+		report.NextPage, err = pag.NewCursor(syntheticReportCursor{
+			Account:  paths[len(report.Results)-1],
+			Currency: last.Currency.String(),
+			Company:  last.Company,
+			Event:    last.Event,
+		})
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("failed to generate next page token: %w", err)
 		}
+	}
+
+	report.Totals, err = r.getSyntheticReportTotals(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
 
-		path := vos.AccountResult{
-			Account: account,
-			Credit:  credit,
-			Debit:   debit,
+// getSyntheticReportTotals sums the entries of the report by currency, regardless of the page and the level.
+func (r *Repository) getSyntheticReportTotals(ctx context.Context, req vos.SyntheticReportRequest) ([]vos.CurrencyTotal, error) {
+	const operation = "Repository.getSyntheticReportTotals"
+
+	query := fmt.Sprintf(_syntheticReportTotalsQueryPrefix, vos.CreditOperation, vos.DebitOperation, timeColumn(req.Time))
+	args := []interface{}{req.Account.Value(), req.StartDate, req.EndDate, knownAtArg(req.Time)}
+
+	query, args = appendAccountEntryFilter(query, args, req.Filter)
+	query += _syntheticReportTotalsQuerySuffix
+
+	defer r.pb.MonitorDataSegment(ctx, collection, operation, query).End()
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get synthetic report totals: %w", err)
+	}
+
+	defer rows.Close()
+
+	totals := make([]vos.CurrencyTotal, 0, 1)
+
+	for rows.Next() {
+		var total vos.CurrencyTotal
+		if err = rows.Scan(&total.Currency, &total.Credit, &total.Debit); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
 		}
 
-		results = append(results, path)
+		totals = append(totals, total)
+	}
 
-		totalCredit = totalCredit + credit
-		totalDebit = totalDebit + debit
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get synthetic report totals: %w", err)
 	}
 
-	errNext := rows.Err()
-	if errNext != nil {
-		return nil, errNext
+	return totals, nil
+}
+
+func generateSyntheticReportQuery(req vos.SyntheticReportRequest) (string, []interface{}, error) {
+	from := req.Level
+	if req.Tree {
+		from = req.Account.RootLevel()
+		if from < 1 {
+			from = 1
+		} else if from > req.Level {
+			from = req.Level
+		}
 	}
 
-	if results == nil || len(results) < 1 {
-		return &vos.SyntheticReport{}, nil
+	company, event := "''::text", "0::smallint"
+	if req.GroupBy.Company {
+		company = "company"
 	}
 
-	syntheticReport, errEntity := vos.NewSyntheticReport(totalCredit, totalDebit, results)
-	if errEntity != nil {
-		return nil, errEntity
+	if req.GroupBy.Event {
+		event = "event"
 	}
 
-	return syntheticReport, nil
-}
+	query := fmt.Sprintf(_syntheticReportQueryPrefix, vos.CreditOperation, vos.DebitOperation, timeColumn(req.Time), company, event)
+	args := []interface{}{
+		from,
+		req.Level,
+		req.Account.Value(),
+		req.StartDate,
+		req.EndDate,
+		knownAtArg(req.Time),
+		req.Page.Size + 1,
+	}
 
-func buildQueryAndParams(query vos.Account, level int, startTime time.Time, endTime time.Time) (string, []interface{}) {
-	sqlQuery := syntheticReportQuery
-	sqlQuery = fmt.Sprintf(sqlQuery, vos.CreditOperation, vos.DebitOperation)
+	query, args = appendAccountEntryFilter(query, args, req.Filter)
 
-	params := make([]interface{}, 0)
-	params = append(params, strconv.Itoa(level), query.Value(), startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+	if req.Page.Cursor != nil {
+		var cursor syntheticReportCursor
+		if err := req.Page.Extract(&cursor); err != nil {
+			return "", nil, err
+		}
+
+		args = append(args, cursor.Account, cursor.Currency, cursor.Company, cursor.Event)
+		query += fmt.Sprintf(_syntheticReportQueryPagination, company, event, len(args)-3, len(args)-2, len(args)-1, len(args))
+	}
 
-	return sqlQuery, params
+	return query + _syntheticReportQuerySuffix, args, nil
 }
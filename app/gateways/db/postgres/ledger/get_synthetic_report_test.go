@@ -2,14 +2,18 @@ package ledger
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
 	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
 	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	pag "github.com/stone-co/the-amazing-ledger/app/pagination"
 )
 
 func TestLedgerRepository_GetSyntheticReportSuccess(t *testing.T) {
@@ -45,13 +49,15 @@ func TestLedgerRepository_GetSyntheticReportSuccess(t *testing.T) {
 				createTransaction(t, ctx, r, e1, e2)
 			},
 			want: &vos.SyntheticReport{
-				TotalCredit: 0,
-				TotalDebit:  100,
+				Totals: []vos.CurrencyTotal{
+					{Currency: vos.DefaultCurrency, Credit: 0, Debit: 100},
+				},
 				Results: []vos.AccountResult{
 					{
-						Account: debitAccount,
-						Credit:  0,
-						Debit:   100,
+						Account:  debitAccount,
+						Currency: vos.DefaultCurrency,
+						Credit:   0,
+						Debit:    100,
 					},
 				},
 			},
@@ -69,18 +75,72 @@ func TestLedgerRepository_GetSyntheticReportSuccess(t *testing.T) {
 				createTransaction(t, ctx, r, e1, e2)
 			},
 			want: &vos.SyntheticReport{
-				TotalCredit: 100,
-				TotalDebit:  100,
+				Totals: []vos.CurrencyTotal{
+					{Currency: vos.DefaultCurrency, Credit: 100, Debit: 100},
+				},
 				Results: []vos.AccountResult{
 					{
-						Account: creditAccount,
-						Credit:  100,
-						Debit:   0,
+						Account:  creditAccount,
+						Currency: vos.DefaultCurrency,
+						Credit:   100,
+						Debit:    0,
 					},
 					{
-						Account: debitAccount,
-						Credit:  0,
-						Debit:   100,
+						Account:  debitAccount,
+						Currency: vos.DefaultCurrency,
+						Credit:   0,
+						Debit:    100,
+					},
+				},
+			},
+		},
+		{
+			name:      "synthetic account with multiple currencies",
+			query:     base + ".*",
+			level:     3,
+			startDate: time.Now().UTC().Add(-time.Second),
+			endDate:   time.Now().UTC().Add(time.Hour),
+			seed: func(t *testing.T, ctx context.Context, r *Repository) {
+				e1 := createEntry(t, vos.DebitOperation, debitAccount.Value(), vos.IgnoreAccountVersion, 100)
+				e2 := createEntry(t, vos.CreditOperation, creditAccount.Value(), vos.IgnoreAccountVersion, 100)
+
+				e3, err := entities.NewEntry(uuid.New(), vos.DebitOperation, debitAccount.Value(), vos.IgnoreAccountVersion, 20, "USD", json.RawMessage(`{}`))
+				require.NoError(t, err)
+
+				e4, err := entities.NewEntry(uuid.New(), vos.CreditOperation, creditAccount.Value(), vos.IgnoreAccountVersion, 20, "USD", json.RawMessage(`{}`))
+				require.NoError(t, err)
+
+				createTransaction(t, ctx, r, e1, e2, e3, e4)
+			},
+			want: &vos.SyntheticReport{
+				Totals: []vos.CurrencyTotal{
+					{Currency: vos.DefaultCurrency, Credit: 100, Debit: 100},
+					{Currency: vos.Currency("USD"), Credit: 20, Debit: 20},
+				},
+				Results: []vos.AccountResult{
+					{
+						Account:  creditAccount,
+						Currency: vos.DefaultCurrency,
+						Credit:   100,
+						Debit:    0,
+					},
+					{
+						Account:  creditAccount,
+						Currency: vos.Currency("USD"),
+						Credit:   20,
+						Debit:    0,
+					},
+					{
+						Account:  debitAccount,
+						Currency: vos.DefaultCurrency,
+						Credit:   0,
+						Debit:    100,
+					},
+					{
+						Account:  debitAccount,
+						Currency: vos.Currency("USD"),
+						Credit:   0,
+						Debit:    20,
 					},
 				},
 			},
@@ -93,9 +153,7 @@ func TestLedgerRepository_GetSyntheticReportSuccess(t *testing.T) {
 			endDate:   time.Now().UTC().Add(time.Hour * 1),
 			seed:      func(_ *testing.T, _ context.Context, _ *Repository) {},
 			want: &vos.SyntheticReport{
-				TotalCredit: 0,
-				TotalDebit:  0,
-				Results:     nil,
+				Results: nil,
 			},
 		},
 		{
@@ -111,9 +169,7 @@ func TestLedgerRepository_GetSyntheticReportSuccess(t *testing.T) {
 				createTransaction(t, ctx, r, e1, e2)
 			},
 			want: &vos.SyntheticReport{
-				TotalCredit: 0,
-				TotalDebit:  0,
-				Results:     nil,
+				Results: nil,
 			},
 		},
 		{
@@ -129,9 +185,7 @@ func TestLedgerRepository_GetSyntheticReportSuccess(t *testing.T) {
 				createTransaction(t, ctx, r, e1, e2)
 			},
 			want: &vos.SyntheticReport{
-				TotalCredit: 0,
-				TotalDebit:  0,
-				Results:     nil,
+				Results: nil,
 			},
 		},
 	}
@@ -150,9 +204,214 @@ func TestLedgerRepository_GetSyntheticReportSuccess(t *testing.T) {
 			query, err := vos.NewAccount(tt.query)
 			assert.NoError(t, err)
 
-			got, err := r.GetSyntheticReport(ctx, query, tt.level, tt.startDate, tt.endDate)
+			got, err := r.GetSyntheticReport(ctx, vos.SyntheticReportRequest{
+				Account:   query,
+				Level:     tt.level,
+				StartDate: tt.startDate,
+				EndDate:   tt.endDate,
+				Page:      pag.Page{Size: 10},
+			})
 			assert.NoError(t, err)
 			assert.Equal(t, tt.want, got)
 		})
 	}
 }
+
+func TestLedgerRepository_GetSyntheticReportTreePages(t *testing.T) {
+	t.Parallel()
+
+	db := newDB(t, t.Name())
+	ctx := context.Background()
+	r := NewRepository(db, &instrumentators.LedgerInstrumentator{})
+
+	e1 := createEntry(t, vos.DebitOperation, "liability.clients.available.account1", vos.IgnoreAccountVersion, 100)
+	e2 := createEntry(t, vos.CreditOperation, "liability.clients.available.account2", vos.IgnoreAccountVersion, 60)
+	e3 := createEntry(t, vos.CreditOperation, "liability.clients.blocked.account1", vos.IgnoreAccountVersion, 40)
+	createTransaction(t, ctx, r, e1, e2, e3)
+
+	query, err := vos.NewAccount("liability.clients.*")
+	require.NoError(t, err)
+
+	req := vos.SyntheticReportRequest{
+		Account:   query,
+		Level:     3,
+		StartDate: time.Now().UTC().Add(-time.Hour),
+		EndDate:   time.Now().UTC().Add(time.Hour),
+		Page:      pag.Page{Size: 2},
+		Tree:      true,
+	}
+
+	first, err := r.GetSyntheticReport(ctx, req)
+	require.NoError(t, err)
+	require.NotNil(t, first.NextPage)
+
+	req.Page.Cursor = first.NextPage
+	second, err := r.GetSyntheticReport(ctx, req)
+	require.NoError(t, err)
+	assert.Nil(t, second.NextPage)
+
+	results := make(map[string]int64)
+	paths := make([]string, 0, 3)
+
+	for _, result := range append(first.Results, second.Results...) {
+		results[result.Account.Value()] = result.Balance()
+		paths = append(paths, result.Account.Value())
+	}
+
+	assert.Equal(t, []string{"liability.clients.*", "liability.clients.available", "liability.clients.blocked"}, paths)
+	assert.Equal(t, map[string]int64{
+		"liability.clients.*":         0,
+		"liability.clients.available": -40,
+		"liability.clients.blocked":   40,
+	}, results)
+
+	assert.Equal(t, first.Totals, second.Totals)
+	assert.Equal(t, []vos.CurrencyTotal{{Currency: vos.DefaultCurrency, Credit: 100, Debit: 100}}, second.Totals)
+}
+
+func TestLedgerRepository_GetSyntheticReportTreeSingleResultPages(t *testing.T) {
+	t.Parallel()
+
+	db := newDB(t, t.Name())
+	ctx := context.Background()
+	r := NewRepository(db, &instrumentators.LedgerInstrumentator{})
+
+	e1 := createEntry(t, vos.DebitOperation, "liability.clients.available.account1", vos.IgnoreAccountVersion, 100)
+	e2 := createEntry(t, vos.CreditOperation, "liability.clients.available.account2", vos.IgnoreAccountVersion, 60)
+	e3 := createEntry(t, vos.CreditOperation, "liability.clients.blocked.account1", vos.IgnoreAccountVersion, 40)
+	createTransaction(t, ctx, r, e1, e2, e3)
+
+	query, err := vos.NewAccount("liability.*")
+	require.NoError(t, err)
+
+	req := vos.SyntheticReportRequest{
+		Account:   query,
+		Level:     4,
+		StartDate: time.Now().UTC().Add(-time.Hour),
+		EndDate:   time.Now().UTC().Add(time.Hour),
+		Page:      pag.Page{Size: 1},
+		Tree:      true,
+	}
+
+	// every page but the last ends on a single result, so most cursors point to synthetic accounts
+	paths := make([]string, 0, 7)
+
+	for {
+		report, err := r.GetSyntheticReport(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, report.Results, 1)
+
+		paths = append(paths, report.Results[0].Account.Value())
+
+		if report.NextPage == nil {
+			break
+		}
+
+		req.Page.Cursor = report.NextPage
+	}
+
+	assert.Equal(t, []string{
+		"liability.*",
+		"liability.clients.*",
+		"liability.clients.available",
+		"liability.clients.available.account1",
+		"liability.clients.available.account2",
+		"liability.clients.blocked",
+		"liability.clients.blocked.account1",
+	}, paths)
+}
+
+func TestLedgerRepository_GetSyntheticReportByCompany(t *testing.T) {
+	t.Parallel()
+
+	db := newDB(t, t.Name())
+	ctx := context.Background()
+	r := NewRepository(db, &instrumentators.LedgerInstrumentator{})
+
+	const account = "liability.clients.available"
+
+	createTransaction(t, ctx, r,
+		createEntry(t, vos.DebitOperation, "asset.bank.cash", vos.IgnoreAccountVersion, 100),
+		createEntry(t, vos.CreditOperation, account, vos.IgnoreAccountVersion, 100),
+	)
+
+	tx, err := entities.NewTransaction(uuid.New(), uint32(1), "xyz", time.Now().Round(time.Microsecond),
+		createEntry(t, vos.DebitOperation, "asset.bank.cash", vos.IgnoreAccountVersion, 30),
+		createEntry(t, vos.CreditOperation, account, vos.IgnoreAccountVersion, 30),
+	)
+	require.NoError(t, err)
+
+	_, err = r.CreateTransaction(ctx, tx)
+	require.NoError(t, err)
+
+	query, err := vos.NewAccount("liability.*")
+	require.NoError(t, err)
+
+	req := vos.SyntheticReportRequest{
+		Account:   query,
+		Level:     3,
+		StartDate: time.Now().UTC().Add(-time.Hour),
+		EndDate:   time.Now().UTC().Add(time.Hour),
+		GroupBy:   vos.ReportGrouping{Company: true},
+		Page:      pag.Page{Size: 1},
+	}
+
+	first, err := r.GetSyntheticReport(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, first.Results, 1)
+	assert.Equal(t, "abc", first.Results[0].Company)
+	assert.Equal(t, int64(100), first.Results[0].Credit)
+
+	req.Page.Cursor = first.NextPage
+	second, err := r.GetSyntheticReport(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, second.Results, 1)
+	assert.Equal(t, "xyz", second.Results[0].Company)
+	assert.Equal(t, int64(30), second.Results[0].Credit)
+	assert.Nil(t, second.NextPage)
+	assert.Equal(t, []vos.CurrencyTotal{{Currency: vos.DefaultCurrency, Credit: 130, Debit: 130}}, second.Totals)
+
+	req.Filter = vos.AccountEntryFilter{Companies: []string{"xyz"}, Events: []int32{1}}
+	req.Page = pag.Page{Size: 10}
+
+	filtered, err := r.GetSyntheticReport(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, filtered.Results, 1)
+	assert.Equal(t, "xyz", filtered.Results[0].Company)
+	assert.Equal(t, []vos.CurrencyTotal{{Currency: vos.DefaultCurrency, Credit: 30, Debit: 0}}, filtered.Totals)
+}
+
+func TestLedgerRepository_GetSyntheticReportTimeAxis(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	// recorded now, but effective a month ago
+	createTransactionWithDate(t, ctx, r, time.Now().UTC().AddDate(0, -1, 0).Round(time.Microsecond),
+		createEntry(t, vos.DebitOperation, "asset.bank.cash", vos.IgnoreAccountVersion, 100),
+		createEntry(t, vos.CreditOperation, "liability.clients.available", vos.IgnoreAccountVersion, 100),
+	)
+
+	query, err := vos.NewAccount("liability.*")
+	require.NoError(t, err)
+
+	req := vos.SyntheticReportRequest{
+		Account:   query,
+		Level:     3,
+		StartDate: time.Now().UTC().Add(-time.Hour),
+		EndDate:   time.Now().UTC().Add(time.Hour),
+		Page:      pag.Page{Size: 10},
+	}
+
+	report, err := r.GetSyntheticReport(ctx, req)
+	require.NoError(t, err)
+	assert.Empty(t, report.Results, "the dates apply to the competence date by default")
+
+	req.Time = vos.TimeView{Axis: vos.RecordedTimeAxis}
+
+	report, err = r.GetSyntheticReport(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, report.Results, 1)
+	assert.Equal(t, int64(100), report.Results[0].Credit)
+}
@@ -0,0 +1,118 @@
+package ledger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/instrumentation/newrelic"
+)
+
+const getTransactionQuery = `
+select
+	id,
+	event,
+	operation,
+	version,
+	amount,
+	currency,
+	competence_date,
+	account,
+	company,
+	metadata,
+	adjustment,
+	created_at
+from
+	entry
+where
+	tx_id = $1
+;
+`
+
+func (r Repository) GetTransaction(ctx context.Context, id uuid.UUID) (entities.Transaction, error) {
+	const operation = "Repository.GetTransaction"
+
+	defer newrelic.NewDatastoreSegment(ctx, collection, operation, getTransactionQuery).End()
+
+	rows, err := r.db.Query(ctx, getTransactionQuery, id)
+	if err != nil {
+		return entities.Transaction{}, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	defer rows.Close()
+
+	var (
+		event          uint32
+		company        string
+		competenceDate time.Time
+		adjustment     bool
+		createdAt      time.Time
+		entries        = make([]entities.Entry, 0)
+	)
+
+	for rows.Next() {
+		var (
+			entryID  uuid.UUID
+			op       vos.OperationType
+			version  vos.Version
+			amount   int
+			currency string
+			account  string
+			metadata json.RawMessage
+		)
+
+		if err = rows.Scan(
+			&entryID,
+			&event,
+			&op,
+			&version,
+			&amount,
+			&currency,
+			&competenceDate,
+			&account,
+			&company,
+			&metadata,
+			&adjustment,
+			&createdAt,
+		); err != nil {
+			return entities.Transaction{}, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		entry, entryErr := entities.NewEntry(entryID, op, account, version, amount, currency, metadata)
+		if entryErr != nil {
+			return entities.Transaction{}, fmt.Errorf("failed to load entry: %w", entryErr)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return entities.Transaction{}, fmt.Errorf("%s rows have error: %w", operation, err)
+	}
+
+	if len(entries) == 0 {
+		return entities.Transaction{}, app.ErrTransactionNotFound
+	}
+
+	tx, err := entities.NewTransaction(id, event, company, competenceDate, entries...)
+	if err != nil {
+		return entities.Transaction{}, fmt.Errorf("failed to load transaction: %w", err)
+	}
+
+	tx.Adjustment = adjustment
+	tx.CreatedAt = createdAt
+
+	if adjustment {
+		if tx.Authorization, err = r.getAdjustmentAuthorization(ctx, id); err != nil {
+			return entities.Transaction{}, err
+		}
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,65 @@
+package ledger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func TestLedgerRepository_GetTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should get all entries of a transaction", func(t *testing.T) {
+		t.Parallel()
+
+		db := newDB(t, t.Name())
+
+		ctx := context.Background()
+		r := NewRepository(db, &instrumentators.LedgerInstrumentator{})
+
+		e1 := createEntry(t, vos.DebitOperation, "liability.abc.account1", vos.NextAccountVersion, 100)
+		e2 := createEntry(t, vos.CreditOperation, "liability.abc.account2", vos.IgnoreAccountVersion, 100)
+
+		tx := createTransaction(t, ctx, r, e1, e2)
+
+		got, err := r.GetTransaction(ctx, tx.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, tx.ID, got.ID)
+		assert.Equal(t, tx.Event, got.Event)
+		assert.Equal(t, tx.Company, got.Company)
+		assert.True(t, tx.CompetenceDate.Equal(got.CompetenceDate))
+		assert.False(t, got.CreatedAt.IsZero())
+		require.Len(t, got.Entries, 2)
+
+		assert.Equal(t, e1.ID, got.Entries[0].ID)
+		assert.Equal(t, e1.Account, got.Entries[0].Account)
+		assert.Equal(t, e1.Operation, got.Entries[0].Operation)
+		assert.Equal(t, e1.Amount, got.Entries[0].Amount)
+		assert.Equal(t, e1.Currency, got.Entries[0].Currency)
+		assert.Equal(t, vos.Version(1), got.Entries[0].Version)
+
+		assert.Equal(t, e2.ID, got.Entries[1].ID)
+		assert.Equal(t, e2.Account, got.Entries[1].Account)
+		assert.Equal(t, e2.Operation, got.Entries[1].Operation)
+		assert.Equal(t, e2.Amount, got.Entries[1].Amount)
+		assert.Equal(t, vos.IgnoreAccountVersion, got.Entries[1].Version)
+	})
+
+	t.Run("should return an error if transaction does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		db := newDB(t, t.Name())
+		r := NewRepository(db, &instrumentators.LedgerInstrumentator{})
+
+		_, err := r.GetTransaction(context.Background(), uuid.New())
+		assert.ErrorIs(t, err, app.ErrTransactionNotFound)
+	})
+}
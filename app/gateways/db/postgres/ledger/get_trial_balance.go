@@ -0,0 +1,80 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/instrumentation/newrelic"
+)
+
+// when grouping by level, accounts shallower than the level are grouped by all of their labels.
+const trialBalanceQuery = `
+select
+	%s,
+	currency,
+	coalesce(sum(amount) filter (where operation = %d), 0),
+	coalesce(sum(amount) filter (where operation = %d), 0)
+from
+	entry
+where
+	%s <= $1
+	and ($2::text = '' or company = $2)
+	and ($3::timestamptz is null or created_at <= $3)
+group by 1, 2
+order by 1, 2;
+`
+
+func (r Repository) GetTrialBalance(ctx context.Context, req vos.TrialBalanceRequest) (vos.TrialBalance, error) {
+	const operation = "Repository.GetTrialBalance"
+
+	query := buildTrialBalanceQuery(req.Level, timeColumn(req.Time))
+
+	defer newrelic.NewDatastoreSegment(ctx, collection, operation, query).End()
+
+	rows, err := r.db.Query(ctx, query, req.AsOf, req.Company, knownAtArg(req.Time))
+	if err != nil {
+		return vos.TrialBalance{}, fmt.Errorf("failed to get trial balance: %w", err)
+	}
+
+	defer rows.Close()
+
+	accounts := make([]vos.AccountResult, 0)
+
+	for rows.Next() {
+		var (
+			account string
+			result  vos.AccountResult
+		)
+
+		if err = rows.Scan(&account, &result.Currency, &result.Credit, &result.Debit); err != nil {
+			return vos.TrialBalance{}, fmt.Errorf("failed to scan trial balance account: %w", err)
+		}
+
+		if req.Level > 0 {
+			result.Account, err = vos.NewAccount(account + ".*")
+		} else {
+			result.Account, err = vos.NewAnalyticAccount(account)
+		}
+		if err != nil {
+			return vos.TrialBalance{}, fmt.Errorf("failed to parse trial balance account: %w", err)
+		}
+
+		accounts = append(accounts, result)
+	}
+
+	if err = rows.Err(); err != nil {
+		return vos.TrialBalance{}, fmt.Errorf("failed to get trial balance: %w", err)
+	}
+
+	return vos.NewTrialBalance(accounts), nil
+}
+
+func buildTrialBalanceQuery(level int, column string) string {
+	account := "account"
+	if level > 0 {
+		account = fmt.Sprintf("subpath(account, 0, least(%d, nlevel(account)))", level)
+	}
+
+	return fmt.Sprintf(trialBalanceQuery, account, vos.CreditOperation, vos.DebitOperation, column)
+}
@@ -0,0 +1,100 @@
+package ledger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func TestLedgerRepository_GetTrialBalance(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := newDB(t, t.Name())
+	r := NewRepository(db, &instrumentators.LedgerInstrumentator{})
+
+	asOf := time.Now().UTC().Add(-24 * time.Hour).Round(time.Microsecond)
+
+	post := func(company string, competenceDate time.Time, debit, credit string, amount int) {
+		tx, err := entities.NewTransaction(
+			uuid.New(),
+			uint32(1),
+			company,
+			competenceDate,
+			createEntry(t, vos.DebitOperation, debit, vos.IgnoreAccountVersion, amount),
+			createEntry(t, vos.CreditOperation, credit, vos.IgnoreAccountVersion, amount),
+		)
+		require.NoError(t, err)
+
+		_, err = r.CreateTransaction(ctx, tx)
+		require.NoError(t, err)
+	}
+
+	post("abc", asOf.Add(-time.Hour), "asset.bacen.reserves", "liability.clients.available.user_1", 100)
+	post("abc", asOf, "asset.bacen.reserves", "liability.clients.available.user_2", 50)
+	post("abc", asOf.Add(time.Hour), "asset.bacen.reserves", "liability.clients.available.user_2", 1000)
+	post("xyz", asOf.Add(-time.Hour), "asset.bacen.reserves", "liability.clients.available.user_1", 7)
+
+	account := func(value string) vos.Account {
+		acc, err := vos.NewAccount(value)
+		require.NoError(t, err)
+
+		return acc
+	}
+
+	testCases := []struct {
+		name string
+		req  vos.TrialBalanceRequest
+		want vos.TrialBalance
+	}{
+		{
+			name: "analytic accounts of a company",
+			req:  vos.TrialBalanceRequest{Company: "abc", AsOf: asOf},
+			want: vos.TrialBalance{
+				Accounts: []vos.AccountResult{
+					{Account: account("asset.bacen.reserves"), Currency: vos.DefaultCurrency, Debit: 150},
+					{Account: account("liability.clients.available.user_1"), Currency: vos.DefaultCurrency, Credit: 100},
+					{Account: account("liability.clients.available.user_2"), Currency: vos.DefaultCurrency, Credit: 50},
+				},
+				Totals: []vos.CurrencyTotal{{Currency: vos.DefaultCurrency, Credit: 150, Debit: 150}},
+			},
+		},
+		{
+			name: "synthetic accounts of all companies",
+			req:  vos.TrialBalanceRequest{AsOf: asOf, Level: 1},
+			want: vos.TrialBalance{
+				Accounts: []vos.AccountResult{
+					{Account: account("asset.*"), Currency: vos.DefaultCurrency, Debit: 157},
+					{Account: account("liability.*"), Currency: vos.DefaultCurrency, Credit: 157},
+				},
+				Totals: []vos.CurrencyTotal{{Currency: vos.DefaultCurrency, Credit: 157, Debit: 157}},
+			},
+		},
+		{
+			name: "no entries up to the date",
+			req:  vos.TrialBalanceRequest{AsOf: asOf.Add(-48 * time.Hour)},
+			want: vos.TrialBalance{
+				Accounts: []vos.AccountResult{},
+				Totals:   []vos.CurrencyTotal{},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.GetTrialBalance(ctx, tt.req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.True(t, got.Balanced())
+		})
+	}
+}
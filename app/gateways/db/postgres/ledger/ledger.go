@@ -1,6 +1,10 @@
 package ledger
 
 import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4/pgxpool"
 
 	"github.com/stone-co/the-amazing-ledger/app/domain"
@@ -8,23 +12,112 @@ import (
 	"github.com/stone-co/the-amazing-ledger/app/gateways/db/querybuilder"
 )
 
-const collection = "entry"
+// defaultPendingTTL is used when no TTL is configured for pending transactions.
+const defaultPendingTTL = 7 * 24 * time.Hour
+
+// defaultScheduleClaimTimeout is used when no claim timeout is configured for scheduled transactions.
+const defaultScheduleClaimTimeout = 5 * time.Minute
+
+const (
+	collection             = "entry"
+	accountCollection      = "account"
+	balanceLimitCollection = "balance_limit"
+	pendingCollection      = "pending_transaction"
+	scheduledCollection    = "scheduled_transaction"
+	eventCollection        = "event"
+	fiscalPeriodCollection = "fiscal_period"
+
+	competenceLockCollection      = "competence_lock"
+	eventMetadataSchemaCollection = "event_metadata_schema"
+)
 
 var _ domain.Repository = &Repository{}
 
+// executor is implemented by both pgxpool.Pool and pgx.Tx.
+type executor interface {
+	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+}
+
 type Repository struct {
 	db *pgxpool.Pool
 	pb *instrumentators.LedgerInstrumentator
 	qb querybuilder.QueryBuilder
+
+	// pqb builds the inserts of pending entries.
+	pqb querybuilder.QueryBuilder
+
+	// sqb builds the inserts of scheduled entries.
+	sqb querybuilder.QueryBuilder
+
+	// strictAccounts rejects entries into accounts that were never opened.
+	strictAccounts bool
+
+	// pendingTTL is how long a pending transaction holds its amounts before expiring.
+	pendingTTL time.Duration
+
+	// scheduleClaimTimeout is how long a scheduled transaction claimed by the scheduler
+	// waits to be posted before it can be claimed again.
+	scheduleClaimTimeout time.Duration
+
+	// metadataSchemas keeps the compiled metadata schemas the postings are validated against.
+	metadataSchemas *metadataSchemaCache
+}
+
+// Option configures optional Repository behaviour.
+type Option func(*Repository)
+
+// WithStrictAccounts makes the repository reject entries into accounts
+// that are not present in the account registry.
+func WithStrictAccounts(strict bool) Option {
+	return func(r *Repository) {
+		r.strictAccounts = strict
+	}
+}
+
+// WithPendingTransactionTTL sets how long pending transactions hold their amounts before expiring.
+// Non-positive values keep the default TTL.
+func WithPendingTransactionTTL(ttl time.Duration) Option {
+	return func(r *Repository) {
+		if ttl > 0 {
+			r.pendingTTL = ttl
+		}
+	}
+}
+
+// WithScheduleClaimTimeout sets how long a claimed scheduled transaction waits to be posted
+// before it can be claimed again. Non-positive values keep the default timeout.
+func WithScheduleClaimTimeout(timeout time.Duration) Option {
+	return func(r *Repository) {
+		if timeout > 0 {
+			r.scheduleClaimTimeout = timeout
+		}
+	}
 }
 
-func NewRepository(db *pgxpool.Pool, pb *instrumentators.LedgerInstrumentator) *Repository {
+func NewRepository(db *pgxpool.Pool, pb *instrumentators.LedgerInstrumentator, opts ...Option) *Repository {
 	qb := querybuilder.New(createTransactionQuery, numArgs)
 	qb.Init(numDefaultQueries)
 
-	return &Repository{
-		db: db,
-		pb: pb,
-		qb: qb,
+	pqb := querybuilder.New(insertPendingEntriesQuery, numPendingEntryArgs)
+	pqb.Init(numDefaultQueries)
+
+	sqb := querybuilder.New(insertScheduledEntriesQuery, numScheduledEntryArgs)
+	sqb.Init(numDefaultQueries)
+
+	r := &Repository{
+		db:                   db,
+		pb:                   pb,
+		qb:                   qb,
+		pqb:                  pqb,
+		sqb:                  sqb,
+		pendingTTL:           defaultPendingTTL,
+		scheduleClaimTimeout: defaultScheduleClaimTimeout,
+		metadataSchemas:      newMetadataSchemaCache(),
 	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
 }
@@ -18,6 +18,7 @@ select
 	version,
 	operation,
 	amount,
+	currency,
 	event,
 	created_at,
 	competence_date,
@@ -25,9 +26,13 @@ select
 from
 	entry
 where
-	account %s $1
-	and competence_date >= $2
-	and competence_date < $3
+	account %[1]s $1
+	and %[2]s >= $2
+	and %[2]s < $3
+`
+
+	_accountEntriesKnownAtFilter = `
+	and created_at <= $%d
 `
 
 	_accountEntriesCompanyFilter = `
@@ -50,6 +55,18 @@ where
 	and operation = $%d
 `
 
+	_accountEntriesMetadataContainsFilter = `
+	and metadata @> $%d::jsonb
+`
+
+	_accountEntriesMetadataKeysFilter = `
+	and metadata ?& $%d::text[]
+`
+
+	_accountEntriesMetadataPathFilter = `
+	and metadata #>> $%d::text[] = $%d
+`
+
 	_accountEntriesQueryPaginationAnalytic = `
 	and (competence_date, version) <= ($%d, $%d)
 `
@@ -109,6 +126,7 @@ func (r Repository) ListAccountEntries(ctx context.Context, req vos.AccountEntry
 			&entry.Version,
 			&entry.Operation,
 			&entry.Amount,
+			&entry.Currency,
 			&entry.Event,
 			&entry.CreatedAt,
 			&entry.CompetenceDate,
@@ -155,39 +173,16 @@ func generateListAccountEntriesQuery(req vos.AccountEntryRequest) (string, []int
 		operator = "~"
 	}
 
-	query := fmt.Sprintf(_accountEntriesQueryPrefix, operator)
+	query := fmt.Sprintf(_accountEntriesQueryPrefix, operator, timeColumn(req.Time))
 
-	switch len(req.Filter.Companies) {
-	case 0:
-		break
-	case 1:
-		query += fmt.Sprintf(_accountEntriesCompanyFilter, totalArgs+1)
-		args = append(args, req.Filter.Companies[0])
-		totalArgs += 1
-	default:
-		query += fmt.Sprintf(_accountEntriesCompaniesFilter, totalArgs+1)
-		args = append(args, req.Filter.Companies)
+	if !req.Time.KnownAt.IsZero() {
+		query += fmt.Sprintf(_accountEntriesKnownAtFilter, totalArgs+1)
+		args = append(args, req.Time.KnownAt)
 		totalArgs += 1
 	}
 
-	switch len(req.Filter.Events) {
-	case 0:
-		break
-	case 1:
-		query += fmt.Sprintf(_accountEntriesEventFilter, totalArgs+1)
-		args = append(args, req.Filter.Events[0])
-		totalArgs += 1
-	default:
-		query += fmt.Sprintf(_accountEntriesEventsFilter, totalArgs+1)
-		args = append(args, req.Filter.Events)
-		totalArgs += 1
-	}
-
-	if req.Filter.Operation != vos.InvalidOperation {
-		query += fmt.Sprintf(_accountEntriesOperationFilter, totalArgs+1)
-		args = append(args, req.Filter.Operation)
-		totalArgs += 1
-	}
+	query, args = appendAccountEntryFilter(query, args, req.Filter)
+	totalArgs = len(args)
 
 	if req.Page.Cursor != nil {
 		var cursor listAccountEntriesCursor
@@ -218,3 +213,50 @@ func generateListAccountEntriesQuery(req vos.AccountEntryRequest) (string, []int
 
 	return query, args, nil
 }
+
+// appendAccountEntryFilter adds the conditions of the filter to the query, numbering their parameters after the given args.
+func appendAccountEntryFilter(query string, args []interface{}, filter vos.AccountEntryFilter) (string, []interface{}) {
+	switch len(filter.Companies) {
+	case 0:
+		break
+	case 1:
+		args = append(args, filter.Companies[0])
+		query += fmt.Sprintf(_accountEntriesCompanyFilter, len(args))
+	default:
+		args = append(args, filter.Companies)
+		query += fmt.Sprintf(_accountEntriesCompaniesFilter, len(args))
+	}
+
+	switch len(filter.Events) {
+	case 0:
+		break
+	case 1:
+		args = append(args, filter.Events[0])
+		query += fmt.Sprintf(_accountEntriesEventFilter, len(args))
+	default:
+		args = append(args, filter.Events)
+		query += fmt.Sprintf(_accountEntriesEventsFilter, len(args))
+	}
+
+	if filter.Operation != vos.InvalidOperation {
+		args = append(args, filter.Operation)
+		query += fmt.Sprintf(_accountEntriesOperationFilter, len(args))
+	}
+
+	if filter.Metadata.Contains != nil {
+		args = append(args, filter.Metadata.Contains)
+		query += fmt.Sprintf(_accountEntriesMetadataContainsFilter, len(args))
+	}
+
+	if len(filter.Metadata.Keys) > 0 {
+		args = append(args, filter.Metadata.Keys)
+		query += fmt.Sprintf(_accountEntriesMetadataKeysFilter, len(args))
+	}
+
+	for _, equals := range filter.Metadata.Equals {
+		args = append(args, equals.Path, equals.Value)
+		query += fmt.Sprintf(_accountEntriesMetadataPathFilter, len(args)-1, len(args))
+	}
+
+	return query, args
+}
@@ -56,7 +56,7 @@ func Test_generateListAccountEntriesQuery(t *testing.T) {
 					},
 				}
 			},
-			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "=") + _accountEntriesQuerySuffixAnalytic,
+			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "=", "competence_date") + _accountEntriesQuerySuffixAnalytic,
 			expectedArgs:  []interface{}{account.Value(), start, end, size + 1},
 			expectedErr:   nil,
 		},
@@ -73,7 +73,7 @@ func Test_generateListAccountEntriesQuery(t *testing.T) {
 					},
 				}
 			},
-			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "~") + _accountEntriesQuerySuffixSynthetic,
+			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "~", "competence_date") + _accountEntriesQuerySuffixSynthetic,
 			expectedArgs:  []interface{}{synthAccount.Value(), start, end, size + 1},
 			expectedErr:   nil,
 		},
@@ -95,7 +95,7 @@ func Test_generateListAccountEntriesQuery(t *testing.T) {
 					},
 				}
 			},
-			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "=") +
+			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "=", "competence_date") +
 				fmt.Sprintf(_accountEntriesQueryPaginationAnalytic, 5, 6) +
 				_accountEntriesQuerySuffixAnalytic,
 			expectedArgs: []interface{}{account.Value(), start, end, size + 1, end, version.AsInt64()},
@@ -117,7 +117,7 @@ func Test_generateListAccountEntriesQuery(t *testing.T) {
 					},
 				}
 			},
-			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "=") +
+			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "=", "competence_date") +
 				fmt.Sprintf(_accountEntriesCompanyFilter, 5) +
 				_accountEntriesQuerySuffixAnalytic,
 			expectedArgs: []interface{}{account.Value(), start, end, size + 1, "company_1"},
@@ -139,7 +139,7 @@ func Test_generateListAccountEntriesQuery(t *testing.T) {
 					},
 				}
 			},
-			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "=") +
+			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "=", "competence_date") +
 				fmt.Sprintf(_accountEntriesCompaniesFilter, 5) +
 				_accountEntriesQuerySuffixAnalytic,
 			expectedArgs: []interface{}{account.Value(), start, end, size + 1, []string{"company_1", "company_2"}},
@@ -161,7 +161,7 @@ func Test_generateListAccountEntriesQuery(t *testing.T) {
 					},
 				}
 			},
-			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "=") +
+			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "=", "competence_date") +
 				fmt.Sprintf(_accountEntriesEventFilter, 5) +
 				_accountEntriesQuerySuffixAnalytic,
 			expectedArgs: []interface{}{account.Value(), start, end, size + 1, int32(1)},
@@ -183,7 +183,7 @@ func Test_generateListAccountEntriesQuery(t *testing.T) {
 					},
 				}
 			},
-			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "=") +
+			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "=", "competence_date") +
 				fmt.Sprintf(_accountEntriesEventsFilter, 5) +
 				_accountEntriesQuerySuffixAnalytic,
 			expectedArgs: []interface{}{account.Value(), start, end, size + 1, []int32{1, 2}},
@@ -205,7 +205,7 @@ func Test_generateListAccountEntriesQuery(t *testing.T) {
 					},
 				}
 			},
-			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "=") +
+			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "=", "competence_date") +
 				fmt.Sprintf(_accountEntriesOperationFilter, 5) +
 				_accountEntriesQuerySuffixAnalytic,
 			expectedArgs: []interface{}{account.Value(), start, end, size + 1, vos.CreditOperation},
@@ -236,7 +236,7 @@ func Test_generateListAccountEntriesQuery(t *testing.T) {
 					},
 				}
 			},
-			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "=") +
+			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "=", "competence_date") +
 				fmt.Sprintf(_accountEntriesCompaniesFilter, 5) +
 				fmt.Sprintf(_accountEntriesEventFilter, 6) +
 				fmt.Sprintf(_accountEntriesOperationFilter, 7) +
@@ -249,6 +249,48 @@ func Test_generateListAccountEntriesQuery(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			name: "valid - metadata filters with company - with pagination",
+			req: func() vos.AccountEntryRequest {
+				filter := vos.AccountEntryFilter{
+					Companies: []string{"company_1"},
+					Metadata: vos.MetadataFilter{
+						Contains: map[string]interface{}{"merchant_id": "abc"},
+						Keys:     []string{"order"},
+						Equals:   []vos.MetadataPathValue{{Path: []string{"order", "id"}, Value: "123"}},
+					},
+				}
+
+				cursor, _ := pagination.NewCursor(listAccountEntriesCursor{
+					CompetenceDate: end,
+					Version:        1,
+				})
+
+				return vos.AccountEntryRequest{
+					Account:   account,
+					StartDate: start,
+					EndDate:   end,
+					Filter:    filter,
+					Page: pagination.Page{
+						Size:   size,
+						Cursor: cursor,
+					},
+				}
+			},
+			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "=", "competence_date") +
+				fmt.Sprintf(_accountEntriesCompanyFilter, 5) +
+				fmt.Sprintf(_accountEntriesMetadataContainsFilter, 6) +
+				fmt.Sprintf(_accountEntriesMetadataKeysFilter, 7) +
+				fmt.Sprintf(_accountEntriesMetadataPathFilter, 8, 9) +
+				fmt.Sprintf(_accountEntriesQueryPaginationAnalytic, 10, 11) +
+				_accountEntriesQuerySuffixAnalytic,
+			expectedArgs: []interface{}{
+				account.Value(), start, end, size + 1,
+				"company_1", map[string]interface{}{"merchant_id": "abc"}, []string{"order"}, []string{"order", "id"}, "123",
+				end, version.AsInt64(),
+			},
+			expectedErr: nil,
+		},
 		{
 			name: "invalid page	token",
 			req: func() vos.AccountEntryRequest {
@@ -284,10 +326,30 @@ func Test_generateListAccountEntriesQuery(t *testing.T) {
 					},
 				}
 			},
-			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "=") + _accountEntriesQuerySuffixAnalytic,
+			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "=", "competence_date") + _accountEntriesQuerySuffixAnalytic,
 			expectedArgs:  []interface{}{account.Value(), start, end, size + 1},
 			expectedErr:   nil,
 		},
+		{
+			name: "valid - recorded time axis known at an instant - no pagination",
+			req: func() vos.AccountEntryRequest {
+				return vos.AccountEntryRequest{
+					Account:   account,
+					StartDate: start,
+					EndDate:   end,
+					Page: pagination.Page{
+						Size:   size,
+						Cursor: nil,
+					},
+					Time: vos.TimeView{Axis: vos.RecordedTimeAxis, KnownAt: end},
+				}
+			},
+			expectedQuery: fmt.Sprintf(_accountEntriesQueryPrefix, "=", "created_at") +
+				fmt.Sprintf(_accountEntriesKnownAtFilter, 5) +
+				_accountEntriesQuerySuffixAnalytic,
+			expectedArgs: []interface{}{account.Value(), start, end, size + 1, end},
+			expectedErr:  nil,
+		},
 	}
 	for _, tt := range testCases {
 		tt := tt
@@ -524,7 +586,7 @@ func TestLedgerRepository_ListAccountEntries(t *testing.T) {
 				)
 				assert.NoError(t, err)
 
-				err = r.CreateTransaction(ctx, tx1)
+				_, err = r.CreateTransaction(ctx, tx1)
 				assert.NoError(t, err)
 
 				e1 = createEntry(t, vos.DebitOperation, account1, vos.Version(2), amount)
@@ -575,7 +637,7 @@ func TestLedgerRepository_ListAccountEntries(t *testing.T) {
 				)
 				assert.NoError(t, err)
 
-				err = r.CreateTransaction(ctx, tx1)
+				_, err = r.CreateTransaction(ctx, tx1)
 				assert.NoError(t, err)
 
 				e1 = createEntry(t, vos.DebitOperation, account1, vos.Version(2), amount)
@@ -627,7 +689,7 @@ func TestLedgerRepository_ListAccountEntries(t *testing.T) {
 				)
 				assert.NoError(t, err)
 
-				err = r.CreateTransaction(ctx, tx1)
+				_, err = r.CreateTransaction(ctx, tx1)
 				assert.NoError(t, err)
 
 				e1 = createEntry(t, vos.DebitOperation, account1, vos.Version(2), amount)
@@ -678,7 +740,7 @@ func TestLedgerRepository_ListAccountEntries(t *testing.T) {
 				)
 				assert.NoError(t, err)
 
-				err = r.CreateTransaction(ctx, tx1)
+				_, err = r.CreateTransaction(ctx, tx1)
 				assert.NoError(t, err)
 
 				e1 = createEntry(t, vos.DebitOperation, account1, vos.Version(2), amount)
@@ -750,6 +812,58 @@ func TestLedgerRepository_ListAccountEntries(t *testing.T) {
 			want: func(t *testing.T, txs []entities.Transaction, _ *Repository) w {
 				entries := accountEntriesFromTransaction(t, txs[0], account1)
 
+				return w{
+					entries: entries,
+					cursor:  nil,
+				}
+			},
+		},
+		{
+			name: "return filtered by metadata",
+			seedRepo: func(t *testing.T, ctx context.Context, r *Repository) []entities.Transaction {
+				e1, err := entities.NewEntry(uuid.New(), vos.DebitOperation, account1, vos.Version(1), amount, "BRL",
+					json.RawMessage(`{"merchant_id": "m1", "order": {"id": "123"}}`))
+				assert.NoError(t, err)
+
+				e2 := createEntry(t, vos.CreditOperation, account2, vos.IgnoreAccountVersion, amount)
+				tx1 := createTransaction(t, ctx, r, e1, e2)
+
+				e1, err = entities.NewEntry(uuid.New(), vos.DebitOperation, account1, vos.Version(2), amount, "BRL",
+					json.RawMessage(`{"merchant_id": "m1", "order": {"id": "456"}}`))
+				assert.NoError(t, err)
+
+				e2 = createEntry(t, vos.CreditOperation, account2, vos.IgnoreAccountVersion, amount)
+				tx2 := createTransaction(t, ctx, r, e1, e2)
+
+				return []entities.Transaction{tx1, tx2}
+			},
+			setupRequest: func(t *testing.T, _ []entities.Transaction, _ *Repository) vos.AccountEntryRequest {
+				account, err := vos.NewAnalyticAccount(account1)
+				assert.NoError(t, err)
+
+				now := time.Now()
+
+				return vos.AccountEntryRequest{
+					Account:   account,
+					StartDate: now.Add(-10 * time.Second),
+					EndDate:   now.Add(10 * time.Second),
+					Filter: vos.AccountEntryFilter{
+						Companies: []string{"abc"},
+						Metadata: vos.MetadataFilter{
+							Contains: map[string]interface{}{"merchant_id": "m1"},
+							Keys:     []string{"order"},
+							Equals:   []vos.MetadataPathValue{{Path: []string{"order", "id"}, Value: "123"}},
+						},
+					},
+					Page: pagination.Page{
+						Size:   10,
+						Cursor: nil,
+					},
+				}
+			},
+			want: func(t *testing.T, txs []entities.Transaction, _ *Repository) w {
+				entries := accountEntriesFromTransaction(t, txs[0], account1)
+
 				return w{
 					entries: entries,
 					cursor:  nil,
@@ -953,6 +1067,7 @@ func accountEntriesFromTransaction(t *testing.T, tx entities.Transaction, accoun
 			Version:        et.Version,
 			Operation:      et.Operation,
 			Amount:         et.Amount,
+			Currency:       et.Currency,
 			Event:          int(tx.Event),
 			CreatedAt:      time.Now(),
 			CompetenceDate: tx.CompetenceDate.Round(time.Microsecond),
@@ -1018,6 +1133,7 @@ func accountEntriesFromTransactionWithSyntheticAccountFilter(t *testing.T, txs [
 				Version:        et.Version,
 				Operation:      et.Operation,
 				Amount:         et.Amount,
+				Currency:       et.Currency,
 				Event:          int(tx.Event),
 				CreatedAt:      time.Now(),
 				CompetenceDate: tx.CompetenceDate.Round(time.Microsecond),
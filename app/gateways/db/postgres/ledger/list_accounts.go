@@ -0,0 +1,306 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/instrumentation/newrelic"
+	pag "github.com/stone-co/the-amazing-ledger/app/pagination"
+)
+
+const (
+	// the page is taken from posted_account first, so only the entries of the accounts in the page are read.
+	// Accounts only posted ignoring their versions have no account_version, so their version is the one of
+	// the entries (-1), like GetAnalyticAccountBalance does.
+	_listAccountsQueryPrefix = `
+select
+	a.account,
+	coalesce(v.version, e.version),
+	e.first_activity,
+	e.last_activity
+from
+	(
+		select
+			account
+		from
+			posted_account
+		where
+			account %s $1
+`
+
+	_listAccountsPagination = `
+			and account > $%d::ltree
+`
+
+	_listAccountsQuerySuffix = `
+		order by
+			account
+		limit $2
+	) a
+	left join account_version v on v.account = a.account
+	cross join lateral (
+		select
+			max(version) as version,
+			min(competence_date) as first_activity,
+			max(competence_date) as last_activity
+		from
+			entry
+		where
+			account = a.account
+	) e
+order by
+	a.account;
+`
+
+	// the balances are read like GetAnalyticAccountBalance does, so they also refresh the balance snapshots.
+	listAccountBalancesQuery = `
+select
+	e.account,
+	e.currency,
+	b.total_credit,
+	b.total_debit,
+	get_held_amount(e.account::text::lquery, e.currency)
+from
+	(select distinct account, currency from entry where account = any($1::ltree[])) e
+	cross join lateral get_analytic_account_balance(e.account, e.currency) b
+order by
+	e.account,
+	e.currency;
+`
+
+	_listAccountChildrenQueryPrefix = `
+select
+	subpath(account, $1, 1)::text as label,
+	count(*),
+	bool_or(nlevel(account) = $1 + 1)
+from
+	posted_account
+where
+	account <@ $2::ltree
+	and nlevel(account) > $1
+`
+
+	_listAccountChildrenPagination = `
+	and subpath(account, $1, 1)::text > $%d
+`
+
+	_listAccountChildrenQuerySuffix = `
+group by
+	label
+order by
+	label
+limit $3;
+`
+)
+
+type listAccountsCursor struct {
+	Account string `json:"account"`
+}
+
+type listAccountChildrenCursor struct {
+	Label string `json:"label"`
+}
+
+func (r Repository) ListAccounts(ctx context.Context, req vos.AccountListRequest) ([]vos.AccountSummary, pag.Cursor, error) {
+	const operation = "Repository.ListAccounts"
+
+	query, args, err := generateListAccountsQuery(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate %s query: %w", operation, err)
+	}
+
+	defer newrelic.NewDatastoreSegment(ctx, collection, operation, query).End()
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	defer rows.Close()
+
+	accounts := make([]vos.AccountSummary, 0)
+
+	for rows.Next() {
+		var (
+			name    string
+			version int64
+			summary vos.AccountSummary
+		)
+
+		if err = rows.Scan(&name, &version, &summary.FirstActivity, &summary.LastActivity); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		account, err := vos.NewAnalyticAccount(name)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse account %s: %w", name, err)
+		}
+
+		summary.Account = account
+		summary.CurrentVersion = vos.Version(version)
+
+		accounts = append(accounts, summary)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("%s rows have error: %w", operation, err)
+	}
+
+	var cursor pag.Cursor
+
+	if len(accounts) > req.Page.Size {
+		accounts = accounts[:req.Page.Size]
+
+		cursor, err = pag.NewCursor(listAccountsCursor{Account: accounts[len(accounts)-1].Account.Value()})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to generate next page token: %w", err)
+		}
+	}
+
+	if req.WithBalance && len(accounts) > 0 {
+		if err = r.loadAccountBalances(ctx, accounts); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return accounts, cursor, nil
+}
+
+func generateListAccountsQuery(req vos.AccountListRequest) (string, []interface{}, error) {
+	operator := "="
+	if req.Account.Type() == vos.Synthetic {
+		operator = "~"
+	}
+
+	query := fmt.Sprintf(_listAccountsQueryPrefix, operator)
+	args := []interface{}{req.Account.Value(), req.Page.Size + 1}
+
+	if req.Page.Cursor != nil {
+		var cursor listAccountsCursor
+		if err := req.Page.Extract(&cursor); err != nil {
+			return "", nil, err
+		}
+
+		args = append(args, cursor.Account)
+		query += fmt.Sprintf(_listAccountsPagination, len(args))
+	}
+
+	return query + _listAccountsQuerySuffix, args, nil
+}
+
+// loadAccountBalances fills the balances of the accounts, in every currency they have entries.
+func (r Repository) loadAccountBalances(ctx context.Context, accounts []vos.AccountSummary) error {
+	const operation = "Repository.ListAccountBalances"
+
+	defer newrelic.NewDatastoreSegment(ctx, collection, operation, listAccountBalancesQuery).End()
+
+	names := make([]string, len(accounts))
+	index := make(map[string]int, len(accounts))
+
+	for i, account := range accounts {
+		names[i] = account.Account.Value()
+		index[account.Account.Value()] = i
+	}
+
+	rows, err := r.db.Query(ctx, listAccountBalancesQuery, names)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			name                string
+			currency            vos.Currency
+			credit, debit, held int
+		)
+
+		if err = rows.Scan(&name, &currency, &credit, &debit, &held); err != nil {
+			return fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		i := index[name]
+
+		balance := vos.NewAnalyticAccountBalance(accounts[i].Account, currency, accounts[i].CurrentVersion, credit, debit)
+		balance.Available -= held
+
+		accounts[i].Balances = append(accounts[i].Balances, balance)
+	}
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("%s rows have error: %w", operation, err)
+	}
+
+	return nil
+}
+
+func (r Repository) ListAccountChildren(ctx context.Context, req vos.AccountChildrenRequest) ([]vos.AccountChild, pag.Cursor, error) {
+	const operation = "Repository.ListAccountChildren"
+
+	query, args, err := generateListAccountChildrenQuery(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate %s query: %w", operation, err)
+	}
+
+	defer newrelic.NewDatastoreSegment(ctx, collection, operation, query).End()
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	defer rows.Close()
+
+	children := make([]vos.AccountChild, 0)
+
+	for rows.Next() {
+		var child vos.AccountChild
+
+		if err = rows.Scan(&child.Label, &child.Accounts, &child.Analytic); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		child.Path = child.Label
+		if req.Path.Value() != "" {
+			child.Path = req.Path.Value() + "." + child.Label
+		}
+
+		children = append(children, child)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("%s rows have error: %w", operation, err)
+	}
+
+	if len(children) <= req.Page.Size {
+		return children, nil, nil
+	}
+
+	children = children[:req.Page.Size]
+
+	cursor, err := pag.NewCursor(listAccountChildrenCursor{Label: children[len(children)-1].Label})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate next page token: %w", err)
+	}
+
+	return children, cursor, nil
+}
+
+func generateListAccountChildrenQuery(req vos.AccountChildrenRequest) (string, []interface{}, error) {
+	query := _listAccountChildrenQueryPrefix
+	args := []interface{}{req.Path.Level(), req.Path.Value(), req.Page.Size + 1}
+
+	if req.Page.Cursor != nil {
+		var cursor listAccountChildrenCursor
+		if err := req.Page.Extract(&cursor); err != nil {
+			return "", nil, err
+		}
+
+		args = append(args, cursor.Label)
+		query += fmt.Sprintf(_listAccountChildrenPagination, len(args))
+	}
+
+	return query + _listAccountChildrenQuerySuffix, args, nil
+}
@@ -0,0 +1,187 @@
+package ledger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/pagination"
+)
+
+func TestLedgerRepository_ListAccounts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := newDB(t, t.Name())
+	r := NewRepository(db, &instrumentators.LedgerInstrumentator{})
+
+	first := time.Now().UTC().Add(-48 * time.Hour).Round(time.Microsecond)
+	last := first.Add(24 * time.Hour)
+
+	post := func(competenceDate time.Time, debit, credit string, creditVersion vos.Version, amount int) {
+		tx, err := entities.NewTransaction(
+			uuid.New(),
+			uint32(1),
+			"abc",
+			competenceDate,
+			createEntry(t, vos.DebitOperation, debit, vos.IgnoreAccountVersion, amount),
+			createEntry(t, vos.CreditOperation, credit, creditVersion, amount),
+		)
+		require.NoError(t, err)
+
+		_, err = r.CreateTransaction(ctx, tx)
+		require.NoError(t, err)
+	}
+
+	// only the first account tracks its version, the others are always posted ignoring it
+	post(first, "asset.bacen.reserves", "liability.clients.available.user_1", vos.NextAccountVersion, 100)
+	post(last, "asset.bacen.reserves", "liability.clients.available.user_2", vos.IgnoreAccountVersion, 50)
+	post(last, "asset.bacen.reserves", "liability.clients.blocked.user_1", vos.IgnoreAccountVersion, 10)
+
+	query, err := vos.NewAccount("liability.clients.*")
+	require.NoError(t, err)
+
+	page := pagination.Page{Size: 2}
+
+	accounts, cursor, err := r.ListAccounts(ctx, vos.AccountListRequest{Account: query, WithBalance: true, Page: page})
+	require.NoError(t, err)
+	require.NotNil(t, cursor)
+	require.Len(t, accounts, 2)
+
+	assert.Equal(t, "liability.clients.available.user_1", accounts[0].Account.Value())
+	assert.Equal(t, vos.Version(1), accounts[0].CurrentVersion)
+	assert.True(t, first.Equal(accounts[0].FirstActivity))
+	assert.True(t, first.Equal(accounts[0].LastActivity))
+	require.Len(t, accounts[0].Balances, 1)
+	assert.Equal(t, vos.DefaultCurrency, accounts[0].Balances[0].Currency)
+	assert.Equal(t, 100, accounts[0].Balances[0].Balance)
+	assert.Equal(t, "liability.clients.available.user_2", accounts[1].Account.Value())
+	assert.Equal(t, vos.IgnoreAccountVersion, accounts[1].CurrentVersion)
+
+	page.Cursor = cursor
+
+	accounts, cursor, err = r.ListAccounts(ctx, vos.AccountListRequest{Account: query, Page: page})
+	require.NoError(t, err)
+	assert.Nil(t, cursor)
+	require.Len(t, accounts, 1)
+	assert.Equal(t, "liability.clients.blocked.user_1", accounts[0].Account.Value())
+	assert.Empty(t, accounts[0].Balances)
+
+	reserves, err := vos.NewAccount("asset.bacen.reserves")
+	require.NoError(t, err)
+
+	accounts, _, err = r.ListAccounts(ctx, vos.AccountListRequest{Account: reserves, Page: page})
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	assert.Equal(t, vos.IgnoreAccountVersion, accounts[0].CurrentVersion)
+	assert.True(t, first.Equal(accounts[0].FirstActivity))
+	assert.True(t, last.Equal(accounts[0].LastActivity))
+}
+
+func TestLedgerRepository_ListAccountChildren(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := newDB(t, t.Name())
+	r := NewRepository(db, &instrumentators.LedgerInstrumentator{})
+
+	createTransaction(t, ctx, r,
+		createEntry(t, vos.DebitOperation, "asset.bacen.reserves", vos.IgnoreAccountVersion, 100),
+		createEntry(t, vos.CreditOperation, "liability.clients.available", vos.IgnoreAccountVersion, 50),
+		createEntry(t, vos.CreditOperation, "liability.clients.available.user_1", vos.IgnoreAccountVersion, 30),
+		createEntry(t, vos.CreditOperation, "liability.clients.blocked.user_1", vos.IgnoreAccountVersion, 20),
+	)
+
+	path := func(value string) vos.AccountPath {
+		p, err := vos.NewAccountPath(value)
+		require.NoError(t, err)
+
+		return p
+	}
+
+	testCases := []struct {
+		name string
+		path vos.AccountPath
+		want []vos.AccountChild
+	}{
+		{
+			name: "classes from the root",
+			path: path(""),
+			want: []vos.AccountChild{
+				{Label: "asset", Path: "asset", Accounts: 1},
+				{Label: "liability", Path: "liability", Accounts: 3},
+			},
+		},
+		{
+			name: "labels under a path",
+			path: path("liability.clients.*"),
+			want: []vos.AccountChild{
+				{Label: "available", Path: "liability.clients.available", Accounts: 2, Analytic: true},
+				{Label: "blocked", Path: "liability.clients.blocked", Accounts: 1},
+			},
+		},
+		{
+			name: "labels under an analytic account",
+			path: path("liability.clients.available"),
+			want: []vos.AccountChild{
+				{Label: "user_1", Path: "liability.clients.available.user_1", Accounts: 1, Analytic: true},
+			},
+		},
+		{
+			name: "unknown path",
+			path: path("equity.*"),
+			want: []vos.AccountChild{},
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			got, cursor, err := r.ListAccountChildren(ctx, vos.AccountChildrenRequest{Path: tt.path, Page: pagination.Page{Size: 10}})
+			require.NoError(t, err)
+			assert.Nil(t, cursor)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("paginates the children", func(t *testing.T) {
+		page := pagination.Page{Size: 1}
+
+		got, cursor, err := r.ListAccountChildren(ctx, vos.AccountChildrenRequest{Path: path("liability.clients"), Page: page})
+		require.NoError(t, err)
+		require.NotNil(t, cursor)
+		assert.Equal(t, "available", got[0].Label)
+
+		page.Cursor = cursor
+
+		got, cursor, err = r.ListAccountChildren(ctx, vos.AccountChildrenRequest{Path: path("liability.clients"), Page: page})
+		require.NoError(t, err)
+		assert.Nil(t, cursor)
+		require.Len(t, got, 1)
+		assert.Equal(t, "blocked", got[0].Label)
+	})
+}
+
+func TestGenerateListAccountsQuery(t *testing.T) {
+	query, err := vos.NewAccount("liability.clients.*")
+	require.NoError(t, err)
+
+	cursor, err := pagination.NewCursor(listAccountsCursor{Account: "liability.clients.available"})
+	require.NoError(t, err)
+
+	sql, args, err := generateListAccountsQuery(vos.AccountListRequest{Account: query, Page: pagination.Page{Size: 10, Cursor: cursor}})
+	require.NoError(t, err)
+	assert.Contains(t, sql, "account ~ $1")
+	assert.Contains(t, sql, "account > $3::ltree")
+	assert.Equal(t, []interface{}{"liability.clients.*", 11, "liability.clients.available"}, args)
+
+	_, _, err = generateListAccountsQuery(vos.AccountListRequest{Account: query, Page: pagination.Page{Size: 10, Cursor: []byte("invalid")}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,46 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/instrumentation/newrelic"
+)
+
+const openAccountQuery = `
+insert into account (account, owner, display_name, opening_date, status)
+values ($1, $2, $3, $4, $5)
+returning created_at, updated_at;
+`
+
+func (r Repository) OpenAccount(ctx context.Context, account entities.Account) (entities.Account, error) {
+	const operation = "Repository.OpenAccount"
+
+	defer newrelic.NewDatastoreSegment(ctx, accountCollection, operation, openAccountQuery).End()
+
+	err := r.db.QueryRow(
+		ctx,
+		openAccountQuery,
+		account.Account.Value(),
+		account.Owner,
+		account.DisplayName,
+		account.OpeningDate,
+		account.Status,
+	).Scan(&account.CreatedAt, &account.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			return entities.Account{}, app.ErrAccountAlreadyOpened
+		}
+
+		return entities.Account{}, fmt.Errorf("failed to insert account: %w", err)
+	}
+
+	return account, nil
+}
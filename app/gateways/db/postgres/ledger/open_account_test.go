@@ -0,0 +1,213 @@
+package ledger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+)
+
+func TestLedgerRepository_OpenAccount(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	account, err := entities.NewAccount(testdata.GenerateAccountPath(), "owner", "Display Name", time.Now().UTC().Round(time.Microsecond))
+	require.NoError(t, err)
+
+	opened, err := r.OpenAccount(ctx, account)
+	assert.NoError(t, err)
+	assert.False(t, opened.CreatedAt.IsZero())
+	assert.Equal(t, opened.CreatedAt, opened.UpdatedAt)
+
+	got, err := r.GetAccount(ctx, account.Account)
+	assert.NoError(t, err)
+	assert.Equal(t, account.Owner, got.Owner)
+	assert.Equal(t, account.DisplayName, got.DisplayName)
+	assert.Equal(t, vos.ActiveAccountStatus, got.Status)
+	assert.True(t, account.OpeningDate.Equal(got.OpeningDate))
+
+	_, err = r.OpenAccount(ctx, account)
+	assert.ErrorIs(t, err, app.ErrAccountAlreadyOpened)
+}
+
+func TestLedgerRepository_GetAccountNotFound(t *testing.T) {
+	t.Parallel()
+
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	account, err := vos.NewAnalyticAccount(testdata.GenerateAccountPath())
+	require.NoError(t, err)
+
+	_, err = r.GetAccount(context.Background(), account)
+	assert.ErrorIs(t, err, app.ErrAccountNotFound)
+}
+
+func TestLedgerRepository_UpdateAccountStatus(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	account, err := entities.NewAccount(testdata.GenerateAccountPath(), "owner", "", time.Now())
+	require.NoError(t, err)
+
+	account, err = r.OpenAccount(ctx, account)
+	require.NoError(t, err)
+
+	frozen, err := account.Freeze()
+	require.NoError(t, err)
+
+	got, err := r.UpdateAccountStatus(ctx, frozen, vos.ActiveAccountStatus)
+	assert.NoError(t, err)
+	assert.Equal(t, vos.FrozenAccountStatus, got.Status)
+
+	// The account is no longer active, so a stale transition must not apply.
+	closed, err := account.Close()
+	require.NoError(t, err)
+
+	_, err = r.UpdateAccountStatus(ctx, closed, vos.ActiveAccountStatus)
+	assert.ErrorIs(t, err, app.ErrInvalidAccountStatusTransition)
+
+	stored, err := r.GetAccount(ctx, account.Account)
+	assert.NoError(t, err)
+	assert.Equal(t, vos.FrozenAccountStatus, stored.Status)
+}
+
+func TestLedgerRepository_CreateTransactionAccountStatus(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		strict      bool
+		status      vos.AccountStatus
+		open        bool
+		expectedErr error
+	}{
+		{
+			name:        "accepts entries into an active account",
+			open:        true,
+			status:      vos.ActiveAccountStatus,
+			expectedErr: nil,
+		},
+		{
+			name:        "rejects entries into a frozen account",
+			open:        true,
+			status:      vos.FrozenAccountStatus,
+			expectedErr: app.ErrAccountNotActive,
+		},
+		{
+			name:        "rejects entries into a closed account",
+			open:        true,
+			status:      vos.ClosedAccountStatus,
+			expectedErr: app.ErrAccountNotActive,
+		},
+		{
+			name:        "accepts entries into unregistered accounts",
+			open:        false,
+			expectedErr: nil,
+		},
+		{
+			name:        "rejects entries into unregistered accounts in strict mode",
+			strict:      true,
+			open:        false,
+			expectedErr: app.ErrAccountNotOpened,
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{}, WithStrictAccounts(tt.strict))
+
+			e1 := createEntry(t, vos.DebitOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100)
+			e2 := createEntry(t, vos.CreditOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100)
+
+			if tt.open {
+				for _, entry := range []entities.Entry{e1, e2} {
+					account, err := entities.NewAccount(entry.Account.Value(), "owner", "", time.Now())
+					require.NoError(t, err)
+
+					account.Status = tt.status
+
+					_, err = r.OpenAccount(ctx, account)
+					require.NoError(t, err)
+				}
+			}
+
+			tx, err := entities.NewTransaction(uuid.New(), 1, "abc", time.Now(), e1, e2)
+			require.NoError(t, err)
+
+			_, err = r.CreateTransaction(ctx, tx)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestLedgerRepository_UpdateAccountStatusWaitsForPostings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := newDB(t, t.Name())
+	r := NewRepository(db, &instrumentators.LedgerInstrumentator{})
+
+	account, err := entities.NewAccount(testdata.GenerateAccountPath(), "owner", "", time.Now())
+	require.NoError(t, err)
+
+	account, err = r.OpenAccount(ctx, account)
+	require.NoError(t, err)
+
+	e1 := createEntry(t, vos.DebitOperation, account.Account.Value(), vos.NextAccountVersion, 100)
+	e2 := createEntry(t, vos.CreditOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100)
+
+	posting, err := db.Begin(ctx)
+	require.NoError(t, err)
+
+	defer func() { _ = posting.Rollback(ctx) }()
+
+	require.NoError(t, r.checkAccounts(ctx, posting, []entities.Entry{e1, e2}))
+
+	var xmax string
+	require.NoError(t, db.QueryRow(ctx, "select xmax::text from account where account = $1::text::ltree;", account.Account.Value()).Scan(&xmax))
+	assert.Equal(t, "0", xmax, "postings don't lock the account row")
+
+	frozen, err := account.Freeze()
+	require.NoError(t, err)
+
+	done := make(chan error, 1)
+	go func() {
+		_, updateErr := r.UpdateAccountStatus(ctx, frozen, vos.ActiveAccountStatus)
+		done <- updateErr
+	}()
+
+	select {
+	case err = <-done:
+		t.Fatalf("account frozen while a posting was in progress: %v", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	require.NoError(t, posting.Commit(ctx))
+	assert.NoError(t, <-done)
+
+	e3 := createEntry(t, vos.DebitOperation, account.Account.Value(), vos.NextAccountVersion, 100)
+	e4 := createEntry(t, vos.CreditOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100)
+
+	tx, err := entities.NewTransaction(uuid.New(), 1, "abc", time.Now(), e3, e4)
+	require.NoError(t, err)
+
+	_, err = r.CreateTransaction(ctx, tx)
+	assert.ErrorIs(t, err, app.ErrAccountNotActive)
+}
@@ -0,0 +1,266 @@
+package ledger
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/instrumentation/newrelic"
+)
+
+const numPendingEntryArgs = 8
+
+const insertPendingTransactionQuery = `
+insert into pending_transaction (id, event, company, competence_date, expires_at)
+values ($1, $2, $3, $4, now() + $5::interval)
+returning expires_at, created_at;
+`
+
+const insertPendingEntriesQuery = `
+insert into pending_entry (id, tx_id, operation, version, amount, currency, account, metadata)
+values %s;`
+
+const getPendingTransactionQuery = `
+select
+	t.event,
+	t.company,
+	t.competence_date,
+	t.status,
+	t.expires_at,
+	t.created_at,
+	e.id,
+	e.operation,
+	e.version,
+	e.amount,
+	e.currency,
+	e.account,
+	e.metadata
+from
+	pending_transaction t
+	join pending_entry e on e.tx_id = t.id
+where
+	t.id = $1
+;
+`
+
+// Expiration is checked again by the database clock, so a hold can't be used after it expired.
+const updatePendingTransactionQuery = `
+update pending_transaction
+set
+	status = $2,
+	captured_tx_id = $3,
+	captured_amount = $4,
+	updated_at = now()
+where
+	id = $1 and status = 1 and expires_at > now()
+;
+`
+
+func (r Repository) AuthorizeTransaction(ctx context.Context, pending entities.PendingTransaction) (entities.PendingTransaction, error) {
+	const operation = "Repository.AuthorizeTransaction"
+
+	defer newrelic.NewDatastoreSegment(ctx, pendingCollection, operation, insertPendingTransactionQuery).End()
+
+	transaction := pending.Transaction
+
+	err := r.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := r.checkEvents(ctx, tx, transaction); err != nil {
+			return err
+		}
+
+		if err := r.checkAccounts(ctx, tx, transaction.Entries); err != nil {
+			return err
+		}
+
+		limits, err := r.lockBalanceLimits(ctx, tx, transaction.Entries)
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(
+			ctx,
+			insertPendingTransactionQuery,
+			transaction.ID,
+			transaction.Event,
+			transaction.Company,
+			transaction.CompetenceDate,
+			r.pendingTTL,
+		).Scan(&pending.ExpiresAt, &pending.Transaction.CreatedAt)
+		if err != nil {
+			return pendingInsertError(err)
+		}
+
+		args := make([]interface{}, 0, len(transaction.Entries)*numPendingEntryArgs)
+		for _, entry := range transaction.Entries {
+			args = append(
+				args,
+				entry.ID,
+				transaction.ID,
+				entry.Operation,
+				entry.Version,
+				entry.Amount,
+				entry.Currency,
+				entry.Account.Value(),
+				entry.Metadata,
+			)
+		}
+
+		if _, err = tx.Exec(ctx, r.pqb.Build(len(transaction.Entries)), args...); err != nil {
+			return pendingInsertError(err)
+		}
+
+		// the new holds are already deducted from the available balances checked here
+		return r.checkBalanceLimits(ctx, tx, limits, nil)
+	})
+	if err != nil {
+		return entities.PendingTransaction{}, err
+	}
+
+	return pending, nil
+}
+
+func pendingInsertError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+		return app.ErrIdempotencyKeyViolation
+	}
+
+	return fmt.Errorf("failed to insert pending transaction: %w", err)
+}
+
+func (r Repository) GetPendingTransaction(ctx context.Context, id uuid.UUID) (entities.PendingTransaction, error) {
+	const operation = "Repository.GetPendingTransaction"
+
+	defer newrelic.NewDatastoreSegment(ctx, pendingCollection, operation, getPendingTransactionQuery).End()
+
+	rows, err := r.db.Query(ctx, getPendingTransactionQuery, id)
+	if err != nil {
+		return entities.PendingTransaction{}, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	defer rows.Close()
+
+	var (
+		event          uint32
+		company        string
+		competenceDate time.Time
+		status         vos.PendingStatus
+		expiresAt      time.Time
+		createdAt      time.Time
+		entries        = make([]entities.Entry, 0)
+	)
+
+	for rows.Next() {
+		var (
+			entryID  uuid.UUID
+			op       vos.OperationType
+			version  vos.Version
+			amount   int
+			currency string
+			account  string
+			metadata json.RawMessage
+		)
+
+		if err = rows.Scan(
+			&event,
+			&company,
+			&competenceDate,
+			&status,
+			&expiresAt,
+			&createdAt,
+			&entryID,
+			&op,
+			&version,
+			&amount,
+			&currency,
+			&account,
+			&metadata,
+		); err != nil {
+			return entities.PendingTransaction{}, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		entry, entryErr := entities.NewEntry(entryID, op, account, version, amount, currency, metadata)
+		if entryErr != nil {
+			return entities.PendingTransaction{}, fmt.Errorf("failed to load entry: %w", entryErr)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return entities.PendingTransaction{}, fmt.Errorf("%s rows have error: %w", operation, err)
+	}
+
+	if len(entries) == 0 {
+		return entities.PendingTransaction{}, app.ErrPendingTransactionNotFound
+	}
+
+	tx, err := entities.NewTransaction(id, event, company, competenceDate, entries...)
+	if err != nil {
+		return entities.PendingTransaction{}, fmt.Errorf("failed to load pending transaction: %w", err)
+	}
+
+	tx.CreatedAt = createdAt
+
+	return entities.PendingTransaction{
+		Transaction: tx,
+		Status:      status,
+		ExpiresAt:   expiresAt,
+	}, nil
+}
+
+func (r Repository) CaptureTransaction(ctx context.Context, capture entities.Capture) error {
+	const operation = "Repository.CaptureTransaction"
+
+	defer newrelic.NewDatastoreSegment(ctx, pendingCollection, operation, updatePendingTransactionQuery).End()
+
+	return r.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		// the hold is released before posting, so it isn't deducted twice from the available balance
+		tag, err := tx.Exec(
+			ctx,
+			updatePendingTransactionQuery,
+			capture.PendingTransactionID,
+			vos.CapturedPendingStatus,
+			capture.Transaction.ID,
+			capture.Amount,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to update pending transaction: %w", err)
+		}
+
+		if tag.RowsAffected() == 0 {
+			return app.ErrPendingTransactionNotPending
+		}
+
+		_, err = r.postTransaction(ctx, tx, capture.Transaction)
+
+		return err
+	})
+}
+
+func (r Repository) VoidTransaction(ctx context.Context, pending entities.PendingTransaction) error {
+	const operation = "Repository.VoidTransaction"
+
+	defer newrelic.NewDatastoreSegment(ctx, pendingCollection, operation, updatePendingTransactionQuery).End()
+
+	tag, err := r.db.Exec(ctx, updatePendingTransactionQuery, pending.Transaction.ID, pending.Status, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to update pending transaction: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return app.ErrPendingTransactionNotPending
+	}
+
+	return nil
+}
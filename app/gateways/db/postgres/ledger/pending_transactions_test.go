@@ -0,0 +1,197 @@
+package ledger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+)
+
+func authorizeTransaction(t *testing.T, ctx context.Context, r *Repository, entries ...entities.Entry) entities.PendingTransaction {
+	t.Helper()
+
+	tx, err := entities.NewTransaction(uuid.New(), 1, "abc", time.Now().Round(time.Microsecond), entries...)
+	require.NoError(t, err)
+
+	pending, err := r.AuthorizeTransaction(ctx, entities.NewPendingTransaction(tx))
+	require.NoError(t, err)
+
+	return pending
+}
+
+func assertBalance(t *testing.T, ctx context.Context, r *Repository, account string, balance, available int) {
+	t.Helper()
+
+	acc, err := vos.NewAccount(account)
+	require.NoError(t, err)
+
+	got, err := r.GetAnalyticAccountBalance(ctx, acc, vos.DefaultCurrency)
+	require.NoError(t, err)
+	assert.Equal(t, balance, got.Balance)
+	assert.Equal(t, available, got.Available)
+}
+
+func TestLedgerRepository_AuthorizeAndCaptureTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	account := testdata.GenerateAccountPath()
+	const merchant = "liability.merchants.available.abc"
+
+	createTransaction(t, ctx, r,
+		createEntry(t, vos.CreditOperation, account, vos.IgnoreAccountVersion, 500),
+		createEntry(t, vos.DebitOperation, "asset.bank.cash", vos.IgnoreAccountVersion, 500),
+	)
+
+	before := time.Now()
+	pending := authorizeTransaction(t, ctx, r,
+		createEntry(t, vos.DebitOperation, account, vos.IgnoreAccountVersion, 200),
+		createEntry(t, vos.CreditOperation, merchant, vos.IgnoreAccountVersion, 200),
+	)
+	assert.True(t, pending.ExpiresAt.After(before.Add(defaultPendingTTL-time.Minute)))
+
+	// holds don't change the posted balance, only the available one
+	assertBalance(t, ctx, r, account, 500, 300)
+
+	got, err := r.GetPendingTransaction(ctx, pending.Transaction.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, vos.PendingPendingStatus, got.Status)
+	assert.Len(t, got.Transaction.Entries, 2)
+
+	capture, err := got.Capture(uuid.New(), 150, time.Now())
+	require.NoError(t, err)
+
+	err = r.CaptureTransaction(ctx, capture)
+	assert.NoError(t, err)
+
+	// the remaining 50 are released along with the hold
+	assertBalance(t, ctx, r, account, 350, 350)
+	assertBalance(t, ctx, r, merchant, 150, 150)
+
+	got, err = r.GetPendingTransaction(ctx, pending.Transaction.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, vos.CapturedPendingStatus, got.Status)
+
+	err = r.CaptureTransaction(ctx, capture)
+	assert.ErrorIs(t, err, app.ErrPendingTransactionNotPending)
+}
+
+func TestLedgerRepository_VoidTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	account := testdata.GenerateAccountPath()
+
+	createTransaction(t, ctx, r,
+		createEntry(t, vos.CreditOperation, account, vos.IgnoreAccountVersion, 500),
+		createEntry(t, vos.DebitOperation, "asset.bank.cash", vos.IgnoreAccountVersion, 500),
+	)
+
+	pending := authorizeTransaction(t, ctx, r,
+		createEntry(t, vos.DebitOperation, account, vos.IgnoreAccountVersion, 200),
+		createEntry(t, vos.CreditOperation, "liability.merchants.available.abc", vos.IgnoreAccountVersion, 200),
+	)
+
+	assertBalance(t, ctx, r, account, 500, 300)
+
+	voided, err := pending.Void(time.Now())
+	require.NoError(t, err)
+
+	err = r.VoidTransaction(ctx, voided)
+	assert.NoError(t, err)
+
+	assertBalance(t, ctx, r, account, 500, 500)
+
+	err = r.VoidTransaction(ctx, voided)
+	assert.ErrorIs(t, err, app.ErrPendingTransactionNotPending)
+}
+
+func TestLedgerRepository_PendingTransactionExpiration(t *testing.T) {
+	t.Parallel()
+
+	const ttl = 500 * time.Millisecond
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{}, WithPendingTransactionTTL(ttl))
+
+	account := testdata.GenerateAccountPath()
+
+	createTransaction(t, ctx, r,
+		createEntry(t, vos.CreditOperation, account, vos.IgnoreAccountVersion, 500),
+		createEntry(t, vos.DebitOperation, "asset.bank.cash", vos.IgnoreAccountVersion, 500),
+	)
+
+	pending := authorizeTransaction(t, ctx, r,
+		createEntry(t, vos.DebitOperation, account, vos.IgnoreAccountVersion, 200),
+		createEntry(t, vos.CreditOperation, "liability.merchants.available.abc", vos.IgnoreAccountVersion, 200),
+	)
+
+	assertBalance(t, ctx, r, account, 500, 300)
+
+	time.Sleep(ttl)
+
+	assertBalance(t, ctx, r, account, 500, 500)
+
+	got, err := r.GetPendingTransaction(ctx, pending.Transaction.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, vos.ExpiredPendingStatus, got.CurrentStatus(time.Now()))
+
+	// the database clock is checked as well, so a stale read can't capture an expired hold
+	capture, err := pending.Capture(uuid.New(), 0, pending.ExpiresAt.Add(-time.Millisecond))
+	require.NoError(t, err)
+
+	err = r.CaptureTransaction(ctx, capture)
+	assert.ErrorIs(t, err, app.ErrPendingTransactionNotPending)
+}
+
+func TestLedgerRepository_AuthorizeTransactionBalanceLimits(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	account := testdata.GenerateAccountPath()
+
+	createTransaction(t, ctx, r,
+		createEntry(t, vos.CreditOperation, account, vos.IgnoreAccountVersion, 100),
+		createEntry(t, vos.DebitOperation, "asset.bank.cash", vos.IgnoreAccountVersion, 100),
+	)
+
+	floor := 0
+	require.NoError(t, r.SetBalanceLimit(ctx, newBalanceLimit(t, account, &floor, nil)))
+
+	authorizeTransaction(t, ctx, r,
+		createEntry(t, vos.DebitOperation, account, vos.IgnoreAccountVersion, 80),
+		createEntry(t, vos.CreditOperation, "liability.merchants.available.abc", vos.IgnoreAccountVersion, 80),
+	)
+
+	// held funds can't be spent by another authorization
+	tx, err := entities.NewTransaction(uuid.New(), 1, "abc", time.Now(),
+		createEntry(t, vos.DebitOperation, account, vos.IgnoreAccountVersion, 30),
+		createEntry(t, vos.CreditOperation, "liability.merchants.available.abc", vos.IgnoreAccountVersion, 30),
+	)
+	require.NoError(t, err)
+
+	_, err = r.AuthorizeTransaction(ctx, entities.NewPendingTransaction(tx))
+	assert.ErrorIs(t, err, app.ErrBalanceLimitExceeded)
+
+	// nor by a posted transaction
+	_, err = r.CreateTransaction(ctx, tx)
+	assert.ErrorIs(t, err, app.ErrBalanceLimitExceeded)
+
+	_, err = r.GetPendingTransaction(ctx, tx.ID)
+	assert.ErrorIs(t, err, app.ErrPendingTransactionNotFound)
+}
@@ -0,0 +1,139 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/instrumentation/newrelic"
+)
+
+// Serializes concurrent reversals of the same transaction until the end of the database transaction.
+const lockReversalQuery = `
+select pg_advisory_xact_lock(hashtext($1));
+`
+
+const getReversalQuery = `
+select
+	original_tx_id,
+	reason,
+	amount,
+	total
+from
+	transaction_reversal
+where
+	tx_id = $1
+;
+`
+
+const revertedAmountQuery = `
+select
+	coalesce(sum(amount), 0)
+from
+	transaction_reversal
+where
+	original_tx_id = $1
+;
+`
+
+const insertReversalQuery = `
+insert into transaction_reversal (tx_id, original_tx_id, reason, amount, total)
+values ($1, $2, $3, $4, $5);
+`
+
+func (r Repository) RevertTransaction(ctx context.Context, reversal entities.Reversal) error {
+	const operation = "Repository.RevertTransaction"
+
+	defer newrelic.NewDatastoreSegment(ctx, collection, operation, insertReversalQuery).End()
+
+	return r.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, lockReversalQuery, reversal.OriginalTransactionID.String())
+		if err != nil {
+			return fmt.Errorf("failed to lock transaction reversal: %w", err)
+		}
+
+		// a retried reversal would be taken as reverting the transaction once more, so the reused id is
+		// reported before the reverted amount is checked
+		_, err = scanReversal(reversal.Transaction.ID, tx.QueryRow(ctx, getReversalQuery, reversal.Transaction.ID))
+		if err == nil {
+			return app.ErrIdempotencyKeyViolation
+		}
+
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("failed to get transaction reversal: %w", err)
+		}
+
+		var reverted int
+		err = tx.QueryRow(ctx, revertedAmountQuery, reversal.OriginalTransactionID).Scan(&reverted)
+		if err != nil {
+			return fmt.Errorf("failed to get reverted amount: %w", err)
+		}
+
+		if reverted+reversal.Amount > reversal.Total {
+			return app.ErrTransactionAlreadyReverted
+		}
+
+		_, err = tx.Exec(
+			ctx,
+			insertReversalQuery,
+			reversal.Transaction.ID,
+			reversal.OriginalTransactionID,
+			reversal.Reason,
+			reversal.Amount,
+			reversal.Total,
+		)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+				return app.ErrIdempotencyKeyViolation
+			}
+
+			return fmt.Errorf("failed to insert transaction reversal: %w", err)
+		}
+
+		_, err = r.postTransaction(ctx, tx, reversal.Transaction)
+
+		return err
+	})
+}
+
+// GetReversal returns the reversal stored with the given transaction id, without its entries.
+func (r Repository) GetReversal(ctx context.Context, id uuid.UUID) (entities.Reversal, error) {
+	const operation = "Repository.GetReversal"
+
+	defer newrelic.NewDatastoreSegment(ctx, collection, operation, getReversalQuery).End()
+
+	reversal, err := scanReversal(id, r.db.QueryRow(ctx, getReversalQuery, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.Reversal{}, app.ErrTransactionNotFound
+		}
+
+		return entities.Reversal{}, fmt.Errorf("failed to get transaction reversal: %w", err)
+	}
+
+	return reversal, nil
+}
+
+func scanReversal(id uuid.UUID, row pgx.Row) (entities.Reversal, error) {
+	reversal := entities.Reversal{Transaction: entities.Transaction{ID: id}}
+
+	err := row.Scan(
+		&reversal.OriginalTransactionID,
+		&reversal.Reason,
+		&reversal.Amount,
+		&reversal.Total,
+	)
+	if err != nil {
+		return entities.Reversal{}, err
+	}
+
+	return reversal, nil
+}
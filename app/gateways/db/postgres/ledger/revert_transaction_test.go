@@ -0,0 +1,145 @@
+package ledger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+func TestLedgerRepository_RevertTransaction(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		amounts         []int
+		expectedErrs    []error
+		expectedBalance int
+	}{
+		{
+			name:            "full reversal",
+			amounts:         []int{0},
+			expectedErrs:    []error{nil},
+			expectedBalance: 0,
+		},
+		{
+			name:            "partial reversals",
+			amounts:         []int{40, 60},
+			expectedErrs:    []error{nil, nil},
+			expectedBalance: 0,
+		},
+		{
+			name:            "second full reversal",
+			amounts:         []int{0, 0},
+			expectedErrs:    []error{nil, app.ErrTransactionAlreadyReverted},
+			expectedBalance: 0,
+		},
+		{
+			name:            "full reversal after partial reversal",
+			amounts:         []int{40, 0},
+			expectedErrs:    []error{nil, app.ErrTransactionAlreadyReverted},
+			expectedBalance: -60,
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db := newDB(t, t.Name())
+
+			ctx := context.Background()
+			r := NewRepository(db, &instrumentators.LedgerInstrumentator{})
+
+			e1 := createEntry(t, vos.DebitOperation, "liability.abc.account1", vos.NextAccountVersion, 100)
+			e2 := createEntry(t, vos.CreditOperation, "liability.abc.account2", vos.NextAccountVersion, 100)
+
+			original := createTransaction(t, ctx, r, e1, e2)
+
+			for i, amount := range tt.amounts {
+				reversal, err := entities.NewReversal(uuid.New(), original, "wrong posting", amount, time.Now())
+				require.NoError(t, err)
+
+				err = r.RevertTransaction(ctx, reversal)
+				assert.ErrorIs(t, err, tt.expectedErrs[i])
+			}
+
+			balance, err := r.GetAnalyticAccountBalance(ctx, e1.Account, vos.DefaultCurrency)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedBalance, balance.Balance)
+		})
+	}
+}
+
+func TestLedgerRepository_RevertTransaction_Idempotency(t *testing.T) {
+	t.Parallel()
+
+	db := newDB(t, t.Name())
+
+	ctx := context.Background()
+	r := NewRepository(db, &instrumentators.LedgerInstrumentator{})
+
+	e1 := createEntry(t, vos.DebitOperation, "liability.abc.account1", vos.NextAccountVersion, 100)
+	e2 := createEntry(t, vos.CreditOperation, "liability.abc.account2", vos.NextAccountVersion, 100)
+
+	original := createTransaction(t, ctx, r, e1, e2)
+
+	reversal, err := entities.NewReversal(uuid.New(), original, "wrong posting", 10, time.Now())
+	require.NoError(t, err)
+
+	err = r.RevertTransaction(ctx, reversal)
+	require.NoError(t, err)
+
+	err = r.RevertTransaction(ctx, reversal)
+	assert.ErrorIs(t, err, app.ErrIdempotencyKeyViolation)
+
+	got, err := r.GetTransaction(ctx, reversal.Transaction.ID)
+	require.NoError(t, err)
+	assert.Len(t, got.Entries, 2)
+
+	stored, err := r.GetReversal(ctx, reversal.Transaction.ID)
+	require.NoError(t, err)
+	assert.Equal(t, original.ID, stored.OriginalTransactionID)
+	assert.Equal(t, "wrong posting", stored.Reason)
+	assert.Equal(t, 10, stored.Amount)
+	assert.Equal(t, 100, stored.Total)
+
+	_, err = r.GetReversal(ctx, original.ID)
+	assert.ErrorIs(t, err, app.ErrTransactionNotFound)
+}
+
+func TestLedgerRepository_RevertTransaction_FullIdempotency(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	e1 := createEntry(t, vos.DebitOperation, "liability.abc.account1", vos.NextAccountVersion, 100)
+	e2 := createEntry(t, vos.CreditOperation, "liability.abc.account2", vos.NextAccountVersion, 100)
+
+	original := createTransaction(t, ctx, r, e1, e2)
+
+	reversal, err := entities.NewReversal(uuid.New(), original, "wrong posting", 0, time.Now())
+	require.NoError(t, err)
+
+	require.NoError(t, r.RevertTransaction(ctx, reversal))
+
+	// retrying the full reversal reports the reused id, not the reverted transaction
+	err = r.RevertTransaction(ctx, reversal)
+	assert.ErrorIs(t, err, app.ErrIdempotencyKeyViolation)
+
+	another, err := entities.NewReversal(uuid.New(), original, "wrong posting", 0, time.Now())
+	require.NoError(t, err)
+
+	err = r.RevertTransaction(ctx, another)
+	assert.ErrorIs(t, err, app.ErrTransactionAlreadyReverted)
+}
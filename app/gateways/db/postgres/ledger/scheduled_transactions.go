@@ -0,0 +1,463 @@
+package ledger
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/instrumentation/newrelic"
+	pag "github.com/stone-co/the-amazing-ledger/app/pagination"
+)
+
+const numScheduledEntryArgs = 8
+
+const insertScheduledTransactionQuery = `
+insert into scheduled_transaction (id, event, company, competence_date)
+values ($1, $2, $3, $4)
+returning created_at, updated_at;
+`
+
+const insertScheduledEntriesQuery = `
+insert into scheduled_entry (id, tx_id, operation, version, amount, currency, account, metadata)
+values %s;`
+
+const getScheduledTransactionQuery = `
+select
+	id,
+	event,
+	company,
+	competence_date,
+	status,
+	reason,
+	created_at,
+	updated_at
+from
+	scheduled_transaction
+where
+	id = $1
+;
+`
+
+const (
+	_scheduledTransactionsQueryPrefix = `
+select
+	id,
+	event,
+	company,
+	competence_date,
+	status,
+	reason,
+	created_at,
+	updated_at
+from
+	scheduled_transaction
+where
+	true
+`
+
+	_scheduledTransactionsStatusFilter = `
+	and status = $%d
+`
+
+	_scheduledTransactionsPagination = `
+	and (competence_date, id) > ($%d, $%d)
+`
+
+	_scheduledTransactionsQuerySuffix = `
+order by
+	competence_date,
+	id
+limit $1;
+`
+)
+
+// Transactions whose claim timed out are claimed again, as the scheduler may have stopped before posting them.
+const claimDueScheduledTransactionsQuery = `
+update scheduled_transaction
+set
+	status = 2,
+	updated_at = now()
+where id in (
+	select
+		id
+	from
+		scheduled_transaction
+	where
+		competence_date <= now()
+		and (status = 1 or (status = 2 and updated_at < now() - $2::interval))
+	order by
+		competence_date
+	limit $1
+	for update skip locked
+)
+returning
+	id,
+	event,
+	company,
+	competence_date,
+	status,
+	reason,
+	created_at,
+	updated_at
+;
+`
+
+const getScheduledEntriesQuery = `
+select
+	tx_id,
+	id,
+	operation,
+	version,
+	amount,
+	currency,
+	account,
+	metadata
+from
+	scheduled_entry
+where
+	tx_id = any($1::uuid[])
+;
+`
+
+const updateScheduledTransactionStatusQuery = `
+update scheduled_transaction
+set
+	status = $2,
+	reason = $3,
+	updated_at = now()
+where
+	id = $1 and status = $4
+returning updated_at;
+`
+
+type listScheduledTransactionsCursor struct {
+	ID             string    `json:"id"`
+	CompetenceDate time.Time `json:"competence_date"`
+}
+
+// scheduledTransactionRow is a scheduled transaction read from the database, before loading its entries.
+type scheduledTransactionRow struct {
+	id             uuid.UUID
+	event          uint32
+	company        string
+	competenceDate time.Time
+	status         vos.ScheduleStatus
+	reason         string
+	createdAt      time.Time
+	updatedAt      time.Time
+}
+
+func (r Repository) ScheduleTransaction(ctx context.Context, scheduled entities.ScheduledTransaction) (entities.ScheduledTransaction, error) {
+	const operation = "Repository.ScheduleTransaction"
+
+	defer newrelic.NewDatastoreSegment(ctx, scheduledCollection, operation, insertScheduledTransactionQuery).End()
+
+	transaction := scheduled.Transaction
+
+	err := r.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := r.checkEvents(ctx, tx, transaction); err != nil {
+			return err
+		}
+
+		err := tx.QueryRow(
+			ctx,
+			insertScheduledTransactionQuery,
+			transaction.ID,
+			transaction.Event,
+			transaction.Company,
+			transaction.CompetenceDate,
+		).Scan(&scheduled.Transaction.CreatedAt, &scheduled.UpdatedAt)
+		if err != nil {
+			return scheduledInsertError(err)
+		}
+
+		args := make([]interface{}, 0, len(transaction.Entries)*numScheduledEntryArgs)
+		for _, entry := range transaction.Entries {
+			args = append(
+				args,
+				entry.ID,
+				transaction.ID,
+				entry.Operation,
+				entry.Version,
+				entry.Amount,
+				entry.Currency,
+				entry.Account.Value(),
+				entry.Metadata,
+			)
+		}
+
+		if _, err = tx.Exec(ctx, r.sqb.Build(len(transaction.Entries)), args...); err != nil {
+			return scheduledInsertError(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entities.ScheduledTransaction{}, err
+	}
+
+	return scheduled, nil
+}
+
+func scheduledInsertError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+		return app.ErrIdempotencyKeyViolation
+	}
+
+	return fmt.Errorf("failed to insert scheduled transaction: %w", err)
+}
+
+func (r Repository) GetScheduledTransaction(ctx context.Context, id uuid.UUID) (entities.ScheduledTransaction, error) {
+	const operation = "Repository.GetScheduledTransaction"
+
+	defer newrelic.NewDatastoreSegment(ctx, scheduledCollection, operation, getScheduledTransactionQuery).End()
+
+	rows, err := r.db.Query(ctx, getScheduledTransactionQuery, id)
+	if err != nil {
+		return entities.ScheduledTransaction{}, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	scheduled, err := r.loadScheduledTransactions(ctx, r.db, rows)
+	if err != nil {
+		return entities.ScheduledTransaction{}, err
+	}
+
+	if len(scheduled) == 0 {
+		return entities.ScheduledTransaction{}, app.ErrScheduledTransactionNotFound
+	}
+
+	return scheduled[0], nil
+}
+
+func (r Repository) ListScheduledTransactions(ctx context.Context, status vos.ScheduleStatus, page pag.Page) ([]entities.ScheduledTransaction, pag.Cursor, error) {
+	const operation = "Repository.ListScheduledTransactions"
+
+	query, args, err := generateListScheduledTransactionsQuery(status, page)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate %s query: %w", operation, err)
+	}
+
+	defer newrelic.NewDatastoreSegment(ctx, scheduledCollection, operation, query).End()
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	scheduled, err := r.loadScheduledTransactions(ctx, r.db, rows)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(scheduled) <= page.Size {
+		return scheduled, nil, nil
+	}
+
+	scheduled = scheduled[:page.Size]
+	last := scheduled[len(scheduled)-1].Transaction
+
+	cursor, err := pag.NewCursor(listScheduledTransactionsCursor{
+		ID:             last.ID.String(),
+		CompetenceDate: last.CompetenceDate,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate next page token: %w", err)
+	}
+
+	return scheduled, cursor, nil
+}
+
+func generateListScheduledTransactionsQuery(status vos.ScheduleStatus, page pag.Page) (string, []interface{}, error) {
+	query := _scheduledTransactionsQueryPrefix
+	args := []interface{}{page.Size + 1}
+
+	if status != vos.InvalidScheduleStatus {
+		args = append(args, status)
+		query += fmt.Sprintf(_scheduledTransactionsStatusFilter, len(args))
+	}
+
+	if page.Cursor != nil {
+		var cursor listScheduledTransactionsCursor
+		if err := page.Extract(&cursor); err != nil {
+			return "", nil, err
+		}
+
+		args = append(args, cursor.CompetenceDate, cursor.ID)
+		query += fmt.Sprintf(_scheduledTransactionsPagination, len(args)-1, len(args))
+	}
+
+	return query + _scheduledTransactionsQuerySuffix, args, nil
+}
+
+func (r Repository) ClaimDueScheduledTransactions(ctx context.Context, limit int) ([]entities.ScheduledTransaction, error) {
+	const operation = "Repository.ClaimDueScheduledTransactions"
+
+	defer newrelic.NewDatastoreSegment(ctx, scheduledCollection, operation, claimDueScheduledTransactionsQuery).End()
+
+	var scheduled []entities.ScheduledTransaction
+
+	err := r.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, claimDueScheduledTransactionsQuery, limit, r.scheduleClaimTimeout)
+		if err != nil {
+			return fmt.Errorf("failed to execute query: %w", err)
+		}
+
+		scheduled, err = r.loadScheduledTransactions(ctx, tx, rows)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduled, nil
+}
+
+func (r Repository) UpdateScheduledTransactionStatus(ctx context.Context, scheduled entities.ScheduledTransaction, from vos.ScheduleStatus) error {
+	const operation = "Repository.UpdateScheduledTransactionStatus"
+
+	defer newrelic.NewDatastoreSegment(ctx, scheduledCollection, operation, updateScheduledTransactionStatusQuery).End()
+
+	var updatedAt time.Time
+
+	err := r.db.QueryRow(
+		ctx,
+		updateScheduledTransactionStatusQuery,
+		scheduled.Transaction.ID,
+		scheduled.Status,
+		scheduled.Reason,
+		from,
+	).Scan(&updatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return app.ErrScheduledTransactionNotScheduled
+		}
+
+		return fmt.Errorf("failed to update scheduled transaction: %w", err)
+	}
+
+	return nil
+}
+
+// querier is implemented by both pgxpool.Pool and pgx.Tx.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
+// loadScheduledTransactions reads the scheduled transaction rows, keeping their order,
+// and loads their entries using the given querier.
+func (r Repository) loadScheduledTransactions(ctx context.Context, db querier, rows pgx.Rows) ([]entities.ScheduledTransaction, error) {
+	defer rows.Close()
+
+	scheduledRows := make([]scheduledTransactionRow, 0)
+
+	for rows.Next() {
+		var row scheduledTransactionRow
+
+		if err := rows.Scan(
+			&row.id,
+			&row.event,
+			&row.company,
+			&row.competenceDate,
+			&row.status,
+			&row.reason,
+			&row.createdAt,
+			&row.updatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		scheduledRows = append(scheduledRows, row)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("scheduled transaction rows have error: %w", err)
+	}
+
+	rows.Close()
+
+	scheduled := make([]entities.ScheduledTransaction, 0, len(scheduledRows))
+	if len(scheduledRows) == 0 {
+		return scheduled, nil
+	}
+
+	ids := make([]string, len(scheduledRows))
+	for i, row := range scheduledRows {
+		ids[i] = row.id.String()
+	}
+
+	entries, err := r.getScheduledEntries(ctx, db, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range scheduledRows {
+		tx, txErr := entities.NewTransaction(row.id, row.event, row.company, row.competenceDate, entries[row.id]...)
+		if txErr != nil {
+			return nil, fmt.Errorf("failed to load scheduled transaction: %w", txErr)
+		}
+
+		tx.CreatedAt = row.createdAt
+
+		scheduled = append(scheduled, entities.ScheduledTransaction{
+			Transaction: tx,
+			Status:      row.status,
+			Reason:      row.reason,
+			UpdatedAt:   row.updatedAt,
+		})
+	}
+
+	return scheduled, nil
+}
+
+func (r Repository) getScheduledEntries(ctx context.Context, db querier, ids []string) (map[uuid.UUID][]entities.Entry, error) {
+	rows, err := db.Query(ctx, getScheduledEntriesQuery, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	defer rows.Close()
+
+	entries := make(map[uuid.UUID][]entities.Entry, len(ids))
+
+	for rows.Next() {
+		var (
+			txID     uuid.UUID
+			entryID  uuid.UUID
+			op       vos.OperationType
+			version  vos.Version
+			amount   int
+			currency string
+			account  string
+			metadata json.RawMessage
+		)
+
+		if err = rows.Scan(&txID, &entryID, &op, &version, &amount, &currency, &account, &metadata); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		entry, entryErr := entities.NewEntry(entryID, op, account, version, amount, currency, metadata)
+		if entryErr != nil {
+			return nil, fmt.Errorf("failed to load entry: %w", entryErr)
+		}
+
+		entries[txID] = append(entries[txID], entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("scheduled entry rows have error: %w", err)
+	}
+
+	return entries, nil
+}
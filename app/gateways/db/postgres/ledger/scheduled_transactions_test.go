@@ -0,0 +1,154 @@
+package ledger
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/pagination"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+)
+
+// scheduleTransaction stores a scheduled transaction, skipping the domain validation of its competence date,
+// so already due transactions can be scheduled.
+func scheduleTransaction(t *testing.T, ctx context.Context, r *Repository, competenceDate time.Time) entities.ScheduledTransaction {
+	t.Helper()
+
+	tx, err := entities.NewTransaction(
+		uuid.New(),
+		uint32(1),
+		"abc",
+		competenceDate.Round(time.Microsecond),
+		createEntry(t, vos.DebitOperation, testdata.GenerateAccountPath(), vos.IgnoreAccountVersion, 100),
+		createEntry(t, vos.CreditOperation, testdata.GenerateAccountPath(), vos.IgnoreAccountVersion, 100),
+	)
+	require.NoError(t, err)
+
+	scheduled, err := r.ScheduleTransaction(ctx, entities.ScheduledTransaction{Transaction: tx, Status: vos.ScheduledScheduleStatus})
+	require.NoError(t, err)
+
+	return scheduled
+}
+
+func TestLedgerRepository_ScheduleTransaction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	scheduled := scheduleTransaction(t, ctx, r, time.Now().Add(24*time.Hour))
+
+	got, err := r.GetScheduledTransaction(ctx, scheduled.Transaction.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, vos.ScheduledScheduleStatus, got.Status)
+	assert.Equal(t, scheduled.Transaction.ID, got.Transaction.ID)
+	assert.Equal(t, scheduled.Transaction.Event, got.Transaction.Event)
+	assert.Equal(t, scheduled.Transaction.Company, got.Transaction.Company)
+	assert.True(t, scheduled.Transaction.CompetenceDate.Equal(got.Transaction.CompetenceDate))
+	assert.Len(t, got.Transaction.Entries, 2)
+
+	_, err = r.ScheduleTransaction(ctx, scheduled)
+	assert.ErrorIs(t, err, app.ErrIdempotencyKeyViolation)
+
+	_, err = r.GetScheduledTransaction(ctx, uuid.New())
+	assert.ErrorIs(t, err, app.ErrScheduledTransactionNotFound)
+
+	canceled, err := got.Cancel()
+	require.NoError(t, err)
+
+	err = r.UpdateScheduledTransactionStatus(ctx, canceled, vos.ScheduledScheduleStatus)
+	assert.NoError(t, err)
+
+	err = r.UpdateScheduledTransactionStatus(ctx, canceled, vos.ScheduledScheduleStatus)
+	assert.ErrorIs(t, err, app.ErrScheduledTransactionNotScheduled)
+
+	got, err = r.GetScheduledTransaction(ctx, scheduled.Transaction.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, vos.CanceledScheduleStatus, got.Status)
+}
+
+func TestLedgerRepository_ListScheduledTransactions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{})
+
+	now := time.Now()
+	third := scheduleTransaction(t, ctx, r, now.Add(3*time.Hour))
+	first := scheduleTransaction(t, ctx, r, now.Add(time.Hour))
+	second := scheduleTransaction(t, ctx, r, now.Add(2*time.Hour))
+
+	canceled, err := second.Cancel()
+	require.NoError(t, err)
+	require.NoError(t, r.UpdateScheduledTransactionStatus(ctx, canceled, vos.ScheduledScheduleStatus))
+
+	got, cursor, err := r.ListScheduledTransactions(ctx, vos.InvalidScheduleStatus, pagination.Page{Size: 2})
+	assert.NoError(t, err)
+	assert.NotNil(t, cursor)
+	require.Len(t, got, 2)
+	assert.Equal(t, first.Transaction.ID, got[0].Transaction.ID)
+	assert.Equal(t, second.Transaction.ID, got[1].Transaction.ID)
+
+	got, cursor, err = r.ListScheduledTransactions(ctx, vos.InvalidScheduleStatus, pagination.Page{Size: 2, Cursor: cursor})
+	assert.NoError(t, err)
+	assert.Nil(t, cursor)
+	require.Len(t, got, 1)
+	assert.Equal(t, third.Transaction.ID, got[0].Transaction.ID)
+
+	got, cursor, err = r.ListScheduledTransactions(ctx, vos.ScheduledScheduleStatus, pagination.Page{Size: 10})
+	assert.NoError(t, err)
+	assert.Nil(t, cursor)
+	require.Len(t, got, 2)
+	assert.Equal(t, first.Transaction.ID, got[0].Transaction.ID)
+	assert.Equal(t, third.Transaction.ID, got[1].Transaction.ID)
+	assert.Len(t, got[1].Transaction.Entries, 2)
+
+	_, _, err = r.ListScheduledTransactions(ctx, vos.InvalidScheduleStatus, pagination.Page{Size: 10, Cursor: []byte("abc")})
+	assert.ErrorIs(t, err, app.ErrInvalidPageCursor)
+}
+
+func TestLedgerRepository_ClaimDueScheduledTransactions(t *testing.T) {
+	t.Parallel()
+
+	const claimTimeout = 500 * time.Millisecond
+
+	ctx := context.Background()
+	r := NewRepository(newDB(t, t.Name()), &instrumentators.LedgerInstrumentator{}, WithScheduleClaimTimeout(claimTimeout))
+
+	now := time.Now()
+	due := scheduleTransaction(t, ctx, r, now.Add(-time.Minute))
+	posted := scheduleTransaction(t, ctx, r, now.Add(-time.Hour))
+	scheduleTransaction(t, ctx, r, now.Add(time.Hour))
+
+	got, err := r.ClaimDueScheduledTransactions(ctx, 10)
+	assert.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, posted.Transaction.ID, got[0].Transaction.ID)
+	assert.Equal(t, due.Transaction.ID, got[1].Transaction.ID)
+	assert.Equal(t, vos.ProcessingScheduleStatus, got[0].Status)
+	assert.Len(t, got[0].Transaction.Entries, 2)
+
+	// claimed transactions aren't claimed again before their claim times out
+	claimed, err := r.ClaimDueScheduledTransactions(ctx, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, claimed)
+
+	done, err := got[0].Post()
+	require.NoError(t, err)
+	require.NoError(t, r.UpdateScheduledTransactionStatus(ctx, done, vos.ProcessingScheduleStatus))
+
+	time.Sleep(claimTimeout)
+
+	claimed, err = r.ClaimDueScheduledTransactions(ctx, 10)
+	assert.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, due.Transaction.ID, claimed[0].Transaction.ID)
+}
@@ -53,6 +53,7 @@ func createEntry(t *testing.T, op vos.OperationType, account string, version vos
 		account,
 		version,
 		amount,
+		"BRL",
 		json.RawMessage(`{}`),
 	)
 	assert.NoError(t, err)
@@ -72,7 +73,7 @@ func createTransaction(t *testing.T, ctx context.Context, r *Repository, entries
 	)
 	assert.NoError(t, err)
 
-	err = r.CreateTransaction(ctx, tx)
+	_, err = r.CreateTransaction(ctx, tx)
 	assert.NoError(t, err)
 
 	return tx
@@ -0,0 +1,26 @@
+package ledger
+
+import (
+	"time"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+)
+
+// timeColumn returns the entry column that the date ranges of a query apply to.
+func timeColumn(view vos.TimeView) string {
+	if view.EffectiveAxis() == vos.RecordedTimeAxis {
+		return "created_at"
+	}
+
+	return "competence_date"
+}
+
+// knownAtArg returns the bitemporal bound of the view as an argument, which is null when it's unbounded,
+// to be used as "($N::timestamptz is null or created_at <= $N)".
+func knownAtArg(view vos.TimeView) *time.Time {
+	if view.KnownAt.IsZero() {
+		return nil
+	}
+
+	return &view.KnownAt
+}
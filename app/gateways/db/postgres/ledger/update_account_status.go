@@ -0,0 +1,60 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/instrumentation/newrelic"
+)
+
+// The previous status guards against concurrent transitions: the update only
+// applies if nobody changed the account since it was read.
+const updateAccountStatusQuery = `
+update account
+set
+	status = $2,
+	updated_at = now()
+where
+	account = $1 and status = $3
+returning updated_at;
+`
+
+func (r Repository) UpdateAccountStatus(ctx context.Context, account entities.Account, previous vos.AccountStatus) (entities.Account, error) {
+	const operation = "Repository.UpdateAccountStatus"
+
+	defer newrelic.NewDatastoreSegment(ctx, accountCollection, operation, updateAccountStatusQuery).End()
+
+	err := r.db.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, lockAccountQuery, account.Account.Value()); err != nil {
+			return fmt.Errorf("failed to lock account: %w", err)
+		}
+
+		err := tx.QueryRow(
+			ctx,
+			updateAccountStatusQuery,
+			account.Account.Value(),
+			account.Status,
+			previous,
+		).Scan(&account.UpdatedAt)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return app.ErrInvalidAccountStatusTransition
+			}
+
+			return fmt.Errorf("failed to update account status: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entities.Account{}, err
+	}
+
+	return account, nil
+}
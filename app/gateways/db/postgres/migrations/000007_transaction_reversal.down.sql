@@ -0,0 +1,7 @@
+begin;
+
+drop index if exists idx_transaction_reversal_original_tx;
+
+drop table if exists transaction_reversal;
+
+commit;
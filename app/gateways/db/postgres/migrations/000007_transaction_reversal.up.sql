@@ -0,0 +1,16 @@
+begin;
+
+create table if not exists transaction_reversal
+(
+    tx_id          uuid primary key,
+    original_tx_id uuid        not null,
+    reason         text        not null,
+    amount         bigint      not null,
+    total          bigint      not null,
+    created_at     timestamptz not null default now()
+);
+
+create index if not exists idx_transaction_reversal_original_tx
+    on transaction_reversal using btree (original_tx_id);
+
+commit;
@@ -0,0 +1,340 @@
+begin;
+
+alter table entry
+    add column if not exists currency text not null default 'BRL';
+
+-- balance snapshots are kept per currency, as amounts in different currencies can't be summed up
+alter table account_balance
+    add column if not exists currency text not null default 'BRL';
+
+alter table account_balance
+    drop constraint if exists account_balance_pkey,
+    add primary key (account, currency);
+
+drop function if exists get_analytic_account_balance(ltree);
+drop function if exists _get_analytic_account_balance(ltree);
+drop function if exists _get_analytic_account_balance_since(ltree, timestamptz);
+drop function if exists get_synthetic_account_balance(lquery);
+drop function if exists _get_synthetic_account_balance(lquery);
+drop function if exists _get_synthetic_account_balance_since(lquery, timestamptz);
+drop procedure if exists _update_account_balance(text, bigint, timestamptz);
+drop procedure if exists _insert_account_balance(text, bigint, timestamptz);
+
+create or replace procedure _update_account_balance(
+    _account text, _currency text, _balance bigint, _dt timestamptz
+)
+    language sql
+as
+$$
+    update account_balance
+    set
+        balance = _balance,
+        tx_date = _dt
+    where account = _account and currency = _currency;
+$$;
+
+create or replace procedure _insert_account_balance(
+    _account text, _currency text, _balance bigint, _dt timestamptz
+)
+    language sql
+as
+$$
+    insert into account_balance (balance, tx_date, account, currency)
+    values (_balance, _dt, _account, _currency);
+$$;
+
+--
+-- Analytic account
+--
+
+create or replace function _get_analytic_account_balance(_account ltree, _currency text)
+    returns table
+        (
+            partial_balance bigint,
+            partial_date    timestamptz,
+            recent_balance  bigint,
+            recent_version  int
+        )
+    language sql
+as
+$$
+    select
+        sum(sub.balance) filter (where sub.row_number > 1) as partial_balance,
+        max(created_at)  filter (where sub.row_number = 2) as partial_date,
+
+        sum(sub.balance) filter (where sub.row_number = 1) as recent_balance,
+        max(version)     filter (where sub.row_number = 1) as recent_version
+    from (
+        select
+            coalesce(sum(amount) filter (where operation = 1), 0) -
+            coalesce(sum(amount) filter (where operation = 2), 0) as balance,
+            max(version) as version,
+            created_at,
+            row_number() over (order by created_at desc) as row_number
+        from entry
+        where
+            account = _account
+            and currency = _currency
+        group by created_at
+        order by created_at desc
+    ) sub
+$$ stable rows 1;
+
+create or replace function _get_analytic_account_balance_since(_account ltree, _currency text, _dt timestamptz)
+    returns table
+        (
+            partial_balance bigint,
+            partial_date    timestamptz,
+            recent_balance  bigint,
+            recent_version  int
+        )
+    language sql
+as
+$$
+    select
+        sum(sub.balance) filter (where sub.row_number > 1) as partial_balance,
+        max(created_at)  filter (where sub.row_number = 2) as partial_date,
+
+        sum(sub.balance) filter (where sub.row_number = 1) as recent_balance,
+        max(version)     filter (where sub.row_number = 1) as recent_version
+    from (
+        select
+            coalesce(sum(amount) filter (where operation = 1), 0) -
+            coalesce(sum(amount) filter (where operation = 2), 0) as balance,
+            max(version) as version,
+            created_at,
+            row_number() over (order by created_at desc) as row_number
+        from entry
+        where
+            account = _account
+            and currency = _currency
+            and created_at > _dt
+        group by created_at
+        order by created_at desc
+    ) sub
+$$ stable rows 1;
+
+create or replace function get_analytic_account_balance(
+    in _account ltree, in _currency text,
+    out total_balance bigint, out version int
+)
+    returns record
+    language plpgsql
+as
+$$
+declare
+    _existing_balance   bigint;
+    _existing_date      timestamptz;
+
+    _partial_balance    bigint;
+    _partial_date       timestamptz;
+begin
+    select
+        balance,
+        tx_date
+    into
+        _existing_balance,
+        _existing_date
+    from
+        account_balance
+    where
+        account = _account::text
+        and currency = _currency;
+
+    if (_existing_balance is null) then
+        select
+            partial_balance,
+            partial_date,
+            coalesce(partial_balance, 0) + recent_balance,
+            recent_version
+        into
+            _partial_balance,
+            _partial_date,
+            total_balance,
+            version
+        from
+            _get_analytic_account_balance(_account, _currency);
+
+        -- No entries found for the given account
+        if (version is null) then
+            raise no_data_found;
+        -- Only recent balance exists, so return it without creating snapshot
+        elsif (_partial_balance is null) then
+            return;
+        end if;
+
+        call _insert_account_balance(
+            _account => _account::text,
+            _currency => _currency,
+            _balance => _partial_balance,
+            _dt => _partial_date
+        );
+
+        return;
+    end if;
+
+    select
+        _existing_balance + partial_balance,
+        partial_date,
+
+        _existing_balance + coalesce(partial_balance, 0) + coalesce(recent_balance, 0),
+        recent_version
+    into
+        _partial_balance,
+        _partial_date,
+
+        total_balance,
+        version
+    from
+        _get_analytic_account_balance_since(_account, _currency, _existing_date);
+
+    -- No new entries exists
+    if (_partial_date is null) then
+        return;
+    end if;
+
+    call _update_account_balance(
+        _account => _account::text,
+        _currency => _currency,
+        _balance => _partial_balance,
+        _dt => _partial_date
+    );
+end;
+$$ volatile;
+
+--
+-- Synthetic account
+--
+
+create or replace function _get_synthetic_account_balance(_account lquery, _currency text)
+    returns table
+        (
+            partial_balance bigint,
+            partial_date    timestamptz,
+            recent_balance  bigint
+        )
+    language sql
+as
+$$
+select sum(sub.balance) filter (where sub.row_number > 1) as partial_balance,
+       max(created_at)  filter (where sub.row_number = 2) as partial_date,
+       sum(sub.balance) filter (where sub.row_number = 1) as recent_balance
+from (
+         select
+            coalesce(sum(amount) filter (where operation = 1), 0) -
+            coalesce(sum(amount) filter (where operation = 2), 0) as balance,
+            created_at,
+            row_number() over (order by created_at desc) as row_number
+         from entry
+         where account ~ _account
+           and currency = _currency
+         group by created_at
+         order by created_at desc
+     ) sub
+$$ stable
+   rows 1
+;
+
+create or replace function _get_synthetic_account_balance_since(_account lquery, _currency text, _dt timestamptz)
+    returns table
+        (
+            partial_balance bigint,
+            partial_date    timestamptz,
+            recent_balance  bigint
+        )
+    language sql
+as
+$$
+select sum(sub.balance) filter (where sub.row_number > 1) as partial_balance,
+       max(created_at) filter (where sub.row_number = 2)  as partial_date,
+       sum(sub.balance) filter (where sub.row_number = 1) as recent_credit
+from (
+         select
+            coalesce(sum(amount) filter (where operation = 1), 0) -
+            coalesce(sum(amount) filter (where operation = 2), 0) as balance,
+            created_at,
+            row_number() over (order by created_at desc) as row_number
+         from entry
+         where account ~ _account
+           and currency = _currency
+           and created_at > _dt
+         group by created_at
+         order by created_at desc
+     ) sub
+$$ stable
+   rows 1
+;
+
+create or replace function get_synthetic_account_balance(
+    in _account lquery, in _currency text, out total_balance bigint
+)
+    returns bigint
+    language plpgsql
+as
+$$
+declare
+    _existing_balance bigint;
+    _existing_date    timestamptz;
+    _partial_balance  bigint;
+    _partial_date     timestamptz;
+begin
+    select balance,
+           tx_date
+    into
+        _existing_balance,
+        _existing_date
+    from account_balance
+    where account = _account::text
+      and currency = _currency;
+
+    if (_existing_balance is null) then
+        select partial_balance,
+               partial_date,
+               coalesce(partial_balance, 0) + recent_balance
+        into
+            _partial_balance,
+            _partial_date,
+            total_balance
+        from
+            _get_synthetic_account_balance(_account, _currency);
+
+        if (total_balance is null) then
+            raise no_data_found;
+        elsif (_partial_balance is null) then
+            return;
+        end if;
+
+        call _insert_account_balance(
+            _account => _account::text,
+            _currency => _currency,
+            _balance => _partial_balance,
+            _dt => _partial_date
+        );
+
+        return;
+    end if;
+
+    select _existing_balance + partial_balance,
+           partial_date,
+           _existing_balance + coalesce(partial_balance, 0) + coalesce(recent_balance, 0)
+    into
+        _partial_balance,
+        _partial_date,
+        total_balance
+    from
+        _get_synthetic_account_balance_since(_account, _currency, _existing_date);
+
+    if (_partial_date is null) then
+        return;
+    end if;
+
+    call _update_account_balance(
+        _account => _account::text,
+        _currency => _currency,
+        _balance => _partial_balance,
+        _dt => _partial_date
+    );
+end;
+$$ volatile;
+
+commit;
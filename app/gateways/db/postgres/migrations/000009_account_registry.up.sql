@@ -0,0 +1,14 @@
+begin;
+
+create table if not exists account
+(
+    account      ltree primary key,
+    owner        text        not null,
+    display_name text        not null,
+    opening_date timestamptz not null,
+    status       smallint    not null default 1 check (status between 1 and 3),
+    created_at   timestamptz not null default now(),
+    updated_at   timestamptz not null default now()
+);
+
+commit;
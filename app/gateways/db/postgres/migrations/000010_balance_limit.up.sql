@@ -0,0 +1,17 @@
+begin;
+
+-- account holds an lquery pattern, stored as text since lquery has no btree operator class
+create table if not exists balance_limit
+(
+    account     text        not null,
+    currency    text        not null,
+    min_balance bigint,
+    max_balance bigint,
+    created_at  timestamptz not null default now(),
+    updated_at  timestamptz not null default now(),
+    primary key (account, currency),
+    check (min_balance is not null or max_balance is not null),
+    check (min_balance <= max_balance)
+);
+
+commit;
@@ -0,0 +1,12 @@
+begin;
+
+drop function if exists get_held_amount(lquery, text);
+
+drop index if exists idx_pending_transaction_active;
+drop index if exists idx_pending_entry_tx;
+drop index if exists idx_pending_entry_account_gist;
+
+drop table if exists pending_entry;
+drop table if exists pending_transaction;
+
+commit;
@@ -0,0 +1,57 @@
+begin;
+
+create table if not exists pending_transaction
+(
+    id              uuid primary key,
+    event           smallint    not null references event(id),
+    company         text        not null,
+    competence_date timestamptz not null,
+    status          smallint    not null default 1 check (status between 1 and 3),
+    captured_tx_id  uuid,
+    captured_amount bigint,
+    expires_at      timestamptz not null,
+    created_at      timestamptz not null default now(),
+    updated_at      timestamptz not null default now()
+);
+
+-- pending entries hold amounts against their accounts, without counting in the posted balance
+create table if not exists pending_entry
+(
+    id        uuid primary key,
+    tx_id     uuid     not null references pending_transaction(id),
+    operation smallint not null check (operation = 1 or operation = 2),
+    version   int      not null,
+    amount    bigint   not null,
+    currency  text     not null,
+    account   ltree    not null,
+    metadata  jsonb    not null default '{}'
+);
+
+create index if not exists idx_pending_entry_account_gist
+    on pending_entry using gist (account gist_ltree_ops(siglen=32));
+create index if not exists idx_pending_entry_tx
+    on pending_entry using btree (tx_id);
+create index if not exists idx_pending_transaction_active
+    on pending_transaction using btree (expires_at) where status = 1;
+
+-- amount held by active pending transactions against the accounts matching _account,
+-- to be deducted from their posted balance to get the available balance
+create or replace function get_held_amount(_account lquery, _currency text)
+    returns bigint
+    language sql
+as
+$$
+    select
+        coalesce(sum(e.amount), 0)
+    from
+        pending_entry e
+        join pending_transaction t on t.id = e.tx_id
+    where
+        e.account ~ _account
+        and e.currency = _currency
+        and e.operation = 2
+        and t.status = 1
+        and t.expires_at > now()
+$$ stable;
+
+commit;
@@ -0,0 +1,10 @@
+begin;
+
+drop index if exists idx_scheduled_transaction_due;
+drop index if exists idx_scheduled_transaction_list;
+drop index if exists idx_scheduled_entry_tx;
+
+drop table if exists scheduled_entry;
+drop table if exists scheduled_transaction;
+
+commit;
@@ -0,0 +1,35 @@
+begin;
+
+create table if not exists scheduled_transaction
+(
+    id              uuid primary key,
+    event           smallint    not null references event(id),
+    company         text        not null,
+    competence_date timestamptz not null,
+    status          smallint    not null default 1 check (status between 1 and 5),
+    reason          text        not null default '',
+    created_at      timestamptz not null default now(),
+    updated_at      timestamptz not null default now()
+);
+
+-- scheduled entries are only inserted into the entry table when their transaction is posted
+create table if not exists scheduled_entry
+(
+    id        uuid primary key,
+    tx_id     uuid     not null references scheduled_transaction(id),
+    operation smallint not null check (operation = 1 or operation = 2),
+    version   int      not null,
+    amount    bigint   not null,
+    currency  text     not null,
+    account   ltree    not null,
+    metadata  jsonb    not null default '{}'
+);
+
+create index if not exists idx_scheduled_entry_tx
+    on scheduled_entry using btree (tx_id);
+create index if not exists idx_scheduled_transaction_list
+    on scheduled_transaction using btree (competence_date, id);
+create index if not exists idx_scheduled_transaction_due
+    on scheduled_transaction using btree (competence_date) where status in (1, 2);
+
+commit;
@@ -0,0 +1,8 @@
+begin;
+
+alter table event
+    drop column if exists description,
+    drop column if exists deprecated_at,
+    drop column if exists created_at;
+
+commit;
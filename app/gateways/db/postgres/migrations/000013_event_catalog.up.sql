@@ -0,0 +1,8 @@
+begin;
+
+alter table event
+    add column if not exists description   text        not null default '',
+    add column if not exists deprecated_at timestamptz,
+    add column if not exists created_at    timestamptz not null default now();
+
+commit;
@@ -0,0 +1,383 @@
+begin;
+
+drop function if exists get_analytic_account_balance(ltree, text);
+drop function if exists _get_analytic_account_balance(ltree, text);
+drop function if exists _get_analytic_account_balance_since(ltree, text, timestamptz);
+drop function if exists get_synthetic_account_balance(lquery, text);
+drop function if exists _get_synthetic_account_balance(lquery, text);
+drop function if exists _get_synthetic_account_balance_since(lquery, text, timestamptz);
+drop procedure if exists _update_account_balance(text, text, bigint, timestamptz);
+drop procedure if exists _insert_account_balance(text, text, bigint, timestamptz);
+
+-- snapshots only hold the net balance, so they're discarded and rebuilt on the next read
+delete from account_balance;
+
+alter table account_balance
+    drop column if exists balance,
+    add column if not exists credit bigint not null,
+    add column if not exists debit  bigint not null;
+
+create or replace procedure _update_account_balance(
+    _account text, _currency text, _credit bigint, _debit bigint, _dt timestamptz
+)
+    language sql
+as
+$$
+    update account_balance
+    set
+        credit = _credit,
+        debit = _debit,
+        tx_date = _dt
+    where account = _account and currency = _currency;
+$$;
+
+create or replace procedure _insert_account_balance(
+    _account text, _currency text, _credit bigint, _debit bigint, _dt timestamptz
+)
+    language sql
+as
+$$
+    insert into account_balance (credit, debit, tx_date, account, currency)
+    values (_credit, _debit, _dt, _account, _currency);
+$$;
+
+--
+-- Analytic account
+--
+
+create or replace function _get_analytic_account_balance(_account ltree, _currency text)
+    returns table
+        (
+            partial_credit bigint,
+            partial_debit  bigint,
+            partial_date   timestamptz,
+            recent_credit  bigint,
+            recent_debit   bigint,
+            recent_version int
+        )
+    language sql
+as
+$$
+    select
+        sum(sub.credit) filter (where sub.row_number > 1) as partial_credit,
+        sum(sub.debit)  filter (where sub.row_number > 1) as partial_debit,
+        max(created_at) filter (where sub.row_number = 2) as partial_date,
+
+        sum(sub.credit) filter (where sub.row_number = 1) as recent_credit,
+        sum(sub.debit)  filter (where sub.row_number = 1) as recent_debit,
+        max(version)    filter (where sub.row_number = 1) as recent_version
+    from (
+        select
+            coalesce(sum(amount) filter (where operation = 1), 0) as credit,
+            coalesce(sum(amount) filter (where operation = 2), 0) as debit,
+            max(version) as version,
+            created_at,
+            row_number() over (order by created_at desc) as row_number
+        from entry
+        where
+            account = _account
+            and currency = _currency
+        group by created_at
+        order by created_at desc
+    ) sub
+$$ stable rows 1;
+
+create or replace function _get_analytic_account_balance_since(_account ltree, _currency text, _dt timestamptz)
+    returns table
+        (
+            partial_credit bigint,
+            partial_debit  bigint,
+            partial_date   timestamptz,
+            recent_credit  bigint,
+            recent_debit   bigint,
+            recent_version int
+        )
+    language sql
+as
+$$
+    select
+        sum(sub.credit) filter (where sub.row_number > 1) as partial_credit,
+        sum(sub.debit)  filter (where sub.row_number > 1) as partial_debit,
+        max(created_at) filter (where sub.row_number = 2) as partial_date,
+
+        sum(sub.credit) filter (where sub.row_number = 1) as recent_credit,
+        sum(sub.debit)  filter (where sub.row_number = 1) as recent_debit,
+        max(version)    filter (where sub.row_number = 1) as recent_version
+    from (
+        select
+            coalesce(sum(amount) filter (where operation = 1), 0) as credit,
+            coalesce(sum(amount) filter (where operation = 2), 0) as debit,
+            max(version) as version,
+            created_at,
+            row_number() over (order by created_at desc) as row_number
+        from entry
+        where
+            account = _account
+            and currency = _currency
+            and created_at > _dt
+        group by created_at
+        order by created_at desc
+    ) sub
+$$ stable rows 1;
+
+create or replace function get_analytic_account_balance(
+    in _account ltree, in _currency text,
+    out total_credit bigint, out total_debit bigint, out version int
+)
+    returns record
+    language plpgsql
+as
+$$
+declare
+    _existing_credit    bigint;
+    _existing_debit     bigint;
+    _existing_date      timestamptz;
+
+    _partial_credit     bigint;
+    _partial_debit      bigint;
+    _partial_date       timestamptz;
+begin
+    select
+        credit,
+        debit,
+        tx_date
+    into
+        _existing_credit,
+        _existing_debit,
+        _existing_date
+    from
+        account_balance
+    where
+        account = _account::text
+        and currency = _currency;
+
+    if (_existing_credit is null) then
+        select
+            partial_credit,
+            partial_debit,
+            partial_date,
+            coalesce(partial_credit, 0) + recent_credit,
+            coalesce(partial_debit, 0) + recent_debit,
+            recent_version
+        into
+            _partial_credit,
+            _partial_debit,
+            _partial_date,
+            total_credit,
+            total_debit,
+            version
+        from
+            _get_analytic_account_balance(_account, _currency);
+
+        -- No entries found for the given account
+        if (version is null) then
+            raise no_data_found;
+        -- Only recent balance exists, so return it without creating snapshot
+        elsif (_partial_date is null) then
+            return;
+        end if;
+
+        call _insert_account_balance(
+            _account => _account::text,
+            _currency => _currency,
+            _credit => _partial_credit,
+            _debit => _partial_debit,
+            _dt => _partial_date
+        );
+
+        return;
+    end if;
+
+    select
+        _existing_credit + partial_credit,
+        _existing_debit + partial_debit,
+        partial_date,
+
+        _existing_credit + coalesce(partial_credit, 0) + coalesce(recent_credit, 0),
+        _existing_debit + coalesce(partial_debit, 0) + coalesce(recent_debit, 0),
+        recent_version
+    into
+        _partial_credit,
+        _partial_debit,
+        _partial_date,
+
+        total_credit,
+        total_debit,
+        version
+    from
+        _get_analytic_account_balance_since(_account, _currency, _existing_date);
+
+    -- No new entries exists
+    if (_partial_date is null) then
+        return;
+    end if;
+
+    call _update_account_balance(
+        _account => _account::text,
+        _currency => _currency,
+        _credit => _partial_credit,
+        _debit => _partial_debit,
+        _dt => _partial_date
+    );
+end;
+$$ volatile;
+
+--
+-- Synthetic account
+--
+
+create or replace function _get_synthetic_account_balance(_account lquery, _currency text)
+    returns table
+        (
+            partial_credit bigint,
+            partial_debit  bigint,
+            partial_date   timestamptz,
+            recent_credit  bigint,
+            recent_debit   bigint
+        )
+    language sql
+as
+$$
+select sum(sub.credit) filter (where sub.row_number > 1) as partial_credit,
+       sum(sub.debit)  filter (where sub.row_number > 1) as partial_debit,
+       max(created_at) filter (where sub.row_number = 2) as partial_date,
+       sum(sub.credit) filter (where sub.row_number = 1) as recent_credit,
+       sum(sub.debit)  filter (where sub.row_number = 1) as recent_debit
+from (
+         select
+            coalesce(sum(amount) filter (where operation = 1), 0) as credit,
+            coalesce(sum(amount) filter (where operation = 2), 0) as debit,
+            created_at,
+            row_number() over (order by created_at desc) as row_number
+         from entry
+         where account ~ _account
+           and currency = _currency
+         group by created_at
+         order by created_at desc
+     ) sub
+$$ stable
+   rows 1
+;
+
+create or replace function _get_synthetic_account_balance_since(_account lquery, _currency text, _dt timestamptz)
+    returns table
+        (
+            partial_credit bigint,
+            partial_debit  bigint,
+            partial_date   timestamptz,
+            recent_credit  bigint,
+            recent_debit   bigint
+        )
+    language sql
+as
+$$
+select sum(sub.credit) filter (where sub.row_number > 1) as partial_credit,
+       sum(sub.debit)  filter (where sub.row_number > 1) as partial_debit,
+       max(created_at) filter (where sub.row_number = 2) as partial_date,
+       sum(sub.credit) filter (where sub.row_number = 1) as recent_credit,
+       sum(sub.debit)  filter (where sub.row_number = 1) as recent_debit
+from (
+         select
+            coalesce(sum(amount) filter (where operation = 1), 0) as credit,
+            coalesce(sum(amount) filter (where operation = 2), 0) as debit,
+            created_at,
+            row_number() over (order by created_at desc) as row_number
+         from entry
+         where account ~ _account
+           and currency = _currency
+           and created_at > _dt
+         group by created_at
+         order by created_at desc
+     ) sub
+$$ stable
+   rows 1
+;
+
+create or replace function get_synthetic_account_balance(
+    in _account lquery, in _currency text,
+    out total_credit bigint, out total_debit bigint
+)
+    returns record
+    language plpgsql
+as
+$$
+declare
+    _existing_credit bigint;
+    _existing_debit  bigint;
+    _existing_date   timestamptz;
+    _partial_credit  bigint;
+    _partial_debit   bigint;
+    _partial_date    timestamptz;
+begin
+    select credit,
+           debit,
+           tx_date
+    into
+        _existing_credit,
+        _existing_debit,
+        _existing_date
+    from account_balance
+    where account = _account::text
+      and currency = _currency;
+
+    if (_existing_credit is null) then
+        select partial_credit,
+               partial_debit,
+               partial_date,
+               coalesce(partial_credit, 0) + recent_credit,
+               coalesce(partial_debit, 0) + recent_debit
+        into
+            _partial_credit,
+            _partial_debit,
+            _partial_date,
+            total_credit,
+            total_debit
+        from
+            _get_synthetic_account_balance(_account, _currency);
+
+        if (total_credit is null) then
+            raise no_data_found;
+        elsif (_partial_date is null) then
+            return;
+        end if;
+
+        call _insert_account_balance(
+            _account => _account::text,
+            _currency => _currency,
+            _credit => _partial_credit,
+            _debit => _partial_debit,
+            _dt => _partial_date
+        );
+
+        return;
+    end if;
+
+    select _existing_credit + partial_credit,
+           _existing_debit + partial_debit,
+           partial_date,
+           _existing_credit + coalesce(partial_credit, 0) + coalesce(recent_credit, 0),
+           _existing_debit + coalesce(partial_debit, 0) + coalesce(recent_debit, 0)
+    into
+        _partial_credit,
+        _partial_debit,
+        _partial_date,
+        total_credit,
+        total_debit
+    from
+        _get_synthetic_account_balance_since(_account, _currency, _existing_date);
+
+    if (_partial_date is null) then
+        return;
+    end if;
+
+    call _update_account_balance(
+        _account => _account::text,
+        _currency => _currency,
+        _credit => _partial_credit,
+        _debit => _partial_debit,
+        _dt => _partial_date
+    );
+end;
+$$ volatile;
+
+commit;
@@ -0,0 +1,6 @@
+begin;
+
+drop table if exists fiscal_period_change;
+drop table if exists fiscal_period;
+
+commit;
@@ -0,0 +1,34 @@
+begin;
+
+-- each company has its own fiscal calendar, whose periods can't overlap
+create table if not exists fiscal_period
+(
+    id         uuid primary key,
+    company    text        not null,
+    start_date timestamptz not null,
+    end_date   timestamptz not null check (end_date > start_date),
+    status     smallint    not null default 1 check (status between 1 and 2),
+    closed_at  timestamptz,
+    closed_by  text        not null default '',
+    created_at timestamptz not null default now()
+);
+
+create index if not exists idx_fiscal_period_company
+    on fiscal_period using btree (company, start_date);
+
+-- every close and reopen of a fiscal period, along with who requested it
+create table if not exists fiscal_period_change
+(
+    id           bigserial primary key,
+    period_id    uuid        not null references fiscal_period(id),
+    action       smallint    not null check (action between 1 and 2),
+    requested_by text        not null,
+    reason       text        not null default '',
+    tx_id        uuid,
+    created_at   timestamptz not null default now()
+);
+
+create index if not exists idx_fiscal_period_change_period
+    on fiscal_period_change using btree (period_id, id);
+
+commit;
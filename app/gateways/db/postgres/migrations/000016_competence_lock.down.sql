@@ -0,0 +1,9 @@
+begin;
+
+drop table if exists competence_lock_change;
+drop table if exists competence_lock;
+
+alter table entry
+    drop column if exists adjustment;
+
+commit;
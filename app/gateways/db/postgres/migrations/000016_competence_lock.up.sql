@@ -0,0 +1,34 @@
+begin;
+
+-- adjustments are authorized to be posted on or before the competence lock date
+alter table entry
+    add column if not exists adjustment boolean not null default false;
+
+-- the lock date of a company (event 0) and its overrides for specific events
+create table if not exists competence_lock
+(
+    company    text        not null,
+    event      smallint    not null default 0 check (event >= 0),
+    lock_date  timestamptz not null,
+    updated_by text        not null,
+    updated_at timestamptz not null default now(),
+    primary key (company, event)
+);
+
+-- every move of a lock date, along with who requested it
+create table if not exists competence_lock_change
+(
+    id            bigserial primary key,
+    company       text        not null,
+    event         smallint    not null,
+    previous_date timestamptz,
+    lock_date     timestamptz,
+    requested_by  text        not null,
+    reason        text        not null default '',
+    created_at    timestamptz not null default now()
+);
+
+create index if not exists idx_competence_lock_change_company
+    on competence_lock_change using btree (company, id);
+
+commit;
@@ -0,0 +1,2 @@
+-- Dropped concurrently, so entries keep being posted meanwhile. That can't run within a transaction.
+drop index concurrently if exists idx_entry_metadata_gin;
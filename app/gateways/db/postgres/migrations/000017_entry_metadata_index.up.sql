@@ -0,0 +1,6 @@
+-- The index is built concurrently, so entries keep being posted while it's built. That can't run within a
+-- transaction, so this migration must hold this single statement. A failed build leaves an invalid index
+-- behind, which must be dropped before migrating again.
+-- The default operator class, unlike jsonb_path_ops, also serves the key existence operators.
+create index concurrently if not exists idx_entry_metadata_gin
+    on entry using gin (metadata);
@@ -0,0 +1,5 @@
+begin;
+
+drop table if exists event_metadata_schema;
+
+commit;
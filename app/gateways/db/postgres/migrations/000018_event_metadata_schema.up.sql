@@ -0,0 +1,13 @@
+begin;
+
+-- the versions of the JSON Schema the metadata of the entries of an event must match, the latest one applying
+create table if not exists event_metadata_schema
+(
+    event      smallint    not null references event(id),
+    version    int         not null check (version > 0),
+    schema     jsonb       not null,
+    created_at timestamptz not null default now(),
+    primary key (event, version)
+);
+
+commit;
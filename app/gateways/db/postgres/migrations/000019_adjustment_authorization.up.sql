@@ -0,0 +1,12 @@
+begin;
+
+-- who authorized each adjustment, and why
+create table if not exists adjustment_authorization
+(
+    tx_id        uuid primary key,
+    requested_by text        not null,
+    reason       text        not null,
+    created_at   timestamptz not null default now()
+);
+
+commit;
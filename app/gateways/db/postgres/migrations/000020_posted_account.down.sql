@@ -0,0 +1,7 @@
+begin;
+
+drop trigger if exists tg_insert_posted_account on entry;
+drop function if exists insert_posted_account;
+drop table if exists posted_account;
+
+commit;
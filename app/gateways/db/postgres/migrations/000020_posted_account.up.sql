@@ -0,0 +1,32 @@
+begin;
+
+-- every account that ever received entries, including the ones posted ignoring their versions, which have
+-- no account_version, so the accounts can be listed without going through all of their entries
+create table if not exists posted_account
+(
+    account ltree primary key
+);
+
+insert into posted_account (account)
+select distinct account from entry
+on conflict do nothing;
+
+create or replace function insert_posted_account()
+    returns trigger
+    language plpgsql
+as
+$$
+begin
+    insert into posted_account (account) values (new.account) on conflict do nothing;
+
+    return new;
+end;
+$$;
+
+create trigger tg_insert_posted_account
+    after insert
+    on entry
+    for each row
+execute procedure insert_posted_account();
+
+commit;
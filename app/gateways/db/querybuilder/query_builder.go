@@ -4,12 +4,19 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 )
 
+// maxCachedSize is the largest number of values whose query is kept in memory. Larger inserts, like
+// transaction batches, vary too much in size to be worth caching.
+const maxCachedSize = 64
+
 // QueryBuilder builds an insert query based on the number of arguments, how many values to insert,
 // and an initial query defined as `insert into table_name(arg1, ..., argn) values %s;`.
 // QueryBuilder maintains a map of queries that have already been built in memory to improve performance.
+// It's safe for concurrent use.
 type QueryBuilder struct {
+	mu      *sync.RWMutex
 	queries map[int]string
 	query   string
 	numArgs int
@@ -18,6 +25,7 @@ type QueryBuilder struct {
 // New creates a QueryBuilder and initializes it with the initial query, the number of arguments, and a 0-size queries map.
 func New(query string, numArgs int) QueryBuilder {
 	qb := QueryBuilder{}
+	qb.mu = &sync.RWMutex{}
 	qb.queries = make(map[int]string)
 	qb.query = query
 	qb.numArgs = numArgs
@@ -36,7 +44,10 @@ func (q QueryBuilder) Init(n int) {
 
 // Build builds a query based on the number of values to insert.
 func (q QueryBuilder) Build(size int) string {
+	q.mu.RLock()
 	query, ok := q.queries[size]
+	q.mu.RUnlock()
+
 	if ok {
 		return query
 	}
@@ -71,7 +82,12 @@ func (q QueryBuilder) build(size int) string {
 	sb.WriteString(")")
 
 	query := fmt.Sprintf(q.query, sb.String())
-	q.queries[size] = query
+
+	if size <= maxCachedSize {
+		q.mu.Lock()
+		q.queries[size] = query
+		q.mu.Unlock()
+	}
 
 	return query
 }
@@ -1,6 +1,7 @@
 package querybuilder
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
@@ -69,6 +70,14 @@ func TestQueryBuilder_Build(t *testing.T) {
 		qb.Build(2)
 		assert.Len(t, qb.queries, 1)
 	})
+
+	t.Run("should not cache queries with too many values", func(t *testing.T) {
+		qb := New(query, numArgs)
+
+		got := qb.Build(maxCachedSize + 1)
+		assert.Len(t, qb.queries, 0)
+		assert.Contains(t, got, fmt.Sprintf("$%d)", (maxCachedSize+1)*numArgs))
+	})
 }
 
 func TestQueryBuilder_build(t *testing.T) {
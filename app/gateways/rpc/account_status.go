@@ -0,0 +1,69 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func (a *API) FreezeAccount(ctx context.Context, req *proto.FreezeAccountRequest) (*proto.FreezeAccountResponse, error) {
+	account, err := a.changeAccountStatus(ctx, req.Account, a.UseCase.FreezeAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.FreezeAccountResponse{Account: account}, nil
+}
+
+func (a *API) UnfreezeAccount(ctx context.Context, req *proto.UnfreezeAccountRequest) (*proto.UnfreezeAccountResponse, error) {
+	account, err := a.changeAccountStatus(ctx, req.Account, a.UseCase.UnfreezeAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.UnfreezeAccountResponse{Account: account}, nil
+}
+
+func (a *API) CloseAccount(ctx context.Context, req *proto.CloseAccountRequest) (*proto.CloseAccountResponse, error) {
+	account, err := a.changeAccountStatus(ctx, req.Account, a.UseCase.CloseAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.CloseAccountResponse{Account: account}, nil
+}
+
+func (a *API) changeAccountStatus(
+	ctx context.Context,
+	name string,
+	change func(context.Context, vos.Account) (entities.Account, error),
+) (*proto.Account, error) {
+	accountName, err := vos.NewAnalyticAccount(name)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("can't create account name")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	account, err := change(ctx, accountName)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to change account status")
+		switch {
+		case errors.Is(err, app.ErrAccountNotFound):
+			return nil, status.Error(codes.NotFound, app.ErrAccountNotFound.Error())
+		case errors.Is(err, app.ErrInvalidAccountStatusTransition):
+			return nil, status.Error(codes.FailedPrecondition, app.ErrInvalidAccountStatusTransition.Error())
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return toProtoAccount(account), nil
+}
@@ -0,0 +1,165 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func TestAPI_DescribeAccount(t *testing.T) {
+	t.Parallel()
+
+	account, err := vos.NewAnalyticAccount(testdata.GenerateAccountPath())
+	require.NoError(t, err)
+
+	t.Run("should describe an account successfully", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{
+			DescribeAccountFunc: func(ctx context.Context, acc vos.Account) (entities.Account, error) {
+				return entities.Account{Account: acc, Owner: "owner", Status: vos.FrozenAccountStatus}, nil
+			},
+		})
+
+		got, err := api.DescribeAccount(context.Background(), &proto.DescribeAccountRequest{Account: account.Value()})
+		assert.NoError(t, err)
+		assert.Equal(t, account.Value(), got.Account.Account)
+		assert.Equal(t, "owner", got.Account.Owner)
+		assert.Equal(t, proto.AccountStatus_ACCOUNT_STATUS_FROZEN, got.Account.Status)
+	})
+
+	t.Run("should return an error if account does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{
+			DescribeAccountFunc: func(ctx context.Context, acc vos.Account) (entities.Account, error) {
+				return entities.Account{}, app.ErrAccountNotFound
+			},
+		})
+
+		_, err := api.DescribeAccount(context.Background(), &proto.DescribeAccountRequest{Account: account.Value()})
+		respStatus, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, respStatus.Code())
+		assert.Equal(t, app.ErrAccountNotFound.Error(), respStatus.Message())
+	})
+}
+
+func TestAPI_ChangeAccountStatus(t *testing.T) {
+	t.Parallel()
+
+	account, err := vos.NewAnalyticAccount(testdata.GenerateAccountPath())
+	require.NoError(t, err)
+
+	changeTo := func(status vos.AccountStatus, err error) func(context.Context, vos.Account) (entities.Account, error) {
+		return func(ctx context.Context, acc vos.Account) (entities.Account, error) {
+			if err != nil {
+				return entities.Account{}, err
+			}
+
+			return entities.Account{Account: acc, Owner: "owner", Status: status}, nil
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		useCaseSetup    *mocks.UseCaseMock
+		call            func(*API) (*proto.Account, error)
+		expectedStatus  proto.AccountStatus
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name:         "should freeze an account",
+			useCaseSetup: &mocks.UseCaseMock{FreezeAccountFunc: changeTo(vos.FrozenAccountStatus, nil)},
+			call: func(api *API) (*proto.Account, error) {
+				resp, err := api.FreezeAccount(context.Background(), &proto.FreezeAccountRequest{Account: account.Value()})
+				return resp.GetAccount(), err
+			},
+			expectedStatus: proto.AccountStatus_ACCOUNT_STATUS_FROZEN,
+			expectedCode:   codes.OK,
+		},
+		{
+			name:         "should unfreeze an account",
+			useCaseSetup: &mocks.UseCaseMock{UnfreezeAccountFunc: changeTo(vos.ActiveAccountStatus, nil)},
+			call: func(api *API) (*proto.Account, error) {
+				resp, err := api.UnfreezeAccount(context.Background(), &proto.UnfreezeAccountRequest{Account: account.Value()})
+				return resp.GetAccount(), err
+			},
+			expectedStatus: proto.AccountStatus_ACCOUNT_STATUS_ACTIVE,
+			expectedCode:   codes.OK,
+		},
+		{
+			name:         "should close an account",
+			useCaseSetup: &mocks.UseCaseMock{CloseAccountFunc: changeTo(vos.ClosedAccountStatus, nil)},
+			call: func(api *API) (*proto.Account, error) {
+				resp, err := api.CloseAccount(context.Background(), &proto.CloseAccountRequest{Account: account.Value()})
+				return resp.GetAccount(), err
+			},
+			expectedStatus: proto.AccountStatus_ACCOUNT_STATUS_CLOSED,
+			expectedCode:   codes.OK,
+		},
+		{
+			name:         "should return an error if account name is invalid",
+			useCaseSetup: &mocks.UseCaseMock{},
+			call: func(api *API) (*proto.Account, error) {
+				resp, err := api.FreezeAccount(context.Background(), &proto.FreezeAccountRequest{Account: "liability.clients.*"})
+				return resp.GetAccount(), err
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidSingleAccountComponentCharacters.Error(),
+		},
+		{
+			name:         "should return an error if transition is invalid",
+			useCaseSetup: &mocks.UseCaseMock{UnfreezeAccountFunc: changeTo(0, app.ErrInvalidAccountStatusTransition)},
+			call: func(api *API) (*proto.Account, error) {
+				resp, err := api.UnfreezeAccount(context.Background(), &proto.UnfreezeAccountRequest{Account: account.Value()})
+				return resp.GetAccount(), err
+			},
+			expectedCode:    codes.FailedPrecondition,
+			expectedMessage: app.ErrInvalidAccountStatusTransition.Error(),
+		},
+		{
+			name:         "should return an error if account does not exist",
+			useCaseSetup: &mocks.UseCaseMock{CloseAccountFunc: changeTo(0, app.ErrAccountNotFound)},
+			call: func(api *API) (*proto.Account, error) {
+				resp, err := api.CloseAccount(context.Background(), &proto.CloseAccountRequest{Account: account.Value()})
+				return resp.GetAccount(), err
+			},
+			expectedCode:    codes.NotFound,
+			expectedMessage: app.ErrAccountNotFound.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.call(NewAPI(tt.useCaseSetup))
+
+			respStatus, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, respStatus.Code())
+
+			if tt.expectedCode != codes.OK {
+				assert.Equal(t, tt.expectedMessage, respStatus.Message())
+				return
+			}
+
+			assert.Equal(t, account.Value(), got.Account)
+			assert.Equal(t, tt.expectedStatus, got.Status)
+		})
+	}
+}
@@ -22,6 +22,15 @@ func (a *API) GetAccountBalance(ctx context.Context, request *proto.GetAccountBa
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	currency := vos.DefaultCurrency
+	if request.Currency != "" {
+		currency, err = vos.NewCurrency(request.Currency)
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("can't create currency")
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	start := time.Time{}
 	if request.StartDate != nil && request.StartDate.IsValid() {
 		start = request.StartDate.AsTime()
@@ -36,10 +45,17 @@ func (a *API) GetAccountBalance(ctx context.Context, request *proto.GetAccountBa
 		return nil, status.Error(codes.InvalidArgument, "end date should be a timestamp set after start date")
 	}
 
+	view, err := parseTimeView(request.TimeAxis, request.KnownAt)
+	if err != nil {
+		return nil, err
+	}
+
 	input := domain.GetAccountBalanceInput{
 		Account:   accountName,
+		Currency:  currency,
 		StartDate: start,
 		EndDate:   end,
+		Time:      view,
 	}
 
 	accountBalance, err := a.UseCase.GetAccountBalance(ctx, input)
@@ -52,9 +68,17 @@ func (a *API) GetAccountBalance(ctx context.Context, request *proto.GetAccountBa
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
+	if request.NaturalSign {
+		accountBalance = accountBalance.Natural()
+	}
+
 	return &proto.GetAccountBalanceResponse{
-		Account:        accountBalance.Account.Value(),
-		CurrentVersion: accountBalance.CurrentVersion.AsInt64(),
-		Balance:        int64(accountBalance.Balance),
+		Account:          accountBalance.Account.Value(),
+		Currency:         accountBalance.Currency.String(),
+		CurrentVersion:   accountBalance.CurrentVersion.AsInt64(),
+		Balance:          int64(accountBalance.Balance),
+		AvailableBalance: int64(accountBalance.Available),
+		Credit:           int64(accountBalance.Credit),
+		Debit:            int64(accountBalance.Debit),
 	}, nil
 }
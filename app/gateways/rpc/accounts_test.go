@@ -24,9 +24,10 @@ func TestAPI_GetAccountBalance_Analytic_Success(t *testing.T) {
 		account, err := vos.NewAccount(testdata.GenerateAccountPath())
 		assert.NoError(t, err)
 
-		accountBalance := vos.NewAnalyticAccountBalance(account, vos.Version(1), 200)
+		accountBalance := vos.NewAnalyticAccountBalance(account, vos.Currency("USD"), vos.Version(1), 200, 0)
 		mockedUsecase := &mocks.UseCaseMock{
 			GetAccountBalanceFunc: func(ctx context.Context, input domain.GetAccountBalanceInput) (vos.AccountBalance, error) {
+				assert.Equal(t, vos.Currency("USD"), input.Currency)
 				accountBalance.Account = input.Account
 
 				return accountBalance, nil
@@ -35,28 +36,62 @@ func TestAPI_GetAccountBalance_Analytic_Success(t *testing.T) {
 		api := NewAPI(mockedUsecase)
 
 		request := &proto.GetAccountBalanceRequest{
-			Account: account.Value(),
+			Account:  account.Value(),
+			Currency: "USD",
 		}
 
 		got, err := api.GetAccountBalance(context.Background(), request)
 		assert.NoError(t, err)
 
 		assert.Equal(t, &proto.GetAccountBalanceResponse{
-			Account:        request.Account,
-			CurrentVersion: accountBalance.CurrentVersion.AsInt64(),
-			Balance:        200,
+			Account:          request.Account,
+			Currency:         "USD",
+			CurrentVersion:   accountBalance.CurrentVersion.AsInt64(),
+			Balance:          200,
+			AvailableBalance: 200,
+			Credit:           200,
 		}, got)
 	})
 }
 
+func TestAPI_GetAccountBalance_NaturalSign(t *testing.T) {
+	t.Parallel()
+
+	account, err := vos.NewAccount("asset.bacen.reserves")
+	require.NoError(t, err)
+
+	api := NewAPI(&mocks.UseCaseMock{
+		GetAccountBalanceFunc: func(ctx context.Context, input domain.GetAccountBalanceInput) (vos.AccountBalance, error) {
+			return vos.NewAnalyticAccountBalance(account, vos.DefaultCurrency, vos.Version(2), 30, 100), nil
+		},
+	})
+
+	got, err := api.GetAccountBalance(context.Background(), &proto.GetAccountBalanceRequest{
+		Account:     account.Value(),
+		NaturalSign: true,
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, &proto.GetAccountBalanceResponse{
+		Account:          account.Value(),
+		Currency:         "BRL",
+		CurrentVersion:   2,
+		Balance:          70,
+		AvailableBalance: 70,
+		Credit:           30,
+		Debit:            100,
+	}, got)
+}
+
 func TestAPI_GetAccountBalance_Synthetic_Success(t *testing.T) {
 	t.Run("should get aggregated balance successfully", func(t *testing.T) {
 		account, err := vos.NewAccount("liability.stone.clients.*")
 		assert.NoError(t, err)
 
-		balance := vos.NewSyntheticAccountBalance(account, 100)
+		balance := vos.NewSyntheticAccountBalance(account, vos.DefaultCurrency, 100, 0)
 		mockedUsecase := &mocks.UseCaseMock{
 			GetAccountBalanceFunc: func(ctx context.Context, input domain.GetAccountBalanceInput) (vos.AccountBalance, error) {
+				assert.Equal(t, vos.DefaultCurrency, input.Currency)
 				return balance, nil
 			},
 		}
@@ -70,9 +105,12 @@ func TestAPI_GetAccountBalance_Synthetic_Success(t *testing.T) {
 		assert.NoError(t, err)
 
 		assert.Equal(t, &proto.GetAccountBalanceResponse{
-			Account:        account.Value(),
-			CurrentVersion: -1,
-			Balance:        100,
+			Account:          account.Value(),
+			Currency:         "BRL",
+			CurrentVersion:   -1,
+			Balance:          100,
+			AvailableBalance: 100,
+			Credit:           100,
 		}, got)
 	})
 }
@@ -83,7 +121,7 @@ func TestAPI_GetAccountBalance_Bounds(t *testing.T) {
 	account, err := vos.NewAccount("liability.stone.clients.*")
 	require.NoError(t, err)
 
-	balance := vos.NewSyntheticAccountBalance(account, 100)
+	balance := vos.NewSyntheticAccountBalance(account, vos.DefaultCurrency, 100, 0)
 	mockedUsecase := &mocks.UseCaseMock{
 		GetAccountBalanceFunc: func(ctx context.Context, input domain.GetAccountBalanceInput) (vos.AccountBalance, error) {
 			return balance, nil
@@ -128,9 +166,12 @@ func TestAPI_GetAccountBalance_Bounds(t *testing.T) {
 			assert.NoError(t, err)
 
 			assert.Equal(t, &proto.GetAccountBalanceResponse{
-				Account:        account.Value(),
-				CurrentVersion: -1,
-				Balance:        100,
+				Account:          account.Value(),
+				Currency:         "BRL",
+				CurrentVersion:   -1,
+				Balance:          100,
+				AvailableBalance: 100,
+				Credit:           100,
 			}, got)
 		})
 	}
@@ -155,6 +196,16 @@ func TestAPI_GetAccountBalance_InvalidRequest(t *testing.T) {
 			expectedCode:    codes.InvalidArgument,
 			expectedMessage: app.ErrInvalidAccountComponentCharacters.Error(),
 		},
+		{
+			name:         "should return an error if currency is invalid",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.GetAccountBalanceRequest{
+				Account:  testdata.GenerateAccountPath(),
+				Currency: "usd",
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidCurrency.Error(),
+		},
 		{
 			name:         "should return an error if dates are invalid",
 			useCaseSetup: &mocks.UseCaseMock{},
@@ -5,7 +5,12 @@ import (
 	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
 )
 
-var _ proto.LedgerAPIServer = &API{}
+var (
+	_ proto.LedgerAPIServer       = &API{}
+	_ proto.AccountAPIServer      = &API{}
+	_ proto.EventAPIServer        = &API{}
+	_ proto.FiscalPeriodAPIServer = &API{}
+)
 
 type API struct {
 	UseCase domain.UseCase
@@ -0,0 +1,85 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func (a *API) GetBalanceHistory(ctx context.Context, request *proto.GetBalanceHistoryRequest) (*proto.GetBalanceHistoryResponse, error) {
+	account, err := vos.NewAccount(request.Account)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("can't create account name")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	currency := vos.DefaultCurrency
+	if request.Currency != "" {
+		currency, err = vos.NewCurrency(request.Currency)
+		if err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("can't create currency")
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if !request.StartDate.IsValid() || !request.EndDate.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "start_date and end_date must be valid")
+	}
+
+	req, err := vos.NewBalanceHistoryRequest(
+		account,
+		currency,
+		request.StartDate.AsTime(),
+		request.EndDate.AsTime(),
+		vos.Granularity(request.Granularity),
+	)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("can't create balance history request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	view, err := parseTimeView(request.TimeAxis, request.KnownAt)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Time = view
+
+	history, err := a.UseCase.GetBalanceHistory(ctx, req)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to get balance history")
+		if errors.Is(err, app.ErrAccountNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	if request.NaturalSign {
+		history = history.Natural()
+	}
+
+	points := make([]*proto.BalancePoint, 0, len(history.Points))
+	for _, point := range history.Points {
+		points = append(points, &proto.BalancePoint{
+			Date:    timestamppb.New(point.Date),
+			Credit:  int64(point.Credit),
+			Debit:   int64(point.Debit),
+			Balance: int64(point.Balance),
+		})
+	}
+
+	return &proto.GetBalanceHistoryResponse{
+		Account:  history.Account.Value(),
+		Currency: history.Currency.String(),
+		Points:   points,
+	}, nil
+}
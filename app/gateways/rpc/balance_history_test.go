@@ -0,0 +1,122 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func TestAPI_GetBalanceHistory(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 2)
+
+	testCases := []struct {
+		name            string
+		useCaseSetup    *mocks.UseCaseMock
+		request         *proto.GetBalanceHistoryRequest
+		expected        *proto.GetBalanceHistoryResponse
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name: "should get the daily balances of a synthetic account in the natural orientation",
+			useCaseSetup: &mocks.UseCaseMock{
+				GetBalanceHistoryFunc: func(ctx context.Context, req vos.BalanceHistoryRequest) (vos.BalanceHistory, error) {
+					assert.Equal(t, "asset.bacen.*", req.Account.Value())
+					assert.Equal(t, vos.DefaultCurrency, req.Currency)
+					assert.Equal(t, vos.DayGranularity, req.Granularity)
+					assert.Equal(t, start, req.Start)
+					assert.Equal(t, end, req.End)
+
+					return vos.BalanceHistory{
+						Account:  req.Account,
+						Currency: req.Currency,
+						Points: []vos.BalancePoint{
+							{Date: start, Debit: 100, Balance: -100},
+							{Date: start.AddDate(0, 0, 1), Credit: 30, Balance: -70},
+						},
+					}, nil
+				},
+			},
+			request: &proto.GetBalanceHistoryRequest{
+				Account:     "asset.bacen.*",
+				StartDate:   timestamppb.New(start),
+				EndDate:     timestamppb.New(end),
+				Granularity: proto.BalanceGranularity_BALANCE_GRANULARITY_DAY,
+				NaturalSign: true,
+			},
+			expected: &proto.GetBalanceHistoryResponse{
+				Account:  "asset.bacen.*",
+				Currency: "BRL",
+				Points: []*proto.BalancePoint{
+					{Date: timestamppb.New(start), Debit: 100, Balance: 100},
+					{Date: timestamppb.New(start.AddDate(0, 0, 1)), Credit: 30, Balance: 70},
+				},
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "should return an error if the granularity is missing",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.GetBalanceHistoryRequest{
+				Account:   "asset.bacen.reserves",
+				StartDate: timestamppb.New(start),
+				EndDate:   timestamppb.New(end),
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidGranularity.Error(),
+		},
+		{
+			name:         "should return an error if the dates are missing",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.GetBalanceHistoryRequest{
+				Account:     "asset.bacen.reserves",
+				StartDate:   timestamppb.New(start),
+				Granularity: proto.BalanceGranularity_BALANCE_GRANULARITY_DAY,
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "start_date and end_date must be valid",
+		},
+		{
+			name:         "should return an error if there are too many points",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.GetBalanceHistoryRequest{
+				Account:     "asset.bacen.reserves",
+				StartDate:   timestamppb.New(start),
+				EndDate:     timestamppb.New(start.AddDate(5, 0, 0)),
+				Granularity: proto.BalanceGranularity_BALANCE_GRANULARITY_DAY,
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrBalanceHistoryTooLong.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := NewAPI(tt.useCaseSetup)
+
+			got, err := api.GetBalanceHistory(context.Background(), tt.request)
+			respStatus, ok := status.FromError(err)
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, respStatus.Code())
+			assert.Equal(t, tt.expectedMessage, respStatus.Message())
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
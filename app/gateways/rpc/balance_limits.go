@@ -0,0 +1,117 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func (a *API) SetBalanceLimit(ctx context.Context, req *proto.SetBalanceLimitRequest) (*proto.SetBalanceLimitResponse, error) {
+	if req.Limit == nil {
+		return nil, status.Error(codes.InvalidArgument, "limit must have a value")
+	}
+
+	account, currency, err := balanceLimitKey(req.Limit.Account, req.Limit.Currency)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("can't create balance limit key")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit, err := vos.NewBalanceLimit(account, currency, fromInt64Value(req.Limit.MinBalance), fromInt64Value(req.Limit.MaxBalance))
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("can't create balance limit")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err = a.UseCase.SetBalanceLimit(ctx, limit); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to set balance limit")
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &proto.SetBalanceLimitResponse{}, nil
+}
+
+func (a *API) DeleteBalanceLimit(ctx context.Context, req *proto.DeleteBalanceLimitRequest) (*proto.DeleteBalanceLimitResponse, error) {
+	account, currency, err := balanceLimitKey(req.Account, req.Currency)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("can't create balance limit key")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err = a.UseCase.DeleteBalanceLimit(ctx, account, currency); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to delete balance limit")
+		if errors.Is(err, app.ErrBalanceLimitNotFound) {
+			return nil, status.Error(codes.NotFound, app.ErrBalanceLimitNotFound.Error())
+		}
+
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &proto.DeleteBalanceLimitResponse{}, nil
+}
+
+func (a *API) ListBalanceLimits(ctx context.Context, _ *proto.ListBalanceLimitsRequest) (*proto.ListBalanceLimitsResponse, error) {
+	limits, err := a.UseCase.ListBalanceLimits(ctx)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to list balance limits")
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	protoLimits := make([]*proto.BalanceLimit, 0, len(limits))
+	for _, limit := range limits {
+		protoLimits = append(protoLimits, &proto.BalanceLimit{
+			Account:    limit.Account.Value(),
+			Currency:   limit.Currency.String(),
+			MinBalance: toInt64Value(limit.MinBalance),
+			MaxBalance: toInt64Value(limit.MaxBalance),
+		})
+	}
+
+	return &proto.ListBalanceLimitsResponse{
+		Limits: protoLimits,
+	}, nil
+}
+
+func balanceLimitKey(account, currency string) (vos.Account, vos.Currency, error) {
+	acc, err := vos.NewAccount(account)
+	if err != nil {
+		return vos.Account{}, "", err
+	}
+
+	if currency == "" {
+		return acc, vos.DefaultCurrency, nil
+	}
+
+	cur, err := vos.NewCurrency(currency)
+	if err != nil {
+		return vos.Account{}, "", err
+	}
+
+	return acc, cur, nil
+}
+
+func fromInt64Value(v *wrapperspb.Int64Value) *int {
+	if v == nil {
+		return nil
+	}
+
+	value := int(v.Value)
+
+	return &value
+}
+
+func toInt64Value(v *int) *wrapperspb.Int64Value {
+	if v == nil {
+		return nil
+	}
+
+	return wrapperspb.Int64(int64(*v))
+}
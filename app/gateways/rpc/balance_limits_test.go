@@ -0,0 +1,152 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func TestAPI_SetBalanceLimit(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		useCaseSetup    *mocks.UseCaseMock
+		request         *proto.SetBalanceLimitRequest
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name: "should set a balance floor for an account pattern",
+			useCaseSetup: &mocks.UseCaseMock{
+				SetBalanceLimitFunc: func(ctx context.Context, limit vos.BalanceLimit) error {
+					assert.Equal(t, "liability.clients.available.*", limit.Account.Value())
+					assert.Equal(t, vos.DefaultCurrency, limit.Currency)
+					assert.Equal(t, 0, *limit.MinBalance)
+					assert.Nil(t, limit.MaxBalance)
+
+					return nil
+				},
+			},
+			request: &proto.SetBalanceLimitRequest{
+				Limit: &proto.BalanceLimit{
+					Account:    "liability.clients.available.*",
+					MinBalance: wrapperspb.Int64(0),
+				},
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:            "should return an error if limit is missing",
+			useCaseSetup:    &mocks.UseCaseMock{},
+			request:         &proto.SetBalanceLimitRequest{},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "limit must have a value",
+		},
+		{
+			name:         "should return an error if account is invalid",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.SetBalanceLimitRequest{
+				Limit: &proto.BalanceLimit{
+					Account:    "liability.clients.abc-123.*",
+					MinBalance: wrapperspb.Int64(0),
+				},
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidAccountComponentCharacters.Error(),
+		},
+		{
+			name:         "should return an error if currency is invalid",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.SetBalanceLimitRequest{
+				Limit: &proto.BalanceLimit{
+					Account:    "liability.clients.available.*",
+					Currency:   "usd",
+					MinBalance: wrapperspb.Int64(0),
+				},
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidCurrency.Error(),
+		},
+		{
+			name:         "should return an error if limit has no bounds",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.SetBalanceLimitRequest{
+				Limit: &proto.BalanceLimit{
+					Account: "liability.clients.available.*",
+				},
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidBalanceLimit.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := NewAPI(tt.useCaseSetup)
+
+			_, err := api.SetBalanceLimit(context.Background(), tt.request)
+			respStatus, ok := status.FromError(err)
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, respStatus.Code())
+			assert.Equal(t, tt.expectedMessage, respStatus.Message())
+		})
+	}
+}
+
+func TestAPI_DeleteBalanceLimit(t *testing.T) {
+	t.Parallel()
+
+	api := NewAPI(&mocks.UseCaseMock{
+		DeleteBalanceLimitFunc: func(ctx context.Context, account vos.Account, currency vos.Currency) error {
+			return app.ErrBalanceLimitNotFound
+		},
+	})
+
+	_, err := api.DeleteBalanceLimit(context.Background(), &proto.DeleteBalanceLimitRequest{Account: "liability.clients.available.*"})
+	respStatus, ok := status.FromError(err)
+
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, respStatus.Code())
+	assert.Equal(t, app.ErrBalanceLimitNotFound.Error(), respStatus.Message())
+}
+
+func TestAPI_ListBalanceLimits(t *testing.T) {
+	t.Parallel()
+
+	account, err := vos.NewAccount("liability.clients.available.*")
+	assert.NoError(t, err)
+
+	overdraft := -1000
+
+	api := NewAPI(&mocks.UseCaseMock{
+		ListBalanceLimitsFunc: func(ctx context.Context) ([]vos.BalanceLimit, error) {
+			return []vos.BalanceLimit{{Account: account, Currency: vos.DefaultCurrency, MinBalance: &overdraft}}, nil
+		},
+	})
+
+	got, err := api.ListBalanceLimits(context.Background(), &proto.ListBalanceLimitsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, &proto.ListBalanceLimitsResponse{
+		Limits: []*proto.BalanceLimit{
+			{
+				Account:    account.Value(),
+				Currency:   "BRL",
+				MinBalance: wrapperspb.Int64(-1000),
+			},
+		},
+	}, got)
+}
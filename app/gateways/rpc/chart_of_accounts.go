@@ -0,0 +1,31 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func (a *API) ListChartOfAccounts(ctx context.Context, _ *proto.ListChartOfAccountsRequest) (*proto.ListChartOfAccountsResponse, error) {
+	chart, err := a.UseCase.ListChartOfAccounts(ctx)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to list chart of accounts")
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	patterns := make([]*proto.AccountPattern, 0, len(chart))
+	for _, pattern := range chart {
+		patterns = append(patterns, &proto.AccountPattern{
+			Class:   pattern.Class(),
+			Pattern: pattern.Value(),
+		})
+	}
+
+	return &proto.ListChartOfAccountsResponse{
+		Patterns: patterns,
+	}, nil
+}
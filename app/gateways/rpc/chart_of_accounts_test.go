@@ -0,0 +1,33 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func TestAPI_ListChartOfAccounts(t *testing.T) {
+	t.Parallel()
+
+	chart, err := vos.NewChartOfAccounts("liability.clients.{available,blocked}.<uuid>", "asset.bacen.*")
+	require.NoError(t, err)
+
+	api := NewAPI(&mocks.UseCaseMock{
+		ListChartOfAccountsFunc: func(ctx context.Context) (vos.ChartOfAccounts, error) {
+			return chart, nil
+		},
+	})
+
+	got, err := api.ListChartOfAccounts(context.Background(), &proto.ListChartOfAccountsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []*proto.AccountPattern{
+		{Class: "liability", Pattern: "liability.clients.{available,blocked}.<uuid>"},
+		{Class: "asset", Pattern: "asset.bacen.*"},
+	}, got.Patterns)
+}
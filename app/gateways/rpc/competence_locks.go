@@ -0,0 +1,157 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func (a *API) SetCompetenceLock(ctx context.Context, req *proto.SetCompetenceLockRequest) (*proto.SetCompetenceLockResponse, error) {
+	if req.LockDate == nil {
+		return nil, status.Error(codes.InvalidArgument, app.ErrInvalidCompetenceLockDate.Error())
+	} else if !req.LockDate.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "lock_date must be valid")
+	}
+
+	change, err := a.UseCase.SetCompetenceLock(ctx, domain.CompetenceLockInput{
+		Company:     req.Company,
+		Event:       req.Event,
+		Date:        time.Unix(req.LockDate.Seconds, 0).UTC(),
+		RequestedBy: req.RequestedBy,
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to set competence lock")
+		return nil, competenceLockError(err)
+	}
+
+	return &proto.SetCompetenceLockResponse{
+		Change: toProtoCompetenceLockChange(change),
+	}, nil
+}
+
+func (a *API) ClearCompetenceLock(ctx context.Context, req *proto.ClearCompetenceLockRequest) (*proto.ClearCompetenceLockResponse, error) {
+	change, err := a.UseCase.ClearCompetenceLock(ctx, domain.CompetenceLockInput{
+		Company:     req.Company,
+		Event:       req.Event,
+		RequestedBy: req.RequestedBy,
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to clear competence lock")
+		return nil, competenceLockError(err)
+	}
+
+	return &proto.ClearCompetenceLockResponse{
+		Change: toProtoCompetenceLockChange(change),
+	}, nil
+}
+
+func (a *API) ListCompetenceLocks(ctx context.Context, req *proto.ListCompetenceLocksRequest) (*proto.ListCompetenceLocksResponse, error) {
+	if req.Company == "" {
+		return nil, status.Error(codes.InvalidArgument, app.ErrInvalidCompetenceLockCompany.Error())
+	}
+
+	output, err := a.UseCase.ListCompetenceLocks(ctx, req.Company)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to list competence locks")
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	locks := make([]*proto.CompetenceLock, 0, len(output.Locks))
+	for _, lock := range output.Locks {
+		locks = append(locks, &proto.CompetenceLock{
+			Company:   lock.Company,
+			Event:     lock.Event,
+			LockDate:  timestamppb.New(lock.Date),
+			UpdatedBy: lock.UpdatedBy,
+			UpdatedAt: timestamppb.New(lock.UpdatedAt),
+		})
+	}
+
+	changes := make([]*proto.CompetenceLockChange, 0, len(output.Changes))
+	for _, change := range output.Changes {
+		changes = append(changes, toProtoCompetenceLockChange(change))
+	}
+
+	return &proto.ListCompetenceLocksResponse{
+		Locks:   locks,
+		Changes: changes,
+	}, nil
+}
+
+// PostAdjustment posts the transaction regardless of the competence locks, recording who authorized it and why.
+func (a *API) PostAdjustment(ctx context.Context, req *proto.PostAdjustmentRequest) (*proto.PostAdjustmentResponse, error) {
+	tx, err := newTransaction(ctx, req.Id, req.Entries, req.CompetenceDate, req.Company, req.Event)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err = tx.Authorize(req.RequestedBy, req.Reason)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	posted, err := a.UseCase.CreateTransaction(ctx, tx)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to post adjustment")
+		return nil, postingError(err)
+	}
+
+	entries, err := toProtoAccountEntries(ctx, posted)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.PostAdjustmentResponse{
+		CreatedAt: timestamppb.New(posted.CreatedAt),
+		Entries:   entries,
+	}, nil
+}
+
+func competenceLockError(err error) error {
+	switch {
+	case errors.Is(err, app.ErrInvalidCompetenceLockCompany):
+		return status.Error(codes.InvalidArgument, app.ErrInvalidCompetenceLockCompany.Error())
+	case errors.Is(err, app.ErrInvalidCompetenceLockRequester):
+		return status.Error(codes.InvalidArgument, app.ErrInvalidCompetenceLockRequester.Error())
+	case errors.Is(err, app.ErrInvalidCompetenceLockDate):
+		return status.Error(codes.InvalidArgument, app.ErrInvalidCompetenceLockDate.Error())
+	case errors.Is(err, app.ErrInvalidEventID):
+		return status.Error(codes.InvalidArgument, app.ErrInvalidEventID.Error())
+	case errors.Is(err, app.ErrCompetenceLockNotFound):
+		return status.Error(codes.NotFound, app.ErrCompetenceLockNotFound.Error())
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
+func toProtoCompetenceLockChange(change entities.CompetenceLockChange) *proto.CompetenceLockChange {
+	protoChange := &proto.CompetenceLockChange{
+		Company:     change.Company,
+		Event:       change.Event,
+		RequestedBy: change.RequestedBy,
+		Reason:      change.Reason,
+		CreatedAt:   timestamppb.New(change.CreatedAt),
+	}
+
+	if !change.PreviousDate.IsZero() {
+		protoChange.PreviousDate = timestamppb.New(change.PreviousDate)
+	}
+
+	if !change.Removes() {
+		protoChange.LockDate = timestamppb.New(change.Date)
+	}
+
+	return protoChange
+}
@@ -0,0 +1,219 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func TestAPI_SetCompetenceLock(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2021, 6, 30, 0, 0, 0, 0, time.UTC)
+	previous := date.AddDate(0, -3, 0)
+	createdAt := time.Now().UTC()
+
+	testCases := []struct {
+		name            string
+		useCaseSetup    *mocks.UseCaseMock
+		request         *proto.SetCompetenceLockRequest
+		expected        *proto.SetCompetenceLockResponse
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name: "should move the lock date successfully",
+			useCaseSetup: &mocks.UseCaseMock{
+				SetCompetenceLockFunc: func(ctx context.Context, input domain.CompetenceLockInput) (entities.CompetenceLockChange, error) {
+					assert.Equal(t, domain.CompetenceLockInput{
+						Company:     "abc",
+						Event:       2,
+						Date:        date,
+						RequestedBy: "accountant",
+						Reason:      "filed",
+					}, input)
+
+					return entities.CompetenceLockChange{
+						Company:      input.Company,
+						Event:        input.Event,
+						PreviousDate: previous,
+						Date:         input.Date,
+						RequestedBy:  input.RequestedBy,
+						Reason:       input.Reason,
+						CreatedAt:    createdAt,
+					}, nil
+				},
+			},
+			request: &proto.SetCompetenceLockRequest{
+				Company:     "abc",
+				Event:       2,
+				LockDate:    timestamppb.New(date),
+				RequestedBy: "accountant",
+				Reason:      "filed",
+			},
+			expected: &proto.SetCompetenceLockResponse{
+				Change: &proto.CompetenceLockChange{
+					Company:      "abc",
+					Event:        2,
+					PreviousDate: timestamppb.New(previous),
+					LockDate:     timestamppb.New(date),
+					RequestedBy:  "accountant",
+					Reason:       "filed",
+					CreatedAt:    timestamppb.New(createdAt),
+				},
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "should return an error if the lock date is missing",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.SetCompetenceLockRequest{
+				Company:     "abc",
+				RequestedBy: "accountant",
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidCompetenceLockDate.Error(),
+		},
+		{
+			name: "should return an error if the requester is missing",
+			useCaseSetup: &mocks.UseCaseMock{
+				SetCompetenceLockFunc: func(ctx context.Context, input domain.CompetenceLockInput) (entities.CompetenceLockChange, error) {
+					return entities.CompetenceLockChange{}, app.ErrInvalidCompetenceLockRequester
+				},
+			},
+			request: &proto.SetCompetenceLockRequest{
+				Company:  "abc",
+				LockDate: timestamppb.New(date),
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidCompetenceLockRequester.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := NewAPI(tt.useCaseSetup)
+
+			got, err := api.SetCompetenceLock(context.Background(), tt.request)
+			respStatus, ok := status.FromError(err)
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, respStatus.Code())
+			assert.Equal(t, tt.expectedMessage, respStatus.Message())
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestAPI_ClearCompetenceLock(t *testing.T) {
+	t.Parallel()
+
+	api := NewAPI(&mocks.UseCaseMock{
+		ClearCompetenceLockFunc: func(ctx context.Context, input domain.CompetenceLockInput) (entities.CompetenceLockChange, error) {
+			return entities.CompetenceLockChange{}, app.ErrCompetenceLockNotFound
+		},
+	})
+
+	_, err := api.ClearCompetenceLock(context.Background(), &proto.ClearCompetenceLockRequest{
+		Company:     "abc",
+		RequestedBy: "accountant",
+	})
+	respStatus, ok := status.FromError(err)
+
+	assert.True(t, ok)
+	assert.Equal(t, codes.NotFound, respStatus.Code())
+	assert.Equal(t, app.ErrCompetenceLockNotFound.Error(), respStatus.Message())
+}
+
+func TestAPI_PostAdjustment(t *testing.T) {
+	t.Parallel()
+
+	competenceDate := time.Date(2021, 6, 15, 0, 0, 0, 0, time.UTC)
+	createdAt := time.Now().UTC()
+
+	newRequest := func(requestedBy, reason string) *proto.PostAdjustmentRequest {
+		return &proto.PostAdjustmentRequest{
+			Id: uuid.New().String(),
+			Entries: []*proto.Entry{
+				{
+					Id:              uuid.New().String(),
+					Account:         "asset.bank.account",
+					ExpectedVersion: int64(vos.IgnoreAccountVersion),
+					Operation:       proto.Operation_OPERATION_DEBIT,
+					Amount:          100,
+				},
+				{
+					Id:              uuid.New().String(),
+					Account:         "revenue.fees.card",
+					ExpectedVersion: int64(vos.IgnoreAccountVersion),
+					Operation:       proto.Operation_OPERATION_CREDIT,
+					Amount:          100,
+				},
+			},
+			CompetenceDate: timestamppb.New(competenceDate),
+			Company:        "abc",
+			Event:          1,
+			RequestedBy:    requestedBy,
+			Reason:         reason,
+		}
+	}
+
+	t.Run("should post the adjustment with its authorization", func(t *testing.T) {
+		t.Parallel()
+
+		useCase := &mocks.UseCaseMock{
+			CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+				assert.True(t, transaction.Adjustment)
+				assert.Equal(t, entities.AdjustmentAuthorization{RequestedBy: "controller", Reason: "late invoice"}, transaction.Authorization)
+
+				transaction.CreatedAt = createdAt
+
+				return transaction, nil
+			},
+		}
+
+		got, err := NewAPI(useCase).PostAdjustment(context.Background(), newRequest("controller", "late invoice"))
+		assert.NoError(t, err)
+		assert.Len(t, got.Entries, 2)
+		assert.Equal(t, timestamppb.New(createdAt), got.CreatedAt)
+		assert.Len(t, useCase.CreateTransactionCalls(), 1)
+	})
+
+	t.Run("should return an error if the requester is missing", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewAPI(&mocks.UseCaseMock{}).PostAdjustment(context.Background(), newRequest("", "late invoice"))
+		respStatus, ok := status.FromError(err)
+
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, respStatus.Code())
+		assert.Equal(t, app.ErrInvalidAdjustmentRequester.Error(), respStatus.Message())
+	})
+
+	t.Run("should return an error if the reason is missing", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewAPI(&mocks.UseCaseMock{}).PostAdjustment(context.Background(), newRequest("controller", ""))
+		respStatus, ok := status.FromError(err)
+
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, respStatus.Code())
+		assert.Equal(t, app.ErrInvalidAdjustmentReason.Error(), respStatus.Message())
+	})
+}
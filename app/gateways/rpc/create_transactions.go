@@ -0,0 +1,99 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+const maxBatchTransactions = 5000
+
+func (a *API) CreateTransactions(ctx context.Context, req *proto.CreateTransactionsRequest) (*proto.CreateTransactionsResponse, error) {
+	if len(req.Transactions) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "transactions must have a value")
+	}
+
+	if len(req.Transactions) > maxBatchTransactions {
+		return nil, status.Errorf(codes.InvalidArgument, "transactions must have at most %d items", maxBatchTransactions)
+	}
+
+	results, err := a.createTransactions(ctx, req.Transactions, req.BestEffort)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.CreateTransactionsResponse{Results: results}, nil
+}
+
+// createTransactions saves a batch of transactions, returning the result of each of them in the request order.
+// In all-or-nothing mode, the status error of the first rejected transaction is returned instead.
+func (a *API) createTransactions(ctx context.Context, requests []*proto.CreateTransactionRequest, bestEffort bool) ([]*proto.CreateTransactionResult, error) {
+	results := make([]*proto.CreateTransactionResult, len(requests))
+	transactions := make([]entities.Transaction, 0, len(requests))
+	// indexes maps each valid transaction to its position in the request
+	indexes := make([]int, 0, len(requests))
+
+	for i, r := range requests {
+		results[i] = &proto.CreateTransactionResult{Id: r.GetId()}
+
+		err := rejectAdjustment(r)
+
+		var tx entities.Transaction
+		if err == nil {
+			tx, err = newTransaction(ctx, r.GetId(), r.GetEntries(), r.GetCompetenceDate(), r.GetCompany(), r.GetEvent())
+		}
+
+		if err != nil {
+			if !bestEffort {
+				return nil, batchError(i, err)
+			}
+
+			setTransactionResult(results[i], err)
+			continue
+		}
+
+		transactions = append(transactions, tx)
+		indexes = append(indexes, i)
+	}
+
+	if len(transactions) == 0 {
+		return results, nil
+	}
+
+	errs, err := a.UseCase.CreateTransactions(ctx, domain.CreateTransactionsInput{
+		Transactions: transactions,
+		BestEffort:   bestEffort,
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to save transactions")
+		return nil, postingError(err)
+	}
+
+	for i, txErr := range errs {
+		if txErr != nil {
+			zerolog.Ctx(ctx).Error().Err(txErr).Int("index", indexes[i]).Msg("failed to save transaction")
+			setTransactionResult(results[indexes[i]], postingError(txErr))
+		}
+	}
+
+	return results, nil
+}
+
+// batchError points out which transaction of the batch the status error refers to.
+func batchError(index int, err error) error {
+	st := status.Convert(err)
+	return status.Error(st.Code(), fmt.Sprintf("transaction %d: %s", index, st.Message()))
+}
+
+func setTransactionResult(result *proto.CreateTransactionResult, err error) {
+	st := status.Convert(err)
+	result.Code = int32(st.Code())
+	result.Message = st.Message()
+}
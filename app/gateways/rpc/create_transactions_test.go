@@ -0,0 +1,306 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/newrelic/go-agent/v3/newrelic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
+	"github.com/stone-co/the-amazing-ledger/app/domain/usecases"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func newCreateTransactionRequest(amount int64) *proto.CreateTransactionRequest {
+	return &proto.CreateTransactionRequest{
+		Id: uuid.New().String(),
+		Entries: []*proto.Entry{
+			{
+				Id:              uuid.New().String(),
+				Account:         testdata.GenerateAccountPath(),
+				ExpectedVersion: -1,
+				Operation:       proto.Operation_OPERATION_DEBIT,
+				Amount:          amount,
+			},
+			{
+				Id:              uuid.New().String(),
+				Account:         testdata.GenerateAccountPath(),
+				ExpectedVersion: -1,
+				Operation:       proto.Operation_OPERATION_CREDIT,
+				Amount:          amount,
+			},
+		},
+		Company:        "abc",
+		Event:          1,
+		CompetenceDate: timestamppb.Now(),
+	}
+}
+
+// newReplayUseCase is a use case over a ledger where every transaction was already posted, stored as
+// returned by store, so that posting them again replays them.
+func newReplayUseCase(store func(entities.Transaction) entities.Transaction) domain.UseCase {
+	var (
+		mu     sync.Mutex
+		stored = make(map[uuid.UUID]entities.Transaction)
+	)
+
+	save := func(txs []entities.Transaction) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		for _, tx := range txs {
+			stored[tx.ID] = store(tx)
+		}
+	}
+
+	repo := &mocks.RepositoryMock{
+		CreateTransactionsFunc: func(ctx context.Context, txs []entities.Transaction) error {
+			save(txs)
+			return app.ErrIdempotencyKeyViolation
+		},
+		CreateTransactionsBestEffortFunc: func(ctx context.Context, txs []entities.Transaction) ([]error, error) {
+			save(txs)
+
+			errs := make([]error, len(txs))
+			for i := range errs {
+				errs[i] = app.ErrIdempotencyKeyViolation
+			}
+
+			return errs, nil
+		},
+		GetTransactionFunc: func(ctx context.Context, id uuid.UUID) (entities.Transaction, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			return stored[id], nil
+		},
+	}
+
+	return usecases.NewLedgerUseCase(repo, instrumentators.NewLedgerInstrumentator(&newrelic.Application{}))
+}
+
+// changeCompany stores the transactions with another company, so replaying them conflicts.
+func changeCompany(tx entities.Transaction) entities.Transaction {
+	tx.Company = "other"
+	return tx
+}
+
+func sameTransaction(tx entities.Transaction) entities.Transaction {
+	return tx
+}
+
+func TestAPI_CreateTransactions(t *testing.T) {
+	t.Parallel()
+
+	valid := newCreateTransactionRequest(100)
+	rejected := newCreateTransactionRequest(200)
+
+	invalid := newCreateTransactionRequest(300)
+	invalid.Entries[0].Amount = 1
+
+	t.Run("should create all transactions at once", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{
+			CreateTransactionsFunc: func(ctx context.Context, input domain.CreateTransactionsInput) ([]error, error) {
+				assert.False(t, input.BestEffort)
+				require.Len(t, input.Transactions, 2)
+				assert.Equal(t, valid.Id, input.Transactions[0].ID.String())
+				assert.Equal(t, rejected.Id, input.Transactions[1].ID.String())
+
+				return make([]error, len(input.Transactions)), nil
+			},
+		})
+
+		got, err := api.CreateTransactions(context.Background(), &proto.CreateTransactionsRequest{
+			Transactions: []*proto.CreateTransactionRequest{valid, rejected},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*proto.CreateTransactionResult{{Id: valid.Id}, {Id: rejected.Id}}, got.Results)
+	})
+
+	t.Run("should reject the whole batch when a transaction is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{})
+
+		_, err := api.CreateTransactions(context.Background(), &proto.CreateTransactionsRequest{
+			Transactions: []*proto.CreateTransactionRequest{valid, invalid},
+		})
+		respStatus, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, respStatus.Code())
+		assert.Equal(t, "transaction 1: "+app.ErrInvalidBalance.Error(), respStatus.Message())
+	})
+
+	t.Run("should reject the adjustments", func(t *testing.T) {
+		t.Parallel()
+
+		adjustment := newCreateTransactionRequest(400)
+		adjustment.Adjustment = true //nolint:staticcheck // the deprecated flag must be rejected
+
+		api := NewAPI(&mocks.UseCaseMock{})
+
+		_, err := api.CreateTransactions(context.Background(), &proto.CreateTransactionsRequest{
+			Transactions: []*proto.CreateTransactionRequest{valid, adjustment},
+		})
+		respStatus, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.PermissionDenied, respStatus.Code())
+		assert.Equal(t, "transaction 1: "+app.ErrUnauthorizedAdjustment.Error(), respStatus.Message())
+	})
+
+	t.Run("should reject the whole batch when a transaction is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{
+			CreateTransactionsFunc: func(ctx context.Context, input domain.CreateTransactionsInput) ([]error, error) {
+				return nil, app.ErrInvalidVersion
+			},
+		})
+
+		_, err := api.CreateTransactions(context.Background(), &proto.CreateTransactionsRequest{
+			Transactions: []*proto.CreateTransactionRequest{valid, rejected},
+		})
+		respStatus, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, respStatus.Code())
+		assert.Equal(t, "invalid account version", respStatus.Message())
+	})
+
+	t.Run("should report the result of each transaction in best-effort mode", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{
+			CreateTransactionsFunc: func(ctx context.Context, input domain.CreateTransactionsInput) ([]error, error) {
+				assert.True(t, input.BestEffort)
+				require.Len(t, input.Transactions, 2)
+
+				return []error{nil, app.ErrAccountNotActive}, nil
+			},
+		})
+
+		got, err := api.CreateTransactions(context.Background(), &proto.CreateTransactionsRequest{
+			Transactions: []*proto.CreateTransactionRequest{valid, invalid, rejected},
+			BestEffort:   true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*proto.CreateTransactionResult{
+			{Id: valid.Id},
+			{Id: invalid.Id, Code: int32(codes.InvalidArgument), Message: app.ErrInvalidBalance.Error()},
+			{Id: rejected.Id, Code: int32(codes.FailedPrecondition), Message: app.ErrAccountNotActive.Error()},
+		}, got.Results)
+	})
+
+	t.Run("should replay the transactions already posted", func(t *testing.T) {
+		t.Parallel()
+
+		for _, bestEffort := range []bool{false, true} {
+			api := NewAPI(newReplayUseCase(sameTransaction))
+
+			got, err := api.CreateTransactions(context.Background(), &proto.CreateTransactionsRequest{
+				Transactions: []*proto.CreateTransactionRequest{valid, rejected},
+				BestEffort:   bestEffort,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, []*proto.CreateTransactionResult{{Id: valid.Id}, {Id: rejected.Id}}, got.Results)
+		}
+	})
+
+	t.Run("should report a conflict when a transaction already posted differs", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(newReplayUseCase(changeCompany))
+
+		_, err := api.CreateTransactions(context.Background(), &proto.CreateTransactionsRequest{
+			Transactions: []*proto.CreateTransactionRequest{valid},
+		})
+		respStatus, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.AlreadyExists, respStatus.Code())
+		assert.Contains(t, respStatus.Message(), `company: "abc" != "other"`)
+
+		got, err := api.CreateTransactions(context.Background(), &proto.CreateTransactionsRequest{
+			Transactions: []*proto.CreateTransactionRequest{valid},
+			BestEffort:   true,
+		})
+		assert.NoError(t, err)
+		require.Len(t, got.Results, 1)
+		assert.Equal(t, int32(codes.AlreadyExists), got.Results[0].Code)
+		assert.Contains(t, got.Results[0].Message, `company: "abc" != "other"`)
+	})
+
+	t.Run("should not post anything if every transaction is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{})
+
+		got, err := api.CreateTransactions(context.Background(), &proto.CreateTransactionsRequest{
+			Transactions: []*proto.CreateTransactionRequest{invalid},
+			BestEffort:   true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*proto.CreateTransactionResult{
+			{Id: invalid.Id, Code: int32(codes.InvalidArgument), Message: app.ErrInvalidBalance.Error()},
+		}, got.Results)
+	})
+
+	t.Run("should fail the batch on unexpected errors in best-effort mode", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{
+			CreateTransactionsFunc: func(ctx context.Context, input domain.CreateTransactionsInput) ([]error, error) {
+				return nil, errors.New("connection refused")
+			},
+		})
+
+		_, err := api.CreateTransactions(context.Background(), &proto.CreateTransactionsRequest{
+			Transactions: []*proto.CreateTransactionRequest{valid},
+			BestEffort:   true,
+		})
+		respStatus, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Internal, respStatus.Code())
+	})
+
+	t.Run("should return an error if the batch is empty", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{})
+
+		_, err := api.CreateTransactions(context.Background(), &proto.CreateTransactionsRequest{})
+		respStatus, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, respStatus.Code())
+		assert.Equal(t, "transactions must have a value", respStatus.Message())
+	})
+
+	t.Run("should return an error if the batch is too large", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{})
+
+		transactions := make([]*proto.CreateTransactionRequest, maxBatchTransactions+1)
+		for i := range transactions {
+			transactions[i] = valid
+		}
+
+		_, err := api.CreateTransactions(context.Background(), &proto.CreateTransactionsRequest{Transactions: transactions})
+		respStatus, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, respStatus.Code())
+	})
+}
@@ -0,0 +1,36 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func (a *API) DescribeAccount(ctx context.Context, req *proto.DescribeAccountRequest) (*proto.DescribeAccountResponse, error) {
+	accountName, err := vos.NewAnalyticAccount(req.Account)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("can't create account name")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	account, err := a.UseCase.DescribeAccount(ctx, accountName)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to describe account")
+		if errors.Is(err, app.ErrAccountNotFound) {
+			return nil, status.Error(codes.NotFound, app.ErrAccountNotFound.Error())
+		}
+
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &proto.DescribeAccountResponse{
+		Account: toProtoAccount(account),
+	}, nil
+}
@@ -0,0 +1,108 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func (a *API) SetEventMetadataSchema(ctx context.Context, req *proto.SetEventMetadataSchemaRequest) (*proto.SetEventMetadataSchemaResponse, error) {
+	raw, err := req.Schema.MarshalJSON()
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to marshal metadata schema")
+		return nil, status.Error(codes.InvalidArgument, app.ErrInvalidMetadataSchema.Error())
+	}
+
+	schema, err := entities.NewEventMetadataSchema(req.EventId, raw)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("can't create event metadata schema")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	created, err := a.UseCase.SetEventMetadataSchema(ctx, schema)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to set event metadata schema")
+		return nil, eventError(err)
+	}
+
+	protoSchema, err := toProtoEventMetadataSchema(ctx, created)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.SetEventMetadataSchemaResponse{
+		Schema: protoSchema,
+	}, nil
+}
+
+func (a *API) ListEventMetadataSchemas(ctx context.Context, req *proto.ListEventMetadataSchemasRequest) (*proto.ListEventMetadataSchemasResponse, error) {
+	schemas, err := a.UseCase.ListEventMetadataSchemas(ctx, req.EventId)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to list event metadata schemas")
+		return nil, eventError(err)
+	}
+
+	protoSchemas := make([]*proto.EventMetadataSchema, 0, len(schemas))
+	for _, schema := range schemas {
+		protoSchema, convErr := toProtoEventMetadataSchema(ctx, schema)
+		if convErr != nil {
+			return nil, convErr
+		}
+
+		protoSchemas = append(protoSchemas, protoSchema)
+	}
+
+	return &proto.ListEventMetadataSchemasResponse{
+		Schemas: protoSchemas,
+	}, nil
+}
+
+// metadataSchemaError reports every field that doesn't match the schema as a violation of a bad request.
+func metadataSchemaError(err error) error {
+	var schemaErr app.MetadataSchemaError
+	if !errors.As(err, &schemaErr) {
+		return status.Error(codes.InvalidArgument, app.ErrMetadataSchemaViolation.Error())
+	}
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(schemaErr.Violations))
+	for _, violation := range schemaErr.Violations {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       violation.Field,
+			Description: violation.Description,
+		})
+	}
+
+	st := status.New(codes.InvalidArgument, schemaErr.Error())
+
+	detailed, detailsErr := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+func toProtoEventMetadataSchema(ctx context.Context, schema entities.EventMetadataSchema) (*proto.EventMetadataSchema, error) {
+	protoSchema := &structpb.Struct{}
+	if err := protoSchema.UnmarshalJSON(schema.Schema); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to convert metadata schema to structpb")
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &proto.EventMetadataSchema{
+		EventId:   schema.Event,
+		Version:   int32(schema.Version),
+		Schema:    protoSchema,
+		CreatedAt: timestamppb.New(schema.CreatedAt),
+	}, nil
+}
@@ -0,0 +1,176 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func TestAPI_SetEventMetadataSchema(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Now().UTC()
+
+	schema, err := structpb.NewStruct(map[string]interface{}{"required": []interface{}{"order_id"}})
+	require.NoError(t, err)
+
+	invalid, err := structpb.NewStruct(map[string]interface{}{"type": "nothing"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name            string
+		useCaseSetup    *mocks.UseCaseMock
+		request         *proto.SetEventMetadataSchemaRequest
+		expected        *proto.SetEventMetadataSchemaResponse
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name: "should set the schema of an event successfully",
+			useCaseSetup: &mocks.UseCaseMock{
+				SetEventMetadataSchemaFunc: func(ctx context.Context, s entities.EventMetadataSchema) (entities.EventMetadataSchema, error) {
+					s.Version = 2
+					s.CreatedAt = createdAt
+					return s, nil
+				},
+			},
+			request: &proto.SetEventMetadataSchemaRequest{EventId: 1, Schema: schema},
+			expected: &proto.SetEventMetadataSchemaResponse{
+				Schema: &proto.EventMetadataSchema{
+					EventId:   1,
+					Version:   2,
+					Schema:    schema,
+					CreatedAt: timestamppb.New(createdAt),
+				},
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:            "should return an error if the schema isn't a JSON Schema",
+			useCaseSetup:    &mocks.UseCaseMock{},
+			request:         &proto.SetEventMetadataSchemaRequest{EventId: 1, Schema: invalid},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidMetadataSchema.Error(),
+		},
+		{
+			name: "should return an error if the event doesn't exist",
+			useCaseSetup: &mocks.UseCaseMock{
+				SetEventMetadataSchemaFunc: func(ctx context.Context, s entities.EventMetadataSchema) (entities.EventMetadataSchema, error) {
+					return entities.EventMetadataSchema{}, app.ErrEventNotFound
+				},
+			},
+			request:         &proto.SetEventMetadataSchemaRequest{EventId: 99, Schema: schema},
+			expectedCode:    codes.NotFound,
+			expectedMessage: app.ErrEventNotFound.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := NewAPI(tt.useCaseSetup)
+
+			got, err := api.SetEventMetadataSchema(context.Background(), tt.request)
+
+			respStatus, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, respStatus.Code())
+
+			if tt.expectedCode != codes.OK {
+				assert.Equal(t, tt.expectedMessage, respStatus.Message())
+				return
+			}
+
+			assert.Equal(t, tt.expected.Schema.EventId, got.Schema.EventId)
+			assert.Equal(t, tt.expected.Schema.Version, got.Schema.Version)
+			assert.Equal(t, tt.expected.Schema.CreatedAt.AsTime(), got.Schema.CreatedAt.AsTime())
+			assert.Equal(t, tt.expected.Schema.Schema.AsMap(), got.Schema.Schema.AsMap())
+		})
+	}
+}
+
+func TestAPI_ListEventMetadataSchemas(t *testing.T) {
+	t.Parallel()
+
+	api := NewAPI(&mocks.UseCaseMock{
+		ListEventMetadataSchemasFunc: func(ctx context.Context, event uint32) ([]entities.EventMetadataSchema, error) {
+			return []entities.EventMetadataSchema{
+				{Event: event, Version: 2, Schema: json.RawMessage(`{"required": ["order_id"]}`)},
+				{Event: event, Version: 1, Schema: json.RawMessage(`{}`)},
+			}, nil
+		},
+	})
+
+	got, err := api.ListEventMetadataSchemas(context.Background(), &proto.ListEventMetadataSchemasRequest{EventId: 1})
+	require.NoError(t, err)
+	require.Len(t, got.Schemas, 2)
+	assert.Equal(t, int32(2), got.Schemas[0].Version)
+	assert.Equal(t, map[string]interface{}{"required": []interface{}{"order_id"}}, got.Schemas[0].Schema.AsMap())
+}
+
+func TestAPI_CreateTransaction_MetadataSchemaViolation(t *testing.T) {
+	t.Parallel()
+
+	api := NewAPI(&mocks.UseCaseMock{
+		CreateTransactionFunc: func(ctx context.Context, tx entities.Transaction) (entities.Transaction, error) {
+			return entities.Transaction{}, app.MetadataSchemaError{
+				Event:   tx.Event,
+				Version: 1,
+				Violations: []app.MetadataViolation{
+					{Field: "entries[id=3c7f9a2e-1d4b-4f6a-9e2d-5b8c0a1f7e34].metadata.order_id", Description: "order_id is required"},
+				},
+			}
+		},
+	})
+
+	_, err := api.CreateTransaction(context.Background(), &proto.CreateTransactionRequest{
+		Id: uuid.New().String(),
+		Entries: []*proto.Entry{
+			{
+				Id:              uuid.New().String(),
+				Account:         testdata.GenerateAccountPath(),
+				ExpectedVersion: 3,
+				Operation:       proto.Operation_OPERATION_DEBIT,
+				Amount:          123,
+			},
+			{
+				Id:              uuid.New().String(),
+				Account:         testdata.GenerateAccountPath(),
+				ExpectedVersion: 3,
+				Operation:       proto.Operation_OPERATION_CREDIT,
+				Amount:          123,
+			},
+		},
+		Company:        "abc",
+		Event:          1,
+		CompetenceDate: timestamppb.Now(),
+	})
+
+	respStatus, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, respStatus.Code())
+	require.Len(t, respStatus.Details(), 1)
+
+	badRequest, ok := respStatus.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	assert.Equal(t, "entries[id=3c7f9a2e-1d4b-4f6a-9e2d-5b8c0a1f7e34].metadata.order_id", badRequest.FieldViolations[0].Field)
+	assert.Equal(t, "order_id is required", badRequest.FieldViolations[0].Description)
+}
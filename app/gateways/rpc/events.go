@@ -0,0 +1,106 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func (a *API) CreateEvent(ctx context.Context, req *proto.CreateEventRequest) (*proto.CreateEventResponse, error) {
+	event, err := entities.NewEvent(req.Id, req.Name, req.Description)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("can't create event")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	created, err := a.UseCase.CreateEvent(ctx, event)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to create event")
+		if errors.Is(err, app.ErrEventAlreadyExists) {
+			return nil, status.Error(codes.AlreadyExists, app.ErrEventAlreadyExists.Error())
+		}
+
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &proto.CreateEventResponse{
+		Event: toProtoEvent(created),
+	}, nil
+}
+
+func (a *API) ListEvents(ctx context.Context, req *proto.ListEventsRequest) (*proto.ListEventsResponse, error) {
+	events, err := a.UseCase.ListEvents(ctx, domain.ListEventsInput{IncludeDeprecated: req.IncludeDeprecated})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to list events")
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	protoEvents := make([]*proto.Event, 0, len(events))
+	for _, event := range events {
+		protoEvents = append(protoEvents, toProtoEvent(event))
+	}
+
+	return &proto.ListEventsResponse{
+		Events: protoEvents,
+	}, nil
+}
+
+func (a *API) DescribeEvent(ctx context.Context, req *proto.DescribeEventRequest) (*proto.DescribeEventResponse, error) {
+	event, err := a.UseCase.DescribeEvent(ctx, req.Id)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to describe event")
+		return nil, eventError(err)
+	}
+
+	return &proto.DescribeEventResponse{
+		Event: toProtoEvent(event),
+	}, nil
+}
+
+func (a *API) DeprecateEvent(ctx context.Context, req *proto.DeprecateEventRequest) (*proto.DeprecateEventResponse, error) {
+	event, err := a.UseCase.DeprecateEvent(ctx, req.Id)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to deprecate event")
+		return nil, eventError(err)
+	}
+
+	return &proto.DeprecateEventResponse{
+		Event: toProtoEvent(event),
+	}, nil
+}
+
+func eventError(err error) error {
+	switch {
+	case errors.Is(err, app.ErrEventNotFound):
+		return status.Error(codes.NotFound, app.ErrEventNotFound.Error())
+	case errors.Is(err, app.ErrEventAlreadyDeprecated):
+		return status.Error(codes.FailedPrecondition, app.ErrEventAlreadyDeprecated.Error())
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
+func toProtoEvent(event entities.Event) *proto.Event {
+	protoEvent := &proto.Event{
+		Id:          event.ID,
+		Name:        event.Name,
+		Description: event.Description,
+		Deprecated:  event.Deprecated(),
+		CreatedAt:   timestamppb.New(event.CreatedAt),
+	}
+
+	if event.Deprecated() {
+		protoEvent.DeprecatedAt = timestamppb.New(event.DeprecatedAt)
+	}
+
+	return protoEvent
+}
@@ -0,0 +1,169 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func TestAPI_CreateEvent(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Now().UTC()
+
+	testCases := []struct {
+		name            string
+		useCaseSetup    *mocks.UseCaseMock
+		request         *proto.CreateEventRequest
+		expected        *proto.CreateEventResponse
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name: "should create an event successfully",
+			useCaseSetup: &mocks.UseCaseMock{
+				CreateEventFunc: func(ctx context.Context, event entities.Event) (entities.Event, error) {
+					event.CreatedAt = createdAt
+					return event, nil
+				},
+			},
+			request: &proto.CreateEventRequest{Id: 10, Name: "pix_transfer", Description: "Pix transfer"},
+			expected: &proto.CreateEventResponse{
+				Event: &proto.Event{
+					Id:          10,
+					Name:        "pix_transfer",
+					Description: "Pix transfer",
+					CreatedAt:   timestamppb.New(createdAt),
+				},
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:            "should return an error if id doesn't fit a smallint",
+			useCaseSetup:    &mocks.UseCaseMock{},
+			request:         &proto.CreateEventRequest{Id: 40000, Name: "pix_transfer"},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidEventID.Error(),
+		},
+		{
+			name:            "should return an error if name is empty",
+			useCaseSetup:    &mocks.UseCaseMock{},
+			request:         &proto.CreateEventRequest{Id: 10},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidEventName.Error(),
+		},
+		{
+			name: "should return an error if the event already exists",
+			useCaseSetup: &mocks.UseCaseMock{
+				CreateEventFunc: func(ctx context.Context, event entities.Event) (entities.Event, error) {
+					return entities.Event{}, app.ErrEventAlreadyExists
+				},
+			},
+			request:         &proto.CreateEventRequest{Id: 10, Name: "pix_transfer"},
+			expectedCode:    codes.AlreadyExists,
+			expectedMessage: app.ErrEventAlreadyExists.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := NewAPI(tt.useCaseSetup)
+
+			got, err := api.CreateEvent(context.Background(), tt.request)
+			respStatus, ok := status.FromError(err)
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, respStatus.Code())
+			assert.Equal(t, tt.expectedMessage, respStatus.Message())
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestAPI_ListEvents(t *testing.T) {
+	t.Parallel()
+
+	deprecatedAt := time.Now().UTC()
+
+	api := NewAPI(&mocks.UseCaseMock{
+		ListEventsFunc: func(ctx context.Context, input domain.ListEventsInput) ([]entities.Event, error) {
+			assert.True(t, input.IncludeDeprecated)
+
+			return []entities.Event{
+				{ID: 1, Name: "default"},
+				{ID: 2, Name: "legacy", DeprecatedAt: deprecatedAt},
+			}, nil
+		},
+	})
+
+	got, err := api.ListEvents(context.Background(), &proto.ListEventsRequest{IncludeDeprecated: true})
+	assert.NoError(t, err)
+	assert.Len(t, got.Events, 2)
+	assert.False(t, got.Events[0].Deprecated)
+	assert.Nil(t, got.Events[0].DeprecatedAt)
+	assert.True(t, got.Events[1].Deprecated)
+	assert.Equal(t, timestamppb.New(deprecatedAt), got.Events[1].DeprecatedAt)
+}
+
+func TestAPI_DeprecateEvent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		err          error
+		expectedCode codes.Code
+	}{
+		{
+			name:         "should deprecate an event successfully",
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "should return an error if the event doesn't exist",
+			err:          app.ErrEventNotFound,
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "should return an error if the event is already deprecated",
+			err:          app.ErrEventAlreadyDeprecated,
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := NewAPI(&mocks.UseCaseMock{
+				DeprecateEventFunc: func(ctx context.Context, id uint32) (entities.Event, error) {
+					if tt.err != nil {
+						return entities.Event{}, tt.err
+					}
+
+					return entities.Event{ID: id, Name: "legacy", DeprecatedAt: time.Now()}, nil
+				},
+			})
+
+			got, err := api.DeprecateEvent(context.Background(), &proto.DeprecateEventRequest{Id: 2})
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+
+			if tt.err == nil {
+				assert.True(t, got.Event.Deprecated)
+			}
+		})
+	}
+}
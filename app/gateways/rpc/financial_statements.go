@@ -0,0 +1,169 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func (a *API) GetBalanceSheet(ctx context.Context, request *proto.GetBalanceSheetRequest) (*proto.GetBalanceSheetResponse, error) {
+	if request.Date == nil {
+		return nil, status.Error(codes.InvalidArgument, "date must have a value")
+	} else if !request.Date.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "date must be valid")
+	}
+
+	if request.ComparativeDate != nil && !request.ComparativeDate.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "comparative_date must be valid")
+	}
+
+	if request.Level < 0 {
+		return nil, status.Error(codes.InvalidArgument, "level must not be negative")
+	}
+
+	view, err := parseTimeView(request.TimeAxis, request.KnownAt)
+	if err != nil {
+		return nil, err
+	}
+
+	input := domain.FinancialStatementInput{
+		Company: request.Company,
+		Current: vos.Period{End: request.Date.AsTime()},
+		Level:   int(request.Level),
+		Time:    view,
+	}
+
+	if request.ComparativeDate != nil {
+		input.Comparative = vos.Period{End: request.ComparativeDate.AsTime()}
+	}
+
+	statement, err := a.UseCase.GetBalanceSheet(ctx, input)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("can't get balance sheet")
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &proto.GetBalanceSheetResponse{
+		Lines:            statementLinesToProto(statement.Lines),
+		Totals:           statementTotalsToProto(statement.Totals),
+		RetainedEarnings: statementAmountsToProto(statement.RetainedEarnings),
+	}, nil
+}
+
+func (a *API) GetIncomeStatement(ctx context.Context, request *proto.GetIncomeStatementRequest) (*proto.GetIncomeStatementResponse, error) {
+	if request.Period == nil {
+		return nil, status.Error(codes.InvalidArgument, "period must have a value")
+	}
+
+	current, err := parsePeriod("period", request.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	var comparative vos.Period
+	if request.ComparativePeriod != nil {
+		comparative, err = parsePeriod("comparative_period", request.ComparativePeriod)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if request.Level < 0 {
+		return nil, status.Error(codes.InvalidArgument, "level must not be negative")
+	}
+
+	view, err := parseTimeView(request.TimeAxis, request.KnownAt)
+	if err != nil {
+		return nil, err
+	}
+
+	statement, err := a.UseCase.GetIncomeStatement(ctx, domain.FinancialStatementInput{
+		Company:     request.Company,
+		Current:     current,
+		Comparative: comparative,
+		Level:       int(request.Level),
+		Time:        view,
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("can't get income statement")
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &proto.GetIncomeStatementResponse{
+		Lines:            statementLinesToProto(statement.Lines),
+		Totals:           statementTotalsToProto(statement.Totals),
+		RetainedEarnings: statementAmountsToProto(statement.RetainedEarnings),
+	}, nil
+}
+
+// parsePeriod validates a period of competence dates, which must have an end after its optional start.
+func parsePeriod(name string, period *proto.Period) (vos.Period, error) {
+	if period.EndDate == nil {
+		return vos.Period{}, status.Errorf(codes.InvalidArgument, "%s.end_date must have a value", name)
+	}
+
+	if !period.EndDate.IsValid() || (period.StartDate != nil && !period.StartDate.IsValid()) {
+		return vos.Period{}, status.Errorf(codes.InvalidArgument, "%s must be valid", name)
+	}
+
+	parsed := vos.Period{End: period.EndDate.AsTime()}
+	if period.StartDate != nil {
+		parsed.Start = period.StartDate.AsTime()
+	}
+
+	if !parsed.Start.Before(parsed.End) {
+		return vos.Period{}, status.Errorf(codes.InvalidArgument, "%[1]s.end_date must be after %[1]s.start_date", name)
+	}
+
+	return parsed, nil
+}
+
+func statementLinesToProto(lines []vos.StatementLine) []*proto.FinancialStatementLine {
+	protoLines := make([]*proto.FinancialStatementLine, 0, len(lines))
+
+	for _, line := range lines {
+		protoLines = append(protoLines, &proto.FinancialStatementLine{
+			Account:     line.Account.Value(),
+			Currency:    line.Currency.String(),
+			Current:     line.Current,
+			Comparative: line.Comparative,
+		})
+	}
+
+	return protoLines
+}
+
+func statementTotalsToProto(totals []vos.StatementTotal) []*proto.FinancialStatementTotal {
+	protoTotals := make([]*proto.FinancialStatementTotal, 0, len(totals))
+
+	for _, total := range totals {
+		protoTotals = append(protoTotals, &proto.FinancialStatementTotal{
+			Class:       total.Class.Name,
+			Currency:    total.Currency.String(),
+			Current:     total.Current,
+			Comparative: total.Comparative,
+		})
+	}
+
+	return protoTotals
+}
+
+func statementAmountsToProto(amounts []vos.StatementAmount) []*proto.FinancialStatementAmount {
+	protoAmounts := make([]*proto.FinancialStatementAmount, 0, len(amounts))
+
+	for _, amount := range amounts {
+		protoAmounts = append(protoAmounts, &proto.FinancialStatementAmount{
+			Currency:    amount.Currency.String(),
+			Current:     amount.Current,
+			Comparative: amount.Comparative,
+		})
+	}
+
+	return protoAmounts
+}
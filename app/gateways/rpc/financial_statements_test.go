@@ -0,0 +1,145 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func TestAPI_GetBalanceSheet(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	asset, err := vos.NewAccount("asset.*")
+	assert.NoError(t, err)
+
+	t.Run("should get the balance sheet", func(t *testing.T) {
+		t.Parallel()
+
+		assetClass, _ := vos.LookupAccountClass("asset")
+
+		api := NewAPI(&mocks.UseCaseMock{
+			GetBalanceSheetFunc: func(ctx context.Context, input domain.FinancialStatementInput) (vos.FinancialStatement, error) {
+				assert.Equal(t, domain.FinancialStatementInput{
+					Company:     "abc",
+					Current:     vos.Period{End: date},
+					Comparative: vos.Period{End: date.AddDate(-1, 0, 0)},
+					Level:       1,
+				}, input)
+
+				return vos.FinancialStatement{
+					Lines:            []vos.StatementLine{{Account: asset, Currency: vos.DefaultCurrency, Current: 100, Comparative: 50}},
+					Totals:           []vos.StatementTotal{{Class: assetClass, Currency: vos.DefaultCurrency, Current: 100, Comparative: 50}},
+					RetainedEarnings: []vos.StatementAmount{{Currency: vos.DefaultCurrency, Current: 20, Comparative: 5}},
+				}, nil
+			},
+		})
+
+		got, err := api.GetBalanceSheet(context.Background(), &proto.GetBalanceSheetRequest{
+			Company:         "abc",
+			Date:            timestamppb.New(date),
+			ComparativeDate: timestamppb.New(date.AddDate(-1, 0, 0)),
+			Level:           1,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*proto.FinancialStatementLine{{Account: "asset.*", Currency: "BRL", Current: 100, Comparative: 50}}, got.Lines)
+		assert.Equal(t, []*proto.FinancialStatementTotal{{Class: "asset", Currency: "BRL", Current: 100, Comparative: 50}}, got.Totals)
+		assert.Equal(t, []*proto.FinancialStatementAmount{{Currency: "BRL", Current: 20, Comparative: 5}}, got.RetainedEarnings)
+	})
+
+	t.Run("should return an error if date is missing", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewAPI(&mocks.UseCaseMock{}).GetBalanceSheet(context.Background(), &proto.GetBalanceSheetRequest{})
+		respStatus, ok := status.FromError(err)
+
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, respStatus.Code())
+		assert.Equal(t, "date must have a value", respStatus.Message())
+	})
+}
+
+func TestAPI_GetIncomeStatement(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name            string
+		request         *proto.GetIncomeStatementRequest
+		expectedInput   domain.FinancialStatementInput
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name: "should get the income statement",
+			request: &proto.GetIncomeStatementRequest{
+				Period:            &proto.Period{StartDate: timestamppb.New(start), EndDate: timestamppb.New(end)},
+				ComparativePeriod: &proto.Period{EndDate: timestamppb.New(start)},
+				Level:             2,
+			},
+			expectedInput: domain.FinancialStatementInput{
+				Current:     vos.Period{Start: start, End: end},
+				Comparative: vos.Period{End: start},
+				Level:       2,
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:            "should return an error if period is missing",
+			request:         &proto.GetIncomeStatementRequest{},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "period must have a value",
+		},
+		{
+			name: "should return an error if period has no end",
+			request: &proto.GetIncomeStatementRequest{
+				Period: &proto.Period{StartDate: timestamppb.New(start)},
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "period.end_date must have a value",
+		},
+		{
+			name: "should return an error if comparative period ends before it starts",
+			request: &proto.GetIncomeStatementRequest{
+				Period:            &proto.Period{StartDate: timestamppb.New(start), EndDate: timestamppb.New(end)},
+				ComparativePeriod: &proto.Period{StartDate: timestamppb.New(end), EndDate: timestamppb.New(start)},
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "comparative_period.end_date must be after comparative_period.start_date",
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := NewAPI(&mocks.UseCaseMock{
+				GetIncomeStatementFunc: func(ctx context.Context, input domain.FinancialStatementInput) (vos.FinancialStatement, error) {
+					assert.Equal(t, tt.expectedInput, input)
+					return vos.NewFinancialStatement(nil, nil), nil
+				},
+			})
+
+			_, err := api.GetIncomeStatement(context.Background(), tt.request)
+			respStatus, ok := status.FromError(err)
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, respStatus.Code())
+			assert.Equal(t, tt.expectedMessage, respStatus.Message())
+		})
+	}
+}
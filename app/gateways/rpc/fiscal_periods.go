@@ -0,0 +1,218 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func (a *API) CreateFiscalPeriod(ctx context.Context, req *proto.CreateFiscalPeriodRequest) (*proto.CreateFiscalPeriodResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to parse fiscal period id")
+		return nil, status.Error(codes.InvalidArgument, app.ErrInvalidFiscalPeriodID.Error())
+	}
+
+	if req.StartDate == nil || req.EndDate == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_date and end_date must have a value")
+	} else if !req.StartDate.IsValid() || !req.EndDate.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "start_date and end_date must be valid")
+	}
+
+	start := time.Unix(req.StartDate.Seconds, 0).UTC()
+	end := time.Unix(req.EndDate.Seconds, 0).UTC()
+
+	period, err := entities.NewFiscalPeriod(id, req.Company, start, end)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("can't create fiscal period")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	created, err := a.UseCase.CreateFiscalPeriod(ctx, period)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to create fiscal period")
+		return nil, fiscalPeriodError(err)
+	}
+
+	return &proto.CreateFiscalPeriodResponse{
+		FiscalPeriod: toProtoFiscalPeriod(created),
+	}, nil
+}
+
+func (a *API) ListFiscalPeriods(ctx context.Context, req *proto.ListFiscalPeriodsRequest) (*proto.ListFiscalPeriodsResponse, error) {
+	if req.Company == "" {
+		return nil, status.Error(codes.InvalidArgument, app.ErrInvalidFiscalPeriodCompany.Error())
+	}
+
+	periods, err := a.UseCase.ListFiscalPeriods(ctx, req.Company)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to list fiscal periods")
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	protoPeriods := make([]*proto.FiscalPeriod, 0, len(periods))
+	for _, period := range periods {
+		protoPeriods = append(protoPeriods, toProtoFiscalPeriod(period))
+	}
+
+	return &proto.ListFiscalPeriodsResponse{
+		FiscalPeriods: protoPeriods,
+	}, nil
+}
+
+func (a *API) DescribeFiscalPeriod(ctx context.Context, req *proto.DescribeFiscalPeriodRequest) (*proto.DescribeFiscalPeriodResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to parse fiscal period id")
+		return nil, status.Error(codes.InvalidArgument, app.ErrInvalidFiscalPeriodID.Error())
+	}
+
+	output, err := a.UseCase.DescribeFiscalPeriod(ctx, id)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to describe fiscal period")
+		return nil, fiscalPeriodError(err)
+	}
+
+	changes := make([]*proto.FiscalPeriodChange, 0, len(output.Changes))
+	for _, change := range output.Changes {
+		changes = append(changes, toProtoFiscalPeriodChange(change))
+	}
+
+	return &proto.DescribeFiscalPeriodResponse{
+		FiscalPeriod: toProtoFiscalPeriod(output.Period),
+		Changes:      changes,
+	}, nil
+}
+
+func (a *API) CloseFiscalPeriod(ctx context.Context, req *proto.CloseFiscalPeriodRequest) (*proto.CloseFiscalPeriodResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to parse fiscal period id")
+		return nil, status.Error(codes.InvalidArgument, app.ErrInvalidFiscalPeriodID.Error())
+	}
+
+	if req.RequestedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, app.ErrInvalidFiscalPeriodRequester.Error())
+	}
+
+	closing, err := a.UseCase.CloseFiscalPeriod(ctx, domain.CloseFiscalPeriodInput{
+		ID:          id,
+		Event:       req.Event,
+		RequestedBy: req.RequestedBy,
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to close fiscal period")
+
+		switch {
+		case errors.Is(err, app.ErrFiscalPeriodNotFound),
+			errors.Is(err, app.ErrFiscalPeriodAlreadyClosed),
+			errors.Is(err, app.ErrInvalidRetainedEarningsAccount):
+			return nil, fiscalPeriodError(err)
+		default:
+			return nil, postingError(err)
+		}
+	}
+
+	var transactionID string
+	if closing.TransactionID != uuid.Nil {
+		transactionID = closing.TransactionID.String()
+	}
+
+	return &proto.CloseFiscalPeriodResponse{
+		FiscalPeriod:  toProtoFiscalPeriod(closing.Period),
+		TransactionId: transactionID,
+	}, nil
+}
+
+func (a *API) ReopenFiscalPeriod(ctx context.Context, req *proto.ReopenFiscalPeriodRequest) (*proto.ReopenFiscalPeriodResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to parse fiscal period id")
+		return nil, status.Error(codes.InvalidArgument, app.ErrInvalidFiscalPeriodID.Error())
+	}
+
+	if req.RequestedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, app.ErrInvalidFiscalPeriodRequester.Error())
+	}
+
+	if req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, app.ErrInvalidReopenReason.Error())
+	}
+
+	period, err := a.UseCase.ReopenFiscalPeriod(ctx, domain.ReopenFiscalPeriodInput{
+		ID:          id,
+		RequestedBy: req.RequestedBy,
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to reopen fiscal period")
+		return nil, fiscalPeriodError(err)
+	}
+
+	return &proto.ReopenFiscalPeriodResponse{
+		FiscalPeriod: toProtoFiscalPeriod(period),
+	}, nil
+}
+
+func fiscalPeriodError(err error) error {
+	switch {
+	case errors.Is(err, app.ErrFiscalPeriodNotFound):
+		return status.Error(codes.NotFound, app.ErrFiscalPeriodNotFound.Error())
+	case errors.Is(err, app.ErrFiscalPeriodOverlap):
+		return status.Error(codes.AlreadyExists, app.ErrFiscalPeriodOverlap.Error())
+	case errors.Is(err, app.ErrIdempotencyKeyViolation):
+		return status.Error(codes.InvalidArgument, "invalid idempotency key")
+	case errors.Is(err, app.ErrFiscalPeriodAlreadyClosed):
+		return status.Error(codes.FailedPrecondition, app.ErrFiscalPeriodAlreadyClosed.Error())
+	case errors.Is(err, app.ErrFiscalPeriodNotClosed):
+		return status.Error(codes.FailedPrecondition, app.ErrFiscalPeriodNotClosed.Error())
+	case errors.Is(err, app.ErrInvalidRetainedEarningsAccount):
+		return status.Error(codes.FailedPrecondition, app.ErrInvalidRetainedEarningsAccount.Error())
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
+func toProtoFiscalPeriod(period entities.FiscalPeriod) *proto.FiscalPeriod {
+	protoPeriod := &proto.FiscalPeriod{
+		Id:        period.ID.String(),
+		Company:   period.Company,
+		StartDate: timestamppb.New(period.Start),
+		EndDate:   timestamppb.New(period.End),
+		Status:    proto.FiscalPeriodStatus(period.Status),
+		ClosedBy:  period.ClosedBy,
+		CreatedAt: timestamppb.New(period.CreatedAt),
+	}
+
+	if period.Closed() {
+		protoPeriod.ClosedAt = timestamppb.New(period.ClosedAt)
+	}
+
+	return protoPeriod
+}
+
+func toProtoFiscalPeriodChange(change entities.FiscalPeriodChange) *proto.FiscalPeriodChange {
+	protoChange := &proto.FiscalPeriodChange{
+		Action:      proto.FiscalPeriodAction(change.Action),
+		RequestedBy: change.RequestedBy,
+		Reason:      change.Reason,
+		CreatedAt:   timestamppb.New(change.CreatedAt),
+	}
+
+	if change.TransactionID != uuid.Nil {
+		protoChange.TransactionId = change.TransactionID.String()
+	}
+
+	return protoChange
+}
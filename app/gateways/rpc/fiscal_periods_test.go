@@ -0,0 +1,264 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func TestAPI_CreateFiscalPeriod(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+	createdAt := time.Now().UTC()
+
+	testCases := []struct {
+		name            string
+		useCaseSetup    *mocks.UseCaseMock
+		request         *proto.CreateFiscalPeriodRequest
+		expected        *proto.CreateFiscalPeriodResponse
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name: "should create a fiscal period successfully",
+			useCaseSetup: &mocks.UseCaseMock{
+				CreateFiscalPeriodFunc: func(ctx context.Context, period entities.FiscalPeriod) (entities.FiscalPeriod, error) {
+					period.CreatedAt = createdAt
+					return period, nil
+				},
+			},
+			request: &proto.CreateFiscalPeriodRequest{
+				Id:        id.String(),
+				Company:   "abc",
+				StartDate: timestamppb.New(start),
+				EndDate:   timestamppb.New(end),
+			},
+			expected: &proto.CreateFiscalPeriodResponse{
+				FiscalPeriod: &proto.FiscalPeriod{
+					Id:        id.String(),
+					Company:   "abc",
+					StartDate: timestamppb.New(start),
+					EndDate:   timestamppb.New(end),
+					Status:    proto.FiscalPeriodStatus_FISCAL_PERIOD_STATUS_OPEN,
+					CreatedAt: timestamppb.New(createdAt),
+				},
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "should return an error if the dates are missing",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.CreateFiscalPeriodRequest{
+				Id:        id.String(),
+				Company:   "abc",
+				StartDate: timestamppb.New(start),
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "start_date and end_date must have a value",
+		},
+		{
+			name:         "should return an error if the period ends before it starts",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.CreateFiscalPeriodRequest{
+				Id:        id.String(),
+				Company:   "abc",
+				StartDate: timestamppb.New(end),
+				EndDate:   timestamppb.New(start),
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidFiscalPeriodDates.Error(),
+		},
+		{
+			name: "should return an error if the period overlaps another one",
+			useCaseSetup: &mocks.UseCaseMock{
+				CreateFiscalPeriodFunc: func(ctx context.Context, period entities.FiscalPeriod) (entities.FiscalPeriod, error) {
+					return entities.FiscalPeriod{}, app.ErrFiscalPeriodOverlap
+				},
+			},
+			request: &proto.CreateFiscalPeriodRequest{
+				Id:        id.String(),
+				Company:   "abc",
+				StartDate: timestamppb.New(start),
+				EndDate:   timestamppb.New(end),
+			},
+			expectedCode:    codes.AlreadyExists,
+			expectedMessage: app.ErrFiscalPeriodOverlap.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := NewAPI(tt.useCaseSetup)
+
+			got, err := api.CreateFiscalPeriod(context.Background(), tt.request)
+			respStatus, ok := status.FromError(err)
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, respStatus.Code())
+			assert.Equal(t, tt.expectedMessage, respStatus.Message())
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestAPI_CloseFiscalPeriod(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	txID := uuid.New()
+
+	testCases := []struct {
+		name            string
+		useCaseSetup    *mocks.UseCaseMock
+		request         *proto.CloseFiscalPeriodRequest
+		expectedTxID    string
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name: "should close a fiscal period successfully",
+			useCaseSetup: &mocks.UseCaseMock{
+				CloseFiscalPeriodFunc: func(ctx context.Context, input domain.CloseFiscalPeriodInput) (entities.FiscalPeriodClosing, error) {
+					assert.Equal(t, domain.CloseFiscalPeriodInput{ID: id, Event: 7, RequestedBy: "accountant"}, input)
+
+					return entities.FiscalPeriodClosing{
+						Period:        entities.FiscalPeriod{ID: id, Status: vos.ClosedFiscalPeriodStatus},
+						TransactionID: txID,
+					}, nil
+				},
+			},
+			request:      &proto.CloseFiscalPeriodRequest{Id: id.String(), Event: 7, RequestedBy: "accountant"},
+			expectedTxID: txID.String(),
+			expectedCode: codes.OK,
+		},
+		{
+			name:            "should return an error if the requester is missing",
+			useCaseSetup:    &mocks.UseCaseMock{},
+			request:         &proto.CloseFiscalPeriodRequest{Id: id.String(), Event: 7},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidFiscalPeriodRequester.Error(),
+		},
+		{
+			name: "should return an error if the period is already closed",
+			useCaseSetup: &mocks.UseCaseMock{
+				CloseFiscalPeriodFunc: func(ctx context.Context, input domain.CloseFiscalPeriodInput) (entities.FiscalPeriodClosing, error) {
+					return entities.FiscalPeriodClosing{}, app.ErrFiscalPeriodAlreadyClosed
+				},
+			},
+			request:         &proto.CloseFiscalPeriodRequest{Id: id.String(), Event: 7, RequestedBy: "accountant"},
+			expectedCode:    codes.FailedPrecondition,
+			expectedMessage: app.ErrFiscalPeriodAlreadyClosed.Error(),
+		},
+		{
+			name: "should return an error if the closing event is unknown",
+			useCaseSetup: &mocks.UseCaseMock{
+				CloseFiscalPeriodFunc: func(ctx context.Context, input domain.CloseFiscalPeriodInput) (entities.FiscalPeriodClosing, error) {
+					return entities.FiscalPeriodClosing{}, app.ErrUnknownEvent
+				},
+			},
+			request:         &proto.CloseFiscalPeriodRequest{Id: id.String(), Event: 7, RequestedBy: "accountant"},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrUnknownEvent.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := NewAPI(tt.useCaseSetup)
+
+			got, err := api.CloseFiscalPeriod(context.Background(), tt.request)
+			respStatus, ok := status.FromError(err)
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, respStatus.Code())
+			assert.Equal(t, tt.expectedMessage, respStatus.Message())
+
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, tt.expectedTxID, got.TransactionId)
+				assert.Equal(t, proto.FiscalPeriodStatus_FISCAL_PERIOD_STATUS_CLOSED, got.FiscalPeriod.Status)
+			}
+		})
+	}
+}
+
+func TestAPI_ReopenFiscalPeriod(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+
+	testCases := []struct {
+		name            string
+		useCaseSetup    *mocks.UseCaseMock
+		request         *proto.ReopenFiscalPeriodRequest
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name: "should reopen a fiscal period successfully",
+			useCaseSetup: &mocks.UseCaseMock{
+				ReopenFiscalPeriodFunc: func(ctx context.Context, input domain.ReopenFiscalPeriodInput) (entities.FiscalPeriod, error) {
+					assert.Equal(t, domain.ReopenFiscalPeriodInput{ID: id, RequestedBy: "admin", Reason: "late entries"}, input)
+					return entities.FiscalPeriod{ID: id, Status: vos.OpenFiscalPeriodStatus}, nil
+				},
+			},
+			request:      &proto.ReopenFiscalPeriodRequest{Id: id.String(), RequestedBy: "admin", Reason: "late entries"},
+			expectedCode: codes.OK,
+		},
+		{
+			name:            "should return an error if the reason is missing",
+			useCaseSetup:    &mocks.UseCaseMock{},
+			request:         &proto.ReopenFiscalPeriodRequest{Id: id.String(), RequestedBy: "admin"},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidReopenReason.Error(),
+		},
+		{
+			name: "should return an error if the period is not closed",
+			useCaseSetup: &mocks.UseCaseMock{
+				ReopenFiscalPeriodFunc: func(ctx context.Context, input domain.ReopenFiscalPeriodInput) (entities.FiscalPeriod, error) {
+					return entities.FiscalPeriod{}, app.ErrFiscalPeriodNotClosed
+				},
+			},
+			request:         &proto.ReopenFiscalPeriodRequest{Id: id.String(), RequestedBy: "admin", Reason: "late entries"},
+			expectedCode:    codes.FailedPrecondition,
+			expectedMessage: app.ErrFiscalPeriodNotClosed.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := NewAPI(tt.useCaseSetup)
+
+			_, err := api.ReopenFiscalPeriod(context.Background(), tt.request)
+			respStatus, ok := status.FromError(err)
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, respStatus.Code())
+			assert.Equal(t, tt.expectedMessage, respStatus.Message())
+		})
+	}
+}
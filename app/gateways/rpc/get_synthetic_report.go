@@ -2,12 +2,15 @@ package rpc
 
 import (
 	"context"
+	"errors"
 
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/stone-co/the-amazing-ledger/app"
 	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/pagination"
 	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
 )
 
@@ -35,29 +38,117 @@ func (a *API) GetSyntheticReport(ctx context.Context, request *proto.GetSyntheti
 		return nil, status.Error(codes.InvalidArgument, "end_date must be valid")
 	}
 
-	syntheticReport, err := a.UseCase.GetSyntheticReport(ctx, account, level, request.StartDate.AsTime(), request.EndDate.AsTime())
+	page, err := pagination.NewPage(request.GetPage())
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("can't create page reference")
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	view, err := parseTimeView(request.TimeAxis, request.KnownAt)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := vos.NewSyntheticReportFilter(request.Filters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var grouping vos.ReportGrouping
+	for _, dimension := range request.GroupBy {
+		switch dimension {
+		case proto.ReportDimension_REPORT_DIMENSION_COMPANY:
+			grouping.Company = true
+		case proto.ReportDimension_REPORT_DIMENSION_EVENT:
+			grouping.Event = true
+		default:
+			return nil, status.Error(codes.InvalidArgument, "group_by must be valid")
+		}
+	}
+
+	syntheticReport, err := a.UseCase.GetSyntheticReport(ctx, vos.SyntheticReportRequest{
+		Account:   account,
+		Level:     level,
+		StartDate: request.StartDate.AsTime(),
+		EndDate:   request.EndDate.AsTime(),
+		Time:      view,
+		Filter:    filter,
+		GroupBy:   grouping,
+		Page:      page,
+		Tree:      request.Tree,
+	})
 	if err != nil {
 		zerolog.Ctx(ctx).Error().Err(err).Msg("can't get synthetic report")
+		if errors.Is(err, app.ErrInvalidPageCursor) {
+			return nil, status.Error(codes.InvalidArgument, app.ErrInvalidPageCursor.Error())
+		}
+
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
-	return &proto.GetSyntheticReportResponse{
-		TotalCredit: syntheticReport.TotalCredit,
-		TotalDebit:  syntheticReport.TotalDebit,
-		Results:     toProto(syntheticReport.Results),
-	}, nil
+	response := &proto.GetSyntheticReportResponse{
+		Results:       toProto(syntheticReport.Results, request.NaturalSign),
+		Totals:        totalsToProto(syntheticReport.Totals),
+		NextPageToken: syntheticReport.NextPage.Tokenize(),
+	}
+
+	if request.Tree {
+		response.Nodes = nodesToProto(syntheticReport.Tree(), request.NaturalSign)
+	}
+
+	return response, nil
 }
 
-func toProto(paths []vos.AccountResult) []*proto.AccountResult {
+func toProto(paths []vos.AccountResult, naturalSign bool) []*proto.AccountResult {
 	protoPaths := make([]*proto.AccountResult, 0, len(paths))
 
 	for _, element := range paths {
-		protoPaths = append(protoPaths, &proto.AccountResult{
-			Account: element.Account.Value(),
-			Credit:  element.Credit,
-			Debit:   element.Debit,
-		})
+		protoPaths = append(protoPaths, toProtoAccountResult(element, naturalSign))
 	}
 
 	return protoPaths
 }
+
+func toProtoAccountResult(result vos.AccountResult, naturalSign bool) *proto.AccountResult {
+	balance := result.Balance()
+	if naturalSign {
+		balance = result.NaturalBalance()
+	}
+
+	return &proto.AccountResult{
+		Account:  result.Account.Value(),
+		Currency: result.Currency.String(),
+		Credit:   result.Credit,
+		Debit:    result.Debit,
+		Balance:  balance,
+		Company:  result.Company,
+		Event:    result.Event,
+	}
+}
+
+func nodesToProto(nodes []*vos.AccountNode, naturalSign bool) []*proto.AccountNode {
+	protoNodes := make([]*proto.AccountNode, 0, len(nodes))
+
+	for _, node := range nodes {
+		protoNodes = append(protoNodes, &proto.AccountNode{
+			Result:   toProtoAccountResult(node.AccountResult, naturalSign),
+			Children: nodesToProto(node.Children, naturalSign),
+		})
+	}
+
+	return protoNodes
+}
+
+func totalsToProto(totals []vos.CurrencyTotal) []*proto.CurrencyTotal {
+	protoTotals := make([]*proto.CurrencyTotal, 0, len(totals))
+
+	for _, total := range totals {
+		protoTotals = append(protoTotals, &proto.CurrencyTotal{
+			Currency: total.Currency.String(),
+			Credit:   total.Credit,
+			Debit:    total.Debit,
+		})
+	}
+
+	return protoTotals
+}
@@ -3,7 +3,6 @@ package rpc
 import (
 	"context"
 	"testing"
-	"time"
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
@@ -20,7 +19,7 @@ import (
 func TestAPI_GetSyntheticReport(t *testing.T) {
 	t.Run("should get synthetic report successfully", func(t *testing.T) {
 		mockedUsecase := &mocks.UseCaseMock{
-			GetSyntheticReportFunc: func(ctx context.Context, account vos.Account, level int, startTime time.Time, endTime time.Time) (*vos.SyntheticReport, error) {
+			GetSyntheticReportFunc: func(ctx context.Context, req vos.SyntheticReportRequest) (*vos.SyntheticReport, error) {
 				return &vos.SyntheticReport{}, nil
 			},
 		}
@@ -38,9 +37,197 @@ func TestAPI_GetSyntheticReport(t *testing.T) {
 		assert.NotNil(t, syntheticReport)
 	})
 
+	t.Run("should get synthetic report split by currency", func(t *testing.T) {
+		account, err := vos.NewAnalyticAccount("liability.credit_card.account1")
+		assert.NoError(t, err)
+
+		report, err := vos.NewSyntheticReport([]vos.AccountResult{
+			{Account: account, Currency: vos.DefaultCurrency, Credit: 100, Debit: 20},
+			{Account: account, Currency: vos.Currency("USD"), Credit: 50, Debit: 10},
+		})
+		assert.NoError(t, err)
+
+		mockedUsecase := &mocks.UseCaseMock{
+			GetSyntheticReportFunc: func(ctx context.Context, req vos.SyntheticReportRequest) (*vos.SyntheticReport, error) {
+				return report, nil
+			},
+		}
+		api := NewAPI(mockedUsecase)
+
+		request := &proto.GetSyntheticReportRequest{
+			Account:   "liability.credit_card.*",
+			StartDate: timestamppb.Now(),
+			EndDate:   timestamppb.Now(),
+			Filters:   &proto.GetSyntheticReportFilters{Level: 3},
+		}
+
+		syntheticReport, err := api.GetSyntheticReport(context.Background(), request)
+		assert.NoError(t, err)
+		assert.Equal(t, []*proto.AccountResult{
+			{Account: account.Value(), Currency: "BRL", Credit: 100, Debit: 20, Balance: 80},
+			{Account: account.Value(), Currency: "USD", Credit: 50, Debit: 10, Balance: 40},
+		}, syntheticReport.Results)
+		assert.Equal(t, []*proto.CurrencyTotal{
+			{Currency: "BRL", Credit: 100, Debit: 20},
+			{Currency: "USD", Credit: 50, Debit: 10},
+		}, syntheticReport.Totals)
+	})
+
+	t.Run("should get synthetic report with natural-sign balances", func(t *testing.T) {
+		asset, err := vos.NewAnalyticAccount("asset.bacen.reserves")
+		assert.NoError(t, err)
+
+		liability, err := vos.NewAnalyticAccount("liability.credit_card.account1")
+		assert.NoError(t, err)
+
+		report, err := vos.NewSyntheticReport([]vos.AccountResult{
+			{Account: asset, Currency: vos.DefaultCurrency, Credit: 20, Debit: 100},
+			{Account: liability, Currency: vos.DefaultCurrency, Credit: 100, Debit: 20},
+		})
+		assert.NoError(t, err)
+
+		api := NewAPI(&mocks.UseCaseMock{
+			GetSyntheticReportFunc: func(ctx context.Context, req vos.SyntheticReportRequest) (*vos.SyntheticReport, error) {
+				return report, nil
+			},
+		})
+
+		syntheticReport, err := api.GetSyntheticReport(context.Background(), &proto.GetSyntheticReportRequest{
+			Account:     "*",
+			StartDate:   timestamppb.Now(),
+			EndDate:     timestamppb.Now(),
+			Filters:     &proto.GetSyntheticReportFilters{Level: 3},
+			NaturalSign: true,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []*proto.AccountResult{
+			{Account: asset.Value(), Currency: "BRL", Credit: 20, Debit: 100, Balance: 80},
+			{Account: liability.Value(), Currency: "BRL", Credit: 100, Debit: 20, Balance: 80},
+		}, syntheticReport.Results)
+	})
+
+	t.Run("should get synthetic report as a tree with the next page", func(t *testing.T) {
+		root, err := vos.NewAccount("liability.credit_card.*")
+		assert.NoError(t, err)
+
+		child, err := vos.NewAnalyticAccount("liability.credit_card.account1")
+		assert.NoError(t, err)
+
+		var got vos.SyntheticReportRequest
+		api := NewAPI(&mocks.UseCaseMock{
+			GetSyntheticReportFunc: func(ctx context.Context, req vos.SyntheticReportRequest) (*vos.SyntheticReport, error) {
+				got = req
+				return &vos.SyntheticReport{
+					Results: []vos.AccountResult{
+						{Account: root, Currency: vos.DefaultCurrency, Credit: 100, Debit: 20},
+						{Account: child, Currency: vos.DefaultCurrency, Credit: 100, Debit: 20},
+					},
+					NextPage: []byte(`{"account":"liability.credit_card.account1","currency":"BRL"}`),
+				}, nil
+			},
+		})
+
+		syntheticReport, err := api.GetSyntheticReport(context.Background(), &proto.GetSyntheticReportRequest{
+			Account:   "liability.credit_card.*",
+			StartDate: timestamppb.Now(),
+			EndDate:   timestamppb.Now(),
+			Filters:   &proto.GetSyntheticReportFilters{Level: 3},
+			Page:      &proto.RequestPagination{PageSize: 2},
+			Tree:      true,
+		})
+		assert.NoError(t, err)
+		assert.True(t, got.Tree)
+		assert.Equal(t, 2, got.Page.Size)
+		assert.Len(t, syntheticReport.Results, 2)
+		assert.Equal(t, []*proto.AccountNode{
+			{
+				Result: &proto.AccountResult{Account: root.Value(), Currency: "BRL", Credit: 100, Debit: 20, Balance: 80},
+				Children: []*proto.AccountNode{
+					{
+						Result:   &proto.AccountResult{Account: child.Value(), Currency: "BRL", Credit: 100, Debit: 20, Balance: 80},
+						Children: []*proto.AccountNode{},
+					},
+				},
+			},
+		}, syntheticReport.Nodes)
+		assert.NotEmpty(t, syntheticReport.NextPageToken)
+	})
+
+	t.Run("should get synthetic report filtered and grouped by company and event", func(t *testing.T) {
+		account, err := vos.NewAnalyticAccount("liability.credit_card.account1")
+		assert.NoError(t, err)
+
+		var got vos.SyntheticReportRequest
+		api := NewAPI(&mocks.UseCaseMock{
+			GetSyntheticReportFunc: func(ctx context.Context, req vos.SyntheticReportRequest) (*vos.SyntheticReport, error) {
+				got = req
+				return &vos.SyntheticReport{
+					Results: []vos.AccountResult{
+						{Account: account, Currency: vos.DefaultCurrency, Company: "abc", Event: 2, Credit: 100},
+					},
+				}, nil
+			},
+		})
+
+		syntheticReport, err := api.GetSyntheticReport(context.Background(), &proto.GetSyntheticReportRequest{
+			Account:   "liability.credit_card.*",
+			StartDate: timestamppb.Now(),
+			EndDate:   timestamppb.Now(),
+			Filters: &proto.GetSyntheticReportFilters{
+				Level:     3,
+				Companies: []string{"abc"},
+				Events:    []int32{2},
+				Operation: proto.Operation_OPERATION_CREDIT,
+			},
+			GroupBy: []proto.ReportDimension{proto.ReportDimension_REPORT_DIMENSION_COMPANY, proto.ReportDimension_REPORT_DIMENSION_EVENT},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, vos.AccountEntryFilter{Companies: []string{"abc"}, Events: []int32{2}, Operation: vos.CreditOperation}, got.Filter)
+		assert.Equal(t, vos.ReportGrouping{Company: true, Event: true}, got.GroupBy)
+		assert.Equal(t, []*proto.AccountResult{
+			{Account: account.Value(), Currency: "BRL", Company: "abc", Event: 2, Credit: 100, Balance: 100},
+		}, syntheticReport.Results)
+	})
+
+	t.Run("should return an error if a group_by dimension is invalid", func(t *testing.T) {
+		api := NewAPI(&mocks.UseCaseMock{})
+
+		_, err := api.GetSyntheticReport(context.Background(), &proto.GetSyntheticReportRequest{
+			Account:   "liability.credit_card.*",
+			StartDate: timestamppb.Now(),
+			EndDate:   timestamppb.Now(),
+			GroupBy:   []proto.ReportDimension{proto.ReportDimension_REPORT_DIMENSION_INVALID},
+		})
+		respStatus, ok := status.FromError(err)
+
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, respStatus.Code())
+		assert.Equal(t, "group_by must be valid", respStatus.Message())
+	})
+
+	t.Run("should return an error if the page cursor is invalid", func(t *testing.T) {
+		api := NewAPI(&mocks.UseCaseMock{
+			GetSyntheticReportFunc: func(ctx context.Context, req vos.SyntheticReportRequest) (*vos.SyntheticReport, error) {
+				return nil, app.ErrInvalidPageCursor
+			},
+		})
+
+		_, err := api.GetSyntheticReport(context.Background(), &proto.GetSyntheticReportRequest{
+			Account:   "liability.credit_card.*",
+			StartDate: timestamppb.Now(),
+			EndDate:   timestamppb.Now(),
+			Page:      &proto.RequestPagination{PageSize: 2, PageToken: "e30="},
+		})
+		respStatus, ok := status.FromError(err)
+
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, respStatus.Code())
+		assert.Equal(t, app.ErrInvalidPageCursor.Error(), respStatus.Message())
+	})
+
 	t.Run("should return an error if account query is invalid", func(t *testing.T) {
 		mockedUsecase := &mocks.UseCaseMock{
-			GetSyntheticReportFunc: func(ctx context.Context, account vos.Account, level int, startTime time.Time, endTime time.Time) (*vos.SyntheticReport, error) {
+			GetSyntheticReportFunc: func(ctx context.Context, req vos.SyntheticReportRequest) (*vos.SyntheticReport, error) {
 				return nil, app.ErrInvalidAccountComponentSize
 			},
 		}
@@ -63,7 +250,7 @@ func TestAPI_GetSyntheticReport(t *testing.T) {
 
 	t.Run("should not get synthetic report successfully, missing dates", func(t *testing.T) {
 		mockedUsecase := &mocks.UseCaseMock{
-			GetSyntheticReportFunc: func(ctx context.Context, account vos.Account, level int, startTime time.Time, endTime time.Time) (*vos.SyntheticReport, error) {
+			GetSyntheticReportFunc: func(ctx context.Context, req vos.SyntheticReportRequest) (*vos.SyntheticReport, error) {
 				return &vos.SyntheticReport{}, nil
 			},
 		}
@@ -85,7 +272,7 @@ func TestAPI_GetSyntheticReport(t *testing.T) {
 
 	t.Run("should get synthetic report successfully, zeroed level", func(t *testing.T) {
 		mockedUsecase := &mocks.UseCaseMock{
-			GetSyntheticReportFunc: func(ctx context.Context, account vos.Account, level int, startTime time.Time, endTime time.Time) (*vos.SyntheticReport, error) {
+			GetSyntheticReportFunc: func(ctx context.Context, req vos.SyntheticReportRequest) (*vos.SyntheticReport, error) {
 				return &vos.SyntheticReport{}, nil
 			},
 		}
@@ -105,7 +292,7 @@ func TestAPI_GetSyntheticReport(t *testing.T) {
 
 	t.Run("should get synthetic report successfully, nil Filter", func(t *testing.T) {
 		mockedUsecase := &mocks.UseCaseMock{
-			GetSyntheticReportFunc: func(ctx context.Context, account vos.Account, level int, startTime time.Time, endTime time.Time) (*vos.SyntheticReport, error) {
+			GetSyntheticReportFunc: func(ctx context.Context, req vos.SyntheticReportRequest) (*vos.SyntheticReport, error) {
 				return &vos.SyntheticReport{}, nil
 			},
 		}
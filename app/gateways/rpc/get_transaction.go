@@ -0,0 +1,80 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func (a *API) GetTransaction(ctx context.Context, req *proto.GetTransactionRequest) (*proto.GetTransactionResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to parse transaction id")
+		return nil, status.Error(codes.InvalidArgument, "invalid transaction id")
+	}
+
+	tx, err := a.UseCase.GetTransaction(ctx, id)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to get transaction")
+		if errors.Is(err, app.ErrTransactionNotFound) {
+			return nil, status.Error(codes.NotFound, app.ErrTransactionNotFound.Error())
+		}
+
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	protoEntries, err := toProtoAccountEntries(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.GetTransactionResponse{
+		Id:                    tx.ID.String(),
+		Company:               tx.Company,
+		Event:                 tx.Event,
+		CompetenceDate:        timestamppb.New(tx.CompetenceDate),
+		CreatedAt:             timestamppb.New(tx.CreatedAt),
+		Entries:               protoEntries,
+		Adjustment:            tx.Adjustment,
+		AdjustmentRequestedBy: tx.Authorization.RequestedBy,
+		AdjustmentReason:      tx.Authorization.Reason,
+	}, nil
+}
+
+// toProtoAccountEntries converts the entries of a stored transaction.
+func toProtoAccountEntries(ctx context.Context, tx entities.Transaction) ([]*proto.AccountEntry, error) {
+	competenceDate := timestamppb.New(tx.CompetenceDate)
+
+	protoEntries := make([]*proto.AccountEntry, 0, len(tx.Entries))
+	for _, entry := range tx.Entries {
+		metadata := &structpb.Struct{}
+		if err := metadata.UnmarshalJSON(entry.Metadata); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("failed to convert metadata to structpb")
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+
+		protoEntries = append(protoEntries, &proto.AccountEntry{
+			Id:             entry.ID.String(),
+			Version:        entry.Version.AsInt64(),
+			Operation:      proto.Operation(entry.Operation),
+			Amount:         int64(entry.Amount),
+			Currency:       entry.Currency.String(),
+			Event:          int32(tx.Event),
+			CompetenceDate: competenceDate,
+			Metadata:       metadata,
+			Account:        entry.Account.Value(),
+		})
+	}
+
+	return protoEntries, nil
+}
@@ -0,0 +1,125 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func TestAPI_GetTransaction_Success(t *testing.T) {
+	t.Run("should get a transaction successfully", func(t *testing.T) {
+		metadata := json.RawMessage(`{"reason":"payment"}`)
+
+		e1, err := entities.NewEntry(uuid.New(), vos.DebitOperation, "liability.abc.account1", vos.Version(2), 100, "BRL", metadata)
+		require.NoError(t, err)
+
+		e2, err := entities.NewEntry(uuid.New(), vos.CreditOperation, "liability.abc.account2", vos.IgnoreAccountVersion, 100, "BRL", metadata)
+		require.NoError(t, err)
+
+		tx, err := entities.NewTransaction(uuid.New(), 1, "abc", time.Now().UTC(), e1, e2)
+		require.NoError(t, err)
+		tx.CreatedAt = time.Now().UTC()
+
+		mockedUsecase := &mocks.UseCaseMock{
+			GetTransactionFunc: func(ctx context.Context, id uuid.UUID) (entities.Transaction, error) {
+				assert.Equal(t, tx.ID, id)
+				return tx, nil
+			},
+		}
+		api := NewAPI(mockedUsecase)
+
+		got, err := api.GetTransaction(context.Background(), &proto.GetTransactionRequest{Id: tx.ID.String()})
+		require.NoError(t, err)
+
+		assert.Equal(t, tx.ID.String(), got.Id)
+		assert.Equal(t, tx.Company, got.Company)
+		assert.Equal(t, tx.Event, got.Event)
+		assert.True(t, tx.CompetenceDate.Equal(got.CompetenceDate.AsTime()))
+		assert.True(t, tx.CreatedAt.Equal(got.CreatedAt.AsTime()))
+		require.Len(t, got.Entries, 2)
+
+		assert.Equal(t, e1.ID.String(), got.Entries[0].Id)
+		assert.Equal(t, e1.Account.Value(), got.Entries[0].Account)
+		assert.Equal(t, proto.Operation_OPERATION_DEBIT, got.Entries[0].Operation)
+		assert.Equal(t, int64(100), got.Entries[0].Amount)
+		assert.Equal(t, int64(2), got.Entries[0].Version)
+		assert.Equal(t, "BRL", got.Entries[0].Currency)
+		assert.Equal(t, "payment", got.Entries[0].Metadata.Fields["reason"].GetStringValue())
+
+		assert.Equal(t, e2.ID.String(), got.Entries[1].Id)
+		assert.Equal(t, proto.Operation_OPERATION_CREDIT, got.Entries[1].Operation)
+		assert.Equal(t, vos.IgnoreAccountVersion.AsInt64(), got.Entries[1].Version)
+	})
+}
+
+func TestAPI_GetTransaction_InvalidRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		useCaseSetup    *mocks.UseCaseMock
+		request         *proto.GetTransactionRequest
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name:            "should return an error if id is invalid",
+			useCaseSetup:    &mocks.UseCaseMock{},
+			request:         &proto.GetTransactionRequest{Id: "invalid UUID"},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "invalid transaction id",
+		},
+		{
+			name: "should return an error if transaction does not exist",
+			useCaseSetup: &mocks.UseCaseMock{
+				GetTransactionFunc: func(ctx context.Context, id uuid.UUID) (entities.Transaction, error) {
+					return entities.Transaction{}, fmt.Errorf("failed to get transaction: %w", app.ErrTransactionNotFound)
+				},
+			},
+			request:         &proto.GetTransactionRequest{Id: uuid.New().String()},
+			expectedCode:    codes.NotFound,
+			expectedMessage: app.ErrTransactionNotFound.Error(),
+		},
+		{
+			name: "should return an error if usecase fails",
+			useCaseSetup: &mocks.UseCaseMock{
+				GetTransactionFunc: func(ctx context.Context, id uuid.UUID) (entities.Transaction, error) {
+					return entities.Transaction{}, app.ErrInvalidEntriesNumber
+				},
+			},
+			request:         &proto.GetTransactionRequest{Id: uuid.New().String()},
+			expectedCode:    codes.Internal,
+			expectedMessage: "internal server error",
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := NewAPI(tt.useCaseSetup)
+
+			_, err := api.GetTransaction(context.Background(), tt.request)
+			respStatus, ok := status.FromError(err)
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, respStatus.Code())
+			assert.Equal(t, tt.expectedMessage, respStatus.Message())
+		})
+	}
+}
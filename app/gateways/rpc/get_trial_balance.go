@@ -0,0 +1,46 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func (a *API) GetTrialBalance(ctx context.Context, request *proto.GetTrialBalanceRequest) (*proto.GetTrialBalanceResponse, error) {
+	if request.AsOf == nil {
+		return nil, status.Error(codes.InvalidArgument, "as_of must have a value")
+	} else if !request.AsOf.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "as_of must be valid")
+	}
+
+	if request.Level < 0 {
+		return nil, status.Error(codes.InvalidArgument, "level must not be negative")
+	}
+
+	view, err := parseTimeView(request.TimeAxis, request.KnownAt)
+	if err != nil {
+		return nil, err
+	}
+
+	trialBalance, err := a.UseCase.GetTrialBalance(ctx, vos.TrialBalanceRequest{
+		Company: request.Company,
+		AsOf:    request.AsOf.AsTime(),
+		Level:   int(request.Level),
+		Time:    view,
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("can't get trial balance")
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &proto.GetTrialBalanceResponse{
+		Accounts: toProto(trialBalance.Accounts, false),
+		Totals:   totalsToProto(trialBalance.Totals),
+		Balanced: trialBalance.Balanced(),
+	}, nil
+}
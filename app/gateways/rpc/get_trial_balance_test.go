@@ -0,0 +1,131 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func TestAPI_GetTrialBalance(t *testing.T) {
+	t.Parallel()
+
+	asset, err := vos.NewAnalyticAccount("asset.bacen.reserves")
+	assert.NoError(t, err)
+
+	liability, err := vos.NewAnalyticAccount("liability.clients.available.user_1")
+	assert.NoError(t, err)
+
+	asOf := timestamppb.Now()
+
+	t.Run("should get the trial balance", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{
+			GetTrialBalanceFunc: func(ctx context.Context, req vos.TrialBalanceRequest) (vos.TrialBalance, error) {
+				assert.Equal(t, vos.TrialBalanceRequest{Company: "abc", AsOf: asOf.AsTime(), Level: 3}, req)
+
+				return vos.NewTrialBalance([]vos.AccountResult{
+					{Account: asset, Currency: vos.DefaultCurrency, Debit: 100},
+					{Account: liability, Currency: vos.DefaultCurrency, Credit: 100},
+				}), nil
+			},
+		})
+
+		got, err := api.GetTrialBalance(context.Background(), &proto.GetTrialBalanceRequest{Company: "abc", AsOf: asOf, Level: 3})
+		assert.NoError(t, err)
+		assert.Equal(t, []*proto.AccountResult{
+			{Account: asset.Value(), Currency: "BRL", Debit: 100, Balance: -100},
+			{Account: liability.Value(), Currency: "BRL", Credit: 100, Balance: 100},
+		}, got.Accounts)
+		assert.Equal(t, []*proto.CurrencyTotal{{Currency: "BRL", Credit: 100, Debit: 100}}, got.Totals)
+		assert.True(t, got.Balanced)
+	})
+
+	t.Run("should get the trial balance as known at an instant", func(t *testing.T) {
+		t.Parallel()
+
+		knownAt := timestamppb.New(asOf.AsTime().Add(-time.Hour))
+
+		api := NewAPI(&mocks.UseCaseMock{
+			GetTrialBalanceFunc: func(ctx context.Context, req vos.TrialBalanceRequest) (vos.TrialBalance, error) {
+				assert.Equal(t, vos.TimeView{Axis: vos.RecordedTimeAxis, KnownAt: knownAt.AsTime()}, req.Time)
+
+				return vos.NewTrialBalance(nil), nil
+			},
+		})
+
+		_, err := api.GetTrialBalance(context.Background(), &proto.GetTrialBalanceRequest{
+			AsOf:     asOf,
+			TimeAxis: proto.TimeAxis_TIME_AXIS_RECORDED_AT,
+			KnownAt:  knownAt,
+		})
+		assert.NoError(t, err)
+	})
+
+	testCases := []struct {
+		name            string
+		useCaseSetup    *mocks.UseCaseMock
+		request         *proto.GetTrialBalanceRequest
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name:            "should return an error if as_of is missing",
+			useCaseSetup:    &mocks.UseCaseMock{},
+			request:         &proto.GetTrialBalanceRequest{},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "as_of must have a value",
+		},
+		{
+			name:            "should return an error if the time axis is unknown",
+			useCaseSetup:    &mocks.UseCaseMock{},
+			request:         &proto.GetTrialBalanceRequest{AsOf: asOf, TimeAxis: 9},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "time_axis must be valid",
+		},
+		{
+			name:            "should return an error if level is negative",
+			useCaseSetup:    &mocks.UseCaseMock{},
+			request:         &proto.GetTrialBalanceRequest{AsOf: asOf, Level: -1},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "level must not be negative",
+		},
+		{
+			name: "should return an error if the usecase fails",
+			useCaseSetup: &mocks.UseCaseMock{
+				GetTrialBalanceFunc: func(ctx context.Context, req vos.TrialBalanceRequest) (vos.TrialBalance, error) {
+					return vos.TrialBalance{}, errors.New("some error")
+				},
+			},
+			request:         &proto.GetTrialBalanceRequest{AsOf: asOf},
+			expectedCode:    codes.Internal,
+			expectedMessage: "internal server error",
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := NewAPI(tt.useCaseSetup)
+
+			_, err := api.GetTrialBalance(context.Background(), tt.request)
+			respStatus, ok := status.FromError(err)
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, respStatus.Code())
+			assert.Equal(t, tt.expectedMessage, respStatus.Message())
+		})
+	}
+}
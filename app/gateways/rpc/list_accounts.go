@@ -0,0 +1,123 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/pagination"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func (a *API) ListAccounts(ctx context.Context, request *proto.ListAccountsRequest) (*proto.ListAccountsResponse, error) {
+	query, err := vos.NewAccount(request.Query)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("can't create account query")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := pagination.NewPage(request.GetPage())
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("can't create page reference")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	list, err := a.UseCase.ListAccounts(ctx, vos.AccountListRequest{
+		Account:     query,
+		WithBalance: request.WithBalance,
+		Page:        page,
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to list accounts")
+		if errors.Is(err, app.ErrInvalidPageCursor) {
+			return nil, status.Error(codes.InvalidArgument, app.ErrInvalidPageCursor.Error())
+		}
+
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	accounts := make([]*proto.AccountSummary, 0, len(list.Accounts))
+	for _, account := range list.Accounts {
+		balances := make([]*proto.CurrencyBalance, 0, len(account.Balances))
+		for _, balance := range account.Balances {
+			balances = append(balances, &proto.CurrencyBalance{
+				Currency:         balance.Currency.String(),
+				Credit:           int64(balance.Credit),
+				Debit:            int64(balance.Debit),
+				Balance:          int64(balance.Balance),
+				AvailableBalance: int64(balance.Available),
+			})
+		}
+
+		accounts = append(accounts, &proto.AccountSummary{
+			Account:        account.Account.Value(),
+			CurrentVersion: account.CurrentVersion.AsInt64(),
+			FirstActivity:  toProtoActivity(account.FirstActivity),
+			LastActivity:   toProtoActivity(account.LastActivity),
+			Balances:       balances,
+		})
+	}
+
+	return &proto.ListAccountsResponse{
+		Accounts:      accounts,
+		NextPageToken: list.NextPage.Tokenize(),
+	}, nil
+}
+
+func (a *API) ListChildren(ctx context.Context, request *proto.ListChildrenRequest) (*proto.ListChildrenResponse, error) {
+	path, err := vos.NewAccountPath(request.Path)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("can't create account path")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	page, err := pagination.NewPage(request.GetPage())
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("can't create page reference")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	list, err := a.UseCase.ListAccountChildren(ctx, vos.AccountChildrenRequest{
+		Path: path,
+		Page: page,
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to list account children")
+		if errors.Is(err, app.ErrInvalidPageCursor) {
+			return nil, status.Error(codes.InvalidArgument, app.ErrInvalidPageCursor.Error())
+		}
+
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	children := make([]*proto.AccountChild, 0, len(list.Children))
+	for _, child := range list.Children {
+		children = append(children, &proto.AccountChild{
+			Label:    child.Label,
+			Path:     child.Path,
+			Accounts: int64(child.Accounts),
+			Analytic: child.Analytic,
+		})
+	}
+
+	return &proto.ListChildrenResponse{
+		Children:      children,
+		NextPageToken: list.NextPage.Tokenize(),
+	}, nil
+}
+
+// toProtoActivity leaves the activity dates of accounts without entries unset.
+func toProtoActivity(date time.Time) *timestamppb.Timestamp {
+	if date.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(date)
+}
@@ -0,0 +1,197 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/pagination"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func TestAPI_ListAccounts(t *testing.T) {
+	t.Parallel()
+
+	account, err := vos.NewAnalyticAccount("liability.clients.available")
+	require.NoError(t, err)
+
+	first := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	last := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	cursor, err := pagination.NewCursor(map[string]interface{}{"account": account.Value()})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name            string
+		useCaseSetup    *mocks.UseCaseMock
+		request         *proto.ListAccountsRequest
+		expected        *proto.ListAccountsResponse
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name: "should list the accounts with their balances",
+			useCaseSetup: &mocks.UseCaseMock{
+				ListAccountsFunc: func(ctx context.Context, req vos.AccountListRequest) (vos.AccountList, error) {
+					assert.Equal(t, "liability.clients.*", req.Account.Value())
+					assert.True(t, req.WithBalance)
+					assert.Equal(t, 1, req.Page.Size)
+
+					return vos.AccountList{
+						Accounts: []vos.AccountSummary{
+							{
+								Account:        account,
+								CurrentVersion: 4,
+								FirstActivity:  first,
+								LastActivity:   last,
+								Balances: []vos.AccountBalance{
+									vos.NewAnalyticAccountBalance(account, vos.DefaultCurrency, 4, 300, 100),
+								},
+							},
+						},
+						NextPage: cursor,
+					}, nil
+				},
+			},
+			request: &proto.ListAccountsRequest{
+				Query:       "liability.clients.*",
+				WithBalance: true,
+				Page:        &proto.RequestPagination{PageSize: 1},
+			},
+			expected: &proto.ListAccountsResponse{
+				Accounts: []*proto.AccountSummary{
+					{
+						Account:        account.Value(),
+						CurrentVersion: 4,
+						FirstActivity:  toProtoActivity(first),
+						LastActivity:   toProtoActivity(last),
+						Balances: []*proto.CurrencyBalance{
+							{Currency: "BRL", Credit: 300, Debit: 100, Balance: 200, AvailableBalance: 200},
+						},
+					},
+				},
+				NextPageToken: cursor.Tokenize(),
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:            "should return an error if the query is invalid",
+			useCaseSetup:    &mocks.UseCaseMock{},
+			request:         &proto.ListAccountsRequest{Query: "foo.*"},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrAccountPathViolation.Error(),
+		},
+		{
+			name: "should return an error if the page token is invalid",
+			useCaseSetup: &mocks.UseCaseMock{
+				ListAccountsFunc: func(ctx context.Context, req vos.AccountListRequest) (vos.AccountList, error) {
+					return vos.AccountList{}, app.ErrInvalidPageCursor
+				},
+			},
+			request:         &proto.ListAccountsRequest{Query: "liability.*"},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidPageCursor.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := NewAPI(tt.useCaseSetup)
+
+			got, err := api.ListAccounts(context.Background(), tt.request)
+
+			respStatus, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, respStatus.Code())
+
+			if tt.expectedCode != codes.OK {
+				assert.Equal(t, tt.expectedMessage, respStatus.Message())
+				return
+			}
+
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestAPI_ListChildren(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		useCaseSetup    *mocks.UseCaseMock
+		request         *proto.ListChildrenRequest
+		expected        *proto.ListChildrenResponse
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name: "should list the children of a path",
+			useCaseSetup: &mocks.UseCaseMock{
+				ListAccountChildrenFunc: func(ctx context.Context, req vos.AccountChildrenRequest) (vos.AccountChildren, error) {
+					assert.Equal(t, "liability.clients", req.Path.Value())
+
+					return vos.AccountChildren{
+						Children: []vos.AccountChild{
+							{Label: "available", Path: "liability.clients.available", Accounts: 3, Analytic: true},
+						},
+					}, nil
+				},
+			},
+			request: &proto.ListChildrenRequest{Path: "liability.clients.*"},
+			expected: &proto.ListChildrenResponse{
+				Children: []*proto.AccountChild{
+					{Label: "available", Path: "liability.clients.available", Accounts: 3, Analytic: true},
+				},
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:            "should return an error if the path has a wildcard before its end",
+			useCaseSetup:    &mocks.UseCaseMock{},
+			request:         &proto.ListChildrenRequest{Path: "liability.*.available"},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidAccountPath.Error(),
+		},
+		{
+			name:            "should return an error if the page size is invalid",
+			useCaseSetup:    &mocks.UseCaseMock{},
+			request:         &proto.ListChildrenRequest{Page: &proto.RequestPagination{PageSize: -1}},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidPageSize.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := NewAPI(tt.useCaseSetup)
+
+			got, err := api.ListChildren(context.Background(), tt.request)
+
+			respStatus, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, respStatus.Code())
+
+			if tt.expectedCode != codes.OK {
+				assert.Equal(t, tt.expectedMessage, respStatus.Message())
+				return
+			}
+
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -39,12 +39,23 @@ func (a *API) ListAccountEntries(ctx context.Context, request *proto.ListAccount
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
+	view, err := parseTimeView(request.TimeAxis, request.KnownAt)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := vos.NewEntryFilter(request.Filter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	req := vos.AccountEntryRequest{
 		Account:   account,
 		StartDate: request.StartDate.AsTime(),
 		EndDate:   request.EndDate.AsTime(),
-		Filter:    vos.NewEntryFilter(request.Filter),
+		Filter:    filter,
 		Page:      page,
+		Time:      view,
 	}
 
 	entries, err := a.UseCase.ListAccountEntries(ctx, req)
@@ -66,6 +77,7 @@ func (a *API) ListAccountEntries(ctx context.Context, request *proto.ListAccount
 			Version:        entry.Version.AsInt64(),
 			Operation:      proto.Operation(entry.Operation),
 			Amount:         int64(entry.Amount),
+			Currency:       entry.Currency.String(),
 			Event:          int32(entry.Event),
 			CompetenceDate: timestamppb.New(entry.CompetenceDate),
 			Metadata:       metadata,
@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/stone-co/the-amazing-ledger/app"
 	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
 	"github.com/stone-co/the-amazing-ledger/app/pagination"
 	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
@@ -141,11 +142,12 @@ func TestAPI_ListAccountEntries_Success(t *testing.T) {
 
 			account, _ := vos.NewAccount(tt.request.Account)
 			page, _ := pagination.NewPage(nil)
+			filter, _ := vos.NewEntryFilter(tt.request.Filter)
 			assert.Equal(t, vos.AccountEntryRequest{
 				Account:   account,
 				StartDate: tt.request.StartDate.AsTime(),
 				EndDate:   tt.request.EndDate.AsTime(),
-				Filter:    vos.NewEntryFilter(tt.request.Filter),
+				Filter:    filter,
 				Page:      page,
 			}, tt.useCaseSetup.ListAccountEntriesCalls()[0].AccountEntryRequest)
 		})
@@ -172,6 +174,22 @@ func TestAPI_ListAccountEntries_InvalidRequest(t *testing.T) {
 			expectedCode:    codes.InvalidArgument,
 			expectedMessage: "only alphanumeric, underscore and star (*) characters are supported",
 		},
+		{
+			name:         "should return an error with an empty metadata path",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.ListAccountEntriesRequest{
+				Account:   "liability.credit_card.account1",
+				StartDate: timestamppb.Now(),
+				EndDate:   timestamppb.Now(),
+				Filter: &proto.ListAccountEntriesRequest_Filter{
+					Metadata: &proto.MetadataFilter{
+						Equals: []*proto.MetadataFilter_PathValue{{Value: "123"}},
+					},
+				},
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidMetadataFilter.Error(),
+		},
 		{
 			name:         "should return an error with nil start date",
 			useCaseSetup: &mocks.UseCaseMock{},
@@ -4,15 +4,29 @@ import (
 	"context"
 	"strings"
 
+	grpcMiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
 )
 
 func loggerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	// Strips the rpc method name from FullMethod. Returns the last part of info.FullMethod after '/'.
-	l := log.With().Str("handler", info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]).Logger()
-
+	l := handlerLogger(info.FullMethod)
 	ctx = l.WithContext(ctx)
 
 	return handler(ctx, req)
 }
+
+func streamLoggerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	l := handlerLogger(info.FullMethod)
+
+	wrapped := grpcMiddleware.WrapServerStream(ss)
+	wrapped.WrappedContext = l.WithContext(ss.Context())
+
+	return handler(srv, wrapped)
+}
+
+func handlerLogger(fullMethod string) zerolog.Logger {
+	// Strips the rpc method name from FullMethod. Returns the last part of fullMethod after '/'.
+	return log.With().Str("handler", fullMethod[strings.LastIndex(fullMethod, "/")+1:]).Logger()
+}
@@ -0,0 +1,59 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func (a *API) OpenAccount(ctx context.Context, req *proto.OpenAccountRequest) (*proto.OpenAccountResponse, error) {
+	openingDate := time.Now()
+	if req.OpeningDate != nil {
+		if !req.OpeningDate.IsValid() {
+			return nil, status.Error(codes.InvalidArgument, "opening_date must be valid")
+		}
+
+		openingDate = req.OpeningDate.AsTime()
+	}
+
+	account, err := entities.NewAccount(req.Account, req.Owner, req.DisplayName, openingDate)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("can't create account")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	opened, err := a.UseCase.OpenAccount(ctx, account)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to open account")
+		if errors.Is(err, app.ErrAccountAlreadyOpened) {
+			return nil, status.Error(codes.AlreadyExists, app.ErrAccountAlreadyOpened.Error())
+		}
+
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	return &proto.OpenAccountResponse{
+		Account: toProtoAccount(opened),
+	}, nil
+}
+
+func toProtoAccount(account entities.Account) *proto.Account {
+	return &proto.Account{
+		Account:     account.Account.Value(),
+		Owner:       account.Owner,
+		DisplayName: account.DisplayName,
+		OpeningDate: timestamppb.New(account.OpeningDate),
+		Status:      proto.AccountStatus(account.Status),
+		CreatedAt:   timestamppb.New(account.CreatedAt),
+		UpdatedAt:   timestamppb.New(account.UpdatedAt),
+	}
+}
@@ -0,0 +1,119 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func TestAPI_OpenAccount_Success(t *testing.T) {
+	t.Run("should open an account successfully", func(t *testing.T) {
+		openingDate := time.Now().UTC().Round(time.Microsecond)
+		createdAt := openingDate.Add(time.Second)
+
+		mockedUsecase := &mocks.UseCaseMock{
+			OpenAccountFunc: func(ctx context.Context, account entities.Account) (entities.Account, error) {
+				account.CreatedAt = createdAt
+				account.UpdatedAt = createdAt
+
+				return account, nil
+			},
+		}
+		api := NewAPI(mockedUsecase)
+
+		request := &proto.OpenAccountRequest{
+			Account:     testdata.GenerateAccountPath(),
+			Owner:       "owner",
+			DisplayName: "Display Name",
+			OpeningDate: timestamppb.New(openingDate),
+		}
+
+		got, err := api.OpenAccount(context.Background(), request)
+		assert.NoError(t, err)
+
+		assert.Equal(t, &proto.OpenAccountResponse{
+			Account: &proto.Account{
+				Account:     request.Account,
+				Owner:       request.Owner,
+				DisplayName: request.DisplayName,
+				OpeningDate: request.OpeningDate,
+				Status:      proto.AccountStatus_ACCOUNT_STATUS_ACTIVE,
+				CreatedAt:   timestamppb.New(createdAt),
+				UpdatedAt:   timestamppb.New(createdAt),
+			},
+		}, got)
+	})
+}
+
+func TestAPI_OpenAccount_InvalidRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		useCaseSetup    *mocks.UseCaseMock
+		request         *proto.OpenAccountRequest
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name:         "should return an error if account is synthetic",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.OpenAccountRequest{
+				Account: "liability.clients.*",
+				Owner:   "owner",
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidSingleAccountComponentCharacters.Error(),
+		},
+		{
+			name:         "should return an error if owner is empty",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.OpenAccountRequest{
+				Account: testdata.GenerateAccountPath(),
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidAccountOwner.Error(),
+		},
+		{
+			name: "should return an error if account was already opened",
+			useCaseSetup: &mocks.UseCaseMock{
+				OpenAccountFunc: func(ctx context.Context, account entities.Account) (entities.Account, error) {
+					return entities.Account{}, app.ErrAccountAlreadyOpened
+				},
+			},
+			request: &proto.OpenAccountRequest{
+				Account: testdata.GenerateAccountPath(),
+				Owner:   "owner",
+			},
+			expectedCode:    codes.AlreadyExists,
+			expectedMessage: app.ErrAccountAlreadyOpened.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := NewAPI(tt.useCaseSetup)
+
+			_, err := api.OpenAccount(context.Background(), tt.request)
+			respStatus, ok := status.FromError(err)
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, respStatus.Code())
+			assert.Equal(t, tt.expectedMessage, respStatus.Message())
+		})
+	}
+}
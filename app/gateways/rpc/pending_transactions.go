@@ -0,0 +1,100 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func (a *API) AuthorizeTransaction(ctx context.Context, req *proto.AuthorizeTransactionRequest) (*proto.AuthorizeTransactionResponse, error) {
+	tx, err := newTransaction(ctx, req.Id, req.Entries, req.CompetenceDate, req.Company, req.Event)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := a.UseCase.AuthorizeTransaction(ctx, tx)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to authorize transaction")
+		return nil, postingError(err)
+	}
+
+	return &proto.AuthorizeTransactionResponse{
+		ExpiresAt: timestamppb.New(pending.ExpiresAt),
+	}, nil
+}
+
+func (a *API) CaptureTransaction(ctx context.Context, req *proto.CaptureTransactionRequest) (*proto.CaptureTransactionResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to parse capture transaction id")
+		return nil, status.Error(codes.InvalidArgument, "invalid transaction id")
+	}
+
+	pid, err := uuid.Parse(req.PendingTransactionId)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to parse pending transaction id")
+		return nil, status.Error(codes.InvalidArgument, "invalid pending transaction id")
+	}
+
+	if req.Amount < 0 {
+		return nil, status.Error(codes.InvalidArgument, app.ErrInvalidCaptureAmount.Error())
+	}
+
+	input := domain.CaptureTransactionInput{
+		ID:                   id,
+		PendingTransactionID: pid,
+		Amount:               int(req.Amount),
+	}
+
+	if err = a.UseCase.CaptureTransaction(ctx, input); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to capture transaction")
+		switch {
+		case errors.Is(err, app.ErrInvalidCaptureAmount):
+			return nil, status.Error(codes.InvalidArgument, app.ErrInvalidCaptureAmount.Error())
+		case errors.Is(err, app.ErrInvalidTransactionID):
+			return nil, status.Error(codes.InvalidArgument, "invalid transaction id")
+		default:
+			return nil, pendingError(err)
+		}
+	}
+
+	return &proto.CaptureTransactionResponse{}, nil
+}
+
+func (a *API) VoidTransaction(ctx context.Context, req *proto.VoidTransactionRequest) (*proto.VoidTransactionResponse, error) {
+	pid, err := uuid.Parse(req.PendingTransactionId)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to parse pending transaction id")
+		return nil, status.Error(codes.InvalidArgument, "invalid pending transaction id")
+	}
+
+	if err = a.UseCase.VoidTransaction(ctx, pid); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to void transaction")
+		return nil, pendingError(err)
+	}
+
+	return &proto.VoidTransactionResponse{}, nil
+}
+
+// pendingError maps the errors of settling a pending transaction to status errors.
+func pendingError(err error) error {
+	switch {
+	case errors.Is(err, app.ErrPendingTransactionNotFound):
+		return status.Error(codes.NotFound, app.ErrPendingTransactionNotFound.Error())
+	case errors.Is(err, app.ErrPendingTransactionNotPending):
+		return status.Error(codes.FailedPrecondition, app.ErrPendingTransactionNotPending.Error())
+	case errors.Is(err, app.ErrPendingTransactionExpired):
+		return status.Error(codes.FailedPrecondition, app.ErrPendingTransactionExpired.Error())
+	default:
+		return postingError(err)
+	}
+}
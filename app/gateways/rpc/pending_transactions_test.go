@@ -0,0 +1,225 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func TestAPI_AuthorizeTransaction(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Now().Add(time.Hour).UTC()
+
+	request := &proto.AuthorizeTransactionRequest{
+		Id: uuid.New().String(),
+		Entries: []*proto.Entry{
+			{
+				Id:              uuid.New().String(),
+				Account:         testdata.GenerateAccountPath(),
+				ExpectedVersion: -1,
+				Operation:       proto.Operation_OPERATION_DEBIT,
+				Amount:          123,
+			},
+			{
+				Id:              uuid.New().String(),
+				Account:         testdata.GenerateAccountPath(),
+				ExpectedVersion: -1,
+				Operation:       proto.Operation_OPERATION_CREDIT,
+				Amount:          123,
+			},
+		},
+		Company:        "abc",
+		Event:          1,
+		CompetenceDate: timestamppb.Now(),
+	}
+
+	t.Run("should authorize a transaction successfully", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{
+			AuthorizeTransactionFunc: func(ctx context.Context, tx entities.Transaction) (entities.PendingTransaction, error) {
+				assert.Equal(t, request.Id, tx.ID.String())
+
+				pending := entities.NewPendingTransaction(tx)
+				pending.ExpiresAt = expiresAt
+
+				return pending, nil
+			},
+		})
+
+		got, err := api.AuthorizeTransaction(context.Background(), request)
+		assert.NoError(t, err)
+		assert.Equal(t, timestamppb.New(expiresAt), got.ExpiresAt)
+	})
+
+	t.Run("should return an error if a balance limit would be exceeded", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{
+			AuthorizeTransactionFunc: func(ctx context.Context, tx entities.Transaction) (entities.PendingTransaction, error) {
+				return entities.PendingTransaction{}, app.ErrBalanceLimitExceeded
+			},
+		})
+
+		_, err := api.AuthorizeTransaction(context.Background(), request)
+		respStatus, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, respStatus.Code())
+		assert.Equal(t, app.ErrBalanceLimitExceeded.Error(), respStatus.Message())
+	})
+
+	t.Run("should return an error if the transaction is not balanced", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{})
+
+		_, err := api.AuthorizeTransaction(context.Background(), &proto.AuthorizeTransactionRequest{
+			Id:             request.Id,
+			Entries:        request.Entries[:1],
+			CompetenceDate: timestamppb.Now(),
+		})
+		respStatus, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, respStatus.Code())
+		assert.Equal(t, app.ErrInvalidEntriesNumber.Error(), respStatus.Message())
+	})
+}
+
+func TestAPI_CaptureTransaction(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		useCaseSetup    *mocks.UseCaseMock
+		request         *proto.CaptureTransactionRequest
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name: "should capture a transaction successfully",
+			useCaseSetup: &mocks.UseCaseMock{
+				CaptureTransactionFunc: func(ctx context.Context, input domain.CaptureTransactionInput) error {
+					assert.Equal(t, 50, input.Amount)
+					return nil
+				},
+			},
+			request: &proto.CaptureTransactionRequest{
+				Id:                   uuid.New().String(),
+				PendingTransactionId: uuid.New().String(),
+				Amount:               50,
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "should return an error if pending transaction id is invalid",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.CaptureTransactionRequest{
+				Id:                   uuid.New().String(),
+				PendingTransactionId: "invalid",
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "invalid pending transaction id",
+		},
+		{
+			name:         "should return an error if amount is negative",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.CaptureTransactionRequest{
+				Id:                   uuid.New().String(),
+				PendingTransactionId: uuid.New().String(),
+				Amount:               -1,
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidCaptureAmount.Error(),
+		},
+		{
+			name: "should return an error if pending transaction does not exist",
+			useCaseSetup: &mocks.UseCaseMock{
+				CaptureTransactionFunc: func(ctx context.Context, input domain.CaptureTransactionInput) error {
+					return app.ErrPendingTransactionNotFound
+				},
+			},
+			request: &proto.CaptureTransactionRequest{
+				Id:                   uuid.New().String(),
+				PendingTransactionId: uuid.New().String(),
+			},
+			expectedCode:    codes.NotFound,
+			expectedMessage: app.ErrPendingTransactionNotFound.Error(),
+		},
+		{
+			name: "should return an error if pending transaction expired",
+			useCaseSetup: &mocks.UseCaseMock{
+				CaptureTransactionFunc: func(ctx context.Context, input domain.CaptureTransactionInput) error {
+					return app.ErrPendingTransactionExpired
+				},
+			},
+			request: &proto.CaptureTransactionRequest{
+				Id:                   uuid.New().String(),
+				PendingTransactionId: uuid.New().String(),
+			},
+			expectedCode:    codes.FailedPrecondition,
+			expectedMessage: app.ErrPendingTransactionExpired.Error(),
+		},
+		{
+			name: "should return an error if capture amount is greater than the authorized one",
+			useCaseSetup: &mocks.UseCaseMock{
+				CaptureTransactionFunc: func(ctx context.Context, input domain.CaptureTransactionInput) error {
+					return app.ErrInvalidCaptureAmount
+				},
+			},
+			request: &proto.CaptureTransactionRequest{
+				Id:                   uuid.New().String(),
+				PendingTransactionId: uuid.New().String(),
+				Amount:               1000,
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidCaptureAmount.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := NewAPI(tt.useCaseSetup)
+
+			_, err := api.CaptureTransaction(context.Background(), tt.request)
+			respStatus, ok := status.FromError(err)
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, respStatus.Code())
+			assert.Equal(t, tt.expectedMessage, respStatus.Message())
+		})
+	}
+}
+
+func TestAPI_VoidTransaction(t *testing.T) {
+	t.Parallel()
+
+	api := NewAPI(&mocks.UseCaseMock{
+		VoidTransactionFunc: func(ctx context.Context, id uuid.UUID) error {
+			return app.ErrPendingTransactionNotPending
+		},
+	})
+
+	_, err := api.VoidTransaction(context.Background(), &proto.VoidTransactionRequest{PendingTransactionId: uuid.New().String()})
+	respStatus, ok := status.FromError(err)
+
+	assert.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, respStatus.Code())
+	assert.Equal(t, app.ErrPendingTransactionNotPending.Error(), respStatus.Message())
+}
@@ -0,0 +1,62 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func (a *API) RevertTransaction(ctx context.Context, req *proto.RevertTransactionRequest) (*proto.RevertTransactionResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to parse reversal transaction id")
+		return nil, status.Error(codes.InvalidArgument, "invalid transaction id")
+	}
+
+	tid, err := uuid.Parse(req.TransactionId)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to parse reverted transaction id")
+		return nil, status.Error(codes.InvalidArgument, "invalid reverted transaction id")
+	}
+
+	if req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason must have a value")
+	}
+
+	if req.Amount < 0 {
+		return nil, status.Error(codes.InvalidArgument, app.ErrInvalidReversalAmount.Error())
+	}
+
+	input := domain.RevertTransactionInput{
+		ID:            id,
+		TransactionID: tid,
+		Reason:        req.Reason,
+		Amount:        int(req.Amount),
+	}
+
+	if err = a.UseCase.RevertTransaction(ctx, input); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to revert transaction")
+		switch {
+		case errors.Is(err, app.ErrTransactionNotFound):
+			return nil, status.Error(codes.NotFound, app.ErrTransactionNotFound.Error())
+		case errors.Is(err, app.ErrTransactionAlreadyReverted):
+			return nil, status.Error(codes.FailedPrecondition, app.ErrTransactionAlreadyReverted.Error())
+		case errors.Is(err, app.ErrInvalidReversalAmount):
+			return nil, status.Error(codes.InvalidArgument, app.ErrInvalidReversalAmount.Error())
+		case errors.Is(err, app.ErrInvalidTransactionID):
+			return nil, status.Error(codes.InvalidArgument, "invalid transaction id")
+		default:
+			return nil, postingError(err)
+		}
+	}
+
+	return &proto.RevertTransactionResponse{}, nil
+}
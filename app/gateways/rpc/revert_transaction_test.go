@@ -0,0 +1,163 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func TestAPI_RevertTransaction_Success(t *testing.T) {
+	t.Run("should revert a transaction successfully", func(t *testing.T) {
+		request := &proto.RevertTransactionRequest{
+			Id:            uuid.New().String(),
+			TransactionId: uuid.New().String(),
+			Reason:        "wrong posting",
+			Amount:        50,
+		}
+
+		mockedUsecase := &mocks.UseCaseMock{
+			RevertTransactionFunc: func(ctx context.Context, input domain.RevertTransactionInput) error {
+				assert.Equal(t, request.Id, input.ID.String())
+				assert.Equal(t, request.TransactionId, input.TransactionID.String())
+				assert.Equal(t, request.Reason, input.Reason)
+				assert.Equal(t, 50, input.Amount)
+
+				return nil
+			},
+		}
+		api := NewAPI(mockedUsecase)
+
+		got, err := api.RevertTransaction(context.Background(), request)
+		assert.NoError(t, err)
+		assert.Equal(t, &proto.RevertTransactionResponse{}, got)
+	})
+}
+
+func TestAPI_RevertTransaction_InvalidRequest(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		useCaseSetup    *mocks.UseCaseMock
+		request         *proto.RevertTransactionRequest
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name:         "should return an error if id is invalid",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.RevertTransactionRequest{
+				Id:            "invalid UUID",
+				TransactionId: uuid.New().String(),
+				Reason:        "wrong posting",
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "invalid transaction id",
+		},
+		{
+			name:         "should return an error if reverted transaction id is invalid",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.RevertTransactionRequest{
+				Id:            uuid.New().String(),
+				TransactionId: "invalid UUID",
+				Reason:        "wrong posting",
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "invalid reverted transaction id",
+		},
+		{
+			name:         "should return an error if reason is empty",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.RevertTransactionRequest{
+				Id:            uuid.New().String(),
+				TransactionId: uuid.New().String(),
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "reason must have a value",
+		},
+		{
+			name:         "should return an error if amount is negative",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.RevertTransactionRequest{
+				Id:            uuid.New().String(),
+				TransactionId: uuid.New().String(),
+				Reason:        "wrong posting",
+				Amount:        -1,
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidReversalAmount.Error(),
+		},
+		{
+			name: "should return an error if transaction does not exist",
+			useCaseSetup: &mocks.UseCaseMock{
+				RevertTransactionFunc: func(ctx context.Context, input domain.RevertTransactionInput) error {
+					return fmt.Errorf("failed to get transaction: %w", app.ErrTransactionNotFound)
+				},
+			},
+			request: &proto.RevertTransactionRequest{
+				Id:            uuid.New().String(),
+				TransactionId: uuid.New().String(),
+				Reason:        "wrong posting",
+			},
+			expectedCode:    codes.NotFound,
+			expectedMessage: app.ErrTransactionNotFound.Error(),
+		},
+		{
+			name: "should return an error if transaction was already reverted",
+			useCaseSetup: &mocks.UseCaseMock{
+				RevertTransactionFunc: func(ctx context.Context, input domain.RevertTransactionInput) error {
+					return fmt.Errorf("failed to revert transaction: %w", app.ErrTransactionAlreadyReverted)
+				},
+			},
+			request: &proto.RevertTransactionRequest{
+				Id:            uuid.New().String(),
+				TransactionId: uuid.New().String(),
+				Reason:        "wrong posting",
+			},
+			expectedCode:    codes.FailedPrecondition,
+			expectedMessage: app.ErrTransactionAlreadyReverted.Error(),
+		},
+		{
+			name: "should return an error if amount is greater than the transaction total",
+			useCaseSetup: &mocks.UseCaseMock{
+				RevertTransactionFunc: func(ctx context.Context, input domain.RevertTransactionInput) error {
+					return fmt.Errorf("failed to create reversal: %w", app.ErrInvalidReversalAmount)
+				},
+			},
+			request: &proto.RevertTransactionRequest{
+				Id:            uuid.New().String(),
+				TransactionId: uuid.New().String(),
+				Reason:        "wrong posting",
+				Amount:        1000,
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidReversalAmount.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := NewAPI(tt.useCaseSetup)
+
+			_, err := api.RevertTransaction(context.Background(), tt.request)
+			respStatus, ok := status.FromError(err)
+
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, respStatus.Code())
+			assert.Equal(t, tt.expectedMessage, respStatus.Message())
+		})
+	}
+}
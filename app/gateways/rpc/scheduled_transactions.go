@@ -0,0 +1,139 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/pagination"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func (a *API) ScheduleTransaction(ctx context.Context, req *proto.ScheduleTransactionRequest) (*proto.ScheduleTransactionResponse, error) {
+	tx, err := parseTransaction(ctx, req.Id, req.Entries, req.CompetenceDate, req.Company, req.Event)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = a.UseCase.ScheduleTransaction(ctx, tx); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to schedule transaction")
+		switch {
+		case errors.Is(err, app.ErrInvalidScheduleDate):
+			return nil, status.Error(codes.InvalidArgument, app.ErrInvalidScheduleDate.Error())
+		case errors.Is(err, app.ErrIdempotencyKeyViolation):
+			return nil, status.Error(codes.InvalidArgument, "invalid idempotency key")
+		case errors.Is(err, app.ErrUnknownEvent):
+			return nil, status.Error(codes.InvalidArgument, app.ErrUnknownEvent.Error())
+		case errors.Is(err, app.ErrAccountNotInChart), errors.Is(err, app.ErrMetadataSchemaViolation):
+			return nil, postingError(err)
+		case errors.Is(err, app.ErrEventDeprecated):
+			return nil, status.Error(codes.FailedPrecondition, app.ErrEventDeprecated.Error())
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return &proto.ScheduleTransactionResponse{}, nil
+}
+
+func (a *API) CancelScheduledTransaction(ctx context.Context, req *proto.CancelScheduledTransactionRequest) (*proto.CancelScheduledTransactionResponse, error) {
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to parse transaction id")
+		return nil, status.Error(codes.InvalidArgument, "invalid transaction id")
+	}
+
+	if err = a.UseCase.CancelScheduledTransaction(ctx, id); err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to cancel scheduled transaction")
+		switch {
+		case errors.Is(err, app.ErrScheduledTransactionNotFound):
+			return nil, status.Error(codes.NotFound, app.ErrScheduledTransactionNotFound.Error())
+		case errors.Is(err, app.ErrScheduledTransactionNotScheduled):
+			return nil, status.Error(codes.FailedPrecondition, app.ErrScheduledTransactionNotScheduled.Error())
+		default:
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+	}
+
+	return &proto.CancelScheduledTransactionResponse{}, nil
+}
+
+func (a *API) ListScheduledTransactions(ctx context.Context, req *proto.ListScheduledTransactionsRequest) (*proto.ListScheduledTransactionsResponse, error) {
+	page, err := pagination.NewPage(req.GetPage())
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("can't create page reference")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	output, err := a.UseCase.ListScheduledTransactions(ctx, domain.ListScheduledTransactionsInput{
+		Status: vos.ScheduleStatus(req.Status),
+		Page:   page,
+	})
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to list scheduled transactions")
+		if errors.Is(err, app.ErrInvalidPageCursor) {
+			return nil, status.Error(codes.InvalidArgument, app.ErrInvalidPageCursor.Error())
+		}
+
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	transactions := make([]*proto.ScheduledTransaction, 0, len(output.Transactions))
+	for _, scheduled := range output.Transactions {
+		protoScheduled, convErr := toProtoScheduledTransaction(scheduled)
+		if convErr != nil {
+			zerolog.Ctx(ctx).Error().Err(convErr).Msg("failed to convert metadata to structpb")
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+
+		transactions = append(transactions, protoScheduled)
+	}
+
+	return &proto.ListScheduledTransactionsResponse{
+		ScheduledTransactions: transactions,
+		NextPageToken:         output.NextPage.Tokenize(),
+	}, nil
+}
+
+func toProtoScheduledTransaction(scheduled entities.ScheduledTransaction) (*proto.ScheduledTransaction, error) {
+	tx := scheduled.Transaction
+
+	entries := make([]*proto.Entry, 0, len(tx.Entries))
+	for _, entry := range tx.Entries {
+		metadata := &structpb.Struct{}
+		if err := metadata.UnmarshalJSON(entry.Metadata); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, &proto.Entry{
+			Id:              entry.ID.String(),
+			Account:         entry.Account.Value(),
+			ExpectedVersion: entry.Version.AsInt64(),
+			Operation:       proto.Operation(entry.Operation),
+			Amount:          int64(entry.Amount),
+			Metadata:        metadata,
+			Currency:        entry.Currency.String(),
+		})
+	}
+
+	return &proto.ScheduledTransaction{
+		Id:             tx.ID.String(),
+		Company:        tx.Company,
+		Event:          tx.Event,
+		CompetenceDate: timestamppb.New(tx.CompetenceDate),
+		CreatedAt:      timestamppb.New(tx.CreatedAt),
+		Entries:        entries,
+		Status:         proto.ScheduleStatus(scheduled.Status),
+		Reason:         scheduled.Reason,
+	}, nil
+}
@@ -0,0 +1,258 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/pagination"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func TestAPI_ScheduleTransaction(t *testing.T) {
+	t.Parallel()
+
+	request := &proto.ScheduleTransactionRequest{
+		Id: uuid.New().String(),
+		Entries: []*proto.Entry{
+			{
+				Id:              uuid.New().String(),
+				Account:         testdata.GenerateAccountPath(),
+				ExpectedVersion: -1,
+				Operation:       proto.Operation_OPERATION_DEBIT,
+				Amount:          123,
+			},
+			{
+				Id:              uuid.New().String(),
+				Account:         testdata.GenerateAccountPath(),
+				ExpectedVersion: -1,
+				Operation:       proto.Operation_OPERATION_CREDIT,
+				Amount:          123,
+			},
+		},
+		Company:        "abc",
+		Event:          1,
+		CompetenceDate: timestamppb.New(time.Now().Add(24 * time.Hour)),
+	}
+
+	testCases := []struct {
+		name            string
+		request         *proto.ScheduleTransactionRequest
+		useCaseErr      error
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name:         "should schedule a transaction to the future successfully",
+			request:      request,
+			expectedCode: codes.OK,
+		},
+		{
+			name:            "should return an error if the competence date isn't in the future",
+			request:         request,
+			useCaseErr:      app.ErrInvalidScheduleDate,
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidScheduleDate.Error(),
+		},
+		{
+			name:            "should return an error if the transaction was already scheduled",
+			request:         request,
+			useCaseErr:      app.ErrIdempotencyKeyViolation,
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "invalid idempotency key",
+		},
+		{
+			name: "should return an error if the transaction id is invalid",
+			request: &proto.ScheduleTransactionRequest{
+				Id:             "abc",
+				Entries:        request.Entries,
+				CompetenceDate: request.CompetenceDate,
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "invalid transaction id",
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := NewAPI(&mocks.UseCaseMock{
+				ScheduleTransactionFunc: func(ctx context.Context, tx entities.Transaction) (entities.ScheduledTransaction, error) {
+					assert.Equal(t, tt.request.Id, tx.ID.String())
+					assert.Equal(t, tt.request.CompetenceDate.Seconds, tx.CompetenceDate.Unix())
+					return entities.ScheduledTransaction{Transaction: tx}, tt.useCaseErr
+				},
+			})
+
+			_, err := api.ScheduleTransaction(context.Background(), tt.request)
+			respStatus, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, respStatus.Code())
+			assert.Equal(t, tt.expectedMessage, respStatus.Message())
+		})
+	}
+}
+
+func TestAPI_CancelScheduledTransaction(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		id              string
+		useCaseErr      error
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			name:         "should cancel a scheduled transaction successfully",
+			id:           uuid.New().String(),
+			expectedCode: codes.OK,
+		},
+		{
+			name:            "should return an error if the transaction id is invalid",
+			id:              "abc",
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "invalid transaction id",
+		},
+		{
+			name:            "should return an error if the transaction isn't scheduled",
+			id:              uuid.New().String(),
+			useCaseErr:      app.ErrScheduledTransactionNotFound,
+			expectedCode:    codes.NotFound,
+			expectedMessage: app.ErrScheduledTransactionNotFound.Error(),
+		},
+		{
+			name:            "should return an error if the transaction was already posted",
+			id:              uuid.New().String(),
+			useCaseErr:      app.ErrScheduledTransactionNotScheduled,
+			expectedCode:    codes.FailedPrecondition,
+			expectedMessage: app.ErrScheduledTransactionNotScheduled.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			api := NewAPI(&mocks.UseCaseMock{
+				CancelScheduledTransactionFunc: func(ctx context.Context, id uuid.UUID) error {
+					assert.Equal(t, tt.id, id.String())
+					return tt.useCaseErr
+				},
+			})
+
+			_, err := api.CancelScheduledTransaction(context.Background(), &proto.CancelScheduledTransactionRequest{Id: tt.id})
+			respStatus, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, tt.expectedCode, respStatus.Code())
+			assert.Equal(t, tt.expectedMessage, respStatus.Message())
+		})
+	}
+}
+
+func TestAPI_ListScheduledTransactions(t *testing.T) {
+	t.Parallel()
+
+	e1, err := entities.NewEntry(uuid.New(), vos.DebitOperation, testdata.GenerateAccountPath(), vos.IgnoreAccountVersion, 100, "BRL", json.RawMessage(`{"key":"value"}`))
+	require.NoError(t, err)
+
+	e2, err := entities.NewEntry(uuid.New(), vos.CreditOperation, testdata.GenerateAccountPath(), vos.IgnoreAccountVersion, 100, "BRL", json.RawMessage(`{}`))
+	require.NoError(t, err)
+
+	tx, err := entities.NewTransaction(uuid.New(), 1, "abc", time.Now().Add(time.Hour).UTC(), e1, e2)
+	require.NoError(t, err)
+
+	tx.CreatedAt = time.Now().UTC()
+	scheduled := entities.ScheduledTransaction{Transaction: tx, Status: vos.FailedScheduleStatus, Reason: "invalid version"}
+
+	t.Run("should list scheduled transactions successfully", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{
+			ListScheduledTransactionsFunc: func(ctx context.Context, input domain.ListScheduledTransactionsInput) (domain.ListScheduledTransactionsOutput, error) {
+				assert.Equal(t, vos.FailedScheduleStatus, input.Status)
+				assert.Equal(t, 5, input.Page.Size)
+
+				return domain.ListScheduledTransactionsOutput{
+					Transactions: []entities.ScheduledTransaction{scheduled},
+					NextPage:     pagination.Cursor(`{"id":"abc"}`),
+				}, nil
+			},
+		})
+
+		got, err := api.ListScheduledTransactions(context.Background(), &proto.ListScheduledTransactionsRequest{
+			Status: proto.ScheduleStatus_SCHEDULE_STATUS_FAILED,
+			Page:   &proto.RequestPagination{PageSize: 5},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, pagination.Cursor(`{"id":"abc"}`).Tokenize(), got.NextPageToken)
+		assert.Len(t, got.ScheduledTransactions, 1)
+
+		metadata, err := structpb.NewStruct(map[string]interface{}{"key": "value"})
+		require.NoError(t, err)
+
+		protoScheduled := got.ScheduledTransactions[0]
+		assert.Equal(t, tx.ID.String(), protoScheduled.Id)
+		assert.Equal(t, timestamppb.New(tx.CompetenceDate), protoScheduled.CompetenceDate)
+		assert.Equal(t, timestamppb.New(tx.CreatedAt), protoScheduled.CreatedAt)
+		assert.Equal(t, proto.ScheduleStatus_SCHEDULE_STATUS_FAILED, protoScheduled.Status)
+		assert.Equal(t, "invalid version", protoScheduled.Reason)
+		assert.Len(t, protoScheduled.Entries, 2)
+
+		for _, entry := range protoScheduled.Entries {
+			if entry.Id == e1.ID.String() {
+				assert.Equal(t, proto.Operation_OPERATION_DEBIT, entry.Operation)
+				assert.Equal(t, e1.Account.Value(), entry.Account)
+				assert.Equal(t, metadata.AsMap(), entry.Metadata.AsMap())
+			}
+		}
+	})
+
+	t.Run("should return an error if the page size is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{})
+
+		_, err := api.ListScheduledTransactions(context.Background(), &proto.ListScheduledTransactionsRequest{
+			Page: &proto.RequestPagination{PageSize: -1},
+		})
+		respStatus, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, respStatus.Code())
+		assert.Equal(t, app.ErrInvalidPageSize.Error(), respStatus.Message())
+	})
+
+	t.Run("should return an error if the page cursor is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{
+			ListScheduledTransactionsFunc: func(ctx context.Context, input domain.ListScheduledTransactionsInput) (domain.ListScheduledTransactionsOutput, error) {
+				return domain.ListScheduledTransactionsOutput{}, app.ErrInvalidPageCursor
+			},
+		})
+
+		_, err := api.ListScheduledTransactions(context.Background(), &proto.ListScheduledTransactionsRequest{})
+		respStatus, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, respStatus.Code())
+		assert.Equal(t, app.ErrInvalidPageCursor.Error(), respStatus.Message())
+	})
+}
@@ -54,10 +54,14 @@ func newRPCServer(api *API, nr *newrelic.Application) *grpc.Server {
 		grpcMiddleware.WithStreamServerChain(
 			grpcRecovery.StreamServerInterceptor(opts...),
 			nrgrpc.StreamServerInterceptor(nr),
+			streamLoggerInterceptor,
 		),
 	)
 
 	proto.RegisterLedgerAPIServer(srv, api)
+	proto.RegisterAccountAPIServer(srv, api)
+	proto.RegisterEventAPIServer(srv, api)
+	proto.RegisterFiscalPeriodAPIServer(srv, api)
 	proto.RegisterHealthAPIServer(srv, api)
 
 	return srv
@@ -77,6 +81,21 @@ func newGatewayServer(ctx context.Context, cfg *app.Config, commit, time string)
 		return nil, fmt.Errorf("failed to register ledger handler: %w", err)
 	}
 
+	err = proto.RegisterAccountAPIHandler(ctx, gwMux, conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register account handler: %w", err)
+	}
+
+	err = proto.RegisterEventAPIHandler(ctx, gwMux, conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register event handler: %w", err)
+	}
+
+	err = proto.RegisterFiscalPeriodAPIHandler(ctx, gwMux, conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register fiscal period handler: %w", err)
+	}
+
 	err = proto.RegisterHealthAPIHandler(ctx, gwMux, conn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to register health handler: %w", err)
@@ -0,0 +1,100 @@
+package rpc
+
+import (
+	"errors"
+	"io"
+
+	"github.com/rs/zerolog"
+
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+// maxStreamBatch is how many transactions received through a stream are saved at once. It also bounds
+// how many transactions are buffered ahead, so a client sending faster than they're saved is slowed
+// down by the gRPC flow control.
+const maxStreamBatch = 500
+
+// StreamTransactions saves the transactions as they're received, in micro-batches of whatever was already
+// received, so a stream under load is saved with few round-trips without delaying a stream that isn't.
+func (a *API) StreamTransactions(stream proto.LedgerAPI_StreamTransactionsServer) error {
+	ctx := stream.Context()
+
+	requests := make(chan *proto.CreateTransactionRequest, maxStreamBatch)
+	recvErr := make(chan error, 1)
+
+	go func() {
+		defer close(requests)
+
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					recvErr <- err
+				}
+
+				return
+			}
+
+			select {
+			case requests <- req.GetTransaction():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	batch := make([]*proto.CreateTransactionRequest, 0, maxStreamBatch)
+
+	for req := range requests {
+		batch = append(batch[:0], req)
+
+	drain:
+		for len(batch) < maxStreamBatch {
+			select {
+			case next, ok := <-requests:
+				if !ok {
+					break drain
+				}
+
+				batch = append(batch, next)
+			default:
+				break drain
+			}
+		}
+
+		if err := a.saveStreamBatch(stream, batch); err != nil {
+			return err
+		}
+	}
+
+	select {
+	case err := <-recvErr:
+		return err
+	default:
+		return nil
+	}
+}
+
+// saveStreamBatch saves the batch and acknowledges each of its transactions. When the whole batch fails,
+// every transaction is acknowledged with the batch error, so the client can retry them.
+func (a *API) saveStreamBatch(stream proto.LedgerAPI_StreamTransactionsServer, batch []*proto.CreateTransactionRequest) error {
+	ctx := stream.Context()
+
+	results, err := a.createTransactions(ctx, batch, true)
+	if err != nil {
+		results = make([]*proto.CreateTransactionResult, len(batch))
+		for i, req := range batch {
+			results[i] = &proto.CreateTransactionResult{Id: req.GetId()}
+			setTransactionResult(results[i], err)
+		}
+	}
+
+	for _, result := range results {
+		if err = stream.Send(&proto.StreamTransactionsResponse{Result: result}); err != nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("failed to acknowledge transaction")
+			return err
+		}
+	}
+
+	return nil
+}
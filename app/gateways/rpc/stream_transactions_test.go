@@ -0,0 +1,231 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+// fakeTransactionsStream replays the given requests, then fails with recvErr (io.EOF by default).
+type fakeTransactionsStream struct {
+	grpc.ServerStream
+
+	requests []*proto.StreamTransactionsRequest
+	recvErr  error
+	sendErr  error
+
+	mu        sync.Mutex
+	responses []*proto.StreamTransactionsResponse
+}
+
+func (s *fakeTransactionsStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeTransactionsStream) Recv() (*proto.StreamTransactionsRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.requests) == 0 {
+		if s.recvErr != nil {
+			return nil, s.recvErr
+		}
+
+		return nil, io.EOF
+	}
+
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+
+	return req, nil
+}
+
+func (s *fakeTransactionsStream) Send(resp *proto.StreamTransactionsResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.sendErr != nil {
+		return s.sendErr
+	}
+
+	s.responses = append(s.responses, resp)
+
+	return nil
+}
+
+func newTransactionsStream(transactions ...*proto.CreateTransactionRequest) *fakeTransactionsStream {
+	stream := &fakeTransactionsStream{}
+	for _, tx := range transactions {
+		stream.requests = append(stream.requests, &proto.StreamTransactionsRequest{Transaction: tx})
+	}
+
+	return stream
+}
+
+func TestAPI_StreamTransactions(t *testing.T) {
+	t.Parallel()
+
+	valid := newCreateTransactionRequest(100)
+	rejected := newCreateTransactionRequest(200)
+
+	invalid := newCreateTransactionRequest(300)
+	invalid.Entries[0].Amount = 1
+
+	t.Run("should acknowledge each transaction in order", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{
+			CreateTransactionsFunc: func(ctx context.Context, input domain.CreateTransactionsInput) ([]error, error) {
+				assert.True(t, input.BestEffort)
+
+				errs := make([]error, len(input.Transactions))
+				for i, tx := range input.Transactions {
+					if tx.ID.String() == rejected.Id {
+						errs[i] = app.ErrAccountNotActive
+					}
+				}
+
+				return errs, nil
+			},
+		})
+
+		stream := newTransactionsStream(valid, invalid, rejected, nil)
+
+		err := api.StreamTransactions(stream)
+		assert.NoError(t, err)
+		assert.Equal(t, []*proto.StreamTransactionsResponse{
+			{Result: &proto.CreateTransactionResult{Id: valid.Id}},
+			{Result: &proto.CreateTransactionResult{Id: invalid.Id, Code: int32(codes.InvalidArgument), Message: app.ErrInvalidBalance.Error()}},
+			{Result: &proto.CreateTransactionResult{Id: rejected.Id, Code: int32(codes.FailedPrecondition), Message: app.ErrAccountNotActive.Error()}},
+			{Result: &proto.CreateTransactionResult{Code: int32(codes.InvalidArgument), Message: "invalid transaction id"}},
+		}, stream.responses)
+	})
+
+	t.Run("should save the stream in micro-batches", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			mu    sync.Mutex
+			saved int
+		)
+
+		api := NewAPI(&mocks.UseCaseMock{
+			CreateTransactionsFunc: func(ctx context.Context, input domain.CreateTransactionsInput) ([]error, error) {
+				assert.LessOrEqual(t, len(input.Transactions), maxStreamBatch)
+
+				mu.Lock()
+				saved += len(input.Transactions)
+				mu.Unlock()
+
+				return make([]error, len(input.Transactions)), nil
+			},
+		})
+
+		transactions := make([]*proto.CreateTransactionRequest, 3*maxStreamBatch)
+		for i := range transactions {
+			transactions[i] = newCreateTransactionRequest(10)
+		}
+
+		stream := newTransactionsStream(transactions...)
+
+		err := api.StreamTransactions(stream)
+		assert.NoError(t, err)
+		assert.Equal(t, len(transactions), saved)
+		require.Len(t, stream.responses, len(transactions))
+
+		for i, resp := range stream.responses {
+			assert.Equal(t, transactions[i].Id, resp.Result.Id)
+			assert.Equal(t, int32(codes.OK), resp.Result.Code)
+		}
+	})
+
+	t.Run("should acknowledge every transaction of a failed batch with its error", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{
+			CreateTransactionsFunc: func(ctx context.Context, input domain.CreateTransactionsInput) ([]error, error) {
+				return nil, errors.New("connection refused")
+			},
+		})
+
+		stream := newTransactionsStream(valid)
+
+		err := api.StreamTransactions(stream)
+		assert.NoError(t, err)
+		assert.Equal(t, []*proto.StreamTransactionsResponse{
+			{Result: &proto.CreateTransactionResult{Id: valid.Id, Code: int32(codes.Internal), Message: "internal server error"}},
+		}, stream.responses)
+	})
+
+	t.Run("should replay the transactions already posted", func(t *testing.T) {
+		t.Parallel()
+
+		stream := newTransactionsStream(valid, rejected)
+
+		err := NewAPI(newReplayUseCase(sameTransaction)).StreamTransactions(stream)
+		assert.NoError(t, err)
+		assert.Equal(t, []*proto.StreamTransactionsResponse{
+			{Result: &proto.CreateTransactionResult{Id: valid.Id}},
+			{Result: &proto.CreateTransactionResult{Id: rejected.Id}},
+		}, stream.responses)
+	})
+
+	t.Run("should report a conflict when a transaction already posted differs", func(t *testing.T) {
+		t.Parallel()
+
+		stream := newTransactionsStream(valid)
+
+		err := NewAPI(newReplayUseCase(changeCompany)).StreamTransactions(stream)
+		assert.NoError(t, err)
+		require.Len(t, stream.responses, 1)
+		assert.Equal(t, int32(codes.AlreadyExists), stream.responses[0].Result.Code)
+		assert.Contains(t, stream.responses[0].Result.Message, `company: "abc" != "other"`)
+	})
+
+	t.Run("should return receive errors", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{
+			CreateTransactionsFunc: func(ctx context.Context, input domain.CreateTransactionsInput) ([]error, error) {
+				return make([]error, len(input.Transactions)), nil
+			},
+		})
+
+		recvErr := errors.New("stream reset")
+		stream := newTransactionsStream(valid)
+		stream.recvErr = recvErr
+
+		err := api.StreamTransactions(stream)
+		assert.ErrorIs(t, err, recvErr)
+		assert.Len(t, stream.responses, 1)
+	})
+
+	t.Run("should return send errors", func(t *testing.T) {
+		t.Parallel()
+
+		api := NewAPI(&mocks.UseCaseMock{
+			CreateTransactionsFunc: func(ctx context.Context, input domain.CreateTransactionsInput) ([]error, error) {
+				return make([]error, len(input.Transactions)), nil
+			},
+		})
+
+		sendErr := errors.New("stream closed")
+		stream := newTransactionsStream(valid, rejected)
+		stream.sendErr = sendErr
+
+		err := api.StreamTransactions(stream)
+		assert.ErrorIs(t, err, sendErr)
+	})
+}
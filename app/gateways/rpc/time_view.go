@@ -0,0 +1,29 @@
+package rpc
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+// parseTimeView parses the time axis and the bitemporal bound of a read request.
+func parseTimeView(axis proto.TimeAxis, knownAt *timestamppb.Timestamp) (vos.TimeView, error) {
+	if _, ok := proto.TimeAxis_name[int32(axis)]; !ok {
+		return vos.TimeView{}, status.Error(codes.InvalidArgument, "time_axis must be valid")
+	}
+
+	view := vos.TimeView{Axis: vos.TimeAxis(axis)}
+
+	if knownAt != nil {
+		if !knownAt.IsValid() {
+			return vos.TimeView{}, status.Error(codes.InvalidArgument, "known_at must be valid")
+		}
+
+		view.KnownAt = knownAt.AsTime()
+	}
+
+	return view, nil
+}
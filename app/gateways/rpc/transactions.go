@@ -13,33 +13,160 @@ import (
 	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (a *API) CreateTransaction(ctx context.Context, req *proto.CreateTransactionRequest) (*proto.CreateTransactionResponse, error) {
-	tid, err := uuid.Parse(req.Id)
+	if err := rejectAdjustment(req); err != nil {
+		return nil, err
+	}
+
+	tx, err := newTransaction(ctx, req.Id, req.Entries, req.CompetenceDate, req.Company, req.Event)
+	if err != nil {
+		return nil, err
+	}
+
+	posted, err := a.UseCase.CreateTransaction(ctx, tx)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to save transaction")
+		return nil, postingError(err)
+	}
+
+	entries, err := toProtoAccountEntries(ctx, posted)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.CreateTransactionResponse{
+		CreatedAt: timestamppb.New(posted.CreatedAt),
+		Entries:   entries,
+	}, nil
+}
+
+// rejectAdjustment rejects the transactions flagged as adjustments, which are only posted through PostAdjustment
+// so that who authorized them is recorded.
+func rejectAdjustment(req *proto.CreateTransactionRequest) error {
+	if req.GetAdjustment() { //nolint:staticcheck // the deprecated flag is still read to be rejected
+		return status.Error(codes.PermissionDenied, app.ErrUnauthorizedAdjustment.Error())
+	}
+
+	return nil
+}
+
+// postingError maps the errors of posting entries into the ledger to status errors.
+func postingError(err error) error {
+	switch {
+	case errors.Is(err, app.ErrInvalidVersion):
+		return status.Error(codes.InvalidArgument, "invalid account version")
+	case errors.Is(err, app.ErrIdempotencyKeyViolation):
+		return status.Error(codes.InvalidArgument, "invalid idempotency key")
+	case errors.Is(err, app.ErrTransactionConflict):
+		var conflictErr app.TransactionConflictError
+		if errors.As(err, &conflictErr) {
+			return status.Error(codes.AlreadyExists, conflictErr.Error())
+		}
+
+		return status.Error(codes.AlreadyExists, app.ErrTransactionConflict.Error())
+	case errors.Is(err, app.ErrAccountNotInChart):
+		var chartErr app.AccountNotInChartError
+		if errors.As(err, &chartErr) {
+			return status.Error(codes.InvalidArgument, chartErr.Error())
+		}
+
+		return status.Error(codes.InvalidArgument, app.ErrAccountNotInChart.Error())
+	case errors.Is(err, app.ErrUnknownEvent):
+		return status.Error(codes.InvalidArgument, app.ErrUnknownEvent.Error())
+	case errors.Is(err, app.ErrEventDeprecated):
+		return status.Error(codes.FailedPrecondition, app.ErrEventDeprecated.Error())
+	case errors.Is(err, app.ErrMetadataSchemaViolation):
+		return metadataSchemaError(err)
+	case errors.Is(err, app.ErrAccountNotActive):
+		return status.Error(codes.FailedPrecondition, app.ErrAccountNotActive.Error())
+	case errors.Is(err, app.ErrAccountNotOpened):
+		return status.Error(codes.FailedPrecondition, app.ErrAccountNotOpened.Error())
+	case errors.Is(err, app.ErrFiscalPeriodClosed):
+		return status.Error(codes.FailedPrecondition, app.ErrFiscalPeriodClosed.Error())
+	case errors.Is(err, app.ErrCompetenceDateLocked):
+		var lockErr app.CompetenceLockError
+		if errors.As(err, &lockErr) {
+			return status.Error(codes.FailedPrecondition, lockErr.Error())
+		}
+
+		return status.Error(codes.FailedPrecondition, app.ErrCompetenceDateLocked.Error())
+	case errors.Is(err, app.ErrUnauthorizedAdjustment):
+		return status.Error(codes.PermissionDenied, app.ErrUnauthorizedAdjustment.Error())
+	case errors.Is(err, app.ErrBalanceLimitExceeded):
+		var limitErr app.BalanceLimitError
+		if errors.As(err, &limitErr) {
+			return status.Error(codes.FailedPrecondition, limitErr.Error())
+		}
+
+		return status.Error(codes.FailedPrecondition, app.ErrBalanceLimitExceeded.Error())
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
+// newTransaction builds a domain transaction to be posted right away, rejecting competence dates in the future.
+func newTransaction(
+	ctx context.Context,
+	id string,
+	entries []*proto.Entry,
+	competenceDate *timestamppb.Timestamp,
+	company string,
+	event uint32,
+) (entities.Transaction, error) {
+	tx, err := parseTransaction(ctx, id, entries, competenceDate, company, event)
+	if err != nil {
+		return entities.Transaction{}, err
+	}
+
+	if tx.CompetenceDate.After(time.Now().UTC()) {
+		return entities.Transaction{}, status.Error(codes.InvalidArgument, "competence date set to the future")
+	}
+
+	return tx, nil
+}
+
+// parseTransaction builds a domain transaction out of the request fields shared by the RPCs that
+// receive transactions, returning the matching status error if any of them is invalid.
+func parseTransaction(
+	ctx context.Context,
+	id string,
+	entries []*proto.Entry,
+	competenceDate *timestamppb.Timestamp,
+	company string,
+	event uint32,
+) (entities.Transaction, error) {
+	tid, err := uuid.Parse(id)
 	if err != nil {
 		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to parse transaction id")
-		return nil, status.Error(codes.InvalidArgument, "invalid transaction id")
+		return entities.Transaction{}, status.Error(codes.InvalidArgument, "invalid transaction id")
 	}
 
-	if req.CompetenceDate == nil {
-		return nil, status.Error(codes.InvalidArgument, "competence_date must have a value")
-	} else if !req.CompetenceDate.IsValid() {
-		return nil, status.Error(codes.InvalidArgument, "competence_date must be valid")
+	if competenceDate == nil {
+		return entities.Transaction{}, status.Error(codes.InvalidArgument, "competence_date must have a value")
+	} else if !competenceDate.IsValid() {
+		return entities.Transaction{}, status.Error(codes.InvalidArgument, "competence_date must be valid")
 	}
 
-	domainEntries := make([]entities.Entry, len(req.Entries))
-	for i, entry := range req.Entries {
+	domainEntries := make([]entities.Entry, len(entries))
+	for i, entry := range entries {
 		entryID, entryErr := uuid.Parse(entry.Id)
 		if entryErr != nil {
 			zerolog.Ctx(ctx).Error().Err(entryErr).Int("index", i).Msg("failed to parse entry id")
-			return nil, status.Error(codes.InvalidArgument, "invalid entry id")
+			return entities.Transaction{}, status.Error(codes.InvalidArgument, "invalid entry id")
 		}
 
 		metadata, mErr := entry.Metadata.MarshalJSON()
 		if mErr != nil {
 			zerolog.Ctx(ctx).Error().Err(mErr).Int("index", i).Msg("failed to marshal entry metadata")
-			return nil, status.Error(codes.InvalidArgument, "invalid entry metadata")
+			return entities.Transaction{}, status.Error(codes.InvalidArgument, "invalid entry metadata")
+		}
+
+		currency := entry.Currency
+		if currency == "" {
+			currency = vos.DefaultCurrency.String()
 		}
 
 		domainEntry, domainErr := entities.NewEntry(
@@ -48,38 +175,24 @@ func (a *API) CreateTransaction(ctx context.Context, req *proto.CreateTransactio
 			entry.Account,
 			vos.Version(entry.ExpectedVersion),
 			int(entry.Amount),
+			currency,
 			metadata,
 		)
 		if domainErr != nil {
 			zerolog.Ctx(ctx).Error().Err(domainErr).Int("index", i).Msg("failed to create entry")
-			return nil, status.Error(codes.InvalidArgument, domainErr.Error())
+			return entities.Transaction{}, status.Error(codes.InvalidArgument, domainErr.Error())
 		}
 
 		domainEntries[i] = domainEntry
 	}
 
-	competenceDate := time.Unix(req.CompetenceDate.Seconds, 0).UTC()
-	if competenceDate.After(time.Now().UTC()) {
-		return nil, status.Error(codes.InvalidArgument, "competence date set to the future")
-	}
+	date := time.Unix(competenceDate.Seconds, 0).UTC()
 
-	tx, err := entities.NewTransaction(tid, req.Event, req.Company, competenceDate, domainEntries...)
+	tx, err := entities.NewTransaction(tid, event, company, date, domainEntries...)
 	if err != nil {
 		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to create transaction")
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
-	if err := a.UseCase.CreateTransaction(ctx, tx); err != nil {
-		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to save transaction")
-		switch {
-		case errors.Is(err, app.ErrInvalidVersion):
-			return nil, status.Error(codes.InvalidArgument, "invalid account version")
-		case errors.Is(err, app.ErrIdempotencyKeyViolation):
-			return nil, status.Error(codes.InvalidArgument, "invalid idempotency key")
-		default:
-			return nil, status.Error(codes.Internal, "internal server error")
-		}
+		return entities.Transaction{}, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	return &proto.CreateTransactionResponse{}, nil
+	return tx, nil
 }
@@ -2,7 +2,9 @@ package rpc
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -13,6 +15,7 @@ import (
 
 	"github.com/stone-co/the-amazing-ledger/app"
 	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
 	"github.com/stone-co/the-amazing-ledger/app/tests/mocks"
 	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
 	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
@@ -27,8 +30,8 @@ func TestAPI_CreateTransaction_Success(t *testing.T) {
 		{
 			name: "should succeed when create a transaction",
 			useCaseSetup: &mocks.UseCaseMock{
-				CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) error {
-					return nil
+				CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+					return transaction, nil
 				},
 			},
 			request: &proto.CreateTransactionRequest{
@@ -72,6 +75,60 @@ func TestAPI_CreateTransaction_Success(t *testing.T) {
 				CompetenceDate: timestamppb.Now(),
 			},
 		},
+		{
+			name: "should succeed when create a transaction balanced per currency",
+			useCaseSetup: &mocks.UseCaseMock{
+				CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+					currencies := make(map[vos.Currency]int)
+					for _, entry := range transaction.Entries {
+						currencies[entry.Currency]++
+					}
+
+					assert.Equal(t, map[vos.Currency]int{"BRL": 2, "USD": 2}, currencies)
+
+					return transaction, nil
+				},
+			},
+			request: &proto.CreateTransactionRequest{
+				Id: uuid.New().String(),
+				Entries: []*proto.Entry{
+					{
+						Id:              uuid.New().String(),
+						Account:         testdata.GenerateAccountPath(),
+						ExpectedVersion: 3,
+						Operation:       proto.Operation_OPERATION_DEBIT,
+						Amount:          123,
+					},
+					{
+						Id:              uuid.New().String(),
+						Account:         testdata.GenerateAccountPath(),
+						ExpectedVersion: 3,
+						Operation:       proto.Operation_OPERATION_CREDIT,
+						Amount:          123,
+						Currency:        "BRL",
+					},
+					{
+						Id:              uuid.New().String(),
+						Account:         testdata.GenerateAccountPath(),
+						ExpectedVersion: 3,
+						Operation:       proto.Operation_OPERATION_DEBIT,
+						Amount:          25,
+						Currency:        "USD",
+					},
+					{
+						Id:              uuid.New().String(),
+						Account:         testdata.GenerateAccountPath(),
+						ExpectedVersion: 3,
+						Operation:       proto.Operation_OPERATION_CREDIT,
+						Amount:          25,
+						Currency:        "USD",
+					},
+				},
+				Company:        "abc",
+				Event:          1,
+				CompetenceDate: timestamppb.Now(),
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -80,7 +137,11 @@ func TestAPI_CreateTransaction_Success(t *testing.T) {
 
 			got, err := api.CreateTransaction(context.Background(), tt.request)
 			assert.NoError(t, err)
-			assert.Equal(t, &proto.CreateTransactionResponse{}, got)
+			assert.Len(t, got.Entries, len(tt.request.Entries))
+
+			for _, entry := range got.Entries {
+				assert.Equal(t, int64(3), entry.Version)
+			}
 		})
 	}
 }
@@ -102,6 +163,35 @@ func TestAPI_CreateTransaction_InvalidRequest(t *testing.T) {
 			expectedCode:    codes.InvalidArgument,
 			expectedMessage: "invalid transaction id",
 		},
+		{
+			name:         "should not create an adjustment",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.CreateTransactionRequest{
+				Id: uuid.New().String(),
+				Entries: []*proto.Entry{
+					{
+						Id:              uuid.New().String(),
+						Account:         testdata.GenerateAccountPath(),
+						ExpectedVersion: 3,
+						Operation:       proto.Operation_OPERATION_DEBIT,
+						Amount:          123,
+					},
+					{
+						Id:              uuid.New().String(),
+						Account:         testdata.GenerateAccountPath(),
+						ExpectedVersion: 3,
+						Operation:       proto.Operation_OPERATION_CREDIT,
+						Amount:          123,
+					},
+				},
+				Company:        "abc",
+				Event:          1,
+				CompetenceDate: timestamppb.Now(),
+				Adjustment:     true, //nolint:staticcheck // the deprecated flag must be rejected
+			},
+			expectedCode:    codes.PermissionDenied,
+			expectedMessage: app.ErrUnauthorizedAdjustment.Error(),
+		},
 		{
 			name:         "should not create transaction when invalid entry ID",
 			useCaseSetup: &mocks.UseCaseMock{},
@@ -235,6 +325,233 @@ func TestAPI_CreateTransaction_InvalidRequest(t *testing.T) {
 			expectedCode:    codes.InvalidArgument,
 			expectedMessage: app.ErrAccountPathViolation.Error(),
 		},
+		{
+			name:         "should not create transaction when currency is invalid",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.CreateTransactionRequest{
+				Id: uuid.New().String(),
+				Entries: []*proto.Entry{
+					{
+						Id:              uuid.New().String(),
+						Account:         testdata.GenerateAccountPath(),
+						ExpectedVersion: 2,
+						Operation:       proto.Operation_OPERATION_CREDIT,
+						Amount:          123,
+						Currency:        "U$",
+					},
+					{
+						Id:              uuid.New().String(),
+						Account:         testdata.GenerateAccountPath(),
+						ExpectedVersion: 3,
+						Operation:       proto.Operation_OPERATION_DEBIT,
+						Amount:          123,
+					},
+				},
+				Company:        "abc",
+				Event:          1,
+				CompetenceDate: timestamppb.Now(),
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidCurrency.Error(),
+		},
+		{
+			name:         "should not create transaction when it is balanced only across currencies",
+			useCaseSetup: &mocks.UseCaseMock{},
+			request: &proto.CreateTransactionRequest{
+				Id: uuid.New().String(),
+				Entries: []*proto.Entry{
+					{
+						Id:              uuid.New().String(),
+						Account:         testdata.GenerateAccountPath(),
+						ExpectedVersion: 2,
+						Operation:       proto.Operation_OPERATION_CREDIT,
+						Amount:          123,
+						Currency:        "USD",
+					},
+					{
+						Id:              uuid.New().String(),
+						Account:         testdata.GenerateAccountPath(),
+						ExpectedVersion: 3,
+						Operation:       proto.Operation_OPERATION_DEBIT,
+						Amount:          123,
+					},
+				},
+				Company:        "abc",
+				Event:          1,
+				CompetenceDate: timestamppb.Now(),
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrInvalidBalance.Error(),
+		},
+		{
+			name: "should not create transaction when an account is frozen or closed",
+			useCaseSetup: &mocks.UseCaseMock{
+				CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+					return entities.Transaction{}, app.ErrAccountNotActive
+				},
+			},
+			request: &proto.CreateTransactionRequest{
+				Id: uuid.New().String(),
+				Entries: []*proto.Entry{
+					{
+						Id:              uuid.New().String(),
+						Account:         testdata.GenerateAccountPath(),
+						ExpectedVersion: 2,
+						Operation:       proto.Operation_OPERATION_CREDIT,
+						Amount:          123,
+					},
+					{
+						Id:              uuid.New().String(),
+						Account:         testdata.GenerateAccountPath(),
+						ExpectedVersion: 3,
+						Operation:       proto.Operation_OPERATION_DEBIT,
+						Amount:          123,
+					},
+				},
+				Company:        "abc",
+				Event:          1,
+				CompetenceDate: timestamppb.Now(),
+			},
+			expectedCode:    codes.FailedPrecondition,
+			expectedMessage: app.ErrAccountNotActive.Error(),
+		},
+		{
+			name: "should not create transaction when a balance limit would be exceeded",
+			useCaseSetup: &mocks.UseCaseMock{
+				CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+					return entities.Transaction{}, fmt.Errorf("failed to create transaction: %w", app.BalanceLimitError{
+						Account:  "liability.clients.available.abc",
+						Currency: "BRL",
+						Balance:  -123,
+					})
+				},
+			},
+			request: &proto.CreateTransactionRequest{
+				Id: uuid.New().String(),
+				Entries: []*proto.Entry{
+					{
+						Id:              uuid.New().String(),
+						Account:         testdata.GenerateAccountPath(),
+						ExpectedVersion: 2,
+						Operation:       proto.Operation_OPERATION_CREDIT,
+						Amount:          123,
+					},
+					{
+						Id:              uuid.New().String(),
+						Account:         testdata.GenerateAccountPath(),
+						ExpectedVersion: 3,
+						Operation:       proto.Operation_OPERATION_DEBIT,
+						Amount:          123,
+					},
+				},
+				Company:        "abc",
+				Event:          1,
+				CompetenceDate: timestamppb.Now(),
+			},
+			expectedCode:    codes.FailedPrecondition,
+			expectedMessage: "balance limit exceeded: account liability.clients.available.abc would have a BRL balance of -123",
+		},
+		{
+			name: "should not create transaction when it conflicts with an existing one",
+			useCaseSetup: &mocks.UseCaseMock{
+				CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+					return entities.Transaction{}, fmt.Errorf("failed to create transaction: %w", app.TransactionConflictError{
+						Differences: []string{"event: 2 != 1"},
+					})
+				},
+			},
+			request: &proto.CreateTransactionRequest{
+				Id: uuid.New().String(),
+				Entries: []*proto.Entry{
+					{
+						Id:              uuid.New().String(),
+						Account:         testdata.GenerateAccountPath(),
+						ExpectedVersion: 2,
+						Operation:       proto.Operation_OPERATION_CREDIT,
+						Amount:          123,
+					},
+					{
+						Id:              uuid.New().String(),
+						Account:         testdata.GenerateAccountPath(),
+						ExpectedVersion: 3,
+						Operation:       proto.Operation_OPERATION_DEBIT,
+						Amount:          123,
+					},
+				},
+				Company:        "abc",
+				Event:          2,
+				CompetenceDate: timestamppb.Now(),
+			},
+			expectedCode:    codes.AlreadyExists,
+			expectedMessage: "transaction conflicts with an existing one: event: 2 != 1",
+		},
+		{
+			name: "should not create transaction when the event is unknown",
+			useCaseSetup: &mocks.UseCaseMock{
+				CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+					return entities.Transaction{}, fmt.Errorf("failed to create transaction: %w", app.ErrUnknownEvent)
+				},
+			},
+			request: &proto.CreateTransactionRequest{
+				Id: uuid.New().String(),
+				Entries: []*proto.Entry{
+					{
+						Id:              uuid.New().String(),
+						Account:         testdata.GenerateAccountPath(),
+						ExpectedVersion: 2,
+						Operation:       proto.Operation_OPERATION_CREDIT,
+						Amount:          123,
+					},
+					{
+						Id:              uuid.New().String(),
+						Account:         testdata.GenerateAccountPath(),
+						ExpectedVersion: 3,
+						Operation:       proto.Operation_OPERATION_DEBIT,
+						Amount:          123,
+					},
+				},
+				Company:        "abc",
+				Event:          999,
+				CompetenceDate: timestamppb.Now(),
+			},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: app.ErrUnknownEvent.Error(),
+		},
+		{
+			name: "should not create transaction when its competence date is locked",
+			useCaseSetup: &mocks.UseCaseMock{
+				CreateTransactionFunc: func(ctx context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+					return entities.Transaction{}, fmt.Errorf("failed to create transaction: %w", app.CompetenceLockError{
+						Company:  "abc",
+						LockDate: time.Date(2021, 6, 30, 0, 0, 0, 0, time.UTC),
+					})
+				},
+			},
+			request: &proto.CreateTransactionRequest{
+				Id: uuid.New().String(),
+				Entries: []*proto.Entry{
+					{
+						Id:              uuid.New().String(),
+						Account:         testdata.GenerateAccountPath(),
+						ExpectedVersion: 2,
+						Operation:       proto.Operation_OPERATION_CREDIT,
+						Amount:          123,
+					},
+					{
+						Id:              uuid.New().String(),
+						Account:         testdata.GenerateAccountPath(),
+						ExpectedVersion: 3,
+						Operation:       proto.Operation_OPERATION_DEBIT,
+						Amount:          123,
+					},
+				},
+				Company:        "abc",
+				Event:          1,
+				CompetenceDate: timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			expectedCode:    codes.FailedPrecondition,
+			expectedMessage: "competence date on or before the lock date: company abc is locked until 2021-06-30T00:00:00Z",
+		},
 	}
 
 	for _, tt := range tests {
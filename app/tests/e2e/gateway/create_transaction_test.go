@@ -289,7 +289,7 @@ func TestE2E_Gateway_CreateTransactionFailure(t *testing.T) {
 			},
 		},
 		{
-			name: "should return an error when occurs idempotency key violation",
+			name: "should return an error when entry ids belong to another transaction",
 			seedRepo: func(t *testing.T) entities.Transaction {
 				e1 := testutils.CreateEntry(t, vos.DebitOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100)
 				e2 := testutils.CreateEntry(t, vos.CreditOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100)
@@ -321,10 +321,10 @@ func TestE2E_Gateway_CreateTransactionFailure(t *testing.T) {
 				Event:          1,
 			},
 			wants: wants{
-				status: http.StatusBadRequest,
+				status: http.StatusConflict,
 				body: responseBody{
-					Code:    3,
-					Message: "invalid idempotency key",
+					Code:    6,
+					Message: "transaction conflicts with an existing one: entry ids already used by another transaction",
 				},
 			},
 		},
@@ -242,7 +242,7 @@ func TestE2E_RPC_CreateTransactionFailure(t *testing.T) {
 			expectedMsg:  "competence date set to the future",
 		},
 		{
-			name: "should return an error when occurs idempotency key violation",
+			name: "should return an error when entry ids belong to another transaction",
 			seedRepo: func(t *testing.T) entities.Transaction {
 				e1 := testutils.CreateEntry(t, vos.DebitOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100)
 				e2 := testutils.CreateEntry(t, vos.CreditOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100)
@@ -272,8 +272,8 @@ func TestE2E_RPC_CreateTransactionFailure(t *testing.T) {
 				Event:          1,
 				CompetenceDate: timestamppb.Now(),
 			},
-			expectedCode: codes.InvalidArgument,
-			expectedMsg:  "invalid idempotency key",
+			expectedCode: codes.AlreadyExists,
+			expectedMsg:  "transaction conflicts with an existing one: entry ids already used by another transaction",
 		},
 	}
 
@@ -4,9 +4,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
 	"github.com/stone-co/the-amazing-ledger/app/tests"
@@ -44,6 +46,46 @@ func TestE2E_RPC_GetAccountBalanceSuccess_Analytic(t *testing.T) {
 	})
 }
 
+func TestE2E_RPC_GetAccountBalanceSuccess_PerCurrency(t *testing.T) {
+	t.Run("should get account balance of each currency", func(t *testing.T) {
+		debitAccount := testdata.GenerateAccountPath()
+		creditAccount := testdata.GenerateAccountPath()
+
+		request := &proto.CreateTransactionRequest{
+			Id: uuid.New().String(),
+			Entries: []*proto.Entry{
+				{Id: uuid.New().String(), Account: debitAccount, Operation: proto.Operation_OPERATION_DEBIT, Amount: 100},
+				{Id: uuid.New().String(), Account: creditAccount, Operation: proto.Operation_OPERATION_CREDIT, Amount: 100},
+				{Id: uuid.New().String(), Account: debitAccount, Operation: proto.Operation_OPERATION_DEBIT, Amount: 20, Currency: "USD"},
+				{Id: uuid.New().String(), Account: creditAccount, Operation: proto.Operation_OPERATION_CREDIT, Amount: 20, Currency: "USD"},
+			},
+			Company:        "abc",
+			Event:          1,
+			CompetenceDate: timestamppb.Now(),
+		}
+
+		_, err := testenv.RPCClient.CreateTransaction(context.Background(), request)
+		assert.NoError(t, err)
+
+		defer tests.TruncateTables(context.Background(), testenv.DB, "entry", "account_version", "account_balance")
+
+		balance, err := testenv.RPCClient.GetAccountBalance(context.Background(), &proto.GetAccountBalanceRequest{
+			Account: debitAccount,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "BRL", balance.Currency)
+		assert.Equal(t, int64(-100), balance.Balance)
+
+		balance, err = testenv.RPCClient.GetAccountBalance(context.Background(), &proto.GetAccountBalanceRequest{
+			Account:  debitAccount,
+			Currency: "USD",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "USD", balance.Currency)
+		assert.Equal(t, int64(-20), balance.Balance)
+	})
+}
+
 func TestE2E_RPC_GetAccountBalanceSuccess_Synthetic(t *testing.T) {
 	e1 := testutils.CreateEntry(t, vos.DebitOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100)
 	e2 := testutils.CreateEntry(t, vos.CreditOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100)
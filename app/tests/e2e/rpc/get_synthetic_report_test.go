@@ -22,9 +22,8 @@ import (
 
 func TestE2E_RPC_GetSyntheticReportSuccess(t *testing.T) {
 	type wants struct {
-		totalCredit int64
-		totalDebit  int64
-		numPaths    int
+		totals   []*proto.CurrencyTotal
+		numPaths int
 	}
 
 	testCases := []struct {
@@ -46,9 +45,8 @@ func TestE2E_RPC_GetSyntheticReportSuccess(t *testing.T) {
 				}
 			},
 			wants: wants{
-				totalCredit: 0,
-				totalDebit:  0,
-				numPaths:    0,
+				totals:   []*proto.CurrencyTotal{},
+				numPaths: 0,
 			},
 		},
 		{
@@ -75,9 +73,8 @@ func TestE2E_RPC_GetSyntheticReportSuccess(t *testing.T) {
 				}
 			},
 			wants: wants{
-				totalCredit: 0,
-				totalDebit:  100,
-				numPaths:    1,
+				totals:   []*proto.CurrencyTotal{{Currency: "BRL", Credit: 0, Debit: 100}},
+				numPaths: 1,
 			},
 		},
 		{
@@ -98,9 +95,8 @@ func TestE2E_RPC_GetSyntheticReportSuccess(t *testing.T) {
 				}
 			},
 			wants: wants{
-				totalCredit: 100,
-				totalDebit:  100,
-				numPaths:    2,
+				totals:   []*proto.CurrencyTotal{{Currency: "BRL", Credit: 100, Debit: 100}},
+				numPaths: 2,
 			},
 		},
 		{
@@ -121,9 +117,8 @@ func TestE2E_RPC_GetSyntheticReportSuccess(t *testing.T) {
 				}
 			},
 			wants: wants{
-				totalCredit: 0,
-				totalDebit:  0,
-				numPaths:    0,
+				totals:   []*proto.CurrencyTotal{},
+				numPaths: 0,
 			},
 		},
 	}
@@ -138,8 +133,12 @@ func TestE2E_RPC_GetSyntheticReportSuccess(t *testing.T) {
 			report, err := testenv.RPCClient.GetSyntheticReport(context.Background(), request)
 			assert.NoError(t, err)
 
-			assert.Equal(t, tt.wants.totalCredit, report.TotalCredit)
-			assert.Equal(t, tt.wants.totalDebit, report.TotalDebit)
+			assert.Len(t, report.Totals, len(tt.wants.totals))
+			for i, total := range tt.wants.totals {
+				assert.Equal(t, total.Currency, report.Totals[i].Currency)
+				assert.Equal(t, total.Credit, report.Totals[i].Credit)
+				assert.Equal(t, total.Debit, report.Totals[i].Debit)
+			}
 			assert.Len(t, report.Results, tt.wants.numPaths)
 		})
 	}
@@ -0,0 +1,57 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testenv"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testseed"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testutils"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func TestE2E_RPC_GetTransactionSuccess(t *testing.T) {
+	t.Run("should get a transaction successfully", func(t *testing.T) {
+		e1 := testutils.CreateEntry(t, vos.DebitOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100)
+		e2 := testutils.CreateEntry(t, vos.CreditOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100)
+
+		tx := testseed.CreateTransaction(t, e1, e2)
+
+		defer tests.TruncateTables(context.Background(), testenv.DB, "entry", "account_version")
+
+		got, err := testenv.RPCClient.GetTransaction(context.Background(), &proto.GetTransactionRequest{
+			Id: tx.ID.String(),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, tx.ID.String(), got.Id)
+		assert.Equal(t, tx.Company, got.Company)
+		assert.Equal(t, tx.Event, got.Event)
+		assert.NotNil(t, got.CreatedAt)
+		require.Len(t, got.Entries, 2)
+		assert.Equal(t, e1.Account.Value(), got.Entries[0].Account)
+		assert.Equal(t, int64(1), got.Entries[0].Version)
+		assert.Equal(t, e2.Account.Value(), got.Entries[1].Account)
+	})
+}
+
+func TestE2E_RPC_GetTransactionFailure(t *testing.T) {
+	t.Run("should return an error if transaction does not exist", func(t *testing.T) {
+		_, err := testenv.RPCClient.GetTransaction(context.Background(), &proto.GetTransactionRequest{
+			Id: uuid.New().String(),
+		})
+		respStatus, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, respStatus.Code())
+		assert.Equal(t, "transaction not found", respStatus.Message())
+	})
+}
@@ -0,0 +1,70 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
+	"github.com/stone-co/the-amazing-ledger/app/tests"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testdata"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testenv"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testseed"
+	"github.com/stone-co/the-amazing-ledger/app/tests/testutils"
+	proto "github.com/stone-co/the-amazing-ledger/gen/ledger/v1beta"
+)
+
+func TestE2E_RPC_RevertTransactionSuccess(t *testing.T) {
+	t.Run("should revert a transaction successfully", func(t *testing.T) {
+		e1 := testutils.CreateEntry(t, vos.DebitOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100)
+		e2 := testutils.CreateEntry(t, vos.CreditOperation, testdata.GenerateAccountPath(), vos.NextAccountVersion, 100)
+
+		tx := testseed.CreateTransaction(t, e1, e2)
+
+		defer tests.TruncateTables(context.Background(), testenv.DB, "entry", "account_version", "transaction_reversal")
+
+		request := &proto.RevertTransactionRequest{
+			Id:            uuid.New().String(),
+			TransactionId: tx.ID.String(),
+			Reason:        "wrong posting",
+		}
+
+		_, err := testenv.RPCClient.RevertTransaction(context.Background(), request)
+		assert.NoError(t, err)
+
+		balance, err := testenv.RPCClient.GetAccountBalance(context.Background(), &proto.GetAccountBalanceRequest{
+			Account: e1.Account.Value(),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), balance.Balance)
+		assert.Equal(t, int64(2), balance.CurrentVersion)
+
+		request.Id = uuid.New().String()
+
+		_, err = testenv.RPCClient.RevertTransaction(context.Background(), request)
+		respStatus, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, respStatus.Code())
+		assert.Equal(t, "transaction already reverted", respStatus.Message())
+	})
+}
+
+func TestE2E_RPC_RevertTransactionFailure(t *testing.T) {
+	t.Run("should return an error if transaction does not exist", func(t *testing.T) {
+		request := &proto.RevertTransactionRequest{
+			Id:            uuid.New().String(),
+			TransactionId: uuid.New().String(),
+			Reason:        "wrong posting",
+		}
+
+		_, err := testenv.RPCClient.RevertTransaction(context.Background(), request)
+		respStatus, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, respStatus.Code())
+		assert.Equal(t, "transaction not found", respStatus.Message())
+	})
+}
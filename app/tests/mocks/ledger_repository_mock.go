@@ -5,6 +5,7 @@ package mocks
 
 import (
 	"context"
+	"github.com/google/uuid"
 	"github.com/stone-co/the-amazing-ledger/app/domain"
 	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
 	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
@@ -23,24 +24,144 @@ var _ domain.Repository = &RepositoryMock{}
 //
 // 		// make and configure a mocked domain.Repository
 // 		mockedRepository := &RepositoryMock{
-// 			CreateTransactionFunc: func(contextMoqParam context.Context, transaction entities.Transaction) error {
+// 			AuthorizeTransactionFunc: func(contextMoqParam context.Context, pendingTransaction entities.PendingTransaction) (entities.PendingTransaction, error) {
+// 				panic("mock out the AuthorizeTransaction method")
+// 			},
+// 			CaptureTransactionFunc: func(contextMoqParam context.Context, capture entities.Capture) error {
+// 				panic("mock out the CaptureTransaction method")
+// 			},
+// 			ChangeCompetenceLockFunc: func(contextMoqParam context.Context, competenceLockChange entities.CompetenceLockChange) (entities.CompetenceLockChange, error) {
+// 				panic("mock out the ChangeCompetenceLock method")
+// 			},
+// 			ClaimDueScheduledTransactionsFunc: func(contextMoqParam context.Context, n int) ([]entities.ScheduledTransaction, error) {
+// 				panic("mock out the ClaimDueScheduledTransactions method")
+// 			},
+// 			CloseFiscalPeriodFunc: func(contextMoqParam context.Context, fiscalPeriodClosing entities.FiscalPeriodClosing) (entities.FiscalPeriodClosing, error) {
+// 				panic("mock out the CloseFiscalPeriod method")
+// 			},
+// 			CreateEventFunc: func(contextMoqParam context.Context, event entities.Event) (entities.Event, error) {
+// 				panic("mock out the CreateEvent method")
+// 			},
+// 			CreateEventMetadataSchemaFunc: func(contextMoqParam context.Context, eventMetadataSchema entities.EventMetadataSchema) (entities.EventMetadataSchema, error) {
+// 				panic("mock out the CreateEventMetadataSchema method")
+// 			},
+// 			CreateFiscalPeriodFunc: func(contextMoqParam context.Context, fiscalPeriod entities.FiscalPeriod) (entities.FiscalPeriod, error) {
+// 				panic("mock out the CreateFiscalPeriod method")
+// 			},
+// 			CreateTransactionFunc: func(contextMoqParam context.Context, transaction entities.Transaction) (entities.Transaction, error) {
 // 				panic("mock out the CreateTransaction method")
 // 			},
-// 			GetAnalyticAccountBalanceFunc: func(contextMoqParam context.Context, account vos.Account) (vos.AccountBalance, error) {
+// 			CreateTransactionsFunc: func(contextMoqParam context.Context, transactions []entities.Transaction) error {
+// 				panic("mock out the CreateTransactions method")
+// 			},
+// 			CreateTransactionsBestEffortFunc: func(contextMoqParam context.Context, transactions []entities.Transaction) ([]error, error) {
+// 				panic("mock out the CreateTransactionsBestEffort method")
+// 			},
+// 			DeleteBalanceLimitFunc: func(contextMoqParam context.Context, account vos.Account, currency vos.Currency) error {
+// 				panic("mock out the DeleteBalanceLimit method")
+// 			},
+// 			DeprecateEventFunc: func(contextMoqParam context.Context, event entities.Event) error {
+// 				panic("mock out the DeprecateEvent method")
+// 			},
+// 			GetAccountFunc: func(contextMoqParam context.Context, account vos.Account) (entities.Account, error) {
+// 				panic("mock out the GetAccount method")
+// 			},
+// 			GetAnalyticAccountBalanceFunc: func(contextMoqParam context.Context, account vos.Account, currency vos.Currency) (vos.AccountBalance, error) {
 // 				panic("mock out the GetAnalyticAccountBalance method")
 // 			},
-// 			GetBoundedAccountBalanceFunc: func(contextMoqParam context.Context, account vos.Account, timeMoqParam1 time.Time, timeMoqParam2 time.Time) (vos.AccountBalance, error) {
+// 			GetBalanceHistoryFunc: func(contextMoqParam context.Context, balanceHistoryRequest vos.BalanceHistoryRequest) (vos.BalanceHistory, error) {
+// 				panic("mock out the GetBalanceHistory method")
+// 			},
+// 			GetBoundedAccountBalanceFunc: func(contextMoqParam context.Context, account vos.Account, currency vos.Currency, timeMoqParam1 time.Time, timeMoqParam2 time.Time, timeView vos.TimeView) (vos.AccountBalance, error) {
 // 				panic("mock out the GetBoundedAccountBalance method")
 // 			},
-// 			GetSyntheticAccountBalanceFunc: func(contextMoqParam context.Context, account vos.Account) (vos.AccountBalance, error) {
+// 			GetEventFunc: func(contextMoqParam context.Context, v uint32) (entities.Event, error) {
+// 				panic("mock out the GetEvent method")
+// 			},
+// 			GetFiscalPeriodFunc: func(contextMoqParam context.Context, uUID uuid.UUID) (entities.FiscalPeriod, error) {
+// 				panic("mock out the GetFiscalPeriod method")
+// 			},
+// 			GetPendingTransactionFunc: func(contextMoqParam context.Context, uUID uuid.UUID) (entities.PendingTransaction, error) {
+// 				panic("mock out the GetPendingTransaction method")
+// 			},
+// 			GetReversalFunc: func(contextMoqParam context.Context, uUID uuid.UUID) (entities.Reversal, error) {
+// 				panic("mock out the GetReversal method")
+// 			},
+// 			GetScheduledTransactionFunc: func(contextMoqParam context.Context, uUID uuid.UUID) (entities.ScheduledTransaction, error) {
+// 				panic("mock out the GetScheduledTransaction method")
+// 			},
+// 			GetStatementLinesFunc: func(contextMoqParam context.Context, statementRequest vos.StatementRequest) ([]vos.StatementLine, error) {
+// 				panic("mock out the GetStatementLines method")
+// 			},
+// 			GetSyntheticAccountBalanceFunc: func(contextMoqParam context.Context, account vos.Account, currency vos.Currency) (vos.AccountBalance, error) {
 // 				panic("mock out the GetSyntheticAccountBalance method")
 // 			},
-// 			GetSyntheticReportFunc: func(contextMoqParam context.Context, account vos.Account, n int, timeMoqParam1 time.Time, timeMoqParam2 time.Time) (*vos.SyntheticReport, error) {
+// 			GetSyntheticReportFunc: func(contextMoqParam context.Context, syntheticReportRequest vos.SyntheticReportRequest) (*vos.SyntheticReport, error) {
 // 				panic("mock out the GetSyntheticReport method")
 // 			},
+// 			GetTransactionFunc: func(contextMoqParam context.Context, uUID uuid.UUID) (entities.Transaction, error) {
+// 				panic("mock out the GetTransaction method")
+// 			},
+// 			GetTrialBalanceFunc: func(contextMoqParam context.Context, trialBalanceRequest vos.TrialBalanceRequest) (vos.TrialBalance, error) {
+// 				panic("mock out the GetTrialBalance method")
+// 			},
+// 			ListAccountChildrenFunc: func(contextMoqParam context.Context, accountChildrenRequest vos.AccountChildrenRequest) ([]vos.AccountChild, pagination.Cursor, error) {
+// 				panic("mock out the ListAccountChildren method")
+// 			},
 // 			ListAccountEntriesFunc: func(contextMoqParam context.Context, accountEntryRequest vos.AccountEntryRequest) ([]vos.AccountEntry, pagination.Cursor, error) {
 // 				panic("mock out the ListAccountEntries method")
 // 			},
+// 			ListAccountsFunc: func(contextMoqParam context.Context, accountListRequest vos.AccountListRequest) ([]vos.AccountSummary, pagination.Cursor, error) {
+// 				panic("mock out the ListAccounts method")
+// 			},
+// 			ListBalanceLimitsFunc: func(contextMoqParam context.Context) ([]vos.BalanceLimit, error) {
+// 				panic("mock out the ListBalanceLimits method")
+// 			},
+// 			ListCompetenceLockChangesFunc: func(contextMoqParam context.Context, s string) ([]entities.CompetenceLockChange, error) {
+// 				panic("mock out the ListCompetenceLockChanges method")
+// 			},
+// 			ListCompetenceLocksFunc: func(contextMoqParam context.Context, s string) ([]entities.CompetenceLock, error) {
+// 				panic("mock out the ListCompetenceLocks method")
+// 			},
+// 			ListEventMetadataSchemasFunc: func(contextMoqParam context.Context, v uint32) ([]entities.EventMetadataSchema, error) {
+// 				panic("mock out the ListEventMetadataSchemas method")
+// 			},
+// 			ListEventsFunc: func(contextMoqParam context.Context, b bool) ([]entities.Event, error) {
+// 				panic("mock out the ListEvents method")
+// 			},
+// 			ListFiscalPeriodChangesFunc: func(contextMoqParam context.Context, uUID uuid.UUID) ([]entities.FiscalPeriodChange, error) {
+// 				panic("mock out the ListFiscalPeriodChanges method")
+// 			},
+// 			ListFiscalPeriodsFunc: func(contextMoqParam context.Context, s string) ([]entities.FiscalPeriod, error) {
+// 				panic("mock out the ListFiscalPeriods method")
+// 			},
+// 			ListScheduledTransactionsFunc: func(contextMoqParam context.Context, scheduleStatus vos.ScheduleStatus, page pagination.Page) ([]entities.ScheduledTransaction, pagination.Cursor, error) {
+// 				panic("mock out the ListScheduledTransactions method")
+// 			},
+// 			OpenAccountFunc: func(contextMoqParam context.Context, account entities.Account) (entities.Account, error) {
+// 				panic("mock out the OpenAccount method")
+// 			},
+// 			ReopenFiscalPeriodFunc: func(contextMoqParam context.Context, fiscalPeriod entities.FiscalPeriod, fiscalPeriodChange entities.FiscalPeriodChange) error {
+// 				panic("mock out the ReopenFiscalPeriod method")
+// 			},
+// 			RevertTransactionFunc: func(contextMoqParam context.Context, reversal entities.Reversal) error {
+// 				panic("mock out the RevertTransaction method")
+// 			},
+// 			ScheduleTransactionFunc: func(contextMoqParam context.Context, scheduledTransaction entities.ScheduledTransaction) (entities.ScheduledTransaction, error) {
+// 				panic("mock out the ScheduleTransaction method")
+// 			},
+// 			SetBalanceLimitFunc: func(contextMoqParam context.Context, balanceLimit vos.BalanceLimit) error {
+// 				panic("mock out the SetBalanceLimit method")
+// 			},
+// 			UpdateAccountStatusFunc: func(contextMoqParam context.Context, account entities.Account, accountStatus vos.AccountStatus) (entities.Account, error) {
+// 				panic("mock out the UpdateAccountStatus method")
+// 			},
+// 			UpdateScheduledTransactionStatusFunc: func(contextMoqParam context.Context, scheduledTransaction entities.ScheduledTransaction, scheduleStatus vos.ScheduleStatus) error {
+// 				panic("mock out the UpdateScheduledTransactionStatus method")
+// 			},
+// 			VoidTransactionFunc: func(contextMoqParam context.Context, pendingTransaction entities.PendingTransaction) error {
+// 				panic("mock out the VoidTransaction method")
+// 			},
 // 		}
 //
 // 		// use mockedRepository in code that requires domain.Repository
@@ -48,26 +169,202 @@ var _ domain.Repository = &RepositoryMock{}
 //
 // 	}
 type RepositoryMock struct {
+	// AuthorizeTransactionFunc mocks the AuthorizeTransaction method.
+	AuthorizeTransactionFunc func(contextMoqParam context.Context, pendingTransaction entities.PendingTransaction) (entities.PendingTransaction, error)
+
+	// CaptureTransactionFunc mocks the CaptureTransaction method.
+	CaptureTransactionFunc func(contextMoqParam context.Context, capture entities.Capture) error
+
+	// ChangeCompetenceLockFunc mocks the ChangeCompetenceLock method.
+	ChangeCompetenceLockFunc func(contextMoqParam context.Context, competenceLockChange entities.CompetenceLockChange) (entities.CompetenceLockChange, error)
+
+	// ClaimDueScheduledTransactionsFunc mocks the ClaimDueScheduledTransactions method.
+	ClaimDueScheduledTransactionsFunc func(contextMoqParam context.Context, n int) ([]entities.ScheduledTransaction, error)
+
+	// CloseFiscalPeriodFunc mocks the CloseFiscalPeriod method.
+	CloseFiscalPeriodFunc func(contextMoqParam context.Context, fiscalPeriodClosing entities.FiscalPeriodClosing) (entities.FiscalPeriodClosing, error)
+
+	// CreateEventFunc mocks the CreateEvent method.
+	CreateEventFunc func(contextMoqParam context.Context, event entities.Event) (entities.Event, error)
+
+	// CreateEventMetadataSchemaFunc mocks the CreateEventMetadataSchema method.
+	CreateEventMetadataSchemaFunc func(contextMoqParam context.Context, eventMetadataSchema entities.EventMetadataSchema) (entities.EventMetadataSchema, error)
+
+	// CreateFiscalPeriodFunc mocks the CreateFiscalPeriod method.
+	CreateFiscalPeriodFunc func(contextMoqParam context.Context, fiscalPeriod entities.FiscalPeriod) (entities.FiscalPeriod, error)
+
 	// CreateTransactionFunc mocks the CreateTransaction method.
-	CreateTransactionFunc func(contextMoqParam context.Context, transaction entities.Transaction) error
+	CreateTransactionFunc func(contextMoqParam context.Context, transaction entities.Transaction) (entities.Transaction, error)
+
+	// CreateTransactionsFunc mocks the CreateTransactions method.
+	CreateTransactionsFunc func(contextMoqParam context.Context, transactions []entities.Transaction) error
+
+	// CreateTransactionsBestEffortFunc mocks the CreateTransactionsBestEffort method.
+	CreateTransactionsBestEffortFunc func(contextMoqParam context.Context, transactions []entities.Transaction) ([]error, error)
+
+	// DeleteBalanceLimitFunc mocks the DeleteBalanceLimit method.
+	DeleteBalanceLimitFunc func(contextMoqParam context.Context, account vos.Account, currency vos.Currency) error
+
+	// DeprecateEventFunc mocks the DeprecateEvent method.
+	DeprecateEventFunc func(contextMoqParam context.Context, event entities.Event) error
+
+	// GetAccountFunc mocks the GetAccount method.
+	GetAccountFunc func(contextMoqParam context.Context, account vos.Account) (entities.Account, error)
 
 	// GetAnalyticAccountBalanceFunc mocks the GetAnalyticAccountBalance method.
-	GetAnalyticAccountBalanceFunc func(contextMoqParam context.Context, account vos.Account) (vos.AccountBalance, error)
+	GetAnalyticAccountBalanceFunc func(contextMoqParam context.Context, account vos.Account, currency vos.Currency) (vos.AccountBalance, error)
+
+	// GetBalanceHistoryFunc mocks the GetBalanceHistory method.
+	GetBalanceHistoryFunc func(contextMoqParam context.Context, balanceHistoryRequest vos.BalanceHistoryRequest) (vos.BalanceHistory, error)
 
 	// GetBoundedAccountBalanceFunc mocks the GetBoundedAccountBalance method.
-	GetBoundedAccountBalanceFunc func(contextMoqParam context.Context, account vos.Account, timeMoqParam1 time.Time, timeMoqParam2 time.Time) (vos.AccountBalance, error)
+	GetBoundedAccountBalanceFunc func(contextMoqParam context.Context, account vos.Account, currency vos.Currency, timeMoqParam1 time.Time, timeMoqParam2 time.Time, timeView vos.TimeView) (vos.AccountBalance, error)
+
+	// GetEventFunc mocks the GetEvent method.
+	GetEventFunc func(contextMoqParam context.Context, v uint32) (entities.Event, error)
+
+	// GetFiscalPeriodFunc mocks the GetFiscalPeriod method.
+	GetFiscalPeriodFunc func(contextMoqParam context.Context, uUID uuid.UUID) (entities.FiscalPeriod, error)
+
+	// GetPendingTransactionFunc mocks the GetPendingTransaction method.
+	GetPendingTransactionFunc func(contextMoqParam context.Context, uUID uuid.UUID) (entities.PendingTransaction, error)
+
+	// GetReversalFunc mocks the GetReversal method.
+	GetReversalFunc func(contextMoqParam context.Context, uUID uuid.UUID) (entities.Reversal, error)
+
+	// GetScheduledTransactionFunc mocks the GetScheduledTransaction method.
+	GetScheduledTransactionFunc func(contextMoqParam context.Context, uUID uuid.UUID) (entities.ScheduledTransaction, error)
+
+	// GetStatementLinesFunc mocks the GetStatementLines method.
+	GetStatementLinesFunc func(contextMoqParam context.Context, statementRequest vos.StatementRequest) ([]vos.StatementLine, error)
 
 	// GetSyntheticAccountBalanceFunc mocks the GetSyntheticAccountBalance method.
-	GetSyntheticAccountBalanceFunc func(contextMoqParam context.Context, account vos.Account) (vos.AccountBalance, error)
+	GetSyntheticAccountBalanceFunc func(contextMoqParam context.Context, account vos.Account, currency vos.Currency) (vos.AccountBalance, error)
 
 	// GetSyntheticReportFunc mocks the GetSyntheticReport method.
-	GetSyntheticReportFunc func(contextMoqParam context.Context, account vos.Account, n int, timeMoqParam1 time.Time, timeMoqParam2 time.Time) (*vos.SyntheticReport, error)
+	GetSyntheticReportFunc func(contextMoqParam context.Context, syntheticReportRequest vos.SyntheticReportRequest) (*vos.SyntheticReport, error)
+
+	// GetTransactionFunc mocks the GetTransaction method.
+	GetTransactionFunc func(contextMoqParam context.Context, uUID uuid.UUID) (entities.Transaction, error)
+
+	// GetTrialBalanceFunc mocks the GetTrialBalance method.
+	GetTrialBalanceFunc func(contextMoqParam context.Context, trialBalanceRequest vos.TrialBalanceRequest) (vos.TrialBalance, error)
+
+	// ListAccountChildrenFunc mocks the ListAccountChildren method.
+	ListAccountChildrenFunc func(contextMoqParam context.Context, accountChildrenRequest vos.AccountChildrenRequest) ([]vos.AccountChild, pagination.Cursor, error)
 
 	// ListAccountEntriesFunc mocks the ListAccountEntries method.
 	ListAccountEntriesFunc func(contextMoqParam context.Context, accountEntryRequest vos.AccountEntryRequest) ([]vos.AccountEntry, pagination.Cursor, error)
 
+	// ListAccountsFunc mocks the ListAccounts method.
+	ListAccountsFunc func(contextMoqParam context.Context, accountListRequest vos.AccountListRequest) ([]vos.AccountSummary, pagination.Cursor, error)
+
+	// ListBalanceLimitsFunc mocks the ListBalanceLimits method.
+	ListBalanceLimitsFunc func(contextMoqParam context.Context) ([]vos.BalanceLimit, error)
+
+	// ListCompetenceLockChangesFunc mocks the ListCompetenceLockChanges method.
+	ListCompetenceLockChangesFunc func(contextMoqParam context.Context, s string) ([]entities.CompetenceLockChange, error)
+
+	// ListCompetenceLocksFunc mocks the ListCompetenceLocks method.
+	ListCompetenceLocksFunc func(contextMoqParam context.Context, s string) ([]entities.CompetenceLock, error)
+
+	// ListEventMetadataSchemasFunc mocks the ListEventMetadataSchemas method.
+	ListEventMetadataSchemasFunc func(contextMoqParam context.Context, v uint32) ([]entities.EventMetadataSchema, error)
+
+	// ListEventsFunc mocks the ListEvents method.
+	ListEventsFunc func(contextMoqParam context.Context, b bool) ([]entities.Event, error)
+
+	// ListFiscalPeriodChangesFunc mocks the ListFiscalPeriodChanges method.
+	ListFiscalPeriodChangesFunc func(contextMoqParam context.Context, uUID uuid.UUID) ([]entities.FiscalPeriodChange, error)
+
+	// ListFiscalPeriodsFunc mocks the ListFiscalPeriods method.
+	ListFiscalPeriodsFunc func(contextMoqParam context.Context, s string) ([]entities.FiscalPeriod, error)
+
+	// ListScheduledTransactionsFunc mocks the ListScheduledTransactions method.
+	ListScheduledTransactionsFunc func(contextMoqParam context.Context, scheduleStatus vos.ScheduleStatus, page pagination.Page) ([]entities.ScheduledTransaction, pagination.Cursor, error)
+
+	// OpenAccountFunc mocks the OpenAccount method.
+	OpenAccountFunc func(contextMoqParam context.Context, account entities.Account) (entities.Account, error)
+
+	// ReopenFiscalPeriodFunc mocks the ReopenFiscalPeriod method.
+	ReopenFiscalPeriodFunc func(contextMoqParam context.Context, fiscalPeriod entities.FiscalPeriod, fiscalPeriodChange entities.FiscalPeriodChange) error
+
+	// RevertTransactionFunc mocks the RevertTransaction method.
+	RevertTransactionFunc func(contextMoqParam context.Context, reversal entities.Reversal) error
+
+	// ScheduleTransactionFunc mocks the ScheduleTransaction method.
+	ScheduleTransactionFunc func(contextMoqParam context.Context, scheduledTransaction entities.ScheduledTransaction) (entities.ScheduledTransaction, error)
+
+	// SetBalanceLimitFunc mocks the SetBalanceLimit method.
+	SetBalanceLimitFunc func(contextMoqParam context.Context, balanceLimit vos.BalanceLimit) error
+
+	// UpdateAccountStatusFunc mocks the UpdateAccountStatus method.
+	UpdateAccountStatusFunc func(contextMoqParam context.Context, account entities.Account, accountStatus vos.AccountStatus) (entities.Account, error)
+
+	// UpdateScheduledTransactionStatusFunc mocks the UpdateScheduledTransactionStatus method.
+	UpdateScheduledTransactionStatusFunc func(contextMoqParam context.Context, scheduledTransaction entities.ScheduledTransaction, scheduleStatus vos.ScheduleStatus) error
+
+	// VoidTransactionFunc mocks the VoidTransaction method.
+	VoidTransactionFunc func(contextMoqParam context.Context, pendingTransaction entities.PendingTransaction) error
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// AuthorizeTransaction holds details about calls to the AuthorizeTransaction method.
+		AuthorizeTransaction []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// PendingTransaction is the pendingTransaction argument value.
+			PendingTransaction entities.PendingTransaction
+		}
+		// CaptureTransaction holds details about calls to the CaptureTransaction method.
+		CaptureTransaction []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// Capture is the capture argument value.
+			Capture entities.Capture
+		}
+		// ChangeCompetenceLock holds details about calls to the ChangeCompetenceLock method.
+		ChangeCompetenceLock []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// CompetenceLockChange is the competenceLockChange argument value.
+			CompetenceLockChange entities.CompetenceLockChange
+		}
+		// ClaimDueScheduledTransactions holds details about calls to the ClaimDueScheduledTransactions method.
+		ClaimDueScheduledTransactions []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// N is the n argument value.
+			N int
+		}
+		// CloseFiscalPeriod holds details about calls to the CloseFiscalPeriod method.
+		CloseFiscalPeriod []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// FiscalPeriodClosing is the fiscalPeriodClosing argument value.
+			FiscalPeriodClosing entities.FiscalPeriodClosing
+		}
+		// CreateEvent holds details about calls to the CreateEvent method.
+		CreateEvent []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// Event is the event argument value.
+			Event entities.Event
+		}
+		// CreateEventMetadataSchema holds details about calls to the CreateEventMetadataSchema method.
+		CreateEventMetadataSchema []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// EventMetadataSchema is the eventMetadataSchema argument value.
+			EventMetadataSchema entities.EventMetadataSchema
+		}
+		// CreateFiscalPeriod holds details about calls to the CreateFiscalPeriod method.
+		CreateFiscalPeriod []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// FiscalPeriod is the fiscalPeriod argument value.
+			FiscalPeriod entities.FiscalPeriod
+		}
 		// CreateTransaction holds details about calls to the CreateTransaction method.
 		CreateTransaction []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
@@ -75,12 +372,58 @@ type RepositoryMock struct {
 			// Transaction is the transaction argument value.
 			Transaction entities.Transaction
 		}
+		// CreateTransactions holds details about calls to the CreateTransactions method.
+		CreateTransactions []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// Transactions is the transactions argument value.
+			Transactions []entities.Transaction
+		}
+		// CreateTransactionsBestEffort holds details about calls to the CreateTransactionsBestEffort method.
+		CreateTransactionsBestEffort []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// Transactions is the transactions argument value.
+			Transactions []entities.Transaction
+		}
+		// DeleteBalanceLimit holds details about calls to the DeleteBalanceLimit method.
+		DeleteBalanceLimit []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// Account is the account argument value.
+			Account vos.Account
+			// Currency is the currency argument value.
+			Currency vos.Currency
+		}
+		// DeprecateEvent holds details about calls to the DeprecateEvent method.
+		DeprecateEvent []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// Event is the event argument value.
+			Event entities.Event
+		}
+		// GetAccount holds details about calls to the GetAccount method.
+		GetAccount []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// Account is the account argument value.
+			Account vos.Account
+		}
 		// GetAnalyticAccountBalance holds details about calls to the GetAnalyticAccountBalance method.
 		GetAnalyticAccountBalance []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
 			// Account is the account argument value.
 			Account vos.Account
+			// Currency is the currency argument value.
+			Currency vos.Currency
+		}
+		// GetBalanceHistory holds details about calls to the GetBalanceHistory method.
+		GetBalanceHistory []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// BalanceHistoryRequest is the balanceHistoryRequest argument value.
+			BalanceHistoryRequest vos.BalanceHistoryRequest
 		}
 		// GetBoundedAccountBalance holds details about calls to the GetBoundedAccountBalance method.
 		GetBoundedAccountBalance []struct {
@@ -88,10 +431,56 @@ type RepositoryMock struct {
 			ContextMoqParam context.Context
 			// Account is the account argument value.
 			Account vos.Account
+			// Currency is the currency argument value.
+			Currency vos.Currency
 			// TimeMoqParam1 is the timeMoqParam1 argument value.
 			TimeMoqParam1 time.Time
 			// TimeMoqParam2 is the timeMoqParam2 argument value.
 			TimeMoqParam2 time.Time
+			// TimeView is the timeView argument value.
+			TimeView vos.TimeView
+		}
+		// GetEvent holds details about calls to the GetEvent method.
+		GetEvent []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// V is the v argument value.
+			V uint32
+		}
+		// GetFiscalPeriod holds details about calls to the GetFiscalPeriod method.
+		GetFiscalPeriod []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// UUID is the uUID argument value.
+			UUID uuid.UUID
+		}
+		// GetPendingTransaction holds details about calls to the GetPendingTransaction method.
+		GetPendingTransaction []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// UUID is the uUID argument value.
+			UUID uuid.UUID
+		}
+		// GetReversal holds details about calls to the GetReversal method.
+		GetReversal []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// UUID is the uUID argument value.
+			UUID uuid.UUID
+		}
+		// GetScheduledTransaction holds details about calls to the GetScheduledTransaction method.
+		GetScheduledTransaction []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// UUID is the uUID argument value.
+			UUID uuid.UUID
+		}
+		// GetStatementLines holds details about calls to the GetStatementLines method.
+		GetStatementLines []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// StatementRequest is the statementRequest argument value.
+			StatementRequest vos.StatementRequest
 		}
 		// GetSyntheticAccountBalance holds details about calls to the GetSyntheticAccountBalance method.
 		GetSyntheticAccountBalance []struct {
@@ -99,19 +488,36 @@ type RepositoryMock struct {
 			ContextMoqParam context.Context
 			// Account is the account argument value.
 			Account vos.Account
+			// Currency is the currency argument value.
+			Currency vos.Currency
 		}
 		// GetSyntheticReport holds details about calls to the GetSyntheticReport method.
 		GetSyntheticReport []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
-			// Account is the account argument value.
-			Account vos.Account
-			// N is the n argument value.
-			N int
-			// TimeMoqParam1 is the timeMoqParam1 argument value.
-			TimeMoqParam1 time.Time
-			// TimeMoqParam2 is the timeMoqParam2 argument value.
-			TimeMoqParam2 time.Time
+			// SyntheticReportRequest is the syntheticReportRequest argument value.
+			SyntheticReportRequest vos.SyntheticReportRequest
+		}
+		// GetTransaction holds details about calls to the GetTransaction method.
+		GetTransaction []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// UUID is the uUID argument value.
+			UUID uuid.UUID
+		}
+		// GetTrialBalance holds details about calls to the GetTrialBalance method.
+		GetTrialBalance []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// TrialBalanceRequest is the trialBalanceRequest argument value.
+			TrialBalanceRequest vos.TrialBalanceRequest
+		}
+		// ListAccountChildren holds details about calls to the ListAccountChildren method.
+		ListAccountChildren []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// AccountChildrenRequest is the accountChildrenRequest argument value.
+			AccountChildrenRequest vos.AccountChildrenRequest
 		}
 		// ListAccountEntries holds details about calls to the ListAccountEntries method.
 		ListAccountEntries []struct {
@@ -120,241 +526,1826 @@ type RepositoryMock struct {
 			// AccountEntryRequest is the accountEntryRequest argument value.
 			AccountEntryRequest vos.AccountEntryRequest
 		}
+		// ListAccounts holds details about calls to the ListAccounts method.
+		ListAccounts []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// AccountListRequest is the accountListRequest argument value.
+			AccountListRequest vos.AccountListRequest
+		}
+		// ListBalanceLimits holds details about calls to the ListBalanceLimits method.
+		ListBalanceLimits []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// ListCompetenceLockChanges holds details about calls to the ListCompetenceLockChanges method.
+		ListCompetenceLockChanges []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// S is the s argument value.
+			S string
+		}
+		// ListCompetenceLocks holds details about calls to the ListCompetenceLocks method.
+		ListCompetenceLocks []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// S is the s argument value.
+			S string
+		}
+		// ListEventMetadataSchemas holds details about calls to the ListEventMetadataSchemas method.
+		ListEventMetadataSchemas []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// V is the v argument value.
+			V uint32
+		}
+		// ListEvents holds details about calls to the ListEvents method.
+		ListEvents []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// B is the b argument value.
+			B bool
+		}
+		// ListFiscalPeriodChanges holds details about calls to the ListFiscalPeriodChanges method.
+		ListFiscalPeriodChanges []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// UUID is the uUID argument value.
+			UUID uuid.UUID
+		}
+		// ListFiscalPeriods holds details about calls to the ListFiscalPeriods method.
+		ListFiscalPeriods []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// S is the s argument value.
+			S string
+		}
+		// ListScheduledTransactions holds details about calls to the ListScheduledTransactions method.
+		ListScheduledTransactions []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// ScheduleStatus is the scheduleStatus argument value.
+			ScheduleStatus vos.ScheduleStatus
+			// Page is the page argument value.
+			Page pagination.Page
+		}
+		// OpenAccount holds details about calls to the OpenAccount method.
+		OpenAccount []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// Account is the account argument value.
+			Account entities.Account
+		}
+		// ReopenFiscalPeriod holds details about calls to the ReopenFiscalPeriod method.
+		ReopenFiscalPeriod []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// FiscalPeriod is the fiscalPeriod argument value.
+			FiscalPeriod entities.FiscalPeriod
+			// FiscalPeriodChange is the fiscalPeriodChange argument value.
+			FiscalPeriodChange entities.FiscalPeriodChange
+		}
+		// RevertTransaction holds details about calls to the RevertTransaction method.
+		RevertTransaction []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// Reversal is the reversal argument value.
+			Reversal entities.Reversal
+		}
+		// ScheduleTransaction holds details about calls to the ScheduleTransaction method.
+		ScheduleTransaction []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// ScheduledTransaction is the scheduledTransaction argument value.
+			ScheduledTransaction entities.ScheduledTransaction
+		}
+		// SetBalanceLimit holds details about calls to the SetBalanceLimit method.
+		SetBalanceLimit []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// BalanceLimit is the balanceLimit argument value.
+			BalanceLimit vos.BalanceLimit
+		}
+		// UpdateAccountStatus holds details about calls to the UpdateAccountStatus method.
+		UpdateAccountStatus []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// Account is the account argument value.
+			Account entities.Account
+			// AccountStatus is the accountStatus argument value.
+			AccountStatus vos.AccountStatus
+		}
+		// UpdateScheduledTransactionStatus holds details about calls to the UpdateScheduledTransactionStatus method.
+		UpdateScheduledTransactionStatus []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// ScheduledTransaction is the scheduledTransaction argument value.
+			ScheduledTransaction entities.ScheduledTransaction
+			// ScheduleStatus is the scheduleStatus argument value.
+			ScheduleStatus vos.ScheduleStatus
+		}
+		// VoidTransaction holds details about calls to the VoidTransaction method.
+		VoidTransaction []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// PendingTransaction is the pendingTransaction argument value.
+			PendingTransaction entities.PendingTransaction
+		}
 	}
-	lockCreateTransaction          sync.RWMutex
-	lockGetAnalyticAccountBalance  sync.RWMutex
-	lockGetBoundedAccountBalance   sync.RWMutex
-	lockGetSyntheticAccountBalance sync.RWMutex
-	lockGetSyntheticReport         sync.RWMutex
-	lockListAccountEntries         sync.RWMutex
+	lockAuthorizeTransaction             sync.RWMutex
+	lockCaptureTransaction               sync.RWMutex
+	lockChangeCompetenceLock             sync.RWMutex
+	lockClaimDueScheduledTransactions    sync.RWMutex
+	lockCloseFiscalPeriod                sync.RWMutex
+	lockCreateEvent                      sync.RWMutex
+	lockCreateEventMetadataSchema        sync.RWMutex
+	lockCreateFiscalPeriod               sync.RWMutex
+	lockCreateTransaction                sync.RWMutex
+	lockCreateTransactions               sync.RWMutex
+	lockCreateTransactionsBestEffort     sync.RWMutex
+	lockDeleteBalanceLimit               sync.RWMutex
+	lockDeprecateEvent                   sync.RWMutex
+	lockGetAccount                       sync.RWMutex
+	lockGetAnalyticAccountBalance        sync.RWMutex
+	lockGetBalanceHistory                sync.RWMutex
+	lockGetBoundedAccountBalance         sync.RWMutex
+	lockGetEvent                         sync.RWMutex
+	lockGetFiscalPeriod                  sync.RWMutex
+	lockGetPendingTransaction            sync.RWMutex
+	lockGetReversal                      sync.RWMutex
+	lockGetScheduledTransaction          sync.RWMutex
+	lockGetStatementLines                sync.RWMutex
+	lockGetSyntheticAccountBalance       sync.RWMutex
+	lockGetSyntheticReport               sync.RWMutex
+	lockGetTransaction                   sync.RWMutex
+	lockGetTrialBalance                  sync.RWMutex
+	lockListAccountChildren              sync.RWMutex
+	lockListAccountEntries               sync.RWMutex
+	lockListAccounts                     sync.RWMutex
+	lockListBalanceLimits                sync.RWMutex
+	lockListCompetenceLockChanges        sync.RWMutex
+	lockListCompetenceLocks              sync.RWMutex
+	lockListEventMetadataSchemas         sync.RWMutex
+	lockListEvents                       sync.RWMutex
+	lockListFiscalPeriodChanges          sync.RWMutex
+	lockListFiscalPeriods                sync.RWMutex
+	lockListScheduledTransactions        sync.RWMutex
+	lockOpenAccount                      sync.RWMutex
+	lockReopenFiscalPeriod               sync.RWMutex
+	lockRevertTransaction                sync.RWMutex
+	lockScheduleTransaction              sync.RWMutex
+	lockSetBalanceLimit                  sync.RWMutex
+	lockUpdateAccountStatus              sync.RWMutex
+	lockUpdateScheduledTransactionStatus sync.RWMutex
+	lockVoidTransaction                  sync.RWMutex
 }
 
-// CreateTransaction calls CreateTransactionFunc.
-func (mock *RepositoryMock) CreateTransaction(contextMoqParam context.Context, transaction entities.Transaction) error {
-	if mock.CreateTransactionFunc == nil {
-		panic("RepositoryMock.CreateTransactionFunc: method is nil but Repository.CreateTransaction was just called")
+// AuthorizeTransaction calls AuthorizeTransactionFunc.
+func (mock *RepositoryMock) AuthorizeTransaction(contextMoqParam context.Context, pendingTransaction entities.PendingTransaction) (entities.PendingTransaction, error) {
+	if mock.AuthorizeTransactionFunc == nil {
+		panic("RepositoryMock.AuthorizeTransactionFunc: method is nil but Repository.AuthorizeTransaction was just called")
 	}
 	callInfo := struct {
-		ContextMoqParam context.Context
-		Transaction     entities.Transaction
+		ContextMoqParam    context.Context
+		PendingTransaction entities.PendingTransaction
 	}{
-		ContextMoqParam: contextMoqParam,
-		Transaction:     transaction,
+		ContextMoqParam:    contextMoqParam,
+		PendingTransaction: pendingTransaction,
 	}
-	mock.lockCreateTransaction.Lock()
-	mock.calls.CreateTransaction = append(mock.calls.CreateTransaction, callInfo)
-	mock.lockCreateTransaction.Unlock()
-	return mock.CreateTransactionFunc(contextMoqParam, transaction)
+	mock.lockAuthorizeTransaction.Lock()
+	mock.calls.AuthorizeTransaction = append(mock.calls.AuthorizeTransaction, callInfo)
+	mock.lockAuthorizeTransaction.Unlock()
+	return mock.AuthorizeTransactionFunc(contextMoqParam, pendingTransaction)
 }
 
-// CreateTransactionCalls gets all the calls that were made to CreateTransaction.
+// AuthorizeTransactionCalls gets all the calls that were made to AuthorizeTransaction.
 // Check the length with:
-//     len(mockedRepository.CreateTransactionCalls())
-func (mock *RepositoryMock) CreateTransactionCalls() []struct {
-	ContextMoqParam context.Context
-	Transaction     entities.Transaction
+//     len(mockedRepository.AuthorizeTransactionCalls())
+func (mock *RepositoryMock) AuthorizeTransactionCalls() []struct {
+	ContextMoqParam    context.Context
+	PendingTransaction entities.PendingTransaction
 } {
 	var calls []struct {
-		ContextMoqParam context.Context
-		Transaction     entities.Transaction
+		ContextMoqParam    context.Context
+		PendingTransaction entities.PendingTransaction
 	}
-	mock.lockCreateTransaction.RLock()
-	calls = mock.calls.CreateTransaction
-	mock.lockCreateTransaction.RUnlock()
+	mock.lockAuthorizeTransaction.RLock()
+	calls = mock.calls.AuthorizeTransaction
+	mock.lockAuthorizeTransaction.RUnlock()
 	return calls
 }
 
-// GetAnalyticAccountBalance calls GetAnalyticAccountBalanceFunc.
-func (mock *RepositoryMock) GetAnalyticAccountBalance(contextMoqParam context.Context, account vos.Account) (vos.AccountBalance, error) {
-	if mock.GetAnalyticAccountBalanceFunc == nil {
-		panic("RepositoryMock.GetAnalyticAccountBalanceFunc: method is nil but Repository.GetAnalyticAccountBalance was just called")
+// CaptureTransaction calls CaptureTransactionFunc.
+func (mock *RepositoryMock) CaptureTransaction(contextMoqParam context.Context, capture entities.Capture) error {
+	if mock.CaptureTransactionFunc == nil {
+		panic("RepositoryMock.CaptureTransactionFunc: method is nil but Repository.CaptureTransaction was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
-		Account         vos.Account
+		Capture         entities.Capture
 	}{
 		ContextMoqParam: contextMoqParam,
-		Account:         account,
+		Capture:         capture,
 	}
-	mock.lockGetAnalyticAccountBalance.Lock()
-	mock.calls.GetAnalyticAccountBalance = append(mock.calls.GetAnalyticAccountBalance, callInfo)
-	mock.lockGetAnalyticAccountBalance.Unlock()
-	return mock.GetAnalyticAccountBalanceFunc(contextMoqParam, account)
+	mock.lockCaptureTransaction.Lock()
+	mock.calls.CaptureTransaction = append(mock.calls.CaptureTransaction, callInfo)
+	mock.lockCaptureTransaction.Unlock()
+	return mock.CaptureTransactionFunc(contextMoqParam, capture)
 }
 
-// GetAnalyticAccountBalanceCalls gets all the calls that were made to GetAnalyticAccountBalance.
+// CaptureTransactionCalls gets all the calls that were made to CaptureTransaction.
 // Check the length with:
-//     len(mockedRepository.GetAnalyticAccountBalanceCalls())
-func (mock *RepositoryMock) GetAnalyticAccountBalanceCalls() []struct {
+//     len(mockedRepository.CaptureTransactionCalls())
+func (mock *RepositoryMock) CaptureTransactionCalls() []struct {
 	ContextMoqParam context.Context
-	Account         vos.Account
+	Capture         entities.Capture
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
-		Account         vos.Account
+		Capture         entities.Capture
 	}
-	mock.lockGetAnalyticAccountBalance.RLock()
-	calls = mock.calls.GetAnalyticAccountBalance
-	mock.lockGetAnalyticAccountBalance.RUnlock()
+	mock.lockCaptureTransaction.RLock()
+	calls = mock.calls.CaptureTransaction
+	mock.lockCaptureTransaction.RUnlock()
 	return calls
 }
 
-// GetBoundedAccountBalance calls GetBoundedAccountBalanceFunc.
-func (mock *RepositoryMock) GetBoundedAccountBalance(contextMoqParam context.Context, account vos.Account, timeMoqParam1 time.Time, timeMoqParam2 time.Time) (vos.AccountBalance, error) {
-	if mock.GetBoundedAccountBalanceFunc == nil {
-		panic("RepositoryMock.GetBoundedAccountBalanceFunc: method is nil but Repository.GetBoundedAccountBalance was just called")
+// ChangeCompetenceLock calls ChangeCompetenceLockFunc.
+func (mock *RepositoryMock) ChangeCompetenceLock(contextMoqParam context.Context, competenceLockChange entities.CompetenceLockChange) (entities.CompetenceLockChange, error) {
+	if mock.ChangeCompetenceLockFunc == nil {
+		panic("RepositoryMock.ChangeCompetenceLockFunc: method is nil but Repository.ChangeCompetenceLock was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam      context.Context
+		CompetenceLockChange entities.CompetenceLockChange
+	}{
+		ContextMoqParam:      contextMoqParam,
+		CompetenceLockChange: competenceLockChange,
+	}
+	mock.lockChangeCompetenceLock.Lock()
+	mock.calls.ChangeCompetenceLock = append(mock.calls.ChangeCompetenceLock, callInfo)
+	mock.lockChangeCompetenceLock.Unlock()
+	return mock.ChangeCompetenceLockFunc(contextMoqParam, competenceLockChange)
+}
+
+// ChangeCompetenceLockCalls gets all the calls that were made to ChangeCompetenceLock.
+// Check the length with:
+//     len(mockedRepository.ChangeCompetenceLockCalls())
+func (mock *RepositoryMock) ChangeCompetenceLockCalls() []struct {
+	ContextMoqParam      context.Context
+	CompetenceLockChange entities.CompetenceLockChange
+} {
+	var calls []struct {
+		ContextMoqParam      context.Context
+		CompetenceLockChange entities.CompetenceLockChange
+	}
+	mock.lockChangeCompetenceLock.RLock()
+	calls = mock.calls.ChangeCompetenceLock
+	mock.lockChangeCompetenceLock.RUnlock()
+	return calls
+}
+
+// ClaimDueScheduledTransactions calls ClaimDueScheduledTransactionsFunc.
+func (mock *RepositoryMock) ClaimDueScheduledTransactions(contextMoqParam context.Context, n int) ([]entities.ScheduledTransaction, error) {
+	if mock.ClaimDueScheduledTransactionsFunc == nil {
+		panic("RepositoryMock.ClaimDueScheduledTransactionsFunc: method is nil but Repository.ClaimDueScheduledTransactions was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
-		Account         vos.Account
-		TimeMoqParam1   time.Time
-		TimeMoqParam2   time.Time
+		N               int
 	}{
 		ContextMoqParam: contextMoqParam,
-		Account:         account,
-		TimeMoqParam1:   timeMoqParam1,
-		TimeMoqParam2:   timeMoqParam2,
+		N:               n,
 	}
-	mock.lockGetBoundedAccountBalance.Lock()
-	mock.calls.GetBoundedAccountBalance = append(mock.calls.GetBoundedAccountBalance, callInfo)
-	mock.lockGetBoundedAccountBalance.Unlock()
-	return mock.GetBoundedAccountBalanceFunc(contextMoqParam, account, timeMoqParam1, timeMoqParam2)
+	mock.lockClaimDueScheduledTransactions.Lock()
+	mock.calls.ClaimDueScheduledTransactions = append(mock.calls.ClaimDueScheduledTransactions, callInfo)
+	mock.lockClaimDueScheduledTransactions.Unlock()
+	return mock.ClaimDueScheduledTransactionsFunc(contextMoqParam, n)
 }
 
-// GetBoundedAccountBalanceCalls gets all the calls that were made to GetBoundedAccountBalance.
+// ClaimDueScheduledTransactionsCalls gets all the calls that were made to ClaimDueScheduledTransactions.
 // Check the length with:
-//     len(mockedRepository.GetBoundedAccountBalanceCalls())
-func (mock *RepositoryMock) GetBoundedAccountBalanceCalls() []struct {
+//     len(mockedRepository.ClaimDueScheduledTransactionsCalls())
+func (mock *RepositoryMock) ClaimDueScheduledTransactionsCalls() []struct {
 	ContextMoqParam context.Context
-	Account         vos.Account
-	TimeMoqParam1   time.Time
-	TimeMoqParam2   time.Time
+	N               int
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
-		Account         vos.Account
-		TimeMoqParam1   time.Time
-		TimeMoqParam2   time.Time
+		N               int
 	}
-	mock.lockGetBoundedAccountBalance.RLock()
-	calls = mock.calls.GetBoundedAccountBalance
-	mock.lockGetBoundedAccountBalance.RUnlock()
+	mock.lockClaimDueScheduledTransactions.RLock()
+	calls = mock.calls.ClaimDueScheduledTransactions
+	mock.lockClaimDueScheduledTransactions.RUnlock()
 	return calls
 }
 
-// GetSyntheticAccountBalance calls GetSyntheticAccountBalanceFunc.
-func (mock *RepositoryMock) GetSyntheticAccountBalance(contextMoqParam context.Context, account vos.Account) (vos.AccountBalance, error) {
-	if mock.GetSyntheticAccountBalanceFunc == nil {
-		panic("RepositoryMock.GetSyntheticAccountBalanceFunc: method is nil but Repository.GetSyntheticAccountBalance was just called")
+// CloseFiscalPeriod calls CloseFiscalPeriodFunc.
+func (mock *RepositoryMock) CloseFiscalPeriod(contextMoqParam context.Context, fiscalPeriodClosing entities.FiscalPeriodClosing) (entities.FiscalPeriodClosing, error) {
+	if mock.CloseFiscalPeriodFunc == nil {
+		panic("RepositoryMock.CloseFiscalPeriodFunc: method is nil but Repository.CloseFiscalPeriod was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam     context.Context
+		FiscalPeriodClosing entities.FiscalPeriodClosing
+	}{
+		ContextMoqParam:     contextMoqParam,
+		FiscalPeriodClosing: fiscalPeriodClosing,
+	}
+	mock.lockCloseFiscalPeriod.Lock()
+	mock.calls.CloseFiscalPeriod = append(mock.calls.CloseFiscalPeriod, callInfo)
+	mock.lockCloseFiscalPeriod.Unlock()
+	return mock.CloseFiscalPeriodFunc(contextMoqParam, fiscalPeriodClosing)
+}
+
+// CloseFiscalPeriodCalls gets all the calls that were made to CloseFiscalPeriod.
+// Check the length with:
+//     len(mockedRepository.CloseFiscalPeriodCalls())
+func (mock *RepositoryMock) CloseFiscalPeriodCalls() []struct {
+	ContextMoqParam     context.Context
+	FiscalPeriodClosing entities.FiscalPeriodClosing
+} {
+	var calls []struct {
+		ContextMoqParam     context.Context
+		FiscalPeriodClosing entities.FiscalPeriodClosing
+	}
+	mock.lockCloseFiscalPeriod.RLock()
+	calls = mock.calls.CloseFiscalPeriod
+	mock.lockCloseFiscalPeriod.RUnlock()
+	return calls
+}
+
+// CreateEvent calls CreateEventFunc.
+func (mock *RepositoryMock) CreateEvent(contextMoqParam context.Context, event entities.Event) (entities.Event, error) {
+	if mock.CreateEventFunc == nil {
+		panic("RepositoryMock.CreateEventFunc: method is nil but Repository.CreateEvent was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		Event           entities.Event
+	}{
+		ContextMoqParam: contextMoqParam,
+		Event:           event,
+	}
+	mock.lockCreateEvent.Lock()
+	mock.calls.CreateEvent = append(mock.calls.CreateEvent, callInfo)
+	mock.lockCreateEvent.Unlock()
+	return mock.CreateEventFunc(contextMoqParam, event)
+}
+
+// CreateEventCalls gets all the calls that were made to CreateEvent.
+// Check the length with:
+//     len(mockedRepository.CreateEventCalls())
+func (mock *RepositoryMock) CreateEventCalls() []struct {
+	ContextMoqParam context.Context
+	Event           entities.Event
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		Event           entities.Event
+	}
+	mock.lockCreateEvent.RLock()
+	calls = mock.calls.CreateEvent
+	mock.lockCreateEvent.RUnlock()
+	return calls
+}
+
+// CreateEventMetadataSchema calls CreateEventMetadataSchemaFunc.
+func (mock *RepositoryMock) CreateEventMetadataSchema(contextMoqParam context.Context, eventMetadataSchema entities.EventMetadataSchema) (entities.EventMetadataSchema, error) {
+	if mock.CreateEventMetadataSchemaFunc == nil {
+		panic("RepositoryMock.CreateEventMetadataSchemaFunc: method is nil but Repository.CreateEventMetadataSchema was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam     context.Context
+		EventMetadataSchema entities.EventMetadataSchema
+	}{
+		ContextMoqParam:     contextMoqParam,
+		EventMetadataSchema: eventMetadataSchema,
+	}
+	mock.lockCreateEventMetadataSchema.Lock()
+	mock.calls.CreateEventMetadataSchema = append(mock.calls.CreateEventMetadataSchema, callInfo)
+	mock.lockCreateEventMetadataSchema.Unlock()
+	return mock.CreateEventMetadataSchemaFunc(contextMoqParam, eventMetadataSchema)
+}
+
+// CreateEventMetadataSchemaCalls gets all the calls that were made to CreateEventMetadataSchema.
+// Check the length with:
+//     len(mockedRepository.CreateEventMetadataSchemaCalls())
+func (mock *RepositoryMock) CreateEventMetadataSchemaCalls() []struct {
+	ContextMoqParam     context.Context
+	EventMetadataSchema entities.EventMetadataSchema
+} {
+	var calls []struct {
+		ContextMoqParam     context.Context
+		EventMetadataSchema entities.EventMetadataSchema
+	}
+	mock.lockCreateEventMetadataSchema.RLock()
+	calls = mock.calls.CreateEventMetadataSchema
+	mock.lockCreateEventMetadataSchema.RUnlock()
+	return calls
+}
+
+// CreateFiscalPeriod calls CreateFiscalPeriodFunc.
+func (mock *RepositoryMock) CreateFiscalPeriod(contextMoqParam context.Context, fiscalPeriod entities.FiscalPeriod) (entities.FiscalPeriod, error) {
+	if mock.CreateFiscalPeriodFunc == nil {
+		panic("RepositoryMock.CreateFiscalPeriodFunc: method is nil but Repository.CreateFiscalPeriod was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		FiscalPeriod    entities.FiscalPeriod
+	}{
+		ContextMoqParam: contextMoqParam,
+		FiscalPeriod:    fiscalPeriod,
+	}
+	mock.lockCreateFiscalPeriod.Lock()
+	mock.calls.CreateFiscalPeriod = append(mock.calls.CreateFiscalPeriod, callInfo)
+	mock.lockCreateFiscalPeriod.Unlock()
+	return mock.CreateFiscalPeriodFunc(contextMoqParam, fiscalPeriod)
+}
+
+// CreateFiscalPeriodCalls gets all the calls that were made to CreateFiscalPeriod.
+// Check the length with:
+//     len(mockedRepository.CreateFiscalPeriodCalls())
+func (mock *RepositoryMock) CreateFiscalPeriodCalls() []struct {
+	ContextMoqParam context.Context
+	FiscalPeriod    entities.FiscalPeriod
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		FiscalPeriod    entities.FiscalPeriod
+	}
+	mock.lockCreateFiscalPeriod.RLock()
+	calls = mock.calls.CreateFiscalPeriod
+	mock.lockCreateFiscalPeriod.RUnlock()
+	return calls
+}
+
+// CreateTransaction calls CreateTransactionFunc.
+func (mock *RepositoryMock) CreateTransaction(contextMoqParam context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+	if mock.CreateTransactionFunc == nil {
+		panic("RepositoryMock.CreateTransactionFunc: method is nil but Repository.CreateTransaction was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		Transaction     entities.Transaction
+	}{
+		ContextMoqParam: contextMoqParam,
+		Transaction:     transaction,
+	}
+	mock.lockCreateTransaction.Lock()
+	mock.calls.CreateTransaction = append(mock.calls.CreateTransaction, callInfo)
+	mock.lockCreateTransaction.Unlock()
+	return mock.CreateTransactionFunc(contextMoqParam, transaction)
+}
+
+// CreateTransactionCalls gets all the calls that were made to CreateTransaction.
+// Check the length with:
+//     len(mockedRepository.CreateTransactionCalls())
+func (mock *RepositoryMock) CreateTransactionCalls() []struct {
+	ContextMoqParam context.Context
+	Transaction     entities.Transaction
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		Transaction     entities.Transaction
+	}
+	mock.lockCreateTransaction.RLock()
+	calls = mock.calls.CreateTransaction
+	mock.lockCreateTransaction.RUnlock()
+	return calls
+}
+
+// CreateTransactions calls CreateTransactionsFunc.
+func (mock *RepositoryMock) CreateTransactions(contextMoqParam context.Context, transactions []entities.Transaction) error {
+	if mock.CreateTransactionsFunc == nil {
+		panic("RepositoryMock.CreateTransactionsFunc: method is nil but Repository.CreateTransactions was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		Transactions    []entities.Transaction
+	}{
+		ContextMoqParam: contextMoqParam,
+		Transactions:    transactions,
+	}
+	mock.lockCreateTransactions.Lock()
+	mock.calls.CreateTransactions = append(mock.calls.CreateTransactions, callInfo)
+	mock.lockCreateTransactions.Unlock()
+	return mock.CreateTransactionsFunc(contextMoqParam, transactions)
+}
+
+// CreateTransactionsCalls gets all the calls that were made to CreateTransactions.
+// Check the length with:
+//     len(mockedRepository.CreateTransactionsCalls())
+func (mock *RepositoryMock) CreateTransactionsCalls() []struct {
+	ContextMoqParam context.Context
+	Transactions    []entities.Transaction
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		Transactions    []entities.Transaction
+	}
+	mock.lockCreateTransactions.RLock()
+	calls = mock.calls.CreateTransactions
+	mock.lockCreateTransactions.RUnlock()
+	return calls
+}
+
+// CreateTransactionsBestEffort calls CreateTransactionsBestEffortFunc.
+func (mock *RepositoryMock) CreateTransactionsBestEffort(contextMoqParam context.Context, transactions []entities.Transaction) ([]error, error) {
+	if mock.CreateTransactionsBestEffortFunc == nil {
+		panic("RepositoryMock.CreateTransactionsBestEffortFunc: method is nil but Repository.CreateTransactionsBestEffort was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		Transactions    []entities.Transaction
+	}{
+		ContextMoqParam: contextMoqParam,
+		Transactions:    transactions,
+	}
+	mock.lockCreateTransactionsBestEffort.Lock()
+	mock.calls.CreateTransactionsBestEffort = append(mock.calls.CreateTransactionsBestEffort, callInfo)
+	mock.lockCreateTransactionsBestEffort.Unlock()
+	return mock.CreateTransactionsBestEffortFunc(contextMoqParam, transactions)
+}
+
+// CreateTransactionsBestEffortCalls gets all the calls that were made to CreateTransactionsBestEffort.
+// Check the length with:
+//     len(mockedRepository.CreateTransactionsBestEffortCalls())
+func (mock *RepositoryMock) CreateTransactionsBestEffortCalls() []struct {
+	ContextMoqParam context.Context
+	Transactions    []entities.Transaction
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		Transactions    []entities.Transaction
+	}
+	mock.lockCreateTransactionsBestEffort.RLock()
+	calls = mock.calls.CreateTransactionsBestEffort
+	mock.lockCreateTransactionsBestEffort.RUnlock()
+	return calls
+}
+
+// DeleteBalanceLimit calls DeleteBalanceLimitFunc.
+func (mock *RepositoryMock) DeleteBalanceLimit(contextMoqParam context.Context, account vos.Account, currency vos.Currency) error {
+	if mock.DeleteBalanceLimitFunc == nil {
+		panic("RepositoryMock.DeleteBalanceLimitFunc: method is nil but Repository.DeleteBalanceLimit was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
 		Account         vos.Account
+		Currency        vos.Currency
 	}{
 		ContextMoqParam: contextMoqParam,
 		Account:         account,
+		Currency:        currency,
 	}
-	mock.lockGetSyntheticAccountBalance.Lock()
-	mock.calls.GetSyntheticAccountBalance = append(mock.calls.GetSyntheticAccountBalance, callInfo)
-	mock.lockGetSyntheticAccountBalance.Unlock()
-	return mock.GetSyntheticAccountBalanceFunc(contextMoqParam, account)
+	mock.lockDeleteBalanceLimit.Lock()
+	mock.calls.DeleteBalanceLimit = append(mock.calls.DeleteBalanceLimit, callInfo)
+	mock.lockDeleteBalanceLimit.Unlock()
+	return mock.DeleteBalanceLimitFunc(contextMoqParam, account, currency)
 }
 
-// GetSyntheticAccountBalanceCalls gets all the calls that were made to GetSyntheticAccountBalance.
+// DeleteBalanceLimitCalls gets all the calls that were made to DeleteBalanceLimit.
 // Check the length with:
-//     len(mockedRepository.GetSyntheticAccountBalanceCalls())
-func (mock *RepositoryMock) GetSyntheticAccountBalanceCalls() []struct {
+//     len(mockedRepository.DeleteBalanceLimitCalls())
+func (mock *RepositoryMock) DeleteBalanceLimitCalls() []struct {
 	ContextMoqParam context.Context
 	Account         vos.Account
+	Currency        vos.Currency
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
 		Account         vos.Account
+		Currency        vos.Currency
 	}
-	mock.lockGetSyntheticAccountBalance.RLock()
-	calls = mock.calls.GetSyntheticAccountBalance
-	mock.lockGetSyntheticAccountBalance.RUnlock()
+	mock.lockDeleteBalanceLimit.RLock()
+	calls = mock.calls.DeleteBalanceLimit
+	mock.lockDeleteBalanceLimit.RUnlock()
 	return calls
 }
 
-// GetSyntheticReport calls GetSyntheticReportFunc.
-func (mock *RepositoryMock) GetSyntheticReport(contextMoqParam context.Context, account vos.Account, n int, timeMoqParam1 time.Time, timeMoqParam2 time.Time) (*vos.SyntheticReport, error) {
-	if mock.GetSyntheticReportFunc == nil {
-		panic("RepositoryMock.GetSyntheticReportFunc: method is nil but Repository.GetSyntheticReport was just called")
+// DeprecateEvent calls DeprecateEventFunc.
+func (mock *RepositoryMock) DeprecateEvent(contextMoqParam context.Context, event entities.Event) error {
+	if mock.DeprecateEventFunc == nil {
+		panic("RepositoryMock.DeprecateEventFunc: method is nil but Repository.DeprecateEvent was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		Event           entities.Event
+	}{
+		ContextMoqParam: contextMoqParam,
+		Event:           event,
+	}
+	mock.lockDeprecateEvent.Lock()
+	mock.calls.DeprecateEvent = append(mock.calls.DeprecateEvent, callInfo)
+	mock.lockDeprecateEvent.Unlock()
+	return mock.DeprecateEventFunc(contextMoqParam, event)
+}
+
+// DeprecateEventCalls gets all the calls that were made to DeprecateEvent.
+// Check the length with:
+//     len(mockedRepository.DeprecateEventCalls())
+func (mock *RepositoryMock) DeprecateEventCalls() []struct {
+	ContextMoqParam context.Context
+	Event           entities.Event
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		Event           entities.Event
+	}
+	mock.lockDeprecateEvent.RLock()
+	calls = mock.calls.DeprecateEvent
+	mock.lockDeprecateEvent.RUnlock()
+	return calls
+}
+
+// GetAccount calls GetAccountFunc.
+func (mock *RepositoryMock) GetAccount(contextMoqParam context.Context, account vos.Account) (entities.Account, error) {
+	if mock.GetAccountFunc == nil {
+		panic("RepositoryMock.GetAccountFunc: method is nil but Repository.GetAccount was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
 		Account         vos.Account
-		N               int
-		TimeMoqParam1   time.Time
-		TimeMoqParam2   time.Time
 	}{
 		ContextMoqParam: contextMoqParam,
 		Account:         account,
-		N:               n,
-		TimeMoqParam1:   timeMoqParam1,
-		TimeMoqParam2:   timeMoqParam2,
 	}
-	mock.lockGetSyntheticReport.Lock()
-	mock.calls.GetSyntheticReport = append(mock.calls.GetSyntheticReport, callInfo)
-	mock.lockGetSyntheticReport.Unlock()
-	return mock.GetSyntheticReportFunc(contextMoqParam, account, n, timeMoqParam1, timeMoqParam2)
+	mock.lockGetAccount.Lock()
+	mock.calls.GetAccount = append(mock.calls.GetAccount, callInfo)
+	mock.lockGetAccount.Unlock()
+	return mock.GetAccountFunc(contextMoqParam, account)
 }
 
-// GetSyntheticReportCalls gets all the calls that were made to GetSyntheticReport.
+// GetAccountCalls gets all the calls that were made to GetAccount.
 // Check the length with:
-//     len(mockedRepository.GetSyntheticReportCalls())
-func (mock *RepositoryMock) GetSyntheticReportCalls() []struct {
+//     len(mockedRepository.GetAccountCalls())
+func (mock *RepositoryMock) GetAccountCalls() []struct {
 	ContextMoqParam context.Context
 	Account         vos.Account
-	N               int
-	TimeMoqParam1   time.Time
-	TimeMoqParam2   time.Time
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
 		Account         vos.Account
-		N               int
-		TimeMoqParam1   time.Time
-		TimeMoqParam2   time.Time
 	}
-	mock.lockGetSyntheticReport.RLock()
-	calls = mock.calls.GetSyntheticReport
-	mock.lockGetSyntheticReport.RUnlock()
+	mock.lockGetAccount.RLock()
+	calls = mock.calls.GetAccount
+	mock.lockGetAccount.RUnlock()
 	return calls
 }
 
-// ListAccountEntries calls ListAccountEntriesFunc.
-func (mock *RepositoryMock) ListAccountEntries(contextMoqParam context.Context, accountEntryRequest vos.AccountEntryRequest) ([]vos.AccountEntry, pagination.Cursor, error) {
-	if mock.ListAccountEntriesFunc == nil {
-		panic("RepositoryMock.ListAccountEntriesFunc: method is nil but Repository.ListAccountEntries was just called")
+// GetAnalyticAccountBalance calls GetAnalyticAccountBalanceFunc.
+func (mock *RepositoryMock) GetAnalyticAccountBalance(contextMoqParam context.Context, account vos.Account, currency vos.Currency) (vos.AccountBalance, error) {
+	if mock.GetAnalyticAccountBalanceFunc == nil {
+		panic("RepositoryMock.GetAnalyticAccountBalanceFunc: method is nil but Repository.GetAnalyticAccountBalance was just called")
 	}
 	callInfo := struct {
-		ContextMoqParam     context.Context
-		AccountEntryRequest vos.AccountEntryRequest
+		ContextMoqParam context.Context
+		Account         vos.Account
+		Currency        vos.Currency
 	}{
-		ContextMoqParam:     contextMoqParam,
-		AccountEntryRequest: accountEntryRequest,
+		ContextMoqParam: contextMoqParam,
+		Account:         account,
+		Currency:        currency,
 	}
-	mock.lockListAccountEntries.Lock()
-	mock.calls.ListAccountEntries = append(mock.calls.ListAccountEntries, callInfo)
-	mock.lockListAccountEntries.Unlock()
-	return mock.ListAccountEntriesFunc(contextMoqParam, accountEntryRequest)
+	mock.lockGetAnalyticAccountBalance.Lock()
+	mock.calls.GetAnalyticAccountBalance = append(mock.calls.GetAnalyticAccountBalance, callInfo)
+	mock.lockGetAnalyticAccountBalance.Unlock()
+	return mock.GetAnalyticAccountBalanceFunc(contextMoqParam, account, currency)
 }
 
-// ListAccountEntriesCalls gets all the calls that were made to ListAccountEntries.
+// GetAnalyticAccountBalanceCalls gets all the calls that were made to GetAnalyticAccountBalance.
 // Check the length with:
-//     len(mockedRepository.ListAccountEntriesCalls())
-func (mock *RepositoryMock) ListAccountEntriesCalls() []struct {
-	ContextMoqParam     context.Context
-	AccountEntryRequest vos.AccountEntryRequest
-} {
+//     len(mockedRepository.GetAnalyticAccountBalanceCalls())
+func (mock *RepositoryMock) GetAnalyticAccountBalanceCalls() []struct {
+	ContextMoqParam context.Context
+	Account         vos.Account
+	Currency        vos.Currency
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		Account         vos.Account
+		Currency        vos.Currency
+	}
+	mock.lockGetAnalyticAccountBalance.RLock()
+	calls = mock.calls.GetAnalyticAccountBalance
+	mock.lockGetAnalyticAccountBalance.RUnlock()
+	return calls
+}
+
+// GetBalanceHistory calls GetBalanceHistoryFunc.
+func (mock *RepositoryMock) GetBalanceHistory(contextMoqParam context.Context, balanceHistoryRequest vos.BalanceHistoryRequest) (vos.BalanceHistory, error) {
+	if mock.GetBalanceHistoryFunc == nil {
+		panic("RepositoryMock.GetBalanceHistoryFunc: method is nil but Repository.GetBalanceHistory was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam       context.Context
+		BalanceHistoryRequest vos.BalanceHistoryRequest
+	}{
+		ContextMoqParam:       contextMoqParam,
+		BalanceHistoryRequest: balanceHistoryRequest,
+	}
+	mock.lockGetBalanceHistory.Lock()
+	mock.calls.GetBalanceHistory = append(mock.calls.GetBalanceHistory, callInfo)
+	mock.lockGetBalanceHistory.Unlock()
+	return mock.GetBalanceHistoryFunc(contextMoqParam, balanceHistoryRequest)
+}
+
+// GetBalanceHistoryCalls gets all the calls that were made to GetBalanceHistory.
+// Check the length with:
+//     len(mockedRepository.GetBalanceHistoryCalls())
+func (mock *RepositoryMock) GetBalanceHistoryCalls() []struct {
+	ContextMoqParam       context.Context
+	BalanceHistoryRequest vos.BalanceHistoryRequest
+} {
+	var calls []struct {
+		ContextMoqParam       context.Context
+		BalanceHistoryRequest vos.BalanceHistoryRequest
+	}
+	mock.lockGetBalanceHistory.RLock()
+	calls = mock.calls.GetBalanceHistory
+	mock.lockGetBalanceHistory.RUnlock()
+	return calls
+}
+
+// GetBoundedAccountBalance calls GetBoundedAccountBalanceFunc.
+func (mock *RepositoryMock) GetBoundedAccountBalance(contextMoqParam context.Context, account vos.Account, currency vos.Currency, timeMoqParam1 time.Time, timeMoqParam2 time.Time, timeView vos.TimeView) (vos.AccountBalance, error) {
+	if mock.GetBoundedAccountBalanceFunc == nil {
+		panic("RepositoryMock.GetBoundedAccountBalanceFunc: method is nil but Repository.GetBoundedAccountBalance was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		Account         vos.Account
+		Currency        vos.Currency
+		TimeMoqParam1   time.Time
+		TimeMoqParam2   time.Time
+		TimeView        vos.TimeView
+	}{
+		ContextMoqParam: contextMoqParam,
+		Account:         account,
+		Currency:        currency,
+		TimeMoqParam1:   timeMoqParam1,
+		TimeMoqParam2:   timeMoqParam2,
+		TimeView:        timeView,
+	}
+	mock.lockGetBoundedAccountBalance.Lock()
+	mock.calls.GetBoundedAccountBalance = append(mock.calls.GetBoundedAccountBalance, callInfo)
+	mock.lockGetBoundedAccountBalance.Unlock()
+	return mock.GetBoundedAccountBalanceFunc(contextMoqParam, account, currency, timeMoqParam1, timeMoqParam2, timeView)
+}
+
+// GetBoundedAccountBalanceCalls gets all the calls that were made to GetBoundedAccountBalance.
+// Check the length with:
+//     len(mockedRepository.GetBoundedAccountBalanceCalls())
+func (mock *RepositoryMock) GetBoundedAccountBalanceCalls() []struct {
+	ContextMoqParam context.Context
+	Account         vos.Account
+	Currency        vos.Currency
+	TimeMoqParam1   time.Time
+	TimeMoqParam2   time.Time
+	TimeView        vos.TimeView
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		Account         vos.Account
+		Currency        vos.Currency
+		TimeMoqParam1   time.Time
+		TimeMoqParam2   time.Time
+		TimeView        vos.TimeView
+	}
+	mock.lockGetBoundedAccountBalance.RLock()
+	calls = mock.calls.GetBoundedAccountBalance
+	mock.lockGetBoundedAccountBalance.RUnlock()
+	return calls
+}
+
+// GetEvent calls GetEventFunc.
+func (mock *RepositoryMock) GetEvent(contextMoqParam context.Context, v uint32) (entities.Event, error) {
+	if mock.GetEventFunc == nil {
+		panic("RepositoryMock.GetEventFunc: method is nil but Repository.GetEvent was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		V               uint32
+	}{
+		ContextMoqParam: contextMoqParam,
+		V:               v,
+	}
+	mock.lockGetEvent.Lock()
+	mock.calls.GetEvent = append(mock.calls.GetEvent, callInfo)
+	mock.lockGetEvent.Unlock()
+	return mock.GetEventFunc(contextMoqParam, v)
+}
+
+// GetEventCalls gets all the calls that were made to GetEvent.
+// Check the length with:
+//     len(mockedRepository.GetEventCalls())
+func (mock *RepositoryMock) GetEventCalls() []struct {
+	ContextMoqParam context.Context
+	V               uint32
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		V               uint32
+	}
+	mock.lockGetEvent.RLock()
+	calls = mock.calls.GetEvent
+	mock.lockGetEvent.RUnlock()
+	return calls
+}
+
+// GetFiscalPeriod calls GetFiscalPeriodFunc.
+func (mock *RepositoryMock) GetFiscalPeriod(contextMoqParam context.Context, uUID uuid.UUID) (entities.FiscalPeriod, error) {
+	if mock.GetFiscalPeriodFunc == nil {
+		panic("RepositoryMock.GetFiscalPeriodFunc: method is nil but Repository.GetFiscalPeriod was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		UUID            uuid.UUID
+	}{
+		ContextMoqParam: contextMoqParam,
+		UUID:            uUID,
+	}
+	mock.lockGetFiscalPeriod.Lock()
+	mock.calls.GetFiscalPeriod = append(mock.calls.GetFiscalPeriod, callInfo)
+	mock.lockGetFiscalPeriod.Unlock()
+	return mock.GetFiscalPeriodFunc(contextMoqParam, uUID)
+}
+
+// GetFiscalPeriodCalls gets all the calls that were made to GetFiscalPeriod.
+// Check the length with:
+//     len(mockedRepository.GetFiscalPeriodCalls())
+func (mock *RepositoryMock) GetFiscalPeriodCalls() []struct {
+	ContextMoqParam context.Context
+	UUID            uuid.UUID
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		UUID            uuid.UUID
+	}
+	mock.lockGetFiscalPeriod.RLock()
+	calls = mock.calls.GetFiscalPeriod
+	mock.lockGetFiscalPeriod.RUnlock()
+	return calls
+}
+
+// GetPendingTransaction calls GetPendingTransactionFunc.
+func (mock *RepositoryMock) GetPendingTransaction(contextMoqParam context.Context, uUID uuid.UUID) (entities.PendingTransaction, error) {
+	if mock.GetPendingTransactionFunc == nil {
+		panic("RepositoryMock.GetPendingTransactionFunc: method is nil but Repository.GetPendingTransaction was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		UUID            uuid.UUID
+	}{
+		ContextMoqParam: contextMoqParam,
+		UUID:            uUID,
+	}
+	mock.lockGetPendingTransaction.Lock()
+	mock.calls.GetPendingTransaction = append(mock.calls.GetPendingTransaction, callInfo)
+	mock.lockGetPendingTransaction.Unlock()
+	return mock.GetPendingTransactionFunc(contextMoqParam, uUID)
+}
+
+// GetPendingTransactionCalls gets all the calls that were made to GetPendingTransaction.
+// Check the length with:
+//     len(mockedRepository.GetPendingTransactionCalls())
+func (mock *RepositoryMock) GetPendingTransactionCalls() []struct {
+	ContextMoqParam context.Context
+	UUID            uuid.UUID
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		UUID            uuid.UUID
+	}
+	mock.lockGetPendingTransaction.RLock()
+	calls = mock.calls.GetPendingTransaction
+	mock.lockGetPendingTransaction.RUnlock()
+	return calls
+}
+
+// GetReversal calls GetReversalFunc.
+func (mock *RepositoryMock) GetReversal(contextMoqParam context.Context, uUID uuid.UUID) (entities.Reversal, error) {
+	if mock.GetReversalFunc == nil {
+		panic("RepositoryMock.GetReversalFunc: method is nil but Repository.GetReversal was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		UUID            uuid.UUID
+	}{
+		ContextMoqParam: contextMoqParam,
+		UUID:            uUID,
+	}
+	mock.lockGetReversal.Lock()
+	mock.calls.GetReversal = append(mock.calls.GetReversal, callInfo)
+	mock.lockGetReversal.Unlock()
+	return mock.GetReversalFunc(contextMoqParam, uUID)
+}
+
+// GetReversalCalls gets all the calls that were made to GetReversal.
+// Check the length with:
+//     len(mockedRepository.GetReversalCalls())
+func (mock *RepositoryMock) GetReversalCalls() []struct {
+	ContextMoqParam context.Context
+	UUID            uuid.UUID
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		UUID            uuid.UUID
+	}
+	mock.lockGetReversal.RLock()
+	calls = mock.calls.GetReversal
+	mock.lockGetReversal.RUnlock()
+	return calls
+}
+
+// GetScheduledTransaction calls GetScheduledTransactionFunc.
+func (mock *RepositoryMock) GetScheduledTransaction(contextMoqParam context.Context, uUID uuid.UUID) (entities.ScheduledTransaction, error) {
+	if mock.GetScheduledTransactionFunc == nil {
+		panic("RepositoryMock.GetScheduledTransactionFunc: method is nil but Repository.GetScheduledTransaction was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		UUID            uuid.UUID
+	}{
+		ContextMoqParam: contextMoqParam,
+		UUID:            uUID,
+	}
+	mock.lockGetScheduledTransaction.Lock()
+	mock.calls.GetScheduledTransaction = append(mock.calls.GetScheduledTransaction, callInfo)
+	mock.lockGetScheduledTransaction.Unlock()
+	return mock.GetScheduledTransactionFunc(contextMoqParam, uUID)
+}
+
+// GetScheduledTransactionCalls gets all the calls that were made to GetScheduledTransaction.
+// Check the length with:
+//     len(mockedRepository.GetScheduledTransactionCalls())
+func (mock *RepositoryMock) GetScheduledTransactionCalls() []struct {
+	ContextMoqParam context.Context
+	UUID            uuid.UUID
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		UUID            uuid.UUID
+	}
+	mock.lockGetScheduledTransaction.RLock()
+	calls = mock.calls.GetScheduledTransaction
+	mock.lockGetScheduledTransaction.RUnlock()
+	return calls
+}
+
+// GetStatementLines calls GetStatementLinesFunc.
+func (mock *RepositoryMock) GetStatementLines(contextMoqParam context.Context, statementRequest vos.StatementRequest) ([]vos.StatementLine, error) {
+	if mock.GetStatementLinesFunc == nil {
+		panic("RepositoryMock.GetStatementLinesFunc: method is nil but Repository.GetStatementLines was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam  context.Context
+		StatementRequest vos.StatementRequest
+	}{
+		ContextMoqParam:  contextMoqParam,
+		StatementRequest: statementRequest,
+	}
+	mock.lockGetStatementLines.Lock()
+	mock.calls.GetStatementLines = append(mock.calls.GetStatementLines, callInfo)
+	mock.lockGetStatementLines.Unlock()
+	return mock.GetStatementLinesFunc(contextMoqParam, statementRequest)
+}
+
+// GetStatementLinesCalls gets all the calls that were made to GetStatementLines.
+// Check the length with:
+//     len(mockedRepository.GetStatementLinesCalls())
+func (mock *RepositoryMock) GetStatementLinesCalls() []struct {
+	ContextMoqParam  context.Context
+	StatementRequest vos.StatementRequest
+} {
+	var calls []struct {
+		ContextMoqParam  context.Context
+		StatementRequest vos.StatementRequest
+	}
+	mock.lockGetStatementLines.RLock()
+	calls = mock.calls.GetStatementLines
+	mock.lockGetStatementLines.RUnlock()
+	return calls
+}
+
+// GetSyntheticAccountBalance calls GetSyntheticAccountBalanceFunc.
+func (mock *RepositoryMock) GetSyntheticAccountBalance(contextMoqParam context.Context, account vos.Account, currency vos.Currency) (vos.AccountBalance, error) {
+	if mock.GetSyntheticAccountBalanceFunc == nil {
+		panic("RepositoryMock.GetSyntheticAccountBalanceFunc: method is nil but Repository.GetSyntheticAccountBalance was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		Account         vos.Account
+		Currency        vos.Currency
+	}{
+		ContextMoqParam: contextMoqParam,
+		Account:         account,
+		Currency:        currency,
+	}
+	mock.lockGetSyntheticAccountBalance.Lock()
+	mock.calls.GetSyntheticAccountBalance = append(mock.calls.GetSyntheticAccountBalance, callInfo)
+	mock.lockGetSyntheticAccountBalance.Unlock()
+	return mock.GetSyntheticAccountBalanceFunc(contextMoqParam, account, currency)
+}
+
+// GetSyntheticAccountBalanceCalls gets all the calls that were made to GetSyntheticAccountBalance.
+// Check the length with:
+//     len(mockedRepository.GetSyntheticAccountBalanceCalls())
+func (mock *RepositoryMock) GetSyntheticAccountBalanceCalls() []struct {
+	ContextMoqParam context.Context
+	Account         vos.Account
+	Currency        vos.Currency
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		Account         vos.Account
+		Currency        vos.Currency
+	}
+	mock.lockGetSyntheticAccountBalance.RLock()
+	calls = mock.calls.GetSyntheticAccountBalance
+	mock.lockGetSyntheticAccountBalance.RUnlock()
+	return calls
+}
+
+// GetSyntheticReport calls GetSyntheticReportFunc.
+func (mock *RepositoryMock) GetSyntheticReport(contextMoqParam context.Context, syntheticReportRequest vos.SyntheticReportRequest) (*vos.SyntheticReport, error) {
+	if mock.GetSyntheticReportFunc == nil {
+		panic("RepositoryMock.GetSyntheticReportFunc: method is nil but Repository.GetSyntheticReport was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam        context.Context
+		SyntheticReportRequest vos.SyntheticReportRequest
+	}{
+		ContextMoqParam:        contextMoqParam,
+		SyntheticReportRequest: syntheticReportRequest,
+	}
+	mock.lockGetSyntheticReport.Lock()
+	mock.calls.GetSyntheticReport = append(mock.calls.GetSyntheticReport, callInfo)
+	mock.lockGetSyntheticReport.Unlock()
+	return mock.GetSyntheticReportFunc(contextMoqParam, syntheticReportRequest)
+}
+
+// GetSyntheticReportCalls gets all the calls that were made to GetSyntheticReport.
+// Check the length with:
+//     len(mockedRepository.GetSyntheticReportCalls())
+func (mock *RepositoryMock) GetSyntheticReportCalls() []struct {
+	ContextMoqParam        context.Context
+	SyntheticReportRequest vos.SyntheticReportRequest
+} {
+	var calls []struct {
+		ContextMoqParam        context.Context
+		SyntheticReportRequest vos.SyntheticReportRequest
+	}
+	mock.lockGetSyntheticReport.RLock()
+	calls = mock.calls.GetSyntheticReport
+	mock.lockGetSyntheticReport.RUnlock()
+	return calls
+}
+
+// GetTransaction calls GetTransactionFunc.
+func (mock *RepositoryMock) GetTransaction(contextMoqParam context.Context, uUID uuid.UUID) (entities.Transaction, error) {
+	if mock.GetTransactionFunc == nil {
+		panic("RepositoryMock.GetTransactionFunc: method is nil but Repository.GetTransaction was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		UUID            uuid.UUID
+	}{
+		ContextMoqParam: contextMoqParam,
+		UUID:            uUID,
+	}
+	mock.lockGetTransaction.Lock()
+	mock.calls.GetTransaction = append(mock.calls.GetTransaction, callInfo)
+	mock.lockGetTransaction.Unlock()
+	return mock.GetTransactionFunc(contextMoqParam, uUID)
+}
+
+// GetTransactionCalls gets all the calls that were made to GetTransaction.
+// Check the length with:
+//     len(mockedRepository.GetTransactionCalls())
+func (mock *RepositoryMock) GetTransactionCalls() []struct {
+	ContextMoqParam context.Context
+	UUID            uuid.UUID
+} {
 	var calls []struct {
+		ContextMoqParam context.Context
+		UUID            uuid.UUID
+	}
+	mock.lockGetTransaction.RLock()
+	calls = mock.calls.GetTransaction
+	mock.lockGetTransaction.RUnlock()
+	return calls
+}
+
+// GetTrialBalance calls GetTrialBalanceFunc.
+func (mock *RepositoryMock) GetTrialBalance(contextMoqParam context.Context, trialBalanceRequest vos.TrialBalanceRequest) (vos.TrialBalance, error) {
+	if mock.GetTrialBalanceFunc == nil {
+		panic("RepositoryMock.GetTrialBalanceFunc: method is nil but Repository.GetTrialBalance was just called")
+	}
+	callInfo := struct {
 		ContextMoqParam     context.Context
-		AccountEntryRequest vos.AccountEntryRequest
+		TrialBalanceRequest vos.TrialBalanceRequest
+	}{
+		ContextMoqParam:     contextMoqParam,
+		TrialBalanceRequest: trialBalanceRequest,
 	}
-	mock.lockListAccountEntries.RLock()
-	calls = mock.calls.ListAccountEntries
-	mock.lockListAccountEntries.RUnlock()
+	mock.lockGetTrialBalance.Lock()
+	mock.calls.GetTrialBalance = append(mock.calls.GetTrialBalance, callInfo)
+	mock.lockGetTrialBalance.Unlock()
+	return mock.GetTrialBalanceFunc(contextMoqParam, trialBalanceRequest)
+}
+
+// GetTrialBalanceCalls gets all the calls that were made to GetTrialBalance.
+// Check the length with:
+//     len(mockedRepository.GetTrialBalanceCalls())
+func (mock *RepositoryMock) GetTrialBalanceCalls() []struct {
+	ContextMoqParam     context.Context
+	TrialBalanceRequest vos.TrialBalanceRequest
+} {
+	var calls []struct {
+		ContextMoqParam     context.Context
+		TrialBalanceRequest vos.TrialBalanceRequest
+	}
+	mock.lockGetTrialBalance.RLock()
+	calls = mock.calls.GetTrialBalance
+	mock.lockGetTrialBalance.RUnlock()
+	return calls
+}
+
+// ListAccountChildren calls ListAccountChildrenFunc.
+func (mock *RepositoryMock) ListAccountChildren(contextMoqParam context.Context, accountChildrenRequest vos.AccountChildrenRequest) ([]vos.AccountChild, pagination.Cursor, error) {
+	if mock.ListAccountChildrenFunc == nil {
+		panic("RepositoryMock.ListAccountChildrenFunc: method is nil but Repository.ListAccountChildren was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam        context.Context
+		AccountChildrenRequest vos.AccountChildrenRequest
+	}{
+		ContextMoqParam:        contextMoqParam,
+		AccountChildrenRequest: accountChildrenRequest,
+	}
+	mock.lockListAccountChildren.Lock()
+	mock.calls.ListAccountChildren = append(mock.calls.ListAccountChildren, callInfo)
+	mock.lockListAccountChildren.Unlock()
+	return mock.ListAccountChildrenFunc(contextMoqParam, accountChildrenRequest)
+}
+
+// ListAccountChildrenCalls gets all the calls that were made to ListAccountChildren.
+// Check the length with:
+//     len(mockedRepository.ListAccountChildrenCalls())
+func (mock *RepositoryMock) ListAccountChildrenCalls() []struct {
+	ContextMoqParam        context.Context
+	AccountChildrenRequest vos.AccountChildrenRequest
+} {
+	var calls []struct {
+		ContextMoqParam        context.Context
+		AccountChildrenRequest vos.AccountChildrenRequest
+	}
+	mock.lockListAccountChildren.RLock()
+	calls = mock.calls.ListAccountChildren
+	mock.lockListAccountChildren.RUnlock()
+	return calls
+}
+
+// ListAccountEntries calls ListAccountEntriesFunc.
+func (mock *RepositoryMock) ListAccountEntries(contextMoqParam context.Context, accountEntryRequest vos.AccountEntryRequest) ([]vos.AccountEntry, pagination.Cursor, error) {
+	if mock.ListAccountEntriesFunc == nil {
+		panic("RepositoryMock.ListAccountEntriesFunc: method is nil but Repository.ListAccountEntries was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam     context.Context
+		AccountEntryRequest vos.AccountEntryRequest
+	}{
+		ContextMoqParam:     contextMoqParam,
+		AccountEntryRequest: accountEntryRequest,
+	}
+	mock.lockListAccountEntries.Lock()
+	mock.calls.ListAccountEntries = append(mock.calls.ListAccountEntries, callInfo)
+	mock.lockListAccountEntries.Unlock()
+	return mock.ListAccountEntriesFunc(contextMoqParam, accountEntryRequest)
+}
+
+// ListAccountEntriesCalls gets all the calls that were made to ListAccountEntries.
+// Check the length with:
+//     len(mockedRepository.ListAccountEntriesCalls())
+func (mock *RepositoryMock) ListAccountEntriesCalls() []struct {
+	ContextMoqParam     context.Context
+	AccountEntryRequest vos.AccountEntryRequest
+} {
+	var calls []struct {
+		ContextMoqParam     context.Context
+		AccountEntryRequest vos.AccountEntryRequest
+	}
+	mock.lockListAccountEntries.RLock()
+	calls = mock.calls.ListAccountEntries
+	mock.lockListAccountEntries.RUnlock()
+	return calls
+}
+
+// ListAccounts calls ListAccountsFunc.
+func (mock *RepositoryMock) ListAccounts(contextMoqParam context.Context, accountListRequest vos.AccountListRequest) ([]vos.AccountSummary, pagination.Cursor, error) {
+	if mock.ListAccountsFunc == nil {
+		panic("RepositoryMock.ListAccountsFunc: method is nil but Repository.ListAccounts was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam    context.Context
+		AccountListRequest vos.AccountListRequest
+	}{
+		ContextMoqParam:    contextMoqParam,
+		AccountListRequest: accountListRequest,
+	}
+	mock.lockListAccounts.Lock()
+	mock.calls.ListAccounts = append(mock.calls.ListAccounts, callInfo)
+	mock.lockListAccounts.Unlock()
+	return mock.ListAccountsFunc(contextMoqParam, accountListRequest)
+}
+
+// ListAccountsCalls gets all the calls that were made to ListAccounts.
+// Check the length with:
+//     len(mockedRepository.ListAccountsCalls())
+func (mock *RepositoryMock) ListAccountsCalls() []struct {
+	ContextMoqParam    context.Context
+	AccountListRequest vos.AccountListRequest
+} {
+	var calls []struct {
+		ContextMoqParam    context.Context
+		AccountListRequest vos.AccountListRequest
+	}
+	mock.lockListAccounts.RLock()
+	calls = mock.calls.ListAccounts
+	mock.lockListAccounts.RUnlock()
+	return calls
+}
+
+// ListBalanceLimits calls ListBalanceLimitsFunc.
+func (mock *RepositoryMock) ListBalanceLimits(contextMoqParam context.Context) ([]vos.BalanceLimit, error) {
+	if mock.ListBalanceLimitsFunc == nil {
+		panic("RepositoryMock.ListBalanceLimitsFunc: method is nil but Repository.ListBalanceLimits was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockListBalanceLimits.Lock()
+	mock.calls.ListBalanceLimits = append(mock.calls.ListBalanceLimits, callInfo)
+	mock.lockListBalanceLimits.Unlock()
+	return mock.ListBalanceLimitsFunc(contextMoqParam)
+}
+
+// ListBalanceLimitsCalls gets all the calls that were made to ListBalanceLimits.
+// Check the length with:
+//     len(mockedRepository.ListBalanceLimitsCalls())
+func (mock *RepositoryMock) ListBalanceLimitsCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockListBalanceLimits.RLock()
+	calls = mock.calls.ListBalanceLimits
+	mock.lockListBalanceLimits.RUnlock()
+	return calls
+}
+
+// ListCompetenceLockChanges calls ListCompetenceLockChangesFunc.
+func (mock *RepositoryMock) ListCompetenceLockChanges(contextMoqParam context.Context, s string) ([]entities.CompetenceLockChange, error) {
+	if mock.ListCompetenceLockChangesFunc == nil {
+		panic("RepositoryMock.ListCompetenceLockChangesFunc: method is nil but Repository.ListCompetenceLockChanges was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		S               string
+	}{
+		ContextMoqParam: contextMoqParam,
+		S:               s,
+	}
+	mock.lockListCompetenceLockChanges.Lock()
+	mock.calls.ListCompetenceLockChanges = append(mock.calls.ListCompetenceLockChanges, callInfo)
+	mock.lockListCompetenceLockChanges.Unlock()
+	return mock.ListCompetenceLockChangesFunc(contextMoqParam, s)
+}
+
+// ListCompetenceLockChangesCalls gets all the calls that were made to ListCompetenceLockChanges.
+// Check the length with:
+//     len(mockedRepository.ListCompetenceLockChangesCalls())
+func (mock *RepositoryMock) ListCompetenceLockChangesCalls() []struct {
+	ContextMoqParam context.Context
+	S               string
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		S               string
+	}
+	mock.lockListCompetenceLockChanges.RLock()
+	calls = mock.calls.ListCompetenceLockChanges
+	mock.lockListCompetenceLockChanges.RUnlock()
+	return calls
+}
+
+// ListCompetenceLocks calls ListCompetenceLocksFunc.
+func (mock *RepositoryMock) ListCompetenceLocks(contextMoqParam context.Context, s string) ([]entities.CompetenceLock, error) {
+	if mock.ListCompetenceLocksFunc == nil {
+		panic("RepositoryMock.ListCompetenceLocksFunc: method is nil but Repository.ListCompetenceLocks was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		S               string
+	}{
+		ContextMoqParam: contextMoqParam,
+		S:               s,
+	}
+	mock.lockListCompetenceLocks.Lock()
+	mock.calls.ListCompetenceLocks = append(mock.calls.ListCompetenceLocks, callInfo)
+	mock.lockListCompetenceLocks.Unlock()
+	return mock.ListCompetenceLocksFunc(contextMoqParam, s)
+}
+
+// ListCompetenceLocksCalls gets all the calls that were made to ListCompetenceLocks.
+// Check the length with:
+//     len(mockedRepository.ListCompetenceLocksCalls())
+func (mock *RepositoryMock) ListCompetenceLocksCalls() []struct {
+	ContextMoqParam context.Context
+	S               string
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		S               string
+	}
+	mock.lockListCompetenceLocks.RLock()
+	calls = mock.calls.ListCompetenceLocks
+	mock.lockListCompetenceLocks.RUnlock()
+	return calls
+}
+
+// ListEventMetadataSchemas calls ListEventMetadataSchemasFunc.
+func (mock *RepositoryMock) ListEventMetadataSchemas(contextMoqParam context.Context, v uint32) ([]entities.EventMetadataSchema, error) {
+	if mock.ListEventMetadataSchemasFunc == nil {
+		panic("RepositoryMock.ListEventMetadataSchemasFunc: method is nil but Repository.ListEventMetadataSchemas was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		V               uint32
+	}{
+		ContextMoqParam: contextMoqParam,
+		V:               v,
+	}
+	mock.lockListEventMetadataSchemas.Lock()
+	mock.calls.ListEventMetadataSchemas = append(mock.calls.ListEventMetadataSchemas, callInfo)
+	mock.lockListEventMetadataSchemas.Unlock()
+	return mock.ListEventMetadataSchemasFunc(contextMoqParam, v)
+}
+
+// ListEventMetadataSchemasCalls gets all the calls that were made to ListEventMetadataSchemas.
+// Check the length with:
+//     len(mockedRepository.ListEventMetadataSchemasCalls())
+func (mock *RepositoryMock) ListEventMetadataSchemasCalls() []struct {
+	ContextMoqParam context.Context
+	V               uint32
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		V               uint32
+	}
+	mock.lockListEventMetadataSchemas.RLock()
+	calls = mock.calls.ListEventMetadataSchemas
+	mock.lockListEventMetadataSchemas.RUnlock()
+	return calls
+}
+
+// ListEvents calls ListEventsFunc.
+func (mock *RepositoryMock) ListEvents(contextMoqParam context.Context, b bool) ([]entities.Event, error) {
+	if mock.ListEventsFunc == nil {
+		panic("RepositoryMock.ListEventsFunc: method is nil but Repository.ListEvents was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		B               bool
+	}{
+		ContextMoqParam: contextMoqParam,
+		B:               b,
+	}
+	mock.lockListEvents.Lock()
+	mock.calls.ListEvents = append(mock.calls.ListEvents, callInfo)
+	mock.lockListEvents.Unlock()
+	return mock.ListEventsFunc(contextMoqParam, b)
+}
+
+// ListEventsCalls gets all the calls that were made to ListEvents.
+// Check the length with:
+//     len(mockedRepository.ListEventsCalls())
+func (mock *RepositoryMock) ListEventsCalls() []struct {
+	ContextMoqParam context.Context
+	B               bool
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		B               bool
+	}
+	mock.lockListEvents.RLock()
+	calls = mock.calls.ListEvents
+	mock.lockListEvents.RUnlock()
+	return calls
+}
+
+// ListFiscalPeriodChanges calls ListFiscalPeriodChangesFunc.
+func (mock *RepositoryMock) ListFiscalPeriodChanges(contextMoqParam context.Context, uUID uuid.UUID) ([]entities.FiscalPeriodChange, error) {
+	if mock.ListFiscalPeriodChangesFunc == nil {
+		panic("RepositoryMock.ListFiscalPeriodChangesFunc: method is nil but Repository.ListFiscalPeriodChanges was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		UUID            uuid.UUID
+	}{
+		ContextMoqParam: contextMoqParam,
+		UUID:            uUID,
+	}
+	mock.lockListFiscalPeriodChanges.Lock()
+	mock.calls.ListFiscalPeriodChanges = append(mock.calls.ListFiscalPeriodChanges, callInfo)
+	mock.lockListFiscalPeriodChanges.Unlock()
+	return mock.ListFiscalPeriodChangesFunc(contextMoqParam, uUID)
+}
+
+// ListFiscalPeriodChangesCalls gets all the calls that were made to ListFiscalPeriodChanges.
+// Check the length with:
+//     len(mockedRepository.ListFiscalPeriodChangesCalls())
+func (mock *RepositoryMock) ListFiscalPeriodChangesCalls() []struct {
+	ContextMoqParam context.Context
+	UUID            uuid.UUID
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		UUID            uuid.UUID
+	}
+	mock.lockListFiscalPeriodChanges.RLock()
+	calls = mock.calls.ListFiscalPeriodChanges
+	mock.lockListFiscalPeriodChanges.RUnlock()
+	return calls
+}
+
+// ListFiscalPeriods calls ListFiscalPeriodsFunc.
+func (mock *RepositoryMock) ListFiscalPeriods(contextMoqParam context.Context, s string) ([]entities.FiscalPeriod, error) {
+	if mock.ListFiscalPeriodsFunc == nil {
+		panic("RepositoryMock.ListFiscalPeriodsFunc: method is nil but Repository.ListFiscalPeriods was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		S               string
+	}{
+		ContextMoqParam: contextMoqParam,
+		S:               s,
+	}
+	mock.lockListFiscalPeriods.Lock()
+	mock.calls.ListFiscalPeriods = append(mock.calls.ListFiscalPeriods, callInfo)
+	mock.lockListFiscalPeriods.Unlock()
+	return mock.ListFiscalPeriodsFunc(contextMoqParam, s)
+}
+
+// ListFiscalPeriodsCalls gets all the calls that were made to ListFiscalPeriods.
+// Check the length with:
+//     len(mockedRepository.ListFiscalPeriodsCalls())
+func (mock *RepositoryMock) ListFiscalPeriodsCalls() []struct {
+	ContextMoqParam context.Context
+	S               string
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		S               string
+	}
+	mock.lockListFiscalPeriods.RLock()
+	calls = mock.calls.ListFiscalPeriods
+	mock.lockListFiscalPeriods.RUnlock()
+	return calls
+}
+
+// ListScheduledTransactions calls ListScheduledTransactionsFunc.
+func (mock *RepositoryMock) ListScheduledTransactions(contextMoqParam context.Context, scheduleStatus vos.ScheduleStatus, page pagination.Page) ([]entities.ScheduledTransaction, pagination.Cursor, error) {
+	if mock.ListScheduledTransactionsFunc == nil {
+		panic("RepositoryMock.ListScheduledTransactionsFunc: method is nil but Repository.ListScheduledTransactions was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		ScheduleStatus  vos.ScheduleStatus
+		Page            pagination.Page
+	}{
+		ContextMoqParam: contextMoqParam,
+		ScheduleStatus:  scheduleStatus,
+		Page:            page,
+	}
+	mock.lockListScheduledTransactions.Lock()
+	mock.calls.ListScheduledTransactions = append(mock.calls.ListScheduledTransactions, callInfo)
+	mock.lockListScheduledTransactions.Unlock()
+	return mock.ListScheduledTransactionsFunc(contextMoqParam, scheduleStatus, page)
+}
+
+// ListScheduledTransactionsCalls gets all the calls that were made to ListScheduledTransactions.
+// Check the length with:
+//     len(mockedRepository.ListScheduledTransactionsCalls())
+func (mock *RepositoryMock) ListScheduledTransactionsCalls() []struct {
+	ContextMoqParam context.Context
+	ScheduleStatus  vos.ScheduleStatus
+	Page            pagination.Page
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		ScheduleStatus  vos.ScheduleStatus
+		Page            pagination.Page
+	}
+	mock.lockListScheduledTransactions.RLock()
+	calls = mock.calls.ListScheduledTransactions
+	mock.lockListScheduledTransactions.RUnlock()
+	return calls
+}
+
+// OpenAccount calls OpenAccountFunc.
+func (mock *RepositoryMock) OpenAccount(contextMoqParam context.Context, account entities.Account) (entities.Account, error) {
+	if mock.OpenAccountFunc == nil {
+		panic("RepositoryMock.OpenAccountFunc: method is nil but Repository.OpenAccount was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		Account         entities.Account
+	}{
+		ContextMoqParam: contextMoqParam,
+		Account:         account,
+	}
+	mock.lockOpenAccount.Lock()
+	mock.calls.OpenAccount = append(mock.calls.OpenAccount, callInfo)
+	mock.lockOpenAccount.Unlock()
+	return mock.OpenAccountFunc(contextMoqParam, account)
+}
+
+// OpenAccountCalls gets all the calls that were made to OpenAccount.
+// Check the length with:
+//     len(mockedRepository.OpenAccountCalls())
+func (mock *RepositoryMock) OpenAccountCalls() []struct {
+	ContextMoqParam context.Context
+	Account         entities.Account
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		Account         entities.Account
+	}
+	mock.lockOpenAccount.RLock()
+	calls = mock.calls.OpenAccount
+	mock.lockOpenAccount.RUnlock()
+	return calls
+}
+
+// ReopenFiscalPeriod calls ReopenFiscalPeriodFunc.
+func (mock *RepositoryMock) ReopenFiscalPeriod(contextMoqParam context.Context, fiscalPeriod entities.FiscalPeriod, fiscalPeriodChange entities.FiscalPeriodChange) error {
+	if mock.ReopenFiscalPeriodFunc == nil {
+		panic("RepositoryMock.ReopenFiscalPeriodFunc: method is nil but Repository.ReopenFiscalPeriod was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam    context.Context
+		FiscalPeriod       entities.FiscalPeriod
+		FiscalPeriodChange entities.FiscalPeriodChange
+	}{
+		ContextMoqParam:    contextMoqParam,
+		FiscalPeriod:       fiscalPeriod,
+		FiscalPeriodChange: fiscalPeriodChange,
+	}
+	mock.lockReopenFiscalPeriod.Lock()
+	mock.calls.ReopenFiscalPeriod = append(mock.calls.ReopenFiscalPeriod, callInfo)
+	mock.lockReopenFiscalPeriod.Unlock()
+	return mock.ReopenFiscalPeriodFunc(contextMoqParam, fiscalPeriod, fiscalPeriodChange)
+}
+
+// ReopenFiscalPeriodCalls gets all the calls that were made to ReopenFiscalPeriod.
+// Check the length with:
+//     len(mockedRepository.ReopenFiscalPeriodCalls())
+func (mock *RepositoryMock) ReopenFiscalPeriodCalls() []struct {
+	ContextMoqParam    context.Context
+	FiscalPeriod       entities.FiscalPeriod
+	FiscalPeriodChange entities.FiscalPeriodChange
+} {
+	var calls []struct {
+		ContextMoqParam    context.Context
+		FiscalPeriod       entities.FiscalPeriod
+		FiscalPeriodChange entities.FiscalPeriodChange
+	}
+	mock.lockReopenFiscalPeriod.RLock()
+	calls = mock.calls.ReopenFiscalPeriod
+	mock.lockReopenFiscalPeriod.RUnlock()
+	return calls
+}
+
+// RevertTransaction calls RevertTransactionFunc.
+func (mock *RepositoryMock) RevertTransaction(contextMoqParam context.Context, reversal entities.Reversal) error {
+	if mock.RevertTransactionFunc == nil {
+		panic("RepositoryMock.RevertTransactionFunc: method is nil but Repository.RevertTransaction was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		Reversal        entities.Reversal
+	}{
+		ContextMoqParam: contextMoqParam,
+		Reversal:        reversal,
+	}
+	mock.lockRevertTransaction.Lock()
+	mock.calls.RevertTransaction = append(mock.calls.RevertTransaction, callInfo)
+	mock.lockRevertTransaction.Unlock()
+	return mock.RevertTransactionFunc(contextMoqParam, reversal)
+}
+
+// RevertTransactionCalls gets all the calls that were made to RevertTransaction.
+// Check the length with:
+//     len(mockedRepository.RevertTransactionCalls())
+func (mock *RepositoryMock) RevertTransactionCalls() []struct {
+	ContextMoqParam context.Context
+	Reversal        entities.Reversal
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		Reversal        entities.Reversal
+	}
+	mock.lockRevertTransaction.RLock()
+	calls = mock.calls.RevertTransaction
+	mock.lockRevertTransaction.RUnlock()
+	return calls
+}
+
+// ScheduleTransaction calls ScheduleTransactionFunc.
+func (mock *RepositoryMock) ScheduleTransaction(contextMoqParam context.Context, scheduledTransaction entities.ScheduledTransaction) (entities.ScheduledTransaction, error) {
+	if mock.ScheduleTransactionFunc == nil {
+		panic("RepositoryMock.ScheduleTransactionFunc: method is nil but Repository.ScheduleTransaction was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam      context.Context
+		ScheduledTransaction entities.ScheduledTransaction
+	}{
+		ContextMoqParam:      contextMoqParam,
+		ScheduledTransaction: scheduledTransaction,
+	}
+	mock.lockScheduleTransaction.Lock()
+	mock.calls.ScheduleTransaction = append(mock.calls.ScheduleTransaction, callInfo)
+	mock.lockScheduleTransaction.Unlock()
+	return mock.ScheduleTransactionFunc(contextMoqParam, scheduledTransaction)
+}
+
+// ScheduleTransactionCalls gets all the calls that were made to ScheduleTransaction.
+// Check the length with:
+//     len(mockedRepository.ScheduleTransactionCalls())
+func (mock *RepositoryMock) ScheduleTransactionCalls() []struct {
+	ContextMoqParam      context.Context
+	ScheduledTransaction entities.ScheduledTransaction
+} {
+	var calls []struct {
+		ContextMoqParam      context.Context
+		ScheduledTransaction entities.ScheduledTransaction
+	}
+	mock.lockScheduleTransaction.RLock()
+	calls = mock.calls.ScheduleTransaction
+	mock.lockScheduleTransaction.RUnlock()
+	return calls
+}
+
+// SetBalanceLimit calls SetBalanceLimitFunc.
+func (mock *RepositoryMock) SetBalanceLimit(contextMoqParam context.Context, balanceLimit vos.BalanceLimit) error {
+	if mock.SetBalanceLimitFunc == nil {
+		panic("RepositoryMock.SetBalanceLimitFunc: method is nil but Repository.SetBalanceLimit was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		BalanceLimit    vos.BalanceLimit
+	}{
+		ContextMoqParam: contextMoqParam,
+		BalanceLimit:    balanceLimit,
+	}
+	mock.lockSetBalanceLimit.Lock()
+	mock.calls.SetBalanceLimit = append(mock.calls.SetBalanceLimit, callInfo)
+	mock.lockSetBalanceLimit.Unlock()
+	return mock.SetBalanceLimitFunc(contextMoqParam, balanceLimit)
+}
+
+// SetBalanceLimitCalls gets all the calls that were made to SetBalanceLimit.
+// Check the length with:
+//     len(mockedRepository.SetBalanceLimitCalls())
+func (mock *RepositoryMock) SetBalanceLimitCalls() []struct {
+	ContextMoqParam context.Context
+	BalanceLimit    vos.BalanceLimit
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		BalanceLimit    vos.BalanceLimit
+	}
+	mock.lockSetBalanceLimit.RLock()
+	calls = mock.calls.SetBalanceLimit
+	mock.lockSetBalanceLimit.RUnlock()
+	return calls
+}
+
+// UpdateAccountStatus calls UpdateAccountStatusFunc.
+func (mock *RepositoryMock) UpdateAccountStatus(contextMoqParam context.Context, account entities.Account, accountStatus vos.AccountStatus) (entities.Account, error) {
+	if mock.UpdateAccountStatusFunc == nil {
+		panic("RepositoryMock.UpdateAccountStatusFunc: method is nil but Repository.UpdateAccountStatus was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		Account         entities.Account
+		AccountStatus   vos.AccountStatus
+	}{
+		ContextMoqParam: contextMoqParam,
+		Account:         account,
+		AccountStatus:   accountStatus,
+	}
+	mock.lockUpdateAccountStatus.Lock()
+	mock.calls.UpdateAccountStatus = append(mock.calls.UpdateAccountStatus, callInfo)
+	mock.lockUpdateAccountStatus.Unlock()
+	return mock.UpdateAccountStatusFunc(contextMoqParam, account, accountStatus)
+}
+
+// UpdateAccountStatusCalls gets all the calls that were made to UpdateAccountStatus.
+// Check the length with:
+//     len(mockedRepository.UpdateAccountStatusCalls())
+func (mock *RepositoryMock) UpdateAccountStatusCalls() []struct {
+	ContextMoqParam context.Context
+	Account         entities.Account
+	AccountStatus   vos.AccountStatus
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		Account         entities.Account
+		AccountStatus   vos.AccountStatus
+	}
+	mock.lockUpdateAccountStatus.RLock()
+	calls = mock.calls.UpdateAccountStatus
+	mock.lockUpdateAccountStatus.RUnlock()
+	return calls
+}
+
+// UpdateScheduledTransactionStatus calls UpdateScheduledTransactionStatusFunc.
+func (mock *RepositoryMock) UpdateScheduledTransactionStatus(contextMoqParam context.Context, scheduledTransaction entities.ScheduledTransaction, scheduleStatus vos.ScheduleStatus) error {
+	if mock.UpdateScheduledTransactionStatusFunc == nil {
+		panic("RepositoryMock.UpdateScheduledTransactionStatusFunc: method is nil but Repository.UpdateScheduledTransactionStatus was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam      context.Context
+		ScheduledTransaction entities.ScheduledTransaction
+		ScheduleStatus       vos.ScheduleStatus
+	}{
+		ContextMoqParam:      contextMoqParam,
+		ScheduledTransaction: scheduledTransaction,
+		ScheduleStatus:       scheduleStatus,
+	}
+	mock.lockUpdateScheduledTransactionStatus.Lock()
+	mock.calls.UpdateScheduledTransactionStatus = append(mock.calls.UpdateScheduledTransactionStatus, callInfo)
+	mock.lockUpdateScheduledTransactionStatus.Unlock()
+	return mock.UpdateScheduledTransactionStatusFunc(contextMoqParam, scheduledTransaction, scheduleStatus)
+}
+
+// UpdateScheduledTransactionStatusCalls gets all the calls that were made to UpdateScheduledTransactionStatus.
+// Check the length with:
+//     len(mockedRepository.UpdateScheduledTransactionStatusCalls())
+func (mock *RepositoryMock) UpdateScheduledTransactionStatusCalls() []struct {
+	ContextMoqParam      context.Context
+	ScheduledTransaction entities.ScheduledTransaction
+	ScheduleStatus       vos.ScheduleStatus
+} {
+	var calls []struct {
+		ContextMoqParam      context.Context
+		ScheduledTransaction entities.ScheduledTransaction
+		ScheduleStatus       vos.ScheduleStatus
+	}
+	mock.lockUpdateScheduledTransactionStatus.RLock()
+	calls = mock.calls.UpdateScheduledTransactionStatus
+	mock.lockUpdateScheduledTransactionStatus.RUnlock()
+	return calls
+}
+
+// VoidTransaction calls VoidTransactionFunc.
+func (mock *RepositoryMock) VoidTransaction(contextMoqParam context.Context, pendingTransaction entities.PendingTransaction) error {
+	if mock.VoidTransactionFunc == nil {
+		panic("RepositoryMock.VoidTransactionFunc: method is nil but Repository.VoidTransaction was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam    context.Context
+		PendingTransaction entities.PendingTransaction
+	}{
+		ContextMoqParam:    contextMoqParam,
+		PendingTransaction: pendingTransaction,
+	}
+	mock.lockVoidTransaction.Lock()
+	mock.calls.VoidTransaction = append(mock.calls.VoidTransaction, callInfo)
+	mock.lockVoidTransaction.Unlock()
+	return mock.VoidTransactionFunc(contextMoqParam, pendingTransaction)
+}
+
+// VoidTransactionCalls gets all the calls that were made to VoidTransaction.
+// Check the length with:
+//     len(mockedRepository.VoidTransactionCalls())
+func (mock *RepositoryMock) VoidTransactionCalls() []struct {
+	ContextMoqParam    context.Context
+	PendingTransaction entities.PendingTransaction
+} {
+	var calls []struct {
+		ContextMoqParam    context.Context
+		PendingTransaction entities.PendingTransaction
+	}
+	mock.lockVoidTransaction.RLock()
+	calls = mock.calls.VoidTransaction
+	mock.lockVoidTransaction.RUnlock()
 	return calls
 }
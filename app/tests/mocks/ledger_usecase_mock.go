@@ -5,11 +5,11 @@ package mocks
 
 import (
 	"context"
+	"github.com/google/uuid"
 	"github.com/stone-co/the-amazing-ledger/app/domain"
 	"github.com/stone-co/the-amazing-ledger/app/domain/entities"
 	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
 	"sync"
-	"time"
 )
 
 // Ensure, that UseCaseMock does implement domain.UseCase.
@@ -22,18 +22,138 @@ var _ domain.UseCase = &UseCaseMock{}
 //
 // 		// make and configure a mocked domain.UseCase
 // 		mockedUseCase := &UseCaseMock{
-// 			CreateTransactionFunc: func(contextMoqParam context.Context, transaction entities.Transaction) error {
+// 			AuthorizeTransactionFunc: func(contextMoqParam context.Context, transaction entities.Transaction) (entities.PendingTransaction, error) {
+// 				panic("mock out the AuthorizeTransaction method")
+// 			},
+// 			CancelScheduledTransactionFunc: func(contextMoqParam context.Context, uUID uuid.UUID) error {
+// 				panic("mock out the CancelScheduledTransaction method")
+// 			},
+// 			CaptureTransactionFunc: func(contextMoqParam context.Context, captureTransactionInput domain.CaptureTransactionInput) error {
+// 				panic("mock out the CaptureTransaction method")
+// 			},
+// 			ClaimDueScheduledTransactionsFunc: func(contextMoqParam context.Context, n int) ([]entities.ScheduledTransaction, error) {
+// 				panic("mock out the ClaimDueScheduledTransactions method")
+// 			},
+// 			ClearCompetenceLockFunc: func(contextMoqParam context.Context, competenceLockInput domain.CompetenceLockInput) (entities.CompetenceLockChange, error) {
+// 				panic("mock out the ClearCompetenceLock method")
+// 			},
+// 			CloseAccountFunc: func(contextMoqParam context.Context, account vos.Account) (entities.Account, error) {
+// 				panic("mock out the CloseAccount method")
+// 			},
+// 			CloseFiscalPeriodFunc: func(contextMoqParam context.Context, closeFiscalPeriodInput domain.CloseFiscalPeriodInput) (entities.FiscalPeriodClosing, error) {
+// 				panic("mock out the CloseFiscalPeriod method")
+// 			},
+// 			CreateEventFunc: func(contextMoqParam context.Context, event entities.Event) (entities.Event, error) {
+// 				panic("mock out the CreateEvent method")
+// 			},
+// 			CreateFiscalPeriodFunc: func(contextMoqParam context.Context, fiscalPeriod entities.FiscalPeriod) (entities.FiscalPeriod, error) {
+// 				panic("mock out the CreateFiscalPeriod method")
+// 			},
+// 			CreateTransactionFunc: func(contextMoqParam context.Context, transaction entities.Transaction) (entities.Transaction, error) {
 // 				panic("mock out the CreateTransaction method")
 // 			},
+// 			CreateTransactionsFunc: func(contextMoqParam context.Context, createTransactionsInput domain.CreateTransactionsInput) ([]error, error) {
+// 				panic("mock out the CreateTransactions method")
+// 			},
+// 			DeleteBalanceLimitFunc: func(contextMoqParam context.Context, account vos.Account, currency vos.Currency) error {
+// 				panic("mock out the DeleteBalanceLimit method")
+// 			},
+// 			DeprecateEventFunc: func(contextMoqParam context.Context, v uint32) (entities.Event, error) {
+// 				panic("mock out the DeprecateEvent method")
+// 			},
+// 			DescribeAccountFunc: func(contextMoqParam context.Context, account vos.Account) (entities.Account, error) {
+// 				panic("mock out the DescribeAccount method")
+// 			},
+// 			DescribeEventFunc: func(contextMoqParam context.Context, v uint32) (entities.Event, error) {
+// 				panic("mock out the DescribeEvent method")
+// 			},
+// 			DescribeFiscalPeriodFunc: func(contextMoqParam context.Context, uUID uuid.UUID) (domain.DescribeFiscalPeriodOutput, error) {
+// 				panic("mock out the DescribeFiscalPeriod method")
+// 			},
+// 			FreezeAccountFunc: func(contextMoqParam context.Context, account vos.Account) (entities.Account, error) {
+// 				panic("mock out the FreezeAccount method")
+// 			},
 // 			GetAccountBalanceFunc: func(contextMoqParam context.Context, getAccountBalanceInput domain.GetAccountBalanceInput) (vos.AccountBalance, error) {
 // 				panic("mock out the GetAccountBalance method")
 // 			},
-// 			GetSyntheticReportFunc: func(contextMoqParam context.Context, account vos.Account, n int, timeMoqParam1 time.Time, timeMoqParam2 time.Time) (*vos.SyntheticReport, error) {
+// 			GetBalanceHistoryFunc: func(contextMoqParam context.Context, balanceHistoryRequest vos.BalanceHistoryRequest) (vos.BalanceHistory, error) {
+// 				panic("mock out the GetBalanceHistory method")
+// 			},
+// 			GetBalanceSheetFunc: func(contextMoqParam context.Context, financialStatementInput domain.FinancialStatementInput) (vos.FinancialStatement, error) {
+// 				panic("mock out the GetBalanceSheet method")
+// 			},
+// 			GetIncomeStatementFunc: func(contextMoqParam context.Context, financialStatementInput domain.FinancialStatementInput) (vos.FinancialStatement, error) {
+// 				panic("mock out the GetIncomeStatement method")
+// 			},
+// 			GetSyntheticReportFunc: func(contextMoqParam context.Context, syntheticReportRequest vos.SyntheticReportRequest) (*vos.SyntheticReport, error) {
 // 				panic("mock out the GetSyntheticReport method")
 // 			},
+// 			GetTransactionFunc: func(contextMoqParam context.Context, uUID uuid.UUID) (entities.Transaction, error) {
+// 				panic("mock out the GetTransaction method")
+// 			},
+// 			GetTrialBalanceFunc: func(contextMoqParam context.Context, trialBalanceRequest vos.TrialBalanceRequest) (vos.TrialBalance, error) {
+// 				panic("mock out the GetTrialBalance method")
+// 			},
+// 			ListAccountChildrenFunc: func(contextMoqParam context.Context, accountChildrenRequest vos.AccountChildrenRequest) (vos.AccountChildren, error) {
+// 				panic("mock out the ListAccountChildren method")
+// 			},
 // 			ListAccountEntriesFunc: func(contextMoqParam context.Context, accountEntryRequest vos.AccountEntryRequest) (vos.AccountEntryResponse, error) {
 // 				panic("mock out the ListAccountEntries method")
 // 			},
+// 			ListAccountsFunc: func(contextMoqParam context.Context, accountListRequest vos.AccountListRequest) (vos.AccountList, error) {
+// 				panic("mock out the ListAccounts method")
+// 			},
+// 			ListBalanceLimitsFunc: func(contextMoqParam context.Context) ([]vos.BalanceLimit, error) {
+// 				panic("mock out the ListBalanceLimits method")
+// 			},
+// 			ListChartOfAccountsFunc: func(contextMoqParam context.Context) (vos.ChartOfAccounts, error) {
+// 				panic("mock out the ListChartOfAccounts method")
+// 			},
+// 			ListCompetenceLocksFunc: func(contextMoqParam context.Context, s string) (domain.ListCompetenceLocksOutput, error) {
+// 				panic("mock out the ListCompetenceLocks method")
+// 			},
+// 			ListEventMetadataSchemasFunc: func(contextMoqParam context.Context, v uint32) ([]entities.EventMetadataSchema, error) {
+// 				panic("mock out the ListEventMetadataSchemas method")
+// 			},
+// 			ListEventsFunc: func(contextMoqParam context.Context, listEventsInput domain.ListEventsInput) ([]entities.Event, error) {
+// 				panic("mock out the ListEvents method")
+// 			},
+// 			ListFiscalPeriodsFunc: func(contextMoqParam context.Context, s string) ([]entities.FiscalPeriod, error) {
+// 				panic("mock out the ListFiscalPeriods method")
+// 			},
+// 			ListScheduledTransactionsFunc: func(contextMoqParam context.Context, listScheduledTransactionsInput domain.ListScheduledTransactionsInput) (domain.ListScheduledTransactionsOutput, error) {
+// 				panic("mock out the ListScheduledTransactions method")
+// 			},
+// 			OpenAccountFunc: func(contextMoqParam context.Context, account entities.Account) (entities.Account, error) {
+// 				panic("mock out the OpenAccount method")
+// 			},
+// 			PostScheduledTransactionFunc: func(contextMoqParam context.Context, scheduledTransaction entities.ScheduledTransaction) error {
+// 				panic("mock out the PostScheduledTransaction method")
+// 			},
+// 			ReopenFiscalPeriodFunc: func(contextMoqParam context.Context, reopenFiscalPeriodInput domain.ReopenFiscalPeriodInput) (entities.FiscalPeriod, error) {
+// 				panic("mock out the ReopenFiscalPeriod method")
+// 			},
+// 			RevertTransactionFunc: func(contextMoqParam context.Context, revertTransactionInput domain.RevertTransactionInput) error {
+// 				panic("mock out the RevertTransaction method")
+// 			},
+// 			ScheduleTransactionFunc: func(contextMoqParam context.Context, transaction entities.Transaction) (entities.ScheduledTransaction, error) {
+// 				panic("mock out the ScheduleTransaction method")
+// 			},
+// 			SetBalanceLimitFunc: func(contextMoqParam context.Context, balanceLimit vos.BalanceLimit) error {
+// 				panic("mock out the SetBalanceLimit method")
+// 			},
+// 			SetCompetenceLockFunc: func(contextMoqParam context.Context, competenceLockInput domain.CompetenceLockInput) (entities.CompetenceLockChange, error) {
+// 				panic("mock out the SetCompetenceLock method")
+// 			},
+// 			SetEventMetadataSchemaFunc: func(contextMoqParam context.Context, eventMetadataSchema entities.EventMetadataSchema) (entities.EventMetadataSchema, error) {
+// 				panic("mock out the SetEventMetadataSchema method")
+// 			},
+// 			UnfreezeAccountFunc: func(contextMoqParam context.Context, account vos.Account) (entities.Account, error) {
+// 				panic("mock out the UnfreezeAccount method")
+// 			},
+// 			VoidTransactionFunc: func(contextMoqParam context.Context, uUID uuid.UUID) error {
+// 				panic("mock out the VoidTransaction method")
+// 			},
 // 		}
 //
 // 		// use mockedUseCase in code that requires domain.UseCase
@@ -41,20 +161,203 @@ var _ domain.UseCase = &UseCaseMock{}
 //
 // 	}
 type UseCaseMock struct {
+	// AuthorizeTransactionFunc mocks the AuthorizeTransaction method.
+	AuthorizeTransactionFunc func(contextMoqParam context.Context, transaction entities.Transaction) (entities.PendingTransaction, error)
+
+	// CancelScheduledTransactionFunc mocks the CancelScheduledTransaction method.
+	CancelScheduledTransactionFunc func(contextMoqParam context.Context, uUID uuid.UUID) error
+
+	// CaptureTransactionFunc mocks the CaptureTransaction method.
+	CaptureTransactionFunc func(contextMoqParam context.Context, captureTransactionInput domain.CaptureTransactionInput) error
+
+	// ClaimDueScheduledTransactionsFunc mocks the ClaimDueScheduledTransactions method.
+	ClaimDueScheduledTransactionsFunc func(contextMoqParam context.Context, n int) ([]entities.ScheduledTransaction, error)
+
+	// ClearCompetenceLockFunc mocks the ClearCompetenceLock method.
+	ClearCompetenceLockFunc func(contextMoqParam context.Context, competenceLockInput domain.CompetenceLockInput) (entities.CompetenceLockChange, error)
+
+	// CloseAccountFunc mocks the CloseAccount method.
+	CloseAccountFunc func(contextMoqParam context.Context, account vos.Account) (entities.Account, error)
+
+	// CloseFiscalPeriodFunc mocks the CloseFiscalPeriod method.
+	CloseFiscalPeriodFunc func(contextMoqParam context.Context, closeFiscalPeriodInput domain.CloseFiscalPeriodInput) (entities.FiscalPeriodClosing, error)
+
+	// CreateEventFunc mocks the CreateEvent method.
+	CreateEventFunc func(contextMoqParam context.Context, event entities.Event) (entities.Event, error)
+
+	// CreateFiscalPeriodFunc mocks the CreateFiscalPeriod method.
+	CreateFiscalPeriodFunc func(contextMoqParam context.Context, fiscalPeriod entities.FiscalPeriod) (entities.FiscalPeriod, error)
+
 	// CreateTransactionFunc mocks the CreateTransaction method.
-	CreateTransactionFunc func(contextMoqParam context.Context, transaction entities.Transaction) error
+	CreateTransactionFunc func(contextMoqParam context.Context, transaction entities.Transaction) (entities.Transaction, error)
+
+	// CreateTransactionsFunc mocks the CreateTransactions method.
+	CreateTransactionsFunc func(contextMoqParam context.Context, createTransactionsInput domain.CreateTransactionsInput) ([]error, error)
+
+	// DeleteBalanceLimitFunc mocks the DeleteBalanceLimit method.
+	DeleteBalanceLimitFunc func(contextMoqParam context.Context, account vos.Account, currency vos.Currency) error
+
+	// DeprecateEventFunc mocks the DeprecateEvent method.
+	DeprecateEventFunc func(contextMoqParam context.Context, v uint32) (entities.Event, error)
+
+	// DescribeAccountFunc mocks the DescribeAccount method.
+	DescribeAccountFunc func(contextMoqParam context.Context, account vos.Account) (entities.Account, error)
+
+	// DescribeEventFunc mocks the DescribeEvent method.
+	DescribeEventFunc func(contextMoqParam context.Context, v uint32) (entities.Event, error)
+
+	// DescribeFiscalPeriodFunc mocks the DescribeFiscalPeriod method.
+	DescribeFiscalPeriodFunc func(contextMoqParam context.Context, uUID uuid.UUID) (domain.DescribeFiscalPeriodOutput, error)
+
+	// FreezeAccountFunc mocks the FreezeAccount method.
+	FreezeAccountFunc func(contextMoqParam context.Context, account vos.Account) (entities.Account, error)
 
 	// GetAccountBalanceFunc mocks the GetAccountBalance method.
 	GetAccountBalanceFunc func(contextMoqParam context.Context, getAccountBalanceInput domain.GetAccountBalanceInput) (vos.AccountBalance, error)
 
+	// GetBalanceHistoryFunc mocks the GetBalanceHistory method.
+	GetBalanceHistoryFunc func(contextMoqParam context.Context, balanceHistoryRequest vos.BalanceHistoryRequest) (vos.BalanceHistory, error)
+
+	// GetBalanceSheetFunc mocks the GetBalanceSheet method.
+	GetBalanceSheetFunc func(contextMoqParam context.Context, financialStatementInput domain.FinancialStatementInput) (vos.FinancialStatement, error)
+
+	// GetIncomeStatementFunc mocks the GetIncomeStatement method.
+	GetIncomeStatementFunc func(contextMoqParam context.Context, financialStatementInput domain.FinancialStatementInput) (vos.FinancialStatement, error)
+
 	// GetSyntheticReportFunc mocks the GetSyntheticReport method.
-	GetSyntheticReportFunc func(contextMoqParam context.Context, account vos.Account, n int, timeMoqParam1 time.Time, timeMoqParam2 time.Time) (*vos.SyntheticReport, error)
+	GetSyntheticReportFunc func(contextMoqParam context.Context, syntheticReportRequest vos.SyntheticReportRequest) (*vos.SyntheticReport, error)
+
+	// GetTransactionFunc mocks the GetTransaction method.
+	GetTransactionFunc func(contextMoqParam context.Context, uUID uuid.UUID) (entities.Transaction, error)
+
+	// GetTrialBalanceFunc mocks the GetTrialBalance method.
+	GetTrialBalanceFunc func(contextMoqParam context.Context, trialBalanceRequest vos.TrialBalanceRequest) (vos.TrialBalance, error)
+
+	// ListAccountChildrenFunc mocks the ListAccountChildren method.
+	ListAccountChildrenFunc func(contextMoqParam context.Context, accountChildrenRequest vos.AccountChildrenRequest) (vos.AccountChildren, error)
 
 	// ListAccountEntriesFunc mocks the ListAccountEntries method.
 	ListAccountEntriesFunc func(contextMoqParam context.Context, accountEntryRequest vos.AccountEntryRequest) (vos.AccountEntryResponse, error)
 
+	// ListAccountsFunc mocks the ListAccounts method.
+	ListAccountsFunc func(contextMoqParam context.Context, accountListRequest vos.AccountListRequest) (vos.AccountList, error)
+
+	// ListBalanceLimitsFunc mocks the ListBalanceLimits method.
+	ListBalanceLimitsFunc func(contextMoqParam context.Context) ([]vos.BalanceLimit, error)
+
+	// ListChartOfAccountsFunc mocks the ListChartOfAccounts method.
+	ListChartOfAccountsFunc func(contextMoqParam context.Context) (vos.ChartOfAccounts, error)
+
+	// ListCompetenceLocksFunc mocks the ListCompetenceLocks method.
+	ListCompetenceLocksFunc func(contextMoqParam context.Context, s string) (domain.ListCompetenceLocksOutput, error)
+
+	// ListEventMetadataSchemasFunc mocks the ListEventMetadataSchemas method.
+	ListEventMetadataSchemasFunc func(contextMoqParam context.Context, v uint32) ([]entities.EventMetadataSchema, error)
+
+	// ListEventsFunc mocks the ListEvents method.
+	ListEventsFunc func(contextMoqParam context.Context, listEventsInput domain.ListEventsInput) ([]entities.Event, error)
+
+	// ListFiscalPeriodsFunc mocks the ListFiscalPeriods method.
+	ListFiscalPeriodsFunc func(contextMoqParam context.Context, s string) ([]entities.FiscalPeriod, error)
+
+	// ListScheduledTransactionsFunc mocks the ListScheduledTransactions method.
+	ListScheduledTransactionsFunc func(contextMoqParam context.Context, listScheduledTransactionsInput domain.ListScheduledTransactionsInput) (domain.ListScheduledTransactionsOutput, error)
+
+	// OpenAccountFunc mocks the OpenAccount method.
+	OpenAccountFunc func(contextMoqParam context.Context, account entities.Account) (entities.Account, error)
+
+	// PostScheduledTransactionFunc mocks the PostScheduledTransaction method.
+	PostScheduledTransactionFunc func(contextMoqParam context.Context, scheduledTransaction entities.ScheduledTransaction) error
+
+	// ReopenFiscalPeriodFunc mocks the ReopenFiscalPeriod method.
+	ReopenFiscalPeriodFunc func(contextMoqParam context.Context, reopenFiscalPeriodInput domain.ReopenFiscalPeriodInput) (entities.FiscalPeriod, error)
+
+	// RevertTransactionFunc mocks the RevertTransaction method.
+	RevertTransactionFunc func(contextMoqParam context.Context, revertTransactionInput domain.RevertTransactionInput) error
+
+	// ScheduleTransactionFunc mocks the ScheduleTransaction method.
+	ScheduleTransactionFunc func(contextMoqParam context.Context, transaction entities.Transaction) (entities.ScheduledTransaction, error)
+
+	// SetBalanceLimitFunc mocks the SetBalanceLimit method.
+	SetBalanceLimitFunc func(contextMoqParam context.Context, balanceLimit vos.BalanceLimit) error
+
+	// SetCompetenceLockFunc mocks the SetCompetenceLock method.
+	SetCompetenceLockFunc func(contextMoqParam context.Context, competenceLockInput domain.CompetenceLockInput) (entities.CompetenceLockChange, error)
+
+	// SetEventMetadataSchemaFunc mocks the SetEventMetadataSchema method.
+	SetEventMetadataSchemaFunc func(contextMoqParam context.Context, eventMetadataSchema entities.EventMetadataSchema) (entities.EventMetadataSchema, error)
+
+	// UnfreezeAccountFunc mocks the UnfreezeAccount method.
+	UnfreezeAccountFunc func(contextMoqParam context.Context, account vos.Account) (entities.Account, error)
+
+	// VoidTransactionFunc mocks the VoidTransaction method.
+	VoidTransactionFunc func(contextMoqParam context.Context, uUID uuid.UUID) error
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// AuthorizeTransaction holds details about calls to the AuthorizeTransaction method.
+		AuthorizeTransaction []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// Transaction is the transaction argument value.
+			Transaction entities.Transaction
+		}
+		// CancelScheduledTransaction holds details about calls to the CancelScheduledTransaction method.
+		CancelScheduledTransaction []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// UUID is the uUID argument value.
+			UUID uuid.UUID
+		}
+		// CaptureTransaction holds details about calls to the CaptureTransaction method.
+		CaptureTransaction []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// CaptureTransactionInput is the captureTransactionInput argument value.
+			CaptureTransactionInput domain.CaptureTransactionInput
+		}
+		// ClaimDueScheduledTransactions holds details about calls to the ClaimDueScheduledTransactions method.
+		ClaimDueScheduledTransactions []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// N is the n argument value.
+			N int
+		}
+		// ClearCompetenceLock holds details about calls to the ClearCompetenceLock method.
+		ClearCompetenceLock []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// CompetenceLockInput is the competenceLockInput argument value.
+			CompetenceLockInput domain.CompetenceLockInput
+		}
+		// CloseAccount holds details about calls to the CloseAccount method.
+		CloseAccount []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// Account is the account argument value.
+			Account vos.Account
+		}
+		// CloseFiscalPeriod holds details about calls to the CloseFiscalPeriod method.
+		CloseFiscalPeriod []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// CloseFiscalPeriodInput is the closeFiscalPeriodInput argument value.
+			CloseFiscalPeriodInput domain.CloseFiscalPeriodInput
+		}
+		// CreateEvent holds details about calls to the CreateEvent method.
+		CreateEvent []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// Event is the event argument value.
+			Event entities.Event
+		}
+		// CreateFiscalPeriod holds details about calls to the CreateFiscalPeriod method.
+		CreateFiscalPeriod []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// FiscalPeriod is the fiscalPeriod argument value.
+			FiscalPeriod entities.FiscalPeriod
+		}
 		// CreateTransaction holds details about calls to the CreateTransaction method.
 		CreateTransaction []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
@@ -62,6 +365,57 @@ type UseCaseMock struct {
 			// Transaction is the transaction argument value.
 			Transaction entities.Transaction
 		}
+		// CreateTransactions holds details about calls to the CreateTransactions method.
+		CreateTransactions []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// CreateTransactionsInput is the createTransactionsInput argument value.
+			CreateTransactionsInput domain.CreateTransactionsInput
+		}
+		// DeleteBalanceLimit holds details about calls to the DeleteBalanceLimit method.
+		DeleteBalanceLimit []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// Account is the account argument value.
+			Account vos.Account
+			// Currency is the currency argument value.
+			Currency vos.Currency
+		}
+		// DeprecateEvent holds details about calls to the DeprecateEvent method.
+		DeprecateEvent []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// V is the v argument value.
+			V uint32
+		}
+		// DescribeAccount holds details about calls to the DescribeAccount method.
+		DescribeAccount []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// Account is the account argument value.
+			Account vos.Account
+		}
+		// DescribeEvent holds details about calls to the DescribeEvent method.
+		DescribeEvent []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// V is the v argument value.
+			V uint32
+		}
+		// DescribeFiscalPeriod holds details about calls to the DescribeFiscalPeriod method.
+		DescribeFiscalPeriod []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// UUID is the uUID argument value.
+			UUID uuid.UUID
+		}
+		// FreezeAccount holds details about calls to the FreezeAccount method.
+		FreezeAccount []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// Account is the account argument value.
+			Account vos.Account
+		}
 		// GetAccountBalance holds details about calls to the GetAccountBalance method.
 		GetAccountBalance []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
@@ -69,18 +423,54 @@ type UseCaseMock struct {
 			// GetAccountBalanceInput is the getAccountBalanceInput argument value.
 			GetAccountBalanceInput domain.GetAccountBalanceInput
 		}
+		// GetBalanceHistory holds details about calls to the GetBalanceHistory method.
+		GetBalanceHistory []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// BalanceHistoryRequest is the balanceHistoryRequest argument value.
+			BalanceHistoryRequest vos.BalanceHistoryRequest
+		}
+		// GetBalanceSheet holds details about calls to the GetBalanceSheet method.
+		GetBalanceSheet []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// FinancialStatementInput is the financialStatementInput argument value.
+			FinancialStatementInput domain.FinancialStatementInput
+		}
+		// GetIncomeStatement holds details about calls to the GetIncomeStatement method.
+		GetIncomeStatement []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// FinancialStatementInput is the financialStatementInput argument value.
+			FinancialStatementInput domain.FinancialStatementInput
+		}
 		// GetSyntheticReport holds details about calls to the GetSyntheticReport method.
 		GetSyntheticReport []struct {
 			// ContextMoqParam is the contextMoqParam argument value.
 			ContextMoqParam context.Context
-			// Account is the account argument value.
-			Account vos.Account
-			// N is the n argument value.
-			N int
-			// TimeMoqParam1 is the timeMoqParam1 argument value.
-			TimeMoqParam1 time.Time
-			// TimeMoqParam2 is the timeMoqParam2 argument value.
-			TimeMoqParam2 time.Time
+			// SyntheticReportRequest is the syntheticReportRequest argument value.
+			SyntheticReportRequest vos.SyntheticReportRequest
+		}
+		// GetTransaction holds details about calls to the GetTransaction method.
+		GetTransaction []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// UUID is the uUID argument value.
+			UUID uuid.UUID
+		}
+		// GetTrialBalance holds details about calls to the GetTrialBalance method.
+		GetTrialBalance []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// TrialBalanceRequest is the trialBalanceRequest argument value.
+			TrialBalanceRequest vos.TrialBalanceRequest
+		}
+		// ListAccountChildren holds details about calls to the ListAccountChildren method.
+		ListAccountChildren []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// AccountChildrenRequest is the accountChildrenRequest argument value.
+			AccountChildrenRequest vos.AccountChildrenRequest
 		}
 		// ListAccountEntries holds details about calls to the ListAccountEntries method.
 		ListAccountEntries []struct {
@@ -89,17 +479,179 @@ type UseCaseMock struct {
 			// AccountEntryRequest is the accountEntryRequest argument value.
 			AccountEntryRequest vos.AccountEntryRequest
 		}
+		// ListAccounts holds details about calls to the ListAccounts method.
+		ListAccounts []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// AccountListRequest is the accountListRequest argument value.
+			AccountListRequest vos.AccountListRequest
+		}
+		// ListBalanceLimits holds details about calls to the ListBalanceLimits method.
+		ListBalanceLimits []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// ListChartOfAccounts holds details about calls to the ListChartOfAccounts method.
+		ListChartOfAccounts []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+		}
+		// ListCompetenceLocks holds details about calls to the ListCompetenceLocks method.
+		ListCompetenceLocks []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// S is the s argument value.
+			S string
+		}
+		// ListEventMetadataSchemas holds details about calls to the ListEventMetadataSchemas method.
+		ListEventMetadataSchemas []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// V is the v argument value.
+			V uint32
+		}
+		// ListEvents holds details about calls to the ListEvents method.
+		ListEvents []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// ListEventsInput is the listEventsInput argument value.
+			ListEventsInput domain.ListEventsInput
+		}
+		// ListFiscalPeriods holds details about calls to the ListFiscalPeriods method.
+		ListFiscalPeriods []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// S is the s argument value.
+			S string
+		}
+		// ListScheduledTransactions holds details about calls to the ListScheduledTransactions method.
+		ListScheduledTransactions []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// ListScheduledTransactionsInput is the listScheduledTransactionsInput argument value.
+			ListScheduledTransactionsInput domain.ListScheduledTransactionsInput
+		}
+		// OpenAccount holds details about calls to the OpenAccount method.
+		OpenAccount []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// Account is the account argument value.
+			Account entities.Account
+		}
+		// PostScheduledTransaction holds details about calls to the PostScheduledTransaction method.
+		PostScheduledTransaction []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// ScheduledTransaction is the scheduledTransaction argument value.
+			ScheduledTransaction entities.ScheduledTransaction
+		}
+		// ReopenFiscalPeriod holds details about calls to the ReopenFiscalPeriod method.
+		ReopenFiscalPeriod []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// ReopenFiscalPeriodInput is the reopenFiscalPeriodInput argument value.
+			ReopenFiscalPeriodInput domain.ReopenFiscalPeriodInput
+		}
+		// RevertTransaction holds details about calls to the RevertTransaction method.
+		RevertTransaction []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// RevertTransactionInput is the revertTransactionInput argument value.
+			RevertTransactionInput domain.RevertTransactionInput
+		}
+		// ScheduleTransaction holds details about calls to the ScheduleTransaction method.
+		ScheduleTransaction []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// Transaction is the transaction argument value.
+			Transaction entities.Transaction
+		}
+		// SetBalanceLimit holds details about calls to the SetBalanceLimit method.
+		SetBalanceLimit []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// BalanceLimit is the balanceLimit argument value.
+			BalanceLimit vos.BalanceLimit
+		}
+		// SetCompetenceLock holds details about calls to the SetCompetenceLock method.
+		SetCompetenceLock []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// CompetenceLockInput is the competenceLockInput argument value.
+			CompetenceLockInput domain.CompetenceLockInput
+		}
+		// SetEventMetadataSchema holds details about calls to the SetEventMetadataSchema method.
+		SetEventMetadataSchema []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// EventMetadataSchema is the eventMetadataSchema argument value.
+			EventMetadataSchema entities.EventMetadataSchema
+		}
+		// UnfreezeAccount holds details about calls to the UnfreezeAccount method.
+		UnfreezeAccount []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// Account is the account argument value.
+			Account vos.Account
+		}
+		// VoidTransaction holds details about calls to the VoidTransaction method.
+		VoidTransaction []struct {
+			// ContextMoqParam is the contextMoqParam argument value.
+			ContextMoqParam context.Context
+			// UUID is the uUID argument value.
+			UUID uuid.UUID
+		}
 	}
-	lockCreateTransaction  sync.RWMutex
-	lockGetAccountBalance  sync.RWMutex
-	lockGetSyntheticReport sync.RWMutex
-	lockListAccountEntries sync.RWMutex
+	lockAuthorizeTransaction          sync.RWMutex
+	lockCancelScheduledTransaction    sync.RWMutex
+	lockCaptureTransaction            sync.RWMutex
+	lockClaimDueScheduledTransactions sync.RWMutex
+	lockClearCompetenceLock           sync.RWMutex
+	lockCloseAccount                  sync.RWMutex
+	lockCloseFiscalPeriod             sync.RWMutex
+	lockCreateEvent                   sync.RWMutex
+	lockCreateFiscalPeriod            sync.RWMutex
+	lockCreateTransaction             sync.RWMutex
+	lockCreateTransactions            sync.RWMutex
+	lockDeleteBalanceLimit            sync.RWMutex
+	lockDeprecateEvent                sync.RWMutex
+	lockDescribeAccount               sync.RWMutex
+	lockDescribeEvent                 sync.RWMutex
+	lockDescribeFiscalPeriod          sync.RWMutex
+	lockFreezeAccount                 sync.RWMutex
+	lockGetAccountBalance             sync.RWMutex
+	lockGetBalanceHistory             sync.RWMutex
+	lockGetBalanceSheet               sync.RWMutex
+	lockGetIncomeStatement            sync.RWMutex
+	lockGetSyntheticReport            sync.RWMutex
+	lockGetTransaction                sync.RWMutex
+	lockGetTrialBalance               sync.RWMutex
+	lockListAccountChildren           sync.RWMutex
+	lockListAccountEntries            sync.RWMutex
+	lockListAccounts                  sync.RWMutex
+	lockListBalanceLimits             sync.RWMutex
+	lockListChartOfAccounts           sync.RWMutex
+	lockListCompetenceLocks           sync.RWMutex
+	lockListEventMetadataSchemas      sync.RWMutex
+	lockListEvents                    sync.RWMutex
+	lockListFiscalPeriods             sync.RWMutex
+	lockListScheduledTransactions     sync.RWMutex
+	lockOpenAccount                   sync.RWMutex
+	lockPostScheduledTransaction      sync.RWMutex
+	lockReopenFiscalPeriod            sync.RWMutex
+	lockRevertTransaction             sync.RWMutex
+	lockScheduleTransaction           sync.RWMutex
+	lockSetBalanceLimit               sync.RWMutex
+	lockSetCompetenceLock             sync.RWMutex
+	lockSetEventMetadataSchema        sync.RWMutex
+	lockUnfreezeAccount               sync.RWMutex
+	lockVoidTransaction               sync.RWMutex
 }
 
-// CreateTransaction calls CreateTransactionFunc.
-func (mock *UseCaseMock) CreateTransaction(contextMoqParam context.Context, transaction entities.Transaction) error {
-	if mock.CreateTransactionFunc == nil {
-		panic("UseCaseMock.CreateTransactionFunc: method is nil but UseCase.CreateTransaction was just called")
+// AuthorizeTransaction calls AuthorizeTransactionFunc.
+func (mock *UseCaseMock) AuthorizeTransaction(contextMoqParam context.Context, transaction entities.Transaction) (entities.PendingTransaction, error) {
+	if mock.AuthorizeTransactionFunc == nil {
+		panic("UseCaseMock.AuthorizeTransactionFunc: method is nil but UseCase.AuthorizeTransaction was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
@@ -108,16 +660,16 @@ func (mock *UseCaseMock) CreateTransaction(contextMoqParam context.Context, tran
 		ContextMoqParam: contextMoqParam,
 		Transaction:     transaction,
 	}
-	mock.lockCreateTransaction.Lock()
-	mock.calls.CreateTransaction = append(mock.calls.CreateTransaction, callInfo)
-	mock.lockCreateTransaction.Unlock()
-	return mock.CreateTransactionFunc(contextMoqParam, transaction)
+	mock.lockAuthorizeTransaction.Lock()
+	mock.calls.AuthorizeTransaction = append(mock.calls.AuthorizeTransaction, callInfo)
+	mock.lockAuthorizeTransaction.Unlock()
+	return mock.AuthorizeTransactionFunc(contextMoqParam, transaction)
 }
 
-// CreateTransactionCalls gets all the calls that were made to CreateTransaction.
+// AuthorizeTransactionCalls gets all the calls that were made to AuthorizeTransaction.
 // Check the length with:
-//     len(mockedUseCase.CreateTransactionCalls())
-func (mock *UseCaseMock) CreateTransactionCalls() []struct {
+//     len(mockedUseCase.AuthorizeTransactionCalls())
+func (mock *UseCaseMock) AuthorizeTransactionCalls() []struct {
 	ContextMoqParam context.Context
 	Transaction     entities.Transaction
 } {
@@ -125,125 +677,1509 @@ func (mock *UseCaseMock) CreateTransactionCalls() []struct {
 		ContextMoqParam context.Context
 		Transaction     entities.Transaction
 	}
-	mock.lockCreateTransaction.RLock()
-	calls = mock.calls.CreateTransaction
-	mock.lockCreateTransaction.RUnlock()
+	mock.lockAuthorizeTransaction.RLock()
+	calls = mock.calls.AuthorizeTransaction
+	mock.lockAuthorizeTransaction.RUnlock()
 	return calls
 }
 
-// GetAccountBalance calls GetAccountBalanceFunc.
-func (mock *UseCaseMock) GetAccountBalance(contextMoqParam context.Context, getAccountBalanceInput domain.GetAccountBalanceInput) (vos.AccountBalance, error) {
-	if mock.GetAccountBalanceFunc == nil {
-		panic("UseCaseMock.GetAccountBalanceFunc: method is nil but UseCase.GetAccountBalance was just called")
+// CancelScheduledTransaction calls CancelScheduledTransactionFunc.
+func (mock *UseCaseMock) CancelScheduledTransaction(contextMoqParam context.Context, uUID uuid.UUID) error {
+	if mock.CancelScheduledTransactionFunc == nil {
+		panic("UseCaseMock.CancelScheduledTransactionFunc: method is nil but UseCase.CancelScheduledTransaction was just called")
 	}
 	callInfo := struct {
-		ContextMoqParam        context.Context
-		GetAccountBalanceInput domain.GetAccountBalanceInput
+		ContextMoqParam context.Context
+		UUID            uuid.UUID
 	}{
-		ContextMoqParam:        contextMoqParam,
-		GetAccountBalanceInput: getAccountBalanceInput,
+		ContextMoqParam: contextMoqParam,
+		UUID:            uUID,
 	}
-	mock.lockGetAccountBalance.Lock()
-	mock.calls.GetAccountBalance = append(mock.calls.GetAccountBalance, callInfo)
-	mock.lockGetAccountBalance.Unlock()
-	return mock.GetAccountBalanceFunc(contextMoqParam, getAccountBalanceInput)
+	mock.lockCancelScheduledTransaction.Lock()
+	mock.calls.CancelScheduledTransaction = append(mock.calls.CancelScheduledTransaction, callInfo)
+	mock.lockCancelScheduledTransaction.Unlock()
+	return mock.CancelScheduledTransactionFunc(contextMoqParam, uUID)
 }
 
-// GetAccountBalanceCalls gets all the calls that were made to GetAccountBalance.
+// CancelScheduledTransactionCalls gets all the calls that were made to CancelScheduledTransaction.
 // Check the length with:
-//     len(mockedUseCase.GetAccountBalanceCalls())
-func (mock *UseCaseMock) GetAccountBalanceCalls() []struct {
-	ContextMoqParam        context.Context
-	GetAccountBalanceInput domain.GetAccountBalanceInput
+//     len(mockedUseCase.CancelScheduledTransactionCalls())
+func (mock *UseCaseMock) CancelScheduledTransactionCalls() []struct {
+	ContextMoqParam context.Context
+	UUID            uuid.UUID
 } {
 	var calls []struct {
-		ContextMoqParam        context.Context
-		GetAccountBalanceInput domain.GetAccountBalanceInput
+		ContextMoqParam context.Context
+		UUID            uuid.UUID
 	}
-	mock.lockGetAccountBalance.RLock()
-	calls = mock.calls.GetAccountBalance
-	mock.lockGetAccountBalance.RUnlock()
+	mock.lockCancelScheduledTransaction.RLock()
+	calls = mock.calls.CancelScheduledTransaction
+	mock.lockCancelScheduledTransaction.RUnlock()
 	return calls
 }
 
-// GetSyntheticReport calls GetSyntheticReportFunc.
-func (mock *UseCaseMock) GetSyntheticReport(contextMoqParam context.Context, account vos.Account, n int, timeMoqParam1 time.Time, timeMoqParam2 time.Time) (*vos.SyntheticReport, error) {
-	if mock.GetSyntheticReportFunc == nil {
-		panic("UseCaseMock.GetSyntheticReportFunc: method is nil but UseCase.GetSyntheticReport was just called")
+// CaptureTransaction calls CaptureTransactionFunc.
+func (mock *UseCaseMock) CaptureTransaction(contextMoqParam context.Context, captureTransactionInput domain.CaptureTransactionInput) error {
+	if mock.CaptureTransactionFunc == nil {
+		panic("UseCaseMock.CaptureTransactionFunc: method is nil but UseCase.CaptureTransaction was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam         context.Context
+		CaptureTransactionInput domain.CaptureTransactionInput
+	}{
+		ContextMoqParam:         contextMoqParam,
+		CaptureTransactionInput: captureTransactionInput,
+	}
+	mock.lockCaptureTransaction.Lock()
+	mock.calls.CaptureTransaction = append(mock.calls.CaptureTransaction, callInfo)
+	mock.lockCaptureTransaction.Unlock()
+	return mock.CaptureTransactionFunc(contextMoqParam, captureTransactionInput)
+}
+
+// CaptureTransactionCalls gets all the calls that were made to CaptureTransaction.
+// Check the length with:
+//     len(mockedUseCase.CaptureTransactionCalls())
+func (mock *UseCaseMock) CaptureTransactionCalls() []struct {
+	ContextMoqParam         context.Context
+	CaptureTransactionInput domain.CaptureTransactionInput
+} {
+	var calls []struct {
+		ContextMoqParam         context.Context
+		CaptureTransactionInput domain.CaptureTransactionInput
+	}
+	mock.lockCaptureTransaction.RLock()
+	calls = mock.calls.CaptureTransaction
+	mock.lockCaptureTransaction.RUnlock()
+	return calls
+}
+
+// ClaimDueScheduledTransactions calls ClaimDueScheduledTransactionsFunc.
+func (mock *UseCaseMock) ClaimDueScheduledTransactions(contextMoqParam context.Context, n int) ([]entities.ScheduledTransaction, error) {
+	if mock.ClaimDueScheduledTransactionsFunc == nil {
+		panic("UseCaseMock.ClaimDueScheduledTransactionsFunc: method is nil but UseCase.ClaimDueScheduledTransactions was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam context.Context
-		Account         vos.Account
 		N               int
-		TimeMoqParam1   time.Time
-		TimeMoqParam2   time.Time
 	}{
 		ContextMoqParam: contextMoqParam,
-		Account:         account,
 		N:               n,
-		TimeMoqParam1:   timeMoqParam1,
-		TimeMoqParam2:   timeMoqParam2,
 	}
-	mock.lockGetSyntheticReport.Lock()
-	mock.calls.GetSyntheticReport = append(mock.calls.GetSyntheticReport, callInfo)
-	mock.lockGetSyntheticReport.Unlock()
-	return mock.GetSyntheticReportFunc(contextMoqParam, account, n, timeMoqParam1, timeMoqParam2)
+	mock.lockClaimDueScheduledTransactions.Lock()
+	mock.calls.ClaimDueScheduledTransactions = append(mock.calls.ClaimDueScheduledTransactions, callInfo)
+	mock.lockClaimDueScheduledTransactions.Unlock()
+	return mock.ClaimDueScheduledTransactionsFunc(contextMoqParam, n)
 }
 
-// GetSyntheticReportCalls gets all the calls that were made to GetSyntheticReport.
+// ClaimDueScheduledTransactionsCalls gets all the calls that were made to ClaimDueScheduledTransactions.
 // Check the length with:
-//     len(mockedUseCase.GetSyntheticReportCalls())
-func (mock *UseCaseMock) GetSyntheticReportCalls() []struct {
+//     len(mockedUseCase.ClaimDueScheduledTransactionsCalls())
+func (mock *UseCaseMock) ClaimDueScheduledTransactionsCalls() []struct {
 	ContextMoqParam context.Context
-	Account         vos.Account
 	N               int
-	TimeMoqParam1   time.Time
-	TimeMoqParam2   time.Time
 } {
 	var calls []struct {
 		ContextMoqParam context.Context
-		Account         vos.Account
 		N               int
-		TimeMoqParam1   time.Time
-		TimeMoqParam2   time.Time
 	}
-	mock.lockGetSyntheticReport.RLock()
-	calls = mock.calls.GetSyntheticReport
-	mock.lockGetSyntheticReport.RUnlock()
+	mock.lockClaimDueScheduledTransactions.RLock()
+	calls = mock.calls.ClaimDueScheduledTransactions
+	mock.lockClaimDueScheduledTransactions.RUnlock()
 	return calls
 }
 
-// ListAccountEntries calls ListAccountEntriesFunc.
-func (mock *UseCaseMock) ListAccountEntries(contextMoqParam context.Context, accountEntryRequest vos.AccountEntryRequest) (vos.AccountEntryResponse, error) {
-	if mock.ListAccountEntriesFunc == nil {
-		panic("UseCaseMock.ListAccountEntriesFunc: method is nil but UseCase.ListAccountEntries was just called")
+// ClearCompetenceLock calls ClearCompetenceLockFunc.
+func (mock *UseCaseMock) ClearCompetenceLock(contextMoqParam context.Context, competenceLockInput domain.CompetenceLockInput) (entities.CompetenceLockChange, error) {
+	if mock.ClearCompetenceLockFunc == nil {
+		panic("UseCaseMock.ClearCompetenceLockFunc: method is nil but UseCase.ClearCompetenceLock was just called")
 	}
 	callInfo := struct {
 		ContextMoqParam     context.Context
-		AccountEntryRequest vos.AccountEntryRequest
+		CompetenceLockInput domain.CompetenceLockInput
 	}{
 		ContextMoqParam:     contextMoqParam,
-		AccountEntryRequest: accountEntryRequest,
+		CompetenceLockInput: competenceLockInput,
 	}
-	mock.lockListAccountEntries.Lock()
-	mock.calls.ListAccountEntries = append(mock.calls.ListAccountEntries, callInfo)
-	mock.lockListAccountEntries.Unlock()
-	return mock.ListAccountEntriesFunc(contextMoqParam, accountEntryRequest)
+	mock.lockClearCompetenceLock.Lock()
+	mock.calls.ClearCompetenceLock = append(mock.calls.ClearCompetenceLock, callInfo)
+	mock.lockClearCompetenceLock.Unlock()
+	return mock.ClearCompetenceLockFunc(contextMoqParam, competenceLockInput)
 }
 
-// ListAccountEntriesCalls gets all the calls that were made to ListAccountEntries.
+// ClearCompetenceLockCalls gets all the calls that were made to ClearCompetenceLock.
 // Check the length with:
-//     len(mockedUseCase.ListAccountEntriesCalls())
-func (mock *UseCaseMock) ListAccountEntriesCalls() []struct {
+//     len(mockedUseCase.ClearCompetenceLockCalls())
+func (mock *UseCaseMock) ClearCompetenceLockCalls() []struct {
 	ContextMoqParam     context.Context
-	AccountEntryRequest vos.AccountEntryRequest
+	CompetenceLockInput domain.CompetenceLockInput
 } {
 	var calls []struct {
 		ContextMoqParam     context.Context
-		AccountEntryRequest vos.AccountEntryRequest
+		CompetenceLockInput domain.CompetenceLockInput
 	}
-	mock.lockListAccountEntries.RLock()
-	calls = mock.calls.ListAccountEntries
-	mock.lockListAccountEntries.RUnlock()
+	mock.lockClearCompetenceLock.RLock()
+	calls = mock.calls.ClearCompetenceLock
+	mock.lockClearCompetenceLock.RUnlock()
+	return calls
+}
+
+// CloseAccount calls CloseAccountFunc.
+func (mock *UseCaseMock) CloseAccount(contextMoqParam context.Context, account vos.Account) (entities.Account, error) {
+	if mock.CloseAccountFunc == nil {
+		panic("UseCaseMock.CloseAccountFunc: method is nil but UseCase.CloseAccount was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		Account         vos.Account
+	}{
+		ContextMoqParam: contextMoqParam,
+		Account:         account,
+	}
+	mock.lockCloseAccount.Lock()
+	mock.calls.CloseAccount = append(mock.calls.CloseAccount, callInfo)
+	mock.lockCloseAccount.Unlock()
+	return mock.CloseAccountFunc(contextMoqParam, account)
+}
+
+// CloseAccountCalls gets all the calls that were made to CloseAccount.
+// Check the length with:
+//     len(mockedUseCase.CloseAccountCalls())
+func (mock *UseCaseMock) CloseAccountCalls() []struct {
+	ContextMoqParam context.Context
+	Account         vos.Account
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		Account         vos.Account
+	}
+	mock.lockCloseAccount.RLock()
+	calls = mock.calls.CloseAccount
+	mock.lockCloseAccount.RUnlock()
+	return calls
+}
+
+// CloseFiscalPeriod calls CloseFiscalPeriodFunc.
+func (mock *UseCaseMock) CloseFiscalPeriod(contextMoqParam context.Context, closeFiscalPeriodInput domain.CloseFiscalPeriodInput) (entities.FiscalPeriodClosing, error) {
+	if mock.CloseFiscalPeriodFunc == nil {
+		panic("UseCaseMock.CloseFiscalPeriodFunc: method is nil but UseCase.CloseFiscalPeriod was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam        context.Context
+		CloseFiscalPeriodInput domain.CloseFiscalPeriodInput
+	}{
+		ContextMoqParam:        contextMoqParam,
+		CloseFiscalPeriodInput: closeFiscalPeriodInput,
+	}
+	mock.lockCloseFiscalPeriod.Lock()
+	mock.calls.CloseFiscalPeriod = append(mock.calls.CloseFiscalPeriod, callInfo)
+	mock.lockCloseFiscalPeriod.Unlock()
+	return mock.CloseFiscalPeriodFunc(contextMoqParam, closeFiscalPeriodInput)
+}
+
+// CloseFiscalPeriodCalls gets all the calls that were made to CloseFiscalPeriod.
+// Check the length with:
+//     len(mockedUseCase.CloseFiscalPeriodCalls())
+func (mock *UseCaseMock) CloseFiscalPeriodCalls() []struct {
+	ContextMoqParam        context.Context
+	CloseFiscalPeriodInput domain.CloseFiscalPeriodInput
+} {
+	var calls []struct {
+		ContextMoqParam        context.Context
+		CloseFiscalPeriodInput domain.CloseFiscalPeriodInput
+	}
+	mock.lockCloseFiscalPeriod.RLock()
+	calls = mock.calls.CloseFiscalPeriod
+	mock.lockCloseFiscalPeriod.RUnlock()
+	return calls
+}
+
+// CreateEvent calls CreateEventFunc.
+func (mock *UseCaseMock) CreateEvent(contextMoqParam context.Context, event entities.Event) (entities.Event, error) {
+	if mock.CreateEventFunc == nil {
+		panic("UseCaseMock.CreateEventFunc: method is nil but UseCase.CreateEvent was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		Event           entities.Event
+	}{
+		ContextMoqParam: contextMoqParam,
+		Event:           event,
+	}
+	mock.lockCreateEvent.Lock()
+	mock.calls.CreateEvent = append(mock.calls.CreateEvent, callInfo)
+	mock.lockCreateEvent.Unlock()
+	return mock.CreateEventFunc(contextMoqParam, event)
+}
+
+// CreateEventCalls gets all the calls that were made to CreateEvent.
+// Check the length with:
+//     len(mockedUseCase.CreateEventCalls())
+func (mock *UseCaseMock) CreateEventCalls() []struct {
+	ContextMoqParam context.Context
+	Event           entities.Event
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		Event           entities.Event
+	}
+	mock.lockCreateEvent.RLock()
+	calls = mock.calls.CreateEvent
+	mock.lockCreateEvent.RUnlock()
+	return calls
+}
+
+// CreateFiscalPeriod calls CreateFiscalPeriodFunc.
+func (mock *UseCaseMock) CreateFiscalPeriod(contextMoqParam context.Context, fiscalPeriod entities.FiscalPeriod) (entities.FiscalPeriod, error) {
+	if mock.CreateFiscalPeriodFunc == nil {
+		panic("UseCaseMock.CreateFiscalPeriodFunc: method is nil but UseCase.CreateFiscalPeriod was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		FiscalPeriod    entities.FiscalPeriod
+	}{
+		ContextMoqParam: contextMoqParam,
+		FiscalPeriod:    fiscalPeriod,
+	}
+	mock.lockCreateFiscalPeriod.Lock()
+	mock.calls.CreateFiscalPeriod = append(mock.calls.CreateFiscalPeriod, callInfo)
+	mock.lockCreateFiscalPeriod.Unlock()
+	return mock.CreateFiscalPeriodFunc(contextMoqParam, fiscalPeriod)
+}
+
+// CreateFiscalPeriodCalls gets all the calls that were made to CreateFiscalPeriod.
+// Check the length with:
+//     len(mockedUseCase.CreateFiscalPeriodCalls())
+func (mock *UseCaseMock) CreateFiscalPeriodCalls() []struct {
+	ContextMoqParam context.Context
+	FiscalPeriod    entities.FiscalPeriod
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		FiscalPeriod    entities.FiscalPeriod
+	}
+	mock.lockCreateFiscalPeriod.RLock()
+	calls = mock.calls.CreateFiscalPeriod
+	mock.lockCreateFiscalPeriod.RUnlock()
+	return calls
+}
+
+// CreateTransaction calls CreateTransactionFunc.
+func (mock *UseCaseMock) CreateTransaction(contextMoqParam context.Context, transaction entities.Transaction) (entities.Transaction, error) {
+	if mock.CreateTransactionFunc == nil {
+		panic("UseCaseMock.CreateTransactionFunc: method is nil but UseCase.CreateTransaction was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		Transaction     entities.Transaction
+	}{
+		ContextMoqParam: contextMoqParam,
+		Transaction:     transaction,
+	}
+	mock.lockCreateTransaction.Lock()
+	mock.calls.CreateTransaction = append(mock.calls.CreateTransaction, callInfo)
+	mock.lockCreateTransaction.Unlock()
+	return mock.CreateTransactionFunc(contextMoqParam, transaction)
+}
+
+// CreateTransactionCalls gets all the calls that were made to CreateTransaction.
+// Check the length with:
+//     len(mockedUseCase.CreateTransactionCalls())
+func (mock *UseCaseMock) CreateTransactionCalls() []struct {
+	ContextMoqParam context.Context
+	Transaction     entities.Transaction
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		Transaction     entities.Transaction
+	}
+	mock.lockCreateTransaction.RLock()
+	calls = mock.calls.CreateTransaction
+	mock.lockCreateTransaction.RUnlock()
+	return calls
+}
+
+// CreateTransactions calls CreateTransactionsFunc.
+func (mock *UseCaseMock) CreateTransactions(contextMoqParam context.Context, createTransactionsInput domain.CreateTransactionsInput) ([]error, error) {
+	if mock.CreateTransactionsFunc == nil {
+		panic("UseCaseMock.CreateTransactionsFunc: method is nil but UseCase.CreateTransactions was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam         context.Context
+		CreateTransactionsInput domain.CreateTransactionsInput
+	}{
+		ContextMoqParam:         contextMoqParam,
+		CreateTransactionsInput: createTransactionsInput,
+	}
+	mock.lockCreateTransactions.Lock()
+	mock.calls.CreateTransactions = append(mock.calls.CreateTransactions, callInfo)
+	mock.lockCreateTransactions.Unlock()
+	return mock.CreateTransactionsFunc(contextMoqParam, createTransactionsInput)
+}
+
+// CreateTransactionsCalls gets all the calls that were made to CreateTransactions.
+// Check the length with:
+//     len(mockedUseCase.CreateTransactionsCalls())
+func (mock *UseCaseMock) CreateTransactionsCalls() []struct {
+	ContextMoqParam         context.Context
+	CreateTransactionsInput domain.CreateTransactionsInput
+} {
+	var calls []struct {
+		ContextMoqParam         context.Context
+		CreateTransactionsInput domain.CreateTransactionsInput
+	}
+	mock.lockCreateTransactions.RLock()
+	calls = mock.calls.CreateTransactions
+	mock.lockCreateTransactions.RUnlock()
+	return calls
+}
+
+// DeleteBalanceLimit calls DeleteBalanceLimitFunc.
+func (mock *UseCaseMock) DeleteBalanceLimit(contextMoqParam context.Context, account vos.Account, currency vos.Currency) error {
+	if mock.DeleteBalanceLimitFunc == nil {
+		panic("UseCaseMock.DeleteBalanceLimitFunc: method is nil but UseCase.DeleteBalanceLimit was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		Account         vos.Account
+		Currency        vos.Currency
+	}{
+		ContextMoqParam: contextMoqParam,
+		Account:         account,
+		Currency:        currency,
+	}
+	mock.lockDeleteBalanceLimit.Lock()
+	mock.calls.DeleteBalanceLimit = append(mock.calls.DeleteBalanceLimit, callInfo)
+	mock.lockDeleteBalanceLimit.Unlock()
+	return mock.DeleteBalanceLimitFunc(contextMoqParam, account, currency)
+}
+
+// DeleteBalanceLimitCalls gets all the calls that were made to DeleteBalanceLimit.
+// Check the length with:
+//     len(mockedUseCase.DeleteBalanceLimitCalls())
+func (mock *UseCaseMock) DeleteBalanceLimitCalls() []struct {
+	ContextMoqParam context.Context
+	Account         vos.Account
+	Currency        vos.Currency
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		Account         vos.Account
+		Currency        vos.Currency
+	}
+	mock.lockDeleteBalanceLimit.RLock()
+	calls = mock.calls.DeleteBalanceLimit
+	mock.lockDeleteBalanceLimit.RUnlock()
+	return calls
+}
+
+// DeprecateEvent calls DeprecateEventFunc.
+func (mock *UseCaseMock) DeprecateEvent(contextMoqParam context.Context, v uint32) (entities.Event, error) {
+	if mock.DeprecateEventFunc == nil {
+		panic("UseCaseMock.DeprecateEventFunc: method is nil but UseCase.DeprecateEvent was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		V               uint32
+	}{
+		ContextMoqParam: contextMoqParam,
+		V:               v,
+	}
+	mock.lockDeprecateEvent.Lock()
+	mock.calls.DeprecateEvent = append(mock.calls.DeprecateEvent, callInfo)
+	mock.lockDeprecateEvent.Unlock()
+	return mock.DeprecateEventFunc(contextMoqParam, v)
+}
+
+// DeprecateEventCalls gets all the calls that were made to DeprecateEvent.
+// Check the length with:
+//     len(mockedUseCase.DeprecateEventCalls())
+func (mock *UseCaseMock) DeprecateEventCalls() []struct {
+	ContextMoqParam context.Context
+	V               uint32
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		V               uint32
+	}
+	mock.lockDeprecateEvent.RLock()
+	calls = mock.calls.DeprecateEvent
+	mock.lockDeprecateEvent.RUnlock()
+	return calls
+}
+
+// DescribeAccount calls DescribeAccountFunc.
+func (mock *UseCaseMock) DescribeAccount(contextMoqParam context.Context, account vos.Account) (entities.Account, error) {
+	if mock.DescribeAccountFunc == nil {
+		panic("UseCaseMock.DescribeAccountFunc: method is nil but UseCase.DescribeAccount was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		Account         vos.Account
+	}{
+		ContextMoqParam: contextMoqParam,
+		Account:         account,
+	}
+	mock.lockDescribeAccount.Lock()
+	mock.calls.DescribeAccount = append(mock.calls.DescribeAccount, callInfo)
+	mock.lockDescribeAccount.Unlock()
+	return mock.DescribeAccountFunc(contextMoqParam, account)
+}
+
+// DescribeAccountCalls gets all the calls that were made to DescribeAccount.
+// Check the length with:
+//     len(mockedUseCase.DescribeAccountCalls())
+func (mock *UseCaseMock) DescribeAccountCalls() []struct {
+	ContextMoqParam context.Context
+	Account         vos.Account
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		Account         vos.Account
+	}
+	mock.lockDescribeAccount.RLock()
+	calls = mock.calls.DescribeAccount
+	mock.lockDescribeAccount.RUnlock()
+	return calls
+}
+
+// DescribeEvent calls DescribeEventFunc.
+func (mock *UseCaseMock) DescribeEvent(contextMoqParam context.Context, v uint32) (entities.Event, error) {
+	if mock.DescribeEventFunc == nil {
+		panic("UseCaseMock.DescribeEventFunc: method is nil but UseCase.DescribeEvent was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		V               uint32
+	}{
+		ContextMoqParam: contextMoqParam,
+		V:               v,
+	}
+	mock.lockDescribeEvent.Lock()
+	mock.calls.DescribeEvent = append(mock.calls.DescribeEvent, callInfo)
+	mock.lockDescribeEvent.Unlock()
+	return mock.DescribeEventFunc(contextMoqParam, v)
+}
+
+// DescribeEventCalls gets all the calls that were made to DescribeEvent.
+// Check the length with:
+//     len(mockedUseCase.DescribeEventCalls())
+func (mock *UseCaseMock) DescribeEventCalls() []struct {
+	ContextMoqParam context.Context
+	V               uint32
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		V               uint32
+	}
+	mock.lockDescribeEvent.RLock()
+	calls = mock.calls.DescribeEvent
+	mock.lockDescribeEvent.RUnlock()
+	return calls
+}
+
+// DescribeFiscalPeriod calls DescribeFiscalPeriodFunc.
+func (mock *UseCaseMock) DescribeFiscalPeriod(contextMoqParam context.Context, uUID uuid.UUID) (domain.DescribeFiscalPeriodOutput, error) {
+	if mock.DescribeFiscalPeriodFunc == nil {
+		panic("UseCaseMock.DescribeFiscalPeriodFunc: method is nil but UseCase.DescribeFiscalPeriod was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		UUID            uuid.UUID
+	}{
+		ContextMoqParam: contextMoqParam,
+		UUID:            uUID,
+	}
+	mock.lockDescribeFiscalPeriod.Lock()
+	mock.calls.DescribeFiscalPeriod = append(mock.calls.DescribeFiscalPeriod, callInfo)
+	mock.lockDescribeFiscalPeriod.Unlock()
+	return mock.DescribeFiscalPeriodFunc(contextMoqParam, uUID)
+}
+
+// DescribeFiscalPeriodCalls gets all the calls that were made to DescribeFiscalPeriod.
+// Check the length with:
+//     len(mockedUseCase.DescribeFiscalPeriodCalls())
+func (mock *UseCaseMock) DescribeFiscalPeriodCalls() []struct {
+	ContextMoqParam context.Context
+	UUID            uuid.UUID
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		UUID            uuid.UUID
+	}
+	mock.lockDescribeFiscalPeriod.RLock()
+	calls = mock.calls.DescribeFiscalPeriod
+	mock.lockDescribeFiscalPeriod.RUnlock()
+	return calls
+}
+
+// FreezeAccount calls FreezeAccountFunc.
+func (mock *UseCaseMock) FreezeAccount(contextMoqParam context.Context, account vos.Account) (entities.Account, error) {
+	if mock.FreezeAccountFunc == nil {
+		panic("UseCaseMock.FreezeAccountFunc: method is nil but UseCase.FreezeAccount was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		Account         vos.Account
+	}{
+		ContextMoqParam: contextMoqParam,
+		Account:         account,
+	}
+	mock.lockFreezeAccount.Lock()
+	mock.calls.FreezeAccount = append(mock.calls.FreezeAccount, callInfo)
+	mock.lockFreezeAccount.Unlock()
+	return mock.FreezeAccountFunc(contextMoqParam, account)
+}
+
+// FreezeAccountCalls gets all the calls that were made to FreezeAccount.
+// Check the length with:
+//     len(mockedUseCase.FreezeAccountCalls())
+func (mock *UseCaseMock) FreezeAccountCalls() []struct {
+	ContextMoqParam context.Context
+	Account         vos.Account
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		Account         vos.Account
+	}
+	mock.lockFreezeAccount.RLock()
+	calls = mock.calls.FreezeAccount
+	mock.lockFreezeAccount.RUnlock()
+	return calls
+}
+
+// GetAccountBalance calls GetAccountBalanceFunc.
+func (mock *UseCaseMock) GetAccountBalance(contextMoqParam context.Context, getAccountBalanceInput domain.GetAccountBalanceInput) (vos.AccountBalance, error) {
+	if mock.GetAccountBalanceFunc == nil {
+		panic("UseCaseMock.GetAccountBalanceFunc: method is nil but UseCase.GetAccountBalance was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam        context.Context
+		GetAccountBalanceInput domain.GetAccountBalanceInput
+	}{
+		ContextMoqParam:        contextMoqParam,
+		GetAccountBalanceInput: getAccountBalanceInput,
+	}
+	mock.lockGetAccountBalance.Lock()
+	mock.calls.GetAccountBalance = append(mock.calls.GetAccountBalance, callInfo)
+	mock.lockGetAccountBalance.Unlock()
+	return mock.GetAccountBalanceFunc(contextMoqParam, getAccountBalanceInput)
+}
+
+// GetAccountBalanceCalls gets all the calls that were made to GetAccountBalance.
+// Check the length with:
+//     len(mockedUseCase.GetAccountBalanceCalls())
+func (mock *UseCaseMock) GetAccountBalanceCalls() []struct {
+	ContextMoqParam        context.Context
+	GetAccountBalanceInput domain.GetAccountBalanceInput
+} {
+	var calls []struct {
+		ContextMoqParam        context.Context
+		GetAccountBalanceInput domain.GetAccountBalanceInput
+	}
+	mock.lockGetAccountBalance.RLock()
+	calls = mock.calls.GetAccountBalance
+	mock.lockGetAccountBalance.RUnlock()
+	return calls
+}
+
+// GetBalanceHistory calls GetBalanceHistoryFunc.
+func (mock *UseCaseMock) GetBalanceHistory(contextMoqParam context.Context, balanceHistoryRequest vos.BalanceHistoryRequest) (vos.BalanceHistory, error) {
+	if mock.GetBalanceHistoryFunc == nil {
+		panic("UseCaseMock.GetBalanceHistoryFunc: method is nil but UseCase.GetBalanceHistory was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam       context.Context
+		BalanceHistoryRequest vos.BalanceHistoryRequest
+	}{
+		ContextMoqParam:       contextMoqParam,
+		BalanceHistoryRequest: balanceHistoryRequest,
+	}
+	mock.lockGetBalanceHistory.Lock()
+	mock.calls.GetBalanceHistory = append(mock.calls.GetBalanceHistory, callInfo)
+	mock.lockGetBalanceHistory.Unlock()
+	return mock.GetBalanceHistoryFunc(contextMoqParam, balanceHistoryRequest)
+}
+
+// GetBalanceHistoryCalls gets all the calls that were made to GetBalanceHistory.
+// Check the length with:
+//     len(mockedUseCase.GetBalanceHistoryCalls())
+func (mock *UseCaseMock) GetBalanceHistoryCalls() []struct {
+	ContextMoqParam       context.Context
+	BalanceHistoryRequest vos.BalanceHistoryRequest
+} {
+	var calls []struct {
+		ContextMoqParam       context.Context
+		BalanceHistoryRequest vos.BalanceHistoryRequest
+	}
+	mock.lockGetBalanceHistory.RLock()
+	calls = mock.calls.GetBalanceHistory
+	mock.lockGetBalanceHistory.RUnlock()
+	return calls
+}
+
+// GetBalanceSheet calls GetBalanceSheetFunc.
+func (mock *UseCaseMock) GetBalanceSheet(contextMoqParam context.Context, financialStatementInput domain.FinancialStatementInput) (vos.FinancialStatement, error) {
+	if mock.GetBalanceSheetFunc == nil {
+		panic("UseCaseMock.GetBalanceSheetFunc: method is nil but UseCase.GetBalanceSheet was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam         context.Context
+		FinancialStatementInput domain.FinancialStatementInput
+	}{
+		ContextMoqParam:         contextMoqParam,
+		FinancialStatementInput: financialStatementInput,
+	}
+	mock.lockGetBalanceSheet.Lock()
+	mock.calls.GetBalanceSheet = append(mock.calls.GetBalanceSheet, callInfo)
+	mock.lockGetBalanceSheet.Unlock()
+	return mock.GetBalanceSheetFunc(contextMoqParam, financialStatementInput)
+}
+
+// GetBalanceSheetCalls gets all the calls that were made to GetBalanceSheet.
+// Check the length with:
+//     len(mockedUseCase.GetBalanceSheetCalls())
+func (mock *UseCaseMock) GetBalanceSheetCalls() []struct {
+	ContextMoqParam         context.Context
+	FinancialStatementInput domain.FinancialStatementInput
+} {
+	var calls []struct {
+		ContextMoqParam         context.Context
+		FinancialStatementInput domain.FinancialStatementInput
+	}
+	mock.lockGetBalanceSheet.RLock()
+	calls = mock.calls.GetBalanceSheet
+	mock.lockGetBalanceSheet.RUnlock()
+	return calls
+}
+
+// GetIncomeStatement calls GetIncomeStatementFunc.
+func (mock *UseCaseMock) GetIncomeStatement(contextMoqParam context.Context, financialStatementInput domain.FinancialStatementInput) (vos.FinancialStatement, error) {
+	if mock.GetIncomeStatementFunc == nil {
+		panic("UseCaseMock.GetIncomeStatementFunc: method is nil but UseCase.GetIncomeStatement was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam         context.Context
+		FinancialStatementInput domain.FinancialStatementInput
+	}{
+		ContextMoqParam:         contextMoqParam,
+		FinancialStatementInput: financialStatementInput,
+	}
+	mock.lockGetIncomeStatement.Lock()
+	mock.calls.GetIncomeStatement = append(mock.calls.GetIncomeStatement, callInfo)
+	mock.lockGetIncomeStatement.Unlock()
+	return mock.GetIncomeStatementFunc(contextMoqParam, financialStatementInput)
+}
+
+// GetIncomeStatementCalls gets all the calls that were made to GetIncomeStatement.
+// Check the length with:
+//     len(mockedUseCase.GetIncomeStatementCalls())
+func (mock *UseCaseMock) GetIncomeStatementCalls() []struct {
+	ContextMoqParam         context.Context
+	FinancialStatementInput domain.FinancialStatementInput
+} {
+	var calls []struct {
+		ContextMoqParam         context.Context
+		FinancialStatementInput domain.FinancialStatementInput
+	}
+	mock.lockGetIncomeStatement.RLock()
+	calls = mock.calls.GetIncomeStatement
+	mock.lockGetIncomeStatement.RUnlock()
+	return calls
+}
+
+// GetSyntheticReport calls GetSyntheticReportFunc.
+func (mock *UseCaseMock) GetSyntheticReport(contextMoqParam context.Context, syntheticReportRequest vos.SyntheticReportRequest) (*vos.SyntheticReport, error) {
+	if mock.GetSyntheticReportFunc == nil {
+		panic("UseCaseMock.GetSyntheticReportFunc: method is nil but UseCase.GetSyntheticReport was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam        context.Context
+		SyntheticReportRequest vos.SyntheticReportRequest
+	}{
+		ContextMoqParam:        contextMoqParam,
+		SyntheticReportRequest: syntheticReportRequest,
+	}
+	mock.lockGetSyntheticReport.Lock()
+	mock.calls.GetSyntheticReport = append(mock.calls.GetSyntheticReport, callInfo)
+	mock.lockGetSyntheticReport.Unlock()
+	return mock.GetSyntheticReportFunc(contextMoqParam, syntheticReportRequest)
+}
+
+// GetSyntheticReportCalls gets all the calls that were made to GetSyntheticReport.
+// Check the length with:
+//     len(mockedUseCase.GetSyntheticReportCalls())
+func (mock *UseCaseMock) GetSyntheticReportCalls() []struct {
+	ContextMoqParam        context.Context
+	SyntheticReportRequest vos.SyntheticReportRequest
+} {
+	var calls []struct {
+		ContextMoqParam        context.Context
+		SyntheticReportRequest vos.SyntheticReportRequest
+	}
+	mock.lockGetSyntheticReport.RLock()
+	calls = mock.calls.GetSyntheticReport
+	mock.lockGetSyntheticReport.RUnlock()
+	return calls
+}
+
+// GetTransaction calls GetTransactionFunc.
+func (mock *UseCaseMock) GetTransaction(contextMoqParam context.Context, uUID uuid.UUID) (entities.Transaction, error) {
+	if mock.GetTransactionFunc == nil {
+		panic("UseCaseMock.GetTransactionFunc: method is nil but UseCase.GetTransaction was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		UUID            uuid.UUID
+	}{
+		ContextMoqParam: contextMoqParam,
+		UUID:            uUID,
+	}
+	mock.lockGetTransaction.Lock()
+	mock.calls.GetTransaction = append(mock.calls.GetTransaction, callInfo)
+	mock.lockGetTransaction.Unlock()
+	return mock.GetTransactionFunc(contextMoqParam, uUID)
+}
+
+// GetTransactionCalls gets all the calls that were made to GetTransaction.
+// Check the length with:
+//     len(mockedUseCase.GetTransactionCalls())
+func (mock *UseCaseMock) GetTransactionCalls() []struct {
+	ContextMoqParam context.Context
+	UUID            uuid.UUID
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		UUID            uuid.UUID
+	}
+	mock.lockGetTransaction.RLock()
+	calls = mock.calls.GetTransaction
+	mock.lockGetTransaction.RUnlock()
+	return calls
+}
+
+// GetTrialBalance calls GetTrialBalanceFunc.
+func (mock *UseCaseMock) GetTrialBalance(contextMoqParam context.Context, trialBalanceRequest vos.TrialBalanceRequest) (vos.TrialBalance, error) {
+	if mock.GetTrialBalanceFunc == nil {
+		panic("UseCaseMock.GetTrialBalanceFunc: method is nil but UseCase.GetTrialBalance was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam     context.Context
+		TrialBalanceRequest vos.TrialBalanceRequest
+	}{
+		ContextMoqParam:     contextMoqParam,
+		TrialBalanceRequest: trialBalanceRequest,
+	}
+	mock.lockGetTrialBalance.Lock()
+	mock.calls.GetTrialBalance = append(mock.calls.GetTrialBalance, callInfo)
+	mock.lockGetTrialBalance.Unlock()
+	return mock.GetTrialBalanceFunc(contextMoqParam, trialBalanceRequest)
+}
+
+// GetTrialBalanceCalls gets all the calls that were made to GetTrialBalance.
+// Check the length with:
+//     len(mockedUseCase.GetTrialBalanceCalls())
+func (mock *UseCaseMock) GetTrialBalanceCalls() []struct {
+	ContextMoqParam     context.Context
+	TrialBalanceRequest vos.TrialBalanceRequest
+} {
+	var calls []struct {
+		ContextMoqParam     context.Context
+		TrialBalanceRequest vos.TrialBalanceRequest
+	}
+	mock.lockGetTrialBalance.RLock()
+	calls = mock.calls.GetTrialBalance
+	mock.lockGetTrialBalance.RUnlock()
+	return calls
+}
+
+// ListAccountChildren calls ListAccountChildrenFunc.
+func (mock *UseCaseMock) ListAccountChildren(contextMoqParam context.Context, accountChildrenRequest vos.AccountChildrenRequest) (vos.AccountChildren, error) {
+	if mock.ListAccountChildrenFunc == nil {
+		panic("UseCaseMock.ListAccountChildrenFunc: method is nil but UseCase.ListAccountChildren was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam        context.Context
+		AccountChildrenRequest vos.AccountChildrenRequest
+	}{
+		ContextMoqParam:        contextMoqParam,
+		AccountChildrenRequest: accountChildrenRequest,
+	}
+	mock.lockListAccountChildren.Lock()
+	mock.calls.ListAccountChildren = append(mock.calls.ListAccountChildren, callInfo)
+	mock.lockListAccountChildren.Unlock()
+	return mock.ListAccountChildrenFunc(contextMoqParam, accountChildrenRequest)
+}
+
+// ListAccountChildrenCalls gets all the calls that were made to ListAccountChildren.
+// Check the length with:
+//     len(mockedUseCase.ListAccountChildrenCalls())
+func (mock *UseCaseMock) ListAccountChildrenCalls() []struct {
+	ContextMoqParam        context.Context
+	AccountChildrenRequest vos.AccountChildrenRequest
+} {
+	var calls []struct {
+		ContextMoqParam        context.Context
+		AccountChildrenRequest vos.AccountChildrenRequest
+	}
+	mock.lockListAccountChildren.RLock()
+	calls = mock.calls.ListAccountChildren
+	mock.lockListAccountChildren.RUnlock()
+	return calls
+}
+
+// ListAccountEntries calls ListAccountEntriesFunc.
+func (mock *UseCaseMock) ListAccountEntries(contextMoqParam context.Context, accountEntryRequest vos.AccountEntryRequest) (vos.AccountEntryResponse, error) {
+	if mock.ListAccountEntriesFunc == nil {
+		panic("UseCaseMock.ListAccountEntriesFunc: method is nil but UseCase.ListAccountEntries was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam     context.Context
+		AccountEntryRequest vos.AccountEntryRequest
+	}{
+		ContextMoqParam:     contextMoqParam,
+		AccountEntryRequest: accountEntryRequest,
+	}
+	mock.lockListAccountEntries.Lock()
+	mock.calls.ListAccountEntries = append(mock.calls.ListAccountEntries, callInfo)
+	mock.lockListAccountEntries.Unlock()
+	return mock.ListAccountEntriesFunc(contextMoqParam, accountEntryRequest)
+}
+
+// ListAccountEntriesCalls gets all the calls that were made to ListAccountEntries.
+// Check the length with:
+//     len(mockedUseCase.ListAccountEntriesCalls())
+func (mock *UseCaseMock) ListAccountEntriesCalls() []struct {
+	ContextMoqParam     context.Context
+	AccountEntryRequest vos.AccountEntryRequest
+} {
+	var calls []struct {
+		ContextMoqParam     context.Context
+		AccountEntryRequest vos.AccountEntryRequest
+	}
+	mock.lockListAccountEntries.RLock()
+	calls = mock.calls.ListAccountEntries
+	mock.lockListAccountEntries.RUnlock()
+	return calls
+}
+
+// ListAccounts calls ListAccountsFunc.
+func (mock *UseCaseMock) ListAccounts(contextMoqParam context.Context, accountListRequest vos.AccountListRequest) (vos.AccountList, error) {
+	if mock.ListAccountsFunc == nil {
+		panic("UseCaseMock.ListAccountsFunc: method is nil but UseCase.ListAccounts was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam    context.Context
+		AccountListRequest vos.AccountListRequest
+	}{
+		ContextMoqParam:    contextMoqParam,
+		AccountListRequest: accountListRequest,
+	}
+	mock.lockListAccounts.Lock()
+	mock.calls.ListAccounts = append(mock.calls.ListAccounts, callInfo)
+	mock.lockListAccounts.Unlock()
+	return mock.ListAccountsFunc(contextMoqParam, accountListRequest)
+}
+
+// ListAccountsCalls gets all the calls that were made to ListAccounts.
+// Check the length with:
+//     len(mockedUseCase.ListAccountsCalls())
+func (mock *UseCaseMock) ListAccountsCalls() []struct {
+	ContextMoqParam    context.Context
+	AccountListRequest vos.AccountListRequest
+} {
+	var calls []struct {
+		ContextMoqParam    context.Context
+		AccountListRequest vos.AccountListRequest
+	}
+	mock.lockListAccounts.RLock()
+	calls = mock.calls.ListAccounts
+	mock.lockListAccounts.RUnlock()
+	return calls
+}
+
+// ListBalanceLimits calls ListBalanceLimitsFunc.
+func (mock *UseCaseMock) ListBalanceLimits(contextMoqParam context.Context) ([]vos.BalanceLimit, error) {
+	if mock.ListBalanceLimitsFunc == nil {
+		panic("UseCaseMock.ListBalanceLimitsFunc: method is nil but UseCase.ListBalanceLimits was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockListBalanceLimits.Lock()
+	mock.calls.ListBalanceLimits = append(mock.calls.ListBalanceLimits, callInfo)
+	mock.lockListBalanceLimits.Unlock()
+	return mock.ListBalanceLimitsFunc(contextMoqParam)
+}
+
+// ListBalanceLimitsCalls gets all the calls that were made to ListBalanceLimits.
+// Check the length with:
+//     len(mockedUseCase.ListBalanceLimitsCalls())
+func (mock *UseCaseMock) ListBalanceLimitsCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockListBalanceLimits.RLock()
+	calls = mock.calls.ListBalanceLimits
+	mock.lockListBalanceLimits.RUnlock()
+	return calls
+}
+
+// ListChartOfAccounts calls ListChartOfAccountsFunc.
+func (mock *UseCaseMock) ListChartOfAccounts(contextMoqParam context.Context) (vos.ChartOfAccounts, error) {
+	if mock.ListChartOfAccountsFunc == nil {
+		panic("UseCaseMock.ListChartOfAccountsFunc: method is nil but UseCase.ListChartOfAccounts was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+	}{
+		ContextMoqParam: contextMoqParam,
+	}
+	mock.lockListChartOfAccounts.Lock()
+	mock.calls.ListChartOfAccounts = append(mock.calls.ListChartOfAccounts, callInfo)
+	mock.lockListChartOfAccounts.Unlock()
+	return mock.ListChartOfAccountsFunc(contextMoqParam)
+}
+
+// ListChartOfAccountsCalls gets all the calls that were made to ListChartOfAccounts.
+// Check the length with:
+//     len(mockedUseCase.ListChartOfAccountsCalls())
+func (mock *UseCaseMock) ListChartOfAccountsCalls() []struct {
+	ContextMoqParam context.Context
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+	}
+	mock.lockListChartOfAccounts.RLock()
+	calls = mock.calls.ListChartOfAccounts
+	mock.lockListChartOfAccounts.RUnlock()
+	return calls
+}
+
+// ListCompetenceLocks calls ListCompetenceLocksFunc.
+func (mock *UseCaseMock) ListCompetenceLocks(contextMoqParam context.Context, s string) (domain.ListCompetenceLocksOutput, error) {
+	if mock.ListCompetenceLocksFunc == nil {
+		panic("UseCaseMock.ListCompetenceLocksFunc: method is nil but UseCase.ListCompetenceLocks was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		S               string
+	}{
+		ContextMoqParam: contextMoqParam,
+		S:               s,
+	}
+	mock.lockListCompetenceLocks.Lock()
+	mock.calls.ListCompetenceLocks = append(mock.calls.ListCompetenceLocks, callInfo)
+	mock.lockListCompetenceLocks.Unlock()
+	return mock.ListCompetenceLocksFunc(contextMoqParam, s)
+}
+
+// ListCompetenceLocksCalls gets all the calls that were made to ListCompetenceLocks.
+// Check the length with:
+//     len(mockedUseCase.ListCompetenceLocksCalls())
+func (mock *UseCaseMock) ListCompetenceLocksCalls() []struct {
+	ContextMoqParam context.Context
+	S               string
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		S               string
+	}
+	mock.lockListCompetenceLocks.RLock()
+	calls = mock.calls.ListCompetenceLocks
+	mock.lockListCompetenceLocks.RUnlock()
+	return calls
+}
+
+// ListEventMetadataSchemas calls ListEventMetadataSchemasFunc.
+func (mock *UseCaseMock) ListEventMetadataSchemas(contextMoqParam context.Context, v uint32) ([]entities.EventMetadataSchema, error) {
+	if mock.ListEventMetadataSchemasFunc == nil {
+		panic("UseCaseMock.ListEventMetadataSchemasFunc: method is nil but UseCase.ListEventMetadataSchemas was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		V               uint32
+	}{
+		ContextMoqParam: contextMoqParam,
+		V:               v,
+	}
+	mock.lockListEventMetadataSchemas.Lock()
+	mock.calls.ListEventMetadataSchemas = append(mock.calls.ListEventMetadataSchemas, callInfo)
+	mock.lockListEventMetadataSchemas.Unlock()
+	return mock.ListEventMetadataSchemasFunc(contextMoqParam, v)
+}
+
+// ListEventMetadataSchemasCalls gets all the calls that were made to ListEventMetadataSchemas.
+// Check the length with:
+//     len(mockedUseCase.ListEventMetadataSchemasCalls())
+func (mock *UseCaseMock) ListEventMetadataSchemasCalls() []struct {
+	ContextMoqParam context.Context
+	V               uint32
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		V               uint32
+	}
+	mock.lockListEventMetadataSchemas.RLock()
+	calls = mock.calls.ListEventMetadataSchemas
+	mock.lockListEventMetadataSchemas.RUnlock()
+	return calls
+}
+
+// ListEvents calls ListEventsFunc.
+func (mock *UseCaseMock) ListEvents(contextMoqParam context.Context, listEventsInput domain.ListEventsInput) ([]entities.Event, error) {
+	if mock.ListEventsFunc == nil {
+		panic("UseCaseMock.ListEventsFunc: method is nil but UseCase.ListEvents was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		ListEventsInput domain.ListEventsInput
+	}{
+		ContextMoqParam: contextMoqParam,
+		ListEventsInput: listEventsInput,
+	}
+	mock.lockListEvents.Lock()
+	mock.calls.ListEvents = append(mock.calls.ListEvents, callInfo)
+	mock.lockListEvents.Unlock()
+	return mock.ListEventsFunc(contextMoqParam, listEventsInput)
+}
+
+// ListEventsCalls gets all the calls that were made to ListEvents.
+// Check the length with:
+//     len(mockedUseCase.ListEventsCalls())
+func (mock *UseCaseMock) ListEventsCalls() []struct {
+	ContextMoqParam context.Context
+	ListEventsInput domain.ListEventsInput
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		ListEventsInput domain.ListEventsInput
+	}
+	mock.lockListEvents.RLock()
+	calls = mock.calls.ListEvents
+	mock.lockListEvents.RUnlock()
+	return calls
+}
+
+// ListFiscalPeriods calls ListFiscalPeriodsFunc.
+func (mock *UseCaseMock) ListFiscalPeriods(contextMoqParam context.Context, s string) ([]entities.FiscalPeriod, error) {
+	if mock.ListFiscalPeriodsFunc == nil {
+		panic("UseCaseMock.ListFiscalPeriodsFunc: method is nil but UseCase.ListFiscalPeriods was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		S               string
+	}{
+		ContextMoqParam: contextMoqParam,
+		S:               s,
+	}
+	mock.lockListFiscalPeriods.Lock()
+	mock.calls.ListFiscalPeriods = append(mock.calls.ListFiscalPeriods, callInfo)
+	mock.lockListFiscalPeriods.Unlock()
+	return mock.ListFiscalPeriodsFunc(contextMoqParam, s)
+}
+
+// ListFiscalPeriodsCalls gets all the calls that were made to ListFiscalPeriods.
+// Check the length with:
+//     len(mockedUseCase.ListFiscalPeriodsCalls())
+func (mock *UseCaseMock) ListFiscalPeriodsCalls() []struct {
+	ContextMoqParam context.Context
+	S               string
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		S               string
+	}
+	mock.lockListFiscalPeriods.RLock()
+	calls = mock.calls.ListFiscalPeriods
+	mock.lockListFiscalPeriods.RUnlock()
+	return calls
+}
+
+// ListScheduledTransactions calls ListScheduledTransactionsFunc.
+func (mock *UseCaseMock) ListScheduledTransactions(contextMoqParam context.Context, listScheduledTransactionsInput domain.ListScheduledTransactionsInput) (domain.ListScheduledTransactionsOutput, error) {
+	if mock.ListScheduledTransactionsFunc == nil {
+		panic("UseCaseMock.ListScheduledTransactionsFunc: method is nil but UseCase.ListScheduledTransactions was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam                context.Context
+		ListScheduledTransactionsInput domain.ListScheduledTransactionsInput
+	}{
+		ContextMoqParam:                contextMoqParam,
+		ListScheduledTransactionsInput: listScheduledTransactionsInput,
+	}
+	mock.lockListScheduledTransactions.Lock()
+	mock.calls.ListScheduledTransactions = append(mock.calls.ListScheduledTransactions, callInfo)
+	mock.lockListScheduledTransactions.Unlock()
+	return mock.ListScheduledTransactionsFunc(contextMoqParam, listScheduledTransactionsInput)
+}
+
+// ListScheduledTransactionsCalls gets all the calls that were made to ListScheduledTransactions.
+// Check the length with:
+//     len(mockedUseCase.ListScheduledTransactionsCalls())
+func (mock *UseCaseMock) ListScheduledTransactionsCalls() []struct {
+	ContextMoqParam                context.Context
+	ListScheduledTransactionsInput domain.ListScheduledTransactionsInput
+} {
+	var calls []struct {
+		ContextMoqParam                context.Context
+		ListScheduledTransactionsInput domain.ListScheduledTransactionsInput
+	}
+	mock.lockListScheduledTransactions.RLock()
+	calls = mock.calls.ListScheduledTransactions
+	mock.lockListScheduledTransactions.RUnlock()
+	return calls
+}
+
+// OpenAccount calls OpenAccountFunc.
+func (mock *UseCaseMock) OpenAccount(contextMoqParam context.Context, account entities.Account) (entities.Account, error) {
+	if mock.OpenAccountFunc == nil {
+		panic("UseCaseMock.OpenAccountFunc: method is nil but UseCase.OpenAccount was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		Account         entities.Account
+	}{
+		ContextMoqParam: contextMoqParam,
+		Account:         account,
+	}
+	mock.lockOpenAccount.Lock()
+	mock.calls.OpenAccount = append(mock.calls.OpenAccount, callInfo)
+	mock.lockOpenAccount.Unlock()
+	return mock.OpenAccountFunc(contextMoqParam, account)
+}
+
+// OpenAccountCalls gets all the calls that were made to OpenAccount.
+// Check the length with:
+//     len(mockedUseCase.OpenAccountCalls())
+func (mock *UseCaseMock) OpenAccountCalls() []struct {
+	ContextMoqParam context.Context
+	Account         entities.Account
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		Account         entities.Account
+	}
+	mock.lockOpenAccount.RLock()
+	calls = mock.calls.OpenAccount
+	mock.lockOpenAccount.RUnlock()
+	return calls
+}
+
+// PostScheduledTransaction calls PostScheduledTransactionFunc.
+func (mock *UseCaseMock) PostScheduledTransaction(contextMoqParam context.Context, scheduledTransaction entities.ScheduledTransaction) error {
+	if mock.PostScheduledTransactionFunc == nil {
+		panic("UseCaseMock.PostScheduledTransactionFunc: method is nil but UseCase.PostScheduledTransaction was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam      context.Context
+		ScheduledTransaction entities.ScheduledTransaction
+	}{
+		ContextMoqParam:      contextMoqParam,
+		ScheduledTransaction: scheduledTransaction,
+	}
+	mock.lockPostScheduledTransaction.Lock()
+	mock.calls.PostScheduledTransaction = append(mock.calls.PostScheduledTransaction, callInfo)
+	mock.lockPostScheduledTransaction.Unlock()
+	return mock.PostScheduledTransactionFunc(contextMoqParam, scheduledTransaction)
+}
+
+// PostScheduledTransactionCalls gets all the calls that were made to PostScheduledTransaction.
+// Check the length with:
+//     len(mockedUseCase.PostScheduledTransactionCalls())
+func (mock *UseCaseMock) PostScheduledTransactionCalls() []struct {
+	ContextMoqParam      context.Context
+	ScheduledTransaction entities.ScheduledTransaction
+} {
+	var calls []struct {
+		ContextMoqParam      context.Context
+		ScheduledTransaction entities.ScheduledTransaction
+	}
+	mock.lockPostScheduledTransaction.RLock()
+	calls = mock.calls.PostScheduledTransaction
+	mock.lockPostScheduledTransaction.RUnlock()
+	return calls
+}
+
+// ReopenFiscalPeriod calls ReopenFiscalPeriodFunc.
+func (mock *UseCaseMock) ReopenFiscalPeriod(contextMoqParam context.Context, reopenFiscalPeriodInput domain.ReopenFiscalPeriodInput) (entities.FiscalPeriod, error) {
+	if mock.ReopenFiscalPeriodFunc == nil {
+		panic("UseCaseMock.ReopenFiscalPeriodFunc: method is nil but UseCase.ReopenFiscalPeriod was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam         context.Context
+		ReopenFiscalPeriodInput domain.ReopenFiscalPeriodInput
+	}{
+		ContextMoqParam:         contextMoqParam,
+		ReopenFiscalPeriodInput: reopenFiscalPeriodInput,
+	}
+	mock.lockReopenFiscalPeriod.Lock()
+	mock.calls.ReopenFiscalPeriod = append(mock.calls.ReopenFiscalPeriod, callInfo)
+	mock.lockReopenFiscalPeriod.Unlock()
+	return mock.ReopenFiscalPeriodFunc(contextMoqParam, reopenFiscalPeriodInput)
+}
+
+// ReopenFiscalPeriodCalls gets all the calls that were made to ReopenFiscalPeriod.
+// Check the length with:
+//     len(mockedUseCase.ReopenFiscalPeriodCalls())
+func (mock *UseCaseMock) ReopenFiscalPeriodCalls() []struct {
+	ContextMoqParam         context.Context
+	ReopenFiscalPeriodInput domain.ReopenFiscalPeriodInput
+} {
+	var calls []struct {
+		ContextMoqParam         context.Context
+		ReopenFiscalPeriodInput domain.ReopenFiscalPeriodInput
+	}
+	mock.lockReopenFiscalPeriod.RLock()
+	calls = mock.calls.ReopenFiscalPeriod
+	mock.lockReopenFiscalPeriod.RUnlock()
+	return calls
+}
+
+// RevertTransaction calls RevertTransactionFunc.
+func (mock *UseCaseMock) RevertTransaction(contextMoqParam context.Context, revertTransactionInput domain.RevertTransactionInput) error {
+	if mock.RevertTransactionFunc == nil {
+		panic("UseCaseMock.RevertTransactionFunc: method is nil but UseCase.RevertTransaction was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam        context.Context
+		RevertTransactionInput domain.RevertTransactionInput
+	}{
+		ContextMoqParam:        contextMoqParam,
+		RevertTransactionInput: revertTransactionInput,
+	}
+	mock.lockRevertTransaction.Lock()
+	mock.calls.RevertTransaction = append(mock.calls.RevertTransaction, callInfo)
+	mock.lockRevertTransaction.Unlock()
+	return mock.RevertTransactionFunc(contextMoqParam, revertTransactionInput)
+}
+
+// RevertTransactionCalls gets all the calls that were made to RevertTransaction.
+// Check the length with:
+//     len(mockedUseCase.RevertTransactionCalls())
+func (mock *UseCaseMock) RevertTransactionCalls() []struct {
+	ContextMoqParam        context.Context
+	RevertTransactionInput domain.RevertTransactionInput
+} {
+	var calls []struct {
+		ContextMoqParam        context.Context
+		RevertTransactionInput domain.RevertTransactionInput
+	}
+	mock.lockRevertTransaction.RLock()
+	calls = mock.calls.RevertTransaction
+	mock.lockRevertTransaction.RUnlock()
+	return calls
+}
+
+// ScheduleTransaction calls ScheduleTransactionFunc.
+func (mock *UseCaseMock) ScheduleTransaction(contextMoqParam context.Context, transaction entities.Transaction) (entities.ScheduledTransaction, error) {
+	if mock.ScheduleTransactionFunc == nil {
+		panic("UseCaseMock.ScheduleTransactionFunc: method is nil but UseCase.ScheduleTransaction was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		Transaction     entities.Transaction
+	}{
+		ContextMoqParam: contextMoqParam,
+		Transaction:     transaction,
+	}
+	mock.lockScheduleTransaction.Lock()
+	mock.calls.ScheduleTransaction = append(mock.calls.ScheduleTransaction, callInfo)
+	mock.lockScheduleTransaction.Unlock()
+	return mock.ScheduleTransactionFunc(contextMoqParam, transaction)
+}
+
+// ScheduleTransactionCalls gets all the calls that were made to ScheduleTransaction.
+// Check the length with:
+//     len(mockedUseCase.ScheduleTransactionCalls())
+func (mock *UseCaseMock) ScheduleTransactionCalls() []struct {
+	ContextMoqParam context.Context
+	Transaction     entities.Transaction
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		Transaction     entities.Transaction
+	}
+	mock.lockScheduleTransaction.RLock()
+	calls = mock.calls.ScheduleTransaction
+	mock.lockScheduleTransaction.RUnlock()
+	return calls
+}
+
+// SetBalanceLimit calls SetBalanceLimitFunc.
+func (mock *UseCaseMock) SetBalanceLimit(contextMoqParam context.Context, balanceLimit vos.BalanceLimit) error {
+	if mock.SetBalanceLimitFunc == nil {
+		panic("UseCaseMock.SetBalanceLimitFunc: method is nil but UseCase.SetBalanceLimit was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		BalanceLimit    vos.BalanceLimit
+	}{
+		ContextMoqParam: contextMoqParam,
+		BalanceLimit:    balanceLimit,
+	}
+	mock.lockSetBalanceLimit.Lock()
+	mock.calls.SetBalanceLimit = append(mock.calls.SetBalanceLimit, callInfo)
+	mock.lockSetBalanceLimit.Unlock()
+	return mock.SetBalanceLimitFunc(contextMoqParam, balanceLimit)
+}
+
+// SetBalanceLimitCalls gets all the calls that were made to SetBalanceLimit.
+// Check the length with:
+//     len(mockedUseCase.SetBalanceLimitCalls())
+func (mock *UseCaseMock) SetBalanceLimitCalls() []struct {
+	ContextMoqParam context.Context
+	BalanceLimit    vos.BalanceLimit
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		BalanceLimit    vos.BalanceLimit
+	}
+	mock.lockSetBalanceLimit.RLock()
+	calls = mock.calls.SetBalanceLimit
+	mock.lockSetBalanceLimit.RUnlock()
+	return calls
+}
+
+// SetCompetenceLock calls SetCompetenceLockFunc.
+func (mock *UseCaseMock) SetCompetenceLock(contextMoqParam context.Context, competenceLockInput domain.CompetenceLockInput) (entities.CompetenceLockChange, error) {
+	if mock.SetCompetenceLockFunc == nil {
+		panic("UseCaseMock.SetCompetenceLockFunc: method is nil but UseCase.SetCompetenceLock was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam     context.Context
+		CompetenceLockInput domain.CompetenceLockInput
+	}{
+		ContextMoqParam:     contextMoqParam,
+		CompetenceLockInput: competenceLockInput,
+	}
+	mock.lockSetCompetenceLock.Lock()
+	mock.calls.SetCompetenceLock = append(mock.calls.SetCompetenceLock, callInfo)
+	mock.lockSetCompetenceLock.Unlock()
+	return mock.SetCompetenceLockFunc(contextMoqParam, competenceLockInput)
+}
+
+// SetCompetenceLockCalls gets all the calls that were made to SetCompetenceLock.
+// Check the length with:
+//     len(mockedUseCase.SetCompetenceLockCalls())
+func (mock *UseCaseMock) SetCompetenceLockCalls() []struct {
+	ContextMoqParam     context.Context
+	CompetenceLockInput domain.CompetenceLockInput
+} {
+	var calls []struct {
+		ContextMoqParam     context.Context
+		CompetenceLockInput domain.CompetenceLockInput
+	}
+	mock.lockSetCompetenceLock.RLock()
+	calls = mock.calls.SetCompetenceLock
+	mock.lockSetCompetenceLock.RUnlock()
+	return calls
+}
+
+// SetEventMetadataSchema calls SetEventMetadataSchemaFunc.
+func (mock *UseCaseMock) SetEventMetadataSchema(contextMoqParam context.Context, eventMetadataSchema entities.EventMetadataSchema) (entities.EventMetadataSchema, error) {
+	if mock.SetEventMetadataSchemaFunc == nil {
+		panic("UseCaseMock.SetEventMetadataSchemaFunc: method is nil but UseCase.SetEventMetadataSchema was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam     context.Context
+		EventMetadataSchema entities.EventMetadataSchema
+	}{
+		ContextMoqParam:     contextMoqParam,
+		EventMetadataSchema: eventMetadataSchema,
+	}
+	mock.lockSetEventMetadataSchema.Lock()
+	mock.calls.SetEventMetadataSchema = append(mock.calls.SetEventMetadataSchema, callInfo)
+	mock.lockSetEventMetadataSchema.Unlock()
+	return mock.SetEventMetadataSchemaFunc(contextMoqParam, eventMetadataSchema)
+}
+
+// SetEventMetadataSchemaCalls gets all the calls that were made to SetEventMetadataSchema.
+// Check the length with:
+//     len(mockedUseCase.SetEventMetadataSchemaCalls())
+func (mock *UseCaseMock) SetEventMetadataSchemaCalls() []struct {
+	ContextMoqParam     context.Context
+	EventMetadataSchema entities.EventMetadataSchema
+} {
+	var calls []struct {
+		ContextMoqParam     context.Context
+		EventMetadataSchema entities.EventMetadataSchema
+	}
+	mock.lockSetEventMetadataSchema.RLock()
+	calls = mock.calls.SetEventMetadataSchema
+	mock.lockSetEventMetadataSchema.RUnlock()
+	return calls
+}
+
+// UnfreezeAccount calls UnfreezeAccountFunc.
+func (mock *UseCaseMock) UnfreezeAccount(contextMoqParam context.Context, account vos.Account) (entities.Account, error) {
+	if mock.UnfreezeAccountFunc == nil {
+		panic("UseCaseMock.UnfreezeAccountFunc: method is nil but UseCase.UnfreezeAccount was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		Account         vos.Account
+	}{
+		ContextMoqParam: contextMoqParam,
+		Account:         account,
+	}
+	mock.lockUnfreezeAccount.Lock()
+	mock.calls.UnfreezeAccount = append(mock.calls.UnfreezeAccount, callInfo)
+	mock.lockUnfreezeAccount.Unlock()
+	return mock.UnfreezeAccountFunc(contextMoqParam, account)
+}
+
+// UnfreezeAccountCalls gets all the calls that were made to UnfreezeAccount.
+// Check the length with:
+//     len(mockedUseCase.UnfreezeAccountCalls())
+func (mock *UseCaseMock) UnfreezeAccountCalls() []struct {
+	ContextMoqParam context.Context
+	Account         vos.Account
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		Account         vos.Account
+	}
+	mock.lockUnfreezeAccount.RLock()
+	calls = mock.calls.UnfreezeAccount
+	mock.lockUnfreezeAccount.RUnlock()
+	return calls
+}
+
+// VoidTransaction calls VoidTransactionFunc.
+func (mock *UseCaseMock) VoidTransaction(contextMoqParam context.Context, uUID uuid.UUID) error {
+	if mock.VoidTransactionFunc == nil {
+		panic("UseCaseMock.VoidTransactionFunc: method is nil but UseCase.VoidTransaction was just called")
+	}
+	callInfo := struct {
+		ContextMoqParam context.Context
+		UUID            uuid.UUID
+	}{
+		ContextMoqParam: contextMoqParam,
+		UUID:            uUID,
+	}
+	mock.lockVoidTransaction.Lock()
+	mock.calls.VoidTransaction = append(mock.calls.VoidTransaction, callInfo)
+	mock.lockVoidTransaction.Unlock()
+	return mock.VoidTransactionFunc(contextMoqParam, uUID)
+}
+
+// VoidTransactionCalls gets all the calls that were made to VoidTransaction.
+// Check the length with:
+//     len(mockedUseCase.VoidTransactionCalls())
+func (mock *UseCaseMock) VoidTransactionCalls() []struct {
+	ContextMoqParam context.Context
+	UUID            uuid.UUID
+} {
+	var calls []struct {
+		ContextMoqParam context.Context
+		UUID            uuid.UUID
+	}
+	mock.lockVoidTransaction.RLock()
+	calls = mock.calls.VoidTransaction
+	mock.lockVoidTransaction.RUnlock()
 	return calls
 }
@@ -20,6 +20,7 @@ func CreateEntry(t *testing.T, op vos.OperationType, account string, version vos
 		account,
 		version,
 		amount,
+		"BRL",
 		json.RawMessage(`{}`),
 	)
 	assert.NoError(t, err)
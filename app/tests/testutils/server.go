@@ -14,6 +14,7 @@ import (
 	"github.com/stone-co/the-amazing-ledger/app"
 	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
 	"github.com/stone-co/the-amazing-ledger/app/domain/usecases"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
 	"github.com/stone-co/the-amazing-ledger/app/gateways/rpc"
 	"github.com/stone-co/the-amazing-ledger/app/tests/testenv"
 )
@@ -27,8 +28,40 @@ func StartServer(ctx context.Context, db *pgxpool.Pool, cfg *app.Config, startGa
 	}
 
 	ledgerInstrumentator := instrumentators.NewLedgerInstrumentator(nr)
-	ledgerRepository := ledger.NewRepository(db, ledgerInstrumentator)
-	ledgerUsecase := usecases.NewLedgerUseCase(ledgerRepository, ledgerInstrumentator)
+	classes, err := vos.ParseAccountClasses(cfg.Ledger.AccountClasses...)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid account classes")
+	}
+
+	if err = vos.RegisterAccountClasses(classes...); err != nil {
+		log.Fatal().Err(err).Msg("failed to register account classes")
+	}
+
+	chart, err := vos.NewChartOfAccounts(cfg.Ledger.ChartOfAccounts...)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid chart of accounts")
+	}
+
+	useCaseOpts := []usecases.Option{usecases.WithChartOfAccounts(chart)}
+
+	// fiscal periods can't be closed without a retained earnings account
+	if cfg.Ledger.RetainedEarningsAccount != "" {
+		retainedEarnings, accErr := vos.NewAnalyticAccount(cfg.Ledger.RetainedEarningsAccount)
+		if accErr != nil {
+			log.Fatal().Err(accErr).Msg("invalid retained earnings account")
+		}
+
+		useCaseOpts = append(useCaseOpts, usecases.WithRetainedEarningsAccount(retainedEarnings))
+	}
+
+	ledgerRepository := ledger.NewRepository(
+		db,
+		ledgerInstrumentator,
+		ledger.WithStrictAccounts(cfg.Ledger.StrictAccounts),
+		ledger.WithPendingTransactionTTL(cfg.Ledger.PendingTransactionTTL),
+		ledger.WithScheduleClaimTimeout(cfg.Ledger.Scheduler.ClaimTimeout),
+	)
+	ledgerUsecase := usecases.NewLedgerUseCase(ledgerRepository, ledgerInstrumentator, useCaseOpts...)
 
 	listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", cfg.RPCServer.Host, cfg.RPCServer.Port))
 	if err != nil {
@@ -17,6 +17,7 @@ import (
 	"github.com/stone-co/the-amazing-ledger/app"
 	"github.com/stone-co/the-amazing-ledger/app/domain/instrumentators"
 	"github.com/stone-co/the-amazing-ledger/app/domain/usecases"
+	"github.com/stone-co/the-amazing-ledger/app/domain/vos"
 	"github.com/stone-co/the-amazing-ledger/app/gateways/db/postgres"
 	"github.com/stone-co/the-amazing-ledger/app/gateways/rpc"
 	"github.com/stone-co/the-amazing-ledger/app/instrumentation/newrelic"
@@ -63,8 +64,40 @@ func main() {
 		logger.Panic().Err(err).Msg("failed to listen")
 	}
 
-	ledgerRepository := ledger.NewRepository(conn, ledgerInstrumentator)
-	ledgerUseCase := usecases.NewLedgerUseCase(ledgerRepository, ledgerInstrumentator)
+	classes, err := vos.ParseAccountClasses(cfg.Ledger.AccountClasses...)
+	if err != nil {
+		logger.Panic().Err(err).Msg("invalid account classes")
+	}
+
+	if err = vos.RegisterAccountClasses(classes...); err != nil {
+		logger.Panic().Err(err).Msg("failed to register account classes")
+	}
+
+	chart, err := vos.NewChartOfAccounts(cfg.Ledger.ChartOfAccounts...)
+	if err != nil {
+		logger.Panic().Err(err).Msg("invalid chart of accounts")
+	}
+
+	useCaseOpts := []usecases.Option{usecases.WithChartOfAccounts(chart)}
+
+	// fiscal periods can't be closed without a retained earnings account
+	if cfg.Ledger.RetainedEarningsAccount != "" {
+		retainedEarnings, accErr := vos.NewAnalyticAccount(cfg.Ledger.RetainedEarningsAccount)
+		if accErr != nil {
+			logger.Panic().Err(accErr).Msg("invalid retained earnings account")
+		}
+
+		useCaseOpts = append(useCaseOpts, usecases.WithRetainedEarningsAccount(retainedEarnings))
+	}
+
+	ledgerRepository := ledger.NewRepository(
+		conn,
+		ledgerInstrumentator,
+		ledger.WithStrictAccounts(cfg.Ledger.StrictAccounts),
+		ledger.WithPendingTransactionTTL(cfg.Ledger.PendingTransactionTTL),
+		ledger.WithScheduleClaimTimeout(cfg.Ledger.Scheduler.ClaimTimeout),
+	)
+	ledgerUseCase := usecases.NewLedgerUseCase(ledgerRepository, ledgerInstrumentator, useCaseOpts...)
 
 	rpcServer, gwServer, err := rpc.NewServer(ctx, ledgerUseCase, nr, cfg, BuildGitCommit, BuildTime)
 	if err != nil {
@@ -96,6 +129,11 @@ func main() {
 		logger.Info().Msg("gateway stopped")
 	}()
 
+	if cfg.Ledger.Scheduler.Interval > 0 && cfg.Ledger.Scheduler.BatchSize > 0 {
+		go newScheduler(ledgerUseCase, cfg.Ledger.Scheduler, logger.With().Str("module", "scheduler").Logger()).run(ctx)
+		logger.Info().Msg("scheduler started")
+	}
+
 	go handleInterrupt(cancel)
 
 	logger.Info().Msg("gatewayServer up")
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/stone-co/the-amazing-ledger/app"
+	"github.com/stone-co/the-amazing-ledger/app/domain"
+)
+
+// scheduler posts the scheduled transactions as they come due. Several server instances can run
+// it at the same time, as due transactions are claimed before being posted.
+type scheduler struct {
+	useCase   domain.UseCase
+	interval  time.Duration
+	batchSize int
+	logger    zerolog.Logger
+}
+
+func newScheduler(useCase domain.UseCase, cfg app.SchedulerConfig, logger zerolog.Logger) scheduler {
+	return scheduler{
+		useCase:   useCase,
+		interval:  cfg.Interval,
+		batchSize: cfg.BatchSize,
+		logger:    logger,
+	}
+}
+
+// run polls for due transactions until the context is canceled.
+func (s scheduler) run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info().Msg("scheduler stopped")
+			return
+		case <-ticker.C:
+			s.postDueTransactions(ctx)
+		}
+	}
+}
+
+// postDueTransactions posts claimed batches until there are no due transactions left.
+func (s scheduler) postDueTransactions(ctx context.Context) {
+	for ctx.Err() == nil {
+		due, err := s.useCase.ClaimDueScheduledTransactions(ctx, s.batchSize)
+		if err != nil {
+			s.logger.Error().Err(err).Msg("failed to claim due scheduled transactions")
+			return
+		}
+
+		for _, scheduled := range due {
+			if err = s.useCase.PostScheduledTransaction(ctx, scheduled); err != nil {
+				s.logger.Error().Err(err).Str("transaction_id", scheduled.Transaction.ID.String()).Msg("failed to post scheduled transaction")
+			}
+		}
+
+		if len(due) < s.batchSize {
+			return
+		}
+	}
+}
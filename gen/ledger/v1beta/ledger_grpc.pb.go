@@ -19,9 +19,23 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type LedgerAPIClient interface {
 	CreateTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*CreateTransactionResponse, error)
+	CreateTransactions(ctx context.Context, in *CreateTransactionsRequest, opts ...grpc.CallOption) (*CreateTransactionsResponse, error)
+	StreamTransactions(ctx context.Context, opts ...grpc.CallOption) (LedgerAPI_StreamTransactionsClient, error)
 	GetAccountBalance(ctx context.Context, in *GetAccountBalanceRequest, opts ...grpc.CallOption) (*GetAccountBalanceResponse, error)
+	GetBalanceHistory(ctx context.Context, in *GetBalanceHistoryRequest, opts ...grpc.CallOption) (*GetBalanceHistoryResponse, error)
 	ListAccountEntries(ctx context.Context, in *ListAccountEntriesRequest, opts ...grpc.CallOption) (*ListAccountEntriesResponse, error)
 	GetSyntheticReport(ctx context.Context, in *GetSyntheticReportRequest, opts ...grpc.CallOption) (*GetSyntheticReportResponse, error)
+	GetTrialBalance(ctx context.Context, in *GetTrialBalanceRequest, opts ...grpc.CallOption) (*GetTrialBalanceResponse, error)
+	GetBalanceSheet(ctx context.Context, in *GetBalanceSheetRequest, opts ...grpc.CallOption) (*GetBalanceSheetResponse, error)
+	GetIncomeStatement(ctx context.Context, in *GetIncomeStatementRequest, opts ...grpc.CallOption) (*GetIncomeStatementResponse, error)
+	RevertTransaction(ctx context.Context, in *RevertTransactionRequest, opts ...grpc.CallOption) (*RevertTransactionResponse, error)
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
+	AuthorizeTransaction(ctx context.Context, in *AuthorizeTransactionRequest, opts ...grpc.CallOption) (*AuthorizeTransactionResponse, error)
+	CaptureTransaction(ctx context.Context, in *CaptureTransactionRequest, opts ...grpc.CallOption) (*CaptureTransactionResponse, error)
+	VoidTransaction(ctx context.Context, in *VoidTransactionRequest, opts ...grpc.CallOption) (*VoidTransactionResponse, error)
+	ScheduleTransaction(ctx context.Context, in *ScheduleTransactionRequest, opts ...grpc.CallOption) (*ScheduleTransactionResponse, error)
+	CancelScheduledTransaction(ctx context.Context, in *CancelScheduledTransactionRequest, opts ...grpc.CallOption) (*CancelScheduledTransactionResponse, error)
+	ListScheduledTransactions(ctx context.Context, in *ListScheduledTransactionsRequest, opts ...grpc.CallOption) (*ListScheduledTransactionsResponse, error)
 }
 
 type ledgerAPIClient struct {
@@ -41,6 +55,46 @@ func (c *ledgerAPIClient) CreateTransaction(ctx context.Context, in *CreateTrans
 	return out, nil
 }
 
+func (c *ledgerAPIClient) CreateTransactions(ctx context.Context, in *CreateTransactionsRequest, opts ...grpc.CallOption) (*CreateTransactionsResponse, error) {
+	out := new(CreateTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.LedgerAPI/CreateTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerAPIClient) StreamTransactions(ctx context.Context, opts ...grpc.CallOption) (LedgerAPI_StreamTransactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LedgerAPI_ServiceDesc.Streams[0], "/ledger.v1beta.LedgerAPI/StreamTransactions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ledgerAPIStreamTransactionsClient{stream}
+	return x, nil
+}
+
+type LedgerAPI_StreamTransactionsClient interface {
+	Send(*StreamTransactionsRequest) error
+	Recv() (*StreamTransactionsResponse, error)
+	grpc.ClientStream
+}
+
+type ledgerAPIStreamTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *ledgerAPIStreamTransactionsClient) Send(m *StreamTransactionsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *ledgerAPIStreamTransactionsClient) Recv() (*StreamTransactionsResponse, error) {
+	m := new(StreamTransactionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *ledgerAPIClient) GetAccountBalance(ctx context.Context, in *GetAccountBalanceRequest, opts ...grpc.CallOption) (*GetAccountBalanceResponse, error) {
 	out := new(GetAccountBalanceResponse)
 	err := c.cc.Invoke(ctx, "/ledger.v1beta.LedgerAPI/GetAccountBalance", in, out, opts...)
@@ -50,6 +104,15 @@ func (c *ledgerAPIClient) GetAccountBalance(ctx context.Context, in *GetAccountB
 	return out, nil
 }
 
+func (c *ledgerAPIClient) GetBalanceHistory(ctx context.Context, in *GetBalanceHistoryRequest, opts ...grpc.CallOption) (*GetBalanceHistoryResponse, error) {
+	out := new(GetBalanceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.LedgerAPI/GetBalanceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ledgerAPIClient) ListAccountEntries(ctx context.Context, in *ListAccountEntriesRequest, opts ...grpc.CallOption) (*ListAccountEntriesResponse, error) {
 	out := new(ListAccountEntriesResponse)
 	err := c.cc.Invoke(ctx, "/ledger.v1beta.LedgerAPI/ListAccountEntries", in, out, opts...)
@@ -68,14 +131,127 @@ func (c *ledgerAPIClient) GetSyntheticReport(ctx context.Context, in *GetSynthet
 	return out, nil
 }
 
+func (c *ledgerAPIClient) GetTrialBalance(ctx context.Context, in *GetTrialBalanceRequest, opts ...grpc.CallOption) (*GetTrialBalanceResponse, error) {
+	out := new(GetTrialBalanceResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.LedgerAPI/GetTrialBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerAPIClient) GetBalanceSheet(ctx context.Context, in *GetBalanceSheetRequest, opts ...grpc.CallOption) (*GetBalanceSheetResponse, error) {
+	out := new(GetBalanceSheetResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.LedgerAPI/GetBalanceSheet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerAPIClient) GetIncomeStatement(ctx context.Context, in *GetIncomeStatementRequest, opts ...grpc.CallOption) (*GetIncomeStatementResponse, error) {
+	out := new(GetIncomeStatementResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.LedgerAPI/GetIncomeStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerAPIClient) RevertTransaction(ctx context.Context, in *RevertTransactionRequest, opts ...grpc.CallOption) (*RevertTransactionResponse, error) {
+	out := new(RevertTransactionResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.LedgerAPI/RevertTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerAPIClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error) {
+	out := new(GetTransactionResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.LedgerAPI/GetTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerAPIClient) AuthorizeTransaction(ctx context.Context, in *AuthorizeTransactionRequest, opts ...grpc.CallOption) (*AuthorizeTransactionResponse, error) {
+	out := new(AuthorizeTransactionResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.LedgerAPI/AuthorizeTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerAPIClient) CaptureTransaction(ctx context.Context, in *CaptureTransactionRequest, opts ...grpc.CallOption) (*CaptureTransactionResponse, error) {
+	out := new(CaptureTransactionResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.LedgerAPI/CaptureTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerAPIClient) VoidTransaction(ctx context.Context, in *VoidTransactionRequest, opts ...grpc.CallOption) (*VoidTransactionResponse, error) {
+	out := new(VoidTransactionResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.LedgerAPI/VoidTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerAPIClient) ScheduleTransaction(ctx context.Context, in *ScheduleTransactionRequest, opts ...grpc.CallOption) (*ScheduleTransactionResponse, error) {
+	out := new(ScheduleTransactionResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.LedgerAPI/ScheduleTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerAPIClient) CancelScheduledTransaction(ctx context.Context, in *CancelScheduledTransactionRequest, opts ...grpc.CallOption) (*CancelScheduledTransactionResponse, error) {
+	out := new(CancelScheduledTransactionResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.LedgerAPI/CancelScheduledTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerAPIClient) ListScheduledTransactions(ctx context.Context, in *ListScheduledTransactionsRequest, opts ...grpc.CallOption) (*ListScheduledTransactionsResponse, error) {
+	out := new(ListScheduledTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.LedgerAPI/ListScheduledTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LedgerAPIServer is the server API for LedgerAPI service.
 // All implementations should embed UnimplementedLedgerAPIServer
 // for forward compatibility
 type LedgerAPIServer interface {
 	CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionResponse, error)
+	CreateTransactions(context.Context, *CreateTransactionsRequest) (*CreateTransactionsResponse, error)
+	StreamTransactions(LedgerAPI_StreamTransactionsServer) error
 	GetAccountBalance(context.Context, *GetAccountBalanceRequest) (*GetAccountBalanceResponse, error)
+	GetBalanceHistory(context.Context, *GetBalanceHistoryRequest) (*GetBalanceHistoryResponse, error)
 	ListAccountEntries(context.Context, *ListAccountEntriesRequest) (*ListAccountEntriesResponse, error)
 	GetSyntheticReport(context.Context, *GetSyntheticReportRequest) (*GetSyntheticReportResponse, error)
+	GetTrialBalance(context.Context, *GetTrialBalanceRequest) (*GetTrialBalanceResponse, error)
+	GetBalanceSheet(context.Context, *GetBalanceSheetRequest) (*GetBalanceSheetResponse, error)
+	GetIncomeStatement(context.Context, *GetIncomeStatementRequest) (*GetIncomeStatementResponse, error)
+	RevertTransaction(context.Context, *RevertTransactionRequest) (*RevertTransactionResponse, error)
+	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
+	AuthorizeTransaction(context.Context, *AuthorizeTransactionRequest) (*AuthorizeTransactionResponse, error)
+	CaptureTransaction(context.Context, *CaptureTransactionRequest) (*CaptureTransactionResponse, error)
+	VoidTransaction(context.Context, *VoidTransactionRequest) (*VoidTransactionResponse, error)
+	ScheduleTransaction(context.Context, *ScheduleTransactionRequest) (*ScheduleTransactionResponse, error)
+	CancelScheduledTransaction(context.Context, *CancelScheduledTransactionRequest) (*CancelScheduledTransactionResponse, error)
+	ListScheduledTransactions(context.Context, *ListScheduledTransactionsRequest) (*ListScheduledTransactionsResponse, error)
 }
 
 // UnimplementedLedgerAPIServer should be embedded to have forward compatible implementations.
@@ -85,15 +261,57 @@ type UnimplementedLedgerAPIServer struct {
 func (UnimplementedLedgerAPIServer) CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTransaction not implemented")
 }
+func (UnimplementedLedgerAPIServer) CreateTransactions(context.Context, *CreateTransactionsRequest) (*CreateTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransactions not implemented")
+}
+func (UnimplementedLedgerAPIServer) StreamTransactions(LedgerAPI_StreamTransactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTransactions not implemented")
+}
 func (UnimplementedLedgerAPIServer) GetAccountBalance(context.Context, *GetAccountBalanceRequest) (*GetAccountBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccountBalance not implemented")
 }
+func (UnimplementedLedgerAPIServer) GetBalanceHistory(context.Context, *GetBalanceHistoryRequest) (*GetBalanceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceHistory not implemented")
+}
 func (UnimplementedLedgerAPIServer) ListAccountEntries(context.Context, *ListAccountEntriesRequest) (*ListAccountEntriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAccountEntries not implemented")
 }
 func (UnimplementedLedgerAPIServer) GetSyntheticReport(context.Context, *GetSyntheticReportRequest) (*GetSyntheticReportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSyntheticReport not implemented")
 }
+func (UnimplementedLedgerAPIServer) GetTrialBalance(context.Context, *GetTrialBalanceRequest) (*GetTrialBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrialBalance not implemented")
+}
+func (UnimplementedLedgerAPIServer) GetBalanceSheet(context.Context, *GetBalanceSheetRequest) (*GetBalanceSheetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceSheet not implemented")
+}
+func (UnimplementedLedgerAPIServer) GetIncomeStatement(context.Context, *GetIncomeStatementRequest) (*GetIncomeStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncomeStatement not implemented")
+}
+func (UnimplementedLedgerAPIServer) RevertTransaction(context.Context, *RevertTransactionRequest) (*RevertTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertTransaction not implemented")
+}
+func (UnimplementedLedgerAPIServer) GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (UnimplementedLedgerAPIServer) AuthorizeTransaction(context.Context, *AuthorizeTransactionRequest) (*AuthorizeTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeTransaction not implemented")
+}
+func (UnimplementedLedgerAPIServer) CaptureTransaction(context.Context, *CaptureTransactionRequest) (*CaptureTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptureTransaction not implemented")
+}
+func (UnimplementedLedgerAPIServer) VoidTransaction(context.Context, *VoidTransactionRequest) (*VoidTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidTransaction not implemented")
+}
+func (UnimplementedLedgerAPIServer) ScheduleTransaction(context.Context, *ScheduleTransactionRequest) (*ScheduleTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleTransaction not implemented")
+}
+func (UnimplementedLedgerAPIServer) CancelScheduledTransaction(context.Context, *CancelScheduledTransactionRequest) (*CancelScheduledTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledTransaction not implemented")
+}
+func (UnimplementedLedgerAPIServer) ListScheduledTransactions(context.Context, *ListScheduledTransactionsRequest) (*ListScheduledTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledTransactions not implemented")
+}
 
 // UnsafeLedgerAPIServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to LedgerAPIServer will
@@ -124,6 +342,50 @@ func _LedgerAPI_CreateTransaction_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LedgerAPI_CreateTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerAPIServer).CreateTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.LedgerAPI/CreateTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerAPIServer).CreateTransactions(ctx, req.(*CreateTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerAPI_StreamTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LedgerAPIServer).StreamTransactions(&ledgerAPIStreamTransactionsServer{stream})
+}
+
+type LedgerAPI_StreamTransactionsServer interface {
+	Send(*StreamTransactionsResponse) error
+	Recv() (*StreamTransactionsRequest, error)
+	grpc.ServerStream
+}
+
+type ledgerAPIStreamTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *ledgerAPIStreamTransactionsServer) Send(m *StreamTransactionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *ledgerAPIStreamTransactionsServer) Recv() (*StreamTransactionsRequest, error) {
+	m := new(StreamTransactionsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _LedgerAPI_GetAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAccountBalanceRequest)
 	if err := dec(in); err != nil {
@@ -142,6 +404,24 @@ func _LedgerAPI_GetAccountBalance_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LedgerAPI_GetBalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerAPIServer).GetBalanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.LedgerAPI/GetBalanceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerAPIServer).GetBalanceHistory(ctx, req.(*GetBalanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LedgerAPI_ListAccountEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListAccountEntriesRequest)
 	if err := dec(in); err != nil {
@@ -178,28 +458,1423 @@ func _LedgerAPI_GetSyntheticReport_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-// LedgerAPI_ServiceDesc is the grpc.ServiceDesc for LedgerAPI service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var LedgerAPI_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "ledger.v1beta.LedgerAPI",
-	HandlerType: (*LedgerAPIServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateTransaction",
-			Handler:    _LedgerAPI_CreateTransaction_Handler,
-		},
-		{
-			MethodName: "GetAccountBalance",
-			Handler:    _LedgerAPI_GetAccountBalance_Handler,
-		},
-		{
-			MethodName: "ListAccountEntries",
-			Handler:    _LedgerAPI_ListAccountEntries_Handler,
-		},
-		{
-			MethodName: "GetSyntheticReport",
-			Handler:    _LedgerAPI_GetSyntheticReport_Handler,
+func _LedgerAPI_GetTrialBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrialBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerAPIServer).GetTrialBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.LedgerAPI/GetTrialBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerAPIServer).GetTrialBalance(ctx, req.(*GetTrialBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerAPI_GetBalanceSheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceSheetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerAPIServer).GetBalanceSheet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.LedgerAPI/GetBalanceSheet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerAPIServer).GetBalanceSheet(ctx, req.(*GetBalanceSheetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerAPI_GetIncomeStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncomeStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerAPIServer).GetIncomeStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.LedgerAPI/GetIncomeStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerAPIServer).GetIncomeStatement(ctx, req.(*GetIncomeStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerAPI_RevertTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerAPIServer).RevertTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.LedgerAPI/RevertTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerAPIServer).RevertTransaction(ctx, req.(*RevertTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerAPI_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerAPIServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.LedgerAPI/GetTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerAPIServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerAPI_AuthorizeTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerAPIServer).AuthorizeTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.LedgerAPI/AuthorizeTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerAPIServer).AuthorizeTransaction(ctx, req.(*AuthorizeTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerAPI_CaptureTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerAPIServer).CaptureTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.LedgerAPI/CaptureTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerAPIServer).CaptureTransaction(ctx, req.(*CaptureTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerAPI_VoidTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerAPIServer).VoidTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.LedgerAPI/VoidTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerAPIServer).VoidTransaction(ctx, req.(*VoidTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerAPI_ScheduleTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerAPIServer).ScheduleTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.LedgerAPI/ScheduleTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerAPIServer).ScheduleTransaction(ctx, req.(*ScheduleTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerAPI_CancelScheduledTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerAPIServer).CancelScheduledTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.LedgerAPI/CancelScheduledTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerAPIServer).CancelScheduledTransaction(ctx, req.(*CancelScheduledTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerAPI_ListScheduledTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerAPIServer).ListScheduledTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.LedgerAPI/ListScheduledTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerAPIServer).ListScheduledTransactions(ctx, req.(*ListScheduledTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LedgerAPI_ServiceDesc is the grpc.ServiceDesc for LedgerAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LedgerAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ledger.v1beta.LedgerAPI",
+	HandlerType: (*LedgerAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTransaction",
+			Handler:    _LedgerAPI_CreateTransaction_Handler,
+		},
+		{
+			MethodName: "CreateTransactions",
+			Handler:    _LedgerAPI_CreateTransactions_Handler,
+		},
+		{
+			MethodName: "GetAccountBalance",
+			Handler:    _LedgerAPI_GetAccountBalance_Handler,
+		},
+		{
+			MethodName: "GetBalanceHistory",
+			Handler:    _LedgerAPI_GetBalanceHistory_Handler,
+		},
+		{
+			MethodName: "ListAccountEntries",
+			Handler:    _LedgerAPI_ListAccountEntries_Handler,
+		},
+		{
+			MethodName: "GetSyntheticReport",
+			Handler:    _LedgerAPI_GetSyntheticReport_Handler,
+		},
+		{
+			MethodName: "GetTrialBalance",
+			Handler:    _LedgerAPI_GetTrialBalance_Handler,
+		},
+		{
+			MethodName: "GetBalanceSheet",
+			Handler:    _LedgerAPI_GetBalanceSheet_Handler,
+		},
+		{
+			MethodName: "GetIncomeStatement",
+			Handler:    _LedgerAPI_GetIncomeStatement_Handler,
+		},
+		{
+			MethodName: "RevertTransaction",
+			Handler:    _LedgerAPI_RevertTransaction_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _LedgerAPI_GetTransaction_Handler,
+		},
+		{
+			MethodName: "AuthorizeTransaction",
+			Handler:    _LedgerAPI_AuthorizeTransaction_Handler,
+		},
+		{
+			MethodName: "CaptureTransaction",
+			Handler:    _LedgerAPI_CaptureTransaction_Handler,
+		},
+		{
+			MethodName: "VoidTransaction",
+			Handler:    _LedgerAPI_VoidTransaction_Handler,
+		},
+		{
+			MethodName: "ScheduleTransaction",
+			Handler:    _LedgerAPI_ScheduleTransaction_Handler,
+		},
+		{
+			MethodName: "CancelScheduledTransaction",
+			Handler:    _LedgerAPI_CancelScheduledTransaction_Handler,
+		},
+		{
+			MethodName: "ListScheduledTransactions",
+			Handler:    _LedgerAPI_ListScheduledTransactions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamTransactions",
+			Handler:       _LedgerAPI_StreamTransactions_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "ledger/v1beta/ledger.proto",
+}
+
+// AccountAPIClient is the client API for AccountAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AccountAPIClient interface {
+	// OpenAccount registers an analytic account in the account registry.
+	OpenAccount(ctx context.Context, in *OpenAccountRequest, opts ...grpc.CallOption) (*OpenAccountResponse, error)
+	// DescribeAccount returns the registry data of an account.
+	DescribeAccount(ctx context.Context, in *DescribeAccountRequest, opts ...grpc.CallOption) (*DescribeAccountResponse, error)
+	// FreezeAccount temporarily blocks new entries into an active account.
+	FreezeAccount(ctx context.Context, in *FreezeAccountRequest, opts ...grpc.CallOption) (*FreezeAccountResponse, error)
+	// UnfreezeAccount allows a frozen account to receive entries again.
+	UnfreezeAccount(ctx context.Context, in *UnfreezeAccountRequest, opts ...grpc.CallOption) (*UnfreezeAccountResponse, error)
+	// CloseAccount permanently blocks new entries into an account.
+	CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*CloseAccountResponse, error)
+	// SetBalanceLimit creates or replaces the balance limit of an account (or account pattern) in a currency.
+	SetBalanceLimit(ctx context.Context, in *SetBalanceLimitRequest, opts ...grpc.CallOption) (*SetBalanceLimitResponse, error)
+	// DeleteBalanceLimit removes a balance limit.
+	DeleteBalanceLimit(ctx context.Context, in *DeleteBalanceLimitRequest, opts ...grpc.CallOption) (*DeleteBalanceLimitResponse, error)
+	// ListBalanceLimits returns all configured balance limits.
+	ListBalanceLimits(ctx context.Context, in *ListBalanceLimitsRequest, opts ...grpc.CallOption) (*ListBalanceLimitsResponse, error)
+	// ListChartOfAccounts returns the account patterns of the chart of accounts.
+	ListChartOfAccounts(ctx context.Context, in *ListChartOfAccountsRequest, opts ...grpc.CallOption) (*ListChartOfAccountsResponse, error)
+	// ListAccounts returns the analytic accounts known by the ledger matching an account query.
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	// ListChildren returns the labels right below a path of the account tree.
+	ListChildren(ctx context.Context, in *ListChildrenRequest, opts ...grpc.CallOption) (*ListChildrenResponse, error)
+}
+
+type accountAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccountAPIClient(cc grpc.ClientConnInterface) AccountAPIClient {
+	return &accountAPIClient{cc}
+}
+
+func (c *accountAPIClient) OpenAccount(ctx context.Context, in *OpenAccountRequest, opts ...grpc.CallOption) (*OpenAccountResponse, error) {
+	out := new(OpenAccountResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.AccountAPI/OpenAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) DescribeAccount(ctx context.Context, in *DescribeAccountRequest, opts ...grpc.CallOption) (*DescribeAccountResponse, error) {
+	out := new(DescribeAccountResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.AccountAPI/DescribeAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) FreezeAccount(ctx context.Context, in *FreezeAccountRequest, opts ...grpc.CallOption) (*FreezeAccountResponse, error) {
+	out := new(FreezeAccountResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.AccountAPI/FreezeAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) UnfreezeAccount(ctx context.Context, in *UnfreezeAccountRequest, opts ...grpc.CallOption) (*UnfreezeAccountResponse, error) {
+	out := new(UnfreezeAccountResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.AccountAPI/UnfreezeAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*CloseAccountResponse, error) {
+	out := new(CloseAccountResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.AccountAPI/CloseAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) SetBalanceLimit(ctx context.Context, in *SetBalanceLimitRequest, opts ...grpc.CallOption) (*SetBalanceLimitResponse, error) {
+	out := new(SetBalanceLimitResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.AccountAPI/SetBalanceLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) DeleteBalanceLimit(ctx context.Context, in *DeleteBalanceLimitRequest, opts ...grpc.CallOption) (*DeleteBalanceLimitResponse, error) {
+	out := new(DeleteBalanceLimitResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.AccountAPI/DeleteBalanceLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) ListBalanceLimits(ctx context.Context, in *ListBalanceLimitsRequest, opts ...grpc.CallOption) (*ListBalanceLimitsResponse, error) {
+	out := new(ListBalanceLimitsResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.AccountAPI/ListBalanceLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) ListChartOfAccounts(ctx context.Context, in *ListChartOfAccountsRequest, opts ...grpc.CallOption) (*ListChartOfAccountsResponse, error) {
+	out := new(ListChartOfAccountsResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.AccountAPI/ListChartOfAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.AccountAPI/ListAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountAPIClient) ListChildren(ctx context.Context, in *ListChildrenRequest, opts ...grpc.CallOption) (*ListChildrenResponse, error) {
+	out := new(ListChildrenResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.AccountAPI/ListChildren", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccountAPIServer is the server API for AccountAPI service.
+// All implementations should embed UnimplementedAccountAPIServer
+// for forward compatibility
+type AccountAPIServer interface {
+	// OpenAccount registers an analytic account in the account registry.
+	OpenAccount(context.Context, *OpenAccountRequest) (*OpenAccountResponse, error)
+	// DescribeAccount returns the registry data of an account.
+	DescribeAccount(context.Context, *DescribeAccountRequest) (*DescribeAccountResponse, error)
+	// FreezeAccount temporarily blocks new entries into an active account.
+	FreezeAccount(context.Context, *FreezeAccountRequest) (*FreezeAccountResponse, error)
+	// UnfreezeAccount allows a frozen account to receive entries again.
+	UnfreezeAccount(context.Context, *UnfreezeAccountRequest) (*UnfreezeAccountResponse, error)
+	// CloseAccount permanently blocks new entries into an account.
+	CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error)
+	// SetBalanceLimit creates or replaces the balance limit of an account (or account pattern) in a currency.
+	SetBalanceLimit(context.Context, *SetBalanceLimitRequest) (*SetBalanceLimitResponse, error)
+	// DeleteBalanceLimit removes a balance limit.
+	DeleteBalanceLimit(context.Context, *DeleteBalanceLimitRequest) (*DeleteBalanceLimitResponse, error)
+	// ListBalanceLimits returns all configured balance limits.
+	ListBalanceLimits(context.Context, *ListBalanceLimitsRequest) (*ListBalanceLimitsResponse, error)
+	// ListChartOfAccounts returns the account patterns of the chart of accounts.
+	ListChartOfAccounts(context.Context, *ListChartOfAccountsRequest) (*ListChartOfAccountsResponse, error)
+	// ListAccounts returns the analytic accounts known by the ledger matching an account query.
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	// ListChildren returns the labels right below a path of the account tree.
+	ListChildren(context.Context, *ListChildrenRequest) (*ListChildrenResponse, error)
+}
+
+// UnimplementedAccountAPIServer should be embedded to have forward compatible implementations.
+type UnimplementedAccountAPIServer struct {
+}
+
+func (UnimplementedAccountAPIServer) OpenAccount(context.Context, *OpenAccountRequest) (*OpenAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenAccount not implemented")
+}
+func (UnimplementedAccountAPIServer) DescribeAccount(context.Context, *DescribeAccountRequest) (*DescribeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeAccount not implemented")
+}
+func (UnimplementedAccountAPIServer) FreezeAccount(context.Context, *FreezeAccountRequest) (*FreezeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeAccount not implemented")
+}
+func (UnimplementedAccountAPIServer) UnfreezeAccount(context.Context, *UnfreezeAccountRequest) (*UnfreezeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeAccount not implemented")
+}
+func (UnimplementedAccountAPIServer) CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseAccount not implemented")
+}
+func (UnimplementedAccountAPIServer) SetBalanceLimit(context.Context, *SetBalanceLimitRequest) (*SetBalanceLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBalanceLimit not implemented")
+}
+func (UnimplementedAccountAPIServer) DeleteBalanceLimit(context.Context, *DeleteBalanceLimitRequest) (*DeleteBalanceLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBalanceLimit not implemented")
+}
+func (UnimplementedAccountAPIServer) ListBalanceLimits(context.Context, *ListBalanceLimitsRequest) (*ListBalanceLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBalanceLimits not implemented")
+}
+func (UnimplementedAccountAPIServer) ListChartOfAccounts(context.Context, *ListChartOfAccountsRequest) (*ListChartOfAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChartOfAccounts not implemented")
+}
+func (UnimplementedAccountAPIServer) ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
+}
+func (UnimplementedAccountAPIServer) ListChildren(context.Context, *ListChildrenRequest) (*ListChildrenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChildren not implemented")
+}
+
+// UnsafeAccountAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccountAPIServer will
+// result in compilation errors.
+type UnsafeAccountAPIServer interface {
+	mustEmbedUnimplementedAccountAPIServer()
+}
+
+func RegisterAccountAPIServer(s grpc.ServiceRegistrar, srv AccountAPIServer) {
+	s.RegisterService(&AccountAPI_ServiceDesc, srv)
+}
+
+func _AccountAPI_OpenAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).OpenAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.AccountAPI/OpenAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).OpenAccount(ctx, req.(*OpenAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_DescribeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).DescribeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.AccountAPI/DescribeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).DescribeAccount(ctx, req.(*DescribeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_FreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).FreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.AccountAPI/FreezeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).FreezeAccount(ctx, req.(*FreezeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_UnfreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).UnfreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.AccountAPI/UnfreezeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).UnfreezeAccount(ctx, req.(*UnfreezeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_CloseAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).CloseAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.AccountAPI/CloseAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).CloseAccount(ctx, req.(*CloseAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_SetBalanceLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBalanceLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).SetBalanceLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.AccountAPI/SetBalanceLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).SetBalanceLimit(ctx, req.(*SetBalanceLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_DeleteBalanceLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBalanceLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).DeleteBalanceLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.AccountAPI/DeleteBalanceLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).DeleteBalanceLimit(ctx, req.(*DeleteBalanceLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_ListBalanceLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBalanceLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).ListBalanceLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.AccountAPI/ListBalanceLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).ListBalanceLimits(ctx, req.(*ListBalanceLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_ListChartOfAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChartOfAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).ListChartOfAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.AccountAPI/ListChartOfAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).ListChartOfAccounts(ctx, req.(*ListChartOfAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.AccountAPI/ListAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountAPI_ListChildren_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChildrenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountAPIServer).ListChildren(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.AccountAPI/ListChildren",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountAPIServer).ListChildren(ctx, req.(*ListChildrenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AccountAPI_ServiceDesc is the grpc.ServiceDesc for AccountAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AccountAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ledger.v1beta.AccountAPI",
+	HandlerType: (*AccountAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OpenAccount",
+			Handler:    _AccountAPI_OpenAccount_Handler,
+		},
+		{
+			MethodName: "DescribeAccount",
+			Handler:    _AccountAPI_DescribeAccount_Handler,
+		},
+		{
+			MethodName: "FreezeAccount",
+			Handler:    _AccountAPI_FreezeAccount_Handler,
+		},
+		{
+			MethodName: "UnfreezeAccount",
+			Handler:    _AccountAPI_UnfreezeAccount_Handler,
+		},
+		{
+			MethodName: "CloseAccount",
+			Handler:    _AccountAPI_CloseAccount_Handler,
+		},
+		{
+			MethodName: "SetBalanceLimit",
+			Handler:    _AccountAPI_SetBalanceLimit_Handler,
+		},
+		{
+			MethodName: "DeleteBalanceLimit",
+			Handler:    _AccountAPI_DeleteBalanceLimit_Handler,
+		},
+		{
+			MethodName: "ListBalanceLimits",
+			Handler:    _AccountAPI_ListBalanceLimits_Handler,
+		},
+		{
+			MethodName: "ListChartOfAccounts",
+			Handler:    _AccountAPI_ListChartOfAccounts_Handler,
+		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _AccountAPI_ListAccounts_Handler,
+		},
+		{
+			MethodName: "ListChildren",
+			Handler:    _AccountAPI_ListChildren_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ledger/v1beta/ledger.proto",
+}
+
+// EventAPIClient is the client API for EventAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EventAPIClient interface {
+	// CreateEvent adds an event to the event catalog.
+	CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*CreateEventResponse, error)
+	// ListEvents returns the events of the catalog.
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	// DescribeEvent returns an event of the catalog.
+	DescribeEvent(ctx context.Context, in *DescribeEventRequest, opts ...grpc.CallOption) (*DescribeEventResponse, error)
+	// DeprecateEvent blocks an event from being used by new postings.
+	DeprecateEvent(ctx context.Context, in *DeprecateEventRequest, opts ...grpc.CallOption) (*DeprecateEventResponse, error)
+	// SetEventMetadataSchema adds a new version of the JSON Schema that the metadata of every entry
+	// posted by the event must match from then on.
+	SetEventMetadataSchema(ctx context.Context, in *SetEventMetadataSchemaRequest, opts ...grpc.CallOption) (*SetEventMetadataSchemaResponse, error)
+	// ListEventMetadataSchemas returns the versions of the JSON Schema of an event.
+	ListEventMetadataSchemas(ctx context.Context, in *ListEventMetadataSchemasRequest, opts ...grpc.CallOption) (*ListEventMetadataSchemasResponse, error)
+}
+
+type eventAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventAPIClient(cc grpc.ClientConnInterface) EventAPIClient {
+	return &eventAPIClient{cc}
+}
+
+func (c *eventAPIClient) CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*CreateEventResponse, error) {
+	out := new(CreateEventResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.EventAPI/CreateEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventAPIClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.EventAPI/ListEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventAPIClient) DescribeEvent(ctx context.Context, in *DescribeEventRequest, opts ...grpc.CallOption) (*DescribeEventResponse, error) {
+	out := new(DescribeEventResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.EventAPI/DescribeEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventAPIClient) DeprecateEvent(ctx context.Context, in *DeprecateEventRequest, opts ...grpc.CallOption) (*DeprecateEventResponse, error) {
+	out := new(DeprecateEventResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.EventAPI/DeprecateEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventAPIClient) SetEventMetadataSchema(ctx context.Context, in *SetEventMetadataSchemaRequest, opts ...grpc.CallOption) (*SetEventMetadataSchemaResponse, error) {
+	out := new(SetEventMetadataSchemaResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.EventAPI/SetEventMetadataSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventAPIClient) ListEventMetadataSchemas(ctx context.Context, in *ListEventMetadataSchemasRequest, opts ...grpc.CallOption) (*ListEventMetadataSchemasResponse, error) {
+	out := new(ListEventMetadataSchemasResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.EventAPI/ListEventMetadataSchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventAPIServer is the server API for EventAPI service.
+// All implementations should embed UnimplementedEventAPIServer
+// for forward compatibility
+type EventAPIServer interface {
+	// CreateEvent adds an event to the event catalog.
+	CreateEvent(context.Context, *CreateEventRequest) (*CreateEventResponse, error)
+	// ListEvents returns the events of the catalog.
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	// DescribeEvent returns an event of the catalog.
+	DescribeEvent(context.Context, *DescribeEventRequest) (*DescribeEventResponse, error)
+	// DeprecateEvent blocks an event from being used by new postings.
+	DeprecateEvent(context.Context, *DeprecateEventRequest) (*DeprecateEventResponse, error)
+	// SetEventMetadataSchema adds a new version of the JSON Schema that the metadata of every entry
+	// posted by the event must match from then on.
+	SetEventMetadataSchema(context.Context, *SetEventMetadataSchemaRequest) (*SetEventMetadataSchemaResponse, error)
+	// ListEventMetadataSchemas returns the versions of the JSON Schema of an event.
+	ListEventMetadataSchemas(context.Context, *ListEventMetadataSchemasRequest) (*ListEventMetadataSchemasResponse, error)
+}
+
+// UnimplementedEventAPIServer should be embedded to have forward compatible implementations.
+type UnimplementedEventAPIServer struct {
+}
+
+func (UnimplementedEventAPIServer) CreateEvent(context.Context, *CreateEventRequest) (*CreateEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEvent not implemented")
+}
+func (UnimplementedEventAPIServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedEventAPIServer) DescribeEvent(context.Context, *DescribeEventRequest) (*DescribeEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeEvent not implemented")
+}
+func (UnimplementedEventAPIServer) DeprecateEvent(context.Context, *DeprecateEventRequest) (*DeprecateEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeprecateEvent not implemented")
+}
+func (UnimplementedEventAPIServer) SetEventMetadataSchema(context.Context, *SetEventMetadataSchemaRequest) (*SetEventMetadataSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEventMetadataSchema not implemented")
+}
+func (UnimplementedEventAPIServer) ListEventMetadataSchemas(context.Context, *ListEventMetadataSchemasRequest) (*ListEventMetadataSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventMetadataSchemas not implemented")
+}
+
+// UnsafeEventAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventAPIServer will
+// result in compilation errors.
+type UnsafeEventAPIServer interface {
+	mustEmbedUnimplementedEventAPIServer()
+}
+
+func RegisterEventAPIServer(s grpc.ServiceRegistrar, srv EventAPIServer) {
+	s.RegisterService(&EventAPI_ServiceDesc, srv)
+}
+
+func _EventAPI_CreateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventAPIServer).CreateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.EventAPI/CreateEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventAPIServer).CreateEvent(ctx, req.(*CreateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventAPI_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventAPIServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.EventAPI/ListEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventAPIServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventAPI_DescribeEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventAPIServer).DescribeEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.EventAPI/DescribeEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventAPIServer).DescribeEvent(ctx, req.(*DescribeEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventAPI_DeprecateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeprecateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventAPIServer).DeprecateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.EventAPI/DeprecateEvent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventAPIServer).DeprecateEvent(ctx, req.(*DeprecateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventAPI_SetEventMetadataSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEventMetadataSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventAPIServer).SetEventMetadataSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.EventAPI/SetEventMetadataSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventAPIServer).SetEventMetadataSchema(ctx, req.(*SetEventMetadataSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventAPI_ListEventMetadataSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventMetadataSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventAPIServer).ListEventMetadataSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.EventAPI/ListEventMetadataSchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventAPIServer).ListEventMetadataSchemas(ctx, req.(*ListEventMetadataSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EventAPI_ServiceDesc is the grpc.ServiceDesc for EventAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EventAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ledger.v1beta.EventAPI",
+	HandlerType: (*EventAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateEvent",
+			Handler:    _EventAPI_CreateEvent_Handler,
+		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _EventAPI_ListEvents_Handler,
+		},
+		{
+			MethodName: "DescribeEvent",
+			Handler:    _EventAPI_DescribeEvent_Handler,
+		},
+		{
+			MethodName: "DeprecateEvent",
+			Handler:    _EventAPI_DeprecateEvent_Handler,
+		},
+		{
+			MethodName: "SetEventMetadataSchema",
+			Handler:    _EventAPI_SetEventMetadataSchema_Handler,
+		},
+		{
+			MethodName: "ListEventMetadataSchemas",
+			Handler:    _EventAPI_ListEventMetadataSchemas_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ledger/v1beta/ledger.proto",
+}
+
+// FiscalPeriodAPIClient is the client API for FiscalPeriodAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FiscalPeriodAPIClient interface {
+	// CreateFiscalPeriod adds a period to the fiscal calendar of a company.
+	CreateFiscalPeriod(ctx context.Context, in *CreateFiscalPeriodRequest, opts ...grpc.CallOption) (*CreateFiscalPeriodResponse, error)
+	// ListFiscalPeriods returns the fiscal calendar of a company.
+	ListFiscalPeriods(ctx context.Context, in *ListFiscalPeriodsRequest, opts ...grpc.CallOption) (*ListFiscalPeriodsResponse, error)
+	// DescribeFiscalPeriod returns a fiscal period along with its closes and reopens.
+	DescribeFiscalPeriod(ctx context.Context, in *DescribeFiscalPeriodRequest, opts ...grpc.CallOption) (*DescribeFiscalPeriodResponse, error)
+	// CloseFiscalPeriod zeroes the income statement accounts of the company into the retained earnings
+	// account, as of the end of the period, and rejects new entries within it.
+	CloseFiscalPeriod(ctx context.Context, in *CloseFiscalPeriodRequest, opts ...grpc.CallOption) (*CloseFiscalPeriodResponse, error)
+	// ReopenFiscalPeriod accepts new entries within a closed period again. It's an audited admin operation.
+	ReopenFiscalPeriod(ctx context.Context, in *ReopenFiscalPeriodRequest, opts ...grpc.CallOption) (*ReopenFiscalPeriodResponse, error)
+	// SetCompetenceLock moves the lock date of a company, or of one of its events. Transactions with competence
+	// dates on or before it are rejected, unless they're adjustments.
+	SetCompetenceLock(ctx context.Context, in *SetCompetenceLockRequest, opts ...grpc.CallOption) (*SetCompetenceLockResponse, error)
+	// ClearCompetenceLock removes the lock date of a company, or of one of its events.
+	ClearCompetenceLock(ctx context.Context, in *ClearCompetenceLockRequest, opts ...grpc.CallOption) (*ClearCompetenceLockResponse, error)
+	// ListCompetenceLocks returns the lock dates of a company along with who moved them.
+	ListCompetenceLocks(ctx context.Context, in *ListCompetenceLocksRequest, opts ...grpc.CallOption) (*ListCompetenceLocksResponse, error)
+	// PostAdjustment posts a transaction regardless of the competence locks. It's an audited admin operation.
+	PostAdjustment(ctx context.Context, in *PostAdjustmentRequest, opts ...grpc.CallOption) (*PostAdjustmentResponse, error)
+}
+
+type fiscalPeriodAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFiscalPeriodAPIClient(cc grpc.ClientConnInterface) FiscalPeriodAPIClient {
+	return &fiscalPeriodAPIClient{cc}
+}
+
+func (c *fiscalPeriodAPIClient) CreateFiscalPeriod(ctx context.Context, in *CreateFiscalPeriodRequest, opts ...grpc.CallOption) (*CreateFiscalPeriodResponse, error) {
+	out := new(CreateFiscalPeriodResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.FiscalPeriodAPI/CreateFiscalPeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fiscalPeriodAPIClient) ListFiscalPeriods(ctx context.Context, in *ListFiscalPeriodsRequest, opts ...grpc.CallOption) (*ListFiscalPeriodsResponse, error) {
+	out := new(ListFiscalPeriodsResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.FiscalPeriodAPI/ListFiscalPeriods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fiscalPeriodAPIClient) DescribeFiscalPeriod(ctx context.Context, in *DescribeFiscalPeriodRequest, opts ...grpc.CallOption) (*DescribeFiscalPeriodResponse, error) {
+	out := new(DescribeFiscalPeriodResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.FiscalPeriodAPI/DescribeFiscalPeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fiscalPeriodAPIClient) CloseFiscalPeriod(ctx context.Context, in *CloseFiscalPeriodRequest, opts ...grpc.CallOption) (*CloseFiscalPeriodResponse, error) {
+	out := new(CloseFiscalPeriodResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.FiscalPeriodAPI/CloseFiscalPeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fiscalPeriodAPIClient) ReopenFiscalPeriod(ctx context.Context, in *ReopenFiscalPeriodRequest, opts ...grpc.CallOption) (*ReopenFiscalPeriodResponse, error) {
+	out := new(ReopenFiscalPeriodResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.FiscalPeriodAPI/ReopenFiscalPeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fiscalPeriodAPIClient) SetCompetenceLock(ctx context.Context, in *SetCompetenceLockRequest, opts ...grpc.CallOption) (*SetCompetenceLockResponse, error) {
+	out := new(SetCompetenceLockResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.FiscalPeriodAPI/SetCompetenceLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fiscalPeriodAPIClient) ClearCompetenceLock(ctx context.Context, in *ClearCompetenceLockRequest, opts ...grpc.CallOption) (*ClearCompetenceLockResponse, error) {
+	out := new(ClearCompetenceLockResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.FiscalPeriodAPI/ClearCompetenceLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fiscalPeriodAPIClient) ListCompetenceLocks(ctx context.Context, in *ListCompetenceLocksRequest, opts ...grpc.CallOption) (*ListCompetenceLocksResponse, error) {
+	out := new(ListCompetenceLocksResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.FiscalPeriodAPI/ListCompetenceLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fiscalPeriodAPIClient) PostAdjustment(ctx context.Context, in *PostAdjustmentRequest, opts ...grpc.CallOption) (*PostAdjustmentResponse, error) {
+	out := new(PostAdjustmentResponse)
+	err := c.cc.Invoke(ctx, "/ledger.v1beta.FiscalPeriodAPI/PostAdjustment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FiscalPeriodAPIServer is the server API for FiscalPeriodAPI service.
+// All implementations should embed UnimplementedFiscalPeriodAPIServer
+// for forward compatibility
+type FiscalPeriodAPIServer interface {
+	// CreateFiscalPeriod adds a period to the fiscal calendar of a company.
+	CreateFiscalPeriod(context.Context, *CreateFiscalPeriodRequest) (*CreateFiscalPeriodResponse, error)
+	// ListFiscalPeriods returns the fiscal calendar of a company.
+	ListFiscalPeriods(context.Context, *ListFiscalPeriodsRequest) (*ListFiscalPeriodsResponse, error)
+	// DescribeFiscalPeriod returns a fiscal period along with its closes and reopens.
+	DescribeFiscalPeriod(context.Context, *DescribeFiscalPeriodRequest) (*DescribeFiscalPeriodResponse, error)
+	// CloseFiscalPeriod zeroes the income statement accounts of the company into the retained earnings
+	// account, as of the end of the period, and rejects new entries within it.
+	CloseFiscalPeriod(context.Context, *CloseFiscalPeriodRequest) (*CloseFiscalPeriodResponse, error)
+	// ReopenFiscalPeriod accepts new entries within a closed period again. It's an audited admin operation.
+	ReopenFiscalPeriod(context.Context, *ReopenFiscalPeriodRequest) (*ReopenFiscalPeriodResponse, error)
+	// SetCompetenceLock moves the lock date of a company, or of one of its events. Transactions with competence
+	// dates on or before it are rejected, unless they're adjustments.
+	SetCompetenceLock(context.Context, *SetCompetenceLockRequest) (*SetCompetenceLockResponse, error)
+	// ClearCompetenceLock removes the lock date of a company, or of one of its events.
+	ClearCompetenceLock(context.Context, *ClearCompetenceLockRequest) (*ClearCompetenceLockResponse, error)
+	// ListCompetenceLocks returns the lock dates of a company along with who moved them.
+	ListCompetenceLocks(context.Context, *ListCompetenceLocksRequest) (*ListCompetenceLocksResponse, error)
+	// PostAdjustment posts a transaction regardless of the competence locks. It's an audited admin operation.
+	PostAdjustment(context.Context, *PostAdjustmentRequest) (*PostAdjustmentResponse, error)
+}
+
+// UnimplementedFiscalPeriodAPIServer should be embedded to have forward compatible implementations.
+type UnimplementedFiscalPeriodAPIServer struct {
+}
+
+func (UnimplementedFiscalPeriodAPIServer) CreateFiscalPeriod(context.Context, *CreateFiscalPeriodRequest) (*CreateFiscalPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFiscalPeriod not implemented")
+}
+func (UnimplementedFiscalPeriodAPIServer) ListFiscalPeriods(context.Context, *ListFiscalPeriodsRequest) (*ListFiscalPeriodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFiscalPeriods not implemented")
+}
+func (UnimplementedFiscalPeriodAPIServer) DescribeFiscalPeriod(context.Context, *DescribeFiscalPeriodRequest) (*DescribeFiscalPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeFiscalPeriod not implemented")
+}
+func (UnimplementedFiscalPeriodAPIServer) CloseFiscalPeriod(context.Context, *CloseFiscalPeriodRequest) (*CloseFiscalPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseFiscalPeriod not implemented")
+}
+func (UnimplementedFiscalPeriodAPIServer) ReopenFiscalPeriod(context.Context, *ReopenFiscalPeriodRequest) (*ReopenFiscalPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenFiscalPeriod not implemented")
+}
+func (UnimplementedFiscalPeriodAPIServer) SetCompetenceLock(context.Context, *SetCompetenceLockRequest) (*SetCompetenceLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCompetenceLock not implemented")
+}
+func (UnimplementedFiscalPeriodAPIServer) ClearCompetenceLock(context.Context, *ClearCompetenceLockRequest) (*ClearCompetenceLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCompetenceLock not implemented")
+}
+func (UnimplementedFiscalPeriodAPIServer) ListCompetenceLocks(context.Context, *ListCompetenceLocksRequest) (*ListCompetenceLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompetenceLocks not implemented")
+}
+func (UnimplementedFiscalPeriodAPIServer) PostAdjustment(context.Context, *PostAdjustmentRequest) (*PostAdjustmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostAdjustment not implemented")
+}
+
+// UnsafeFiscalPeriodAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FiscalPeriodAPIServer will
+// result in compilation errors.
+type UnsafeFiscalPeriodAPIServer interface {
+	mustEmbedUnimplementedFiscalPeriodAPIServer()
+}
+
+func RegisterFiscalPeriodAPIServer(s grpc.ServiceRegistrar, srv FiscalPeriodAPIServer) {
+	s.RegisterService(&FiscalPeriodAPI_ServiceDesc, srv)
+}
+
+func _FiscalPeriodAPI_CreateFiscalPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFiscalPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FiscalPeriodAPIServer).CreateFiscalPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.FiscalPeriodAPI/CreateFiscalPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FiscalPeriodAPIServer).CreateFiscalPeriod(ctx, req.(*CreateFiscalPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FiscalPeriodAPI_ListFiscalPeriods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFiscalPeriodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FiscalPeriodAPIServer).ListFiscalPeriods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.FiscalPeriodAPI/ListFiscalPeriods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FiscalPeriodAPIServer).ListFiscalPeriods(ctx, req.(*ListFiscalPeriodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FiscalPeriodAPI_DescribeFiscalPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeFiscalPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FiscalPeriodAPIServer).DescribeFiscalPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.FiscalPeriodAPI/DescribeFiscalPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FiscalPeriodAPIServer).DescribeFiscalPeriod(ctx, req.(*DescribeFiscalPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FiscalPeriodAPI_CloseFiscalPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseFiscalPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FiscalPeriodAPIServer).CloseFiscalPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.FiscalPeriodAPI/CloseFiscalPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FiscalPeriodAPIServer).CloseFiscalPeriod(ctx, req.(*CloseFiscalPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FiscalPeriodAPI_ReopenFiscalPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReopenFiscalPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FiscalPeriodAPIServer).ReopenFiscalPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.FiscalPeriodAPI/ReopenFiscalPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FiscalPeriodAPIServer).ReopenFiscalPeriod(ctx, req.(*ReopenFiscalPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FiscalPeriodAPI_SetCompetenceLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCompetenceLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FiscalPeriodAPIServer).SetCompetenceLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.FiscalPeriodAPI/SetCompetenceLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FiscalPeriodAPIServer).SetCompetenceLock(ctx, req.(*SetCompetenceLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FiscalPeriodAPI_ClearCompetenceLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearCompetenceLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FiscalPeriodAPIServer).ClearCompetenceLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.FiscalPeriodAPI/ClearCompetenceLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FiscalPeriodAPIServer).ClearCompetenceLock(ctx, req.(*ClearCompetenceLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FiscalPeriodAPI_ListCompetenceLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompetenceLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FiscalPeriodAPIServer).ListCompetenceLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.FiscalPeriodAPI/ListCompetenceLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FiscalPeriodAPIServer).ListCompetenceLocks(ctx, req.(*ListCompetenceLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FiscalPeriodAPI_PostAdjustment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostAdjustmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FiscalPeriodAPIServer).PostAdjustment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ledger.v1beta.FiscalPeriodAPI/PostAdjustment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FiscalPeriodAPIServer).PostAdjustment(ctx, req.(*PostAdjustmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FiscalPeriodAPI_ServiceDesc is the grpc.ServiceDesc for FiscalPeriodAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FiscalPeriodAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ledger.v1beta.FiscalPeriodAPI",
+	HandlerType: (*FiscalPeriodAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFiscalPeriod",
+			Handler:    _FiscalPeriodAPI_CreateFiscalPeriod_Handler,
+		},
+		{
+			MethodName: "ListFiscalPeriods",
+			Handler:    _FiscalPeriodAPI_ListFiscalPeriods_Handler,
+		},
+		{
+			MethodName: "DescribeFiscalPeriod",
+			Handler:    _FiscalPeriodAPI_DescribeFiscalPeriod_Handler,
+		},
+		{
+			MethodName: "CloseFiscalPeriod",
+			Handler:    _FiscalPeriodAPI_CloseFiscalPeriod_Handler,
+		},
+		{
+			MethodName: "ReopenFiscalPeriod",
+			Handler:    _FiscalPeriodAPI_ReopenFiscalPeriod_Handler,
+		},
+		{
+			MethodName: "SetCompetenceLock",
+			Handler:    _FiscalPeriodAPI_SetCompetenceLock_Handler,
+		},
+		{
+			MethodName: "ClearCompetenceLock",
+			Handler:    _FiscalPeriodAPI_ClearCompetenceLock_Handler,
+		},
+		{
+			MethodName: "ListCompetenceLocks",
+			Handler:    _FiscalPeriodAPI_ListCompetenceLocks_Handler,
+		},
+		{
+			MethodName: "PostAdjustment",
+			Handler:    _FiscalPeriodAPI_PostAdjustment_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},